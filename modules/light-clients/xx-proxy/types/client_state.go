@@ -8,11 +8,16 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
 	commitmenttypes "github.com/cosmos/ibc-go/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/modules/core/24-host"
 	"github.com/cosmos/ibc-go/modules/core/exported"
 )
 
 const ProxyClientType string = "proxyclient"
 
+// PacketReceiptAbsenceMarker is the value committed by the proxy at the path returned by PacketReceiptAbsencePath for a packet
+// whose receipt is verified to be absent on the upstream.
+var PacketReceiptAbsenceMarker = []byte{byte(1)}
+
 var _ exported.ClientState = (*ClientState)(nil)
 var _ codectypes.UnpackInterfacesMessage = (*ClientState)(nil)
 
@@ -41,6 +46,9 @@ func (cs *ClientState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	if err := unpacker.UnpackAny(cs.ProxyClientState, new(exported.ClientState)); err != nil {
 		return err
 	}
+	if cs.UpstreamClientProof != nil {
+		return cs.UpstreamClientProof.UnpackInterfaces(unpacker)
+	}
 	return nil
 }
 
@@ -56,6 +64,9 @@ func (cs *ClientState) Status(
 	clientStore sdk.KVStore,
 	cdc codec.BinaryCodec,
 ) exported.Status {
+	if !cs.FrozenHeight.IsZero() {
+		return exported.Frozen
+	}
 	return cs.GetProxyClientState().Status(ctx, NewProxyExtractorStore(cdc, clientStore), cdc)
 }
 
@@ -71,7 +82,7 @@ func (cs *ClientState) GetProofSpecs() []*ics23.ProofSpec {
 // Clients must validate the initial consensus state, and may store any client-specific metadata
 // necessary for correct light client operation
 func (cs *ClientState) Initialize(ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore, consState exported.ConsensusState) error {
-	if cs.ProxyClientState == nil || cs.IbcPrefix == nil || cs.ProxyPrefix == nil {
+	if cs.ProxyClientState == nil || cs.IbcPrefix == nil || cs.ProxyPrefix == nil || len(cs.UpstreamChainId) == 0 {
 		return sdkerrors.Wrap(clienttypes.ErrInvalidClient, "each fields of the clientState must be non-empty")
 	} else if consState == nil {
 		return sdkerrors.Wrap(clienttypes.ErrInvalidConsensus, "each fields of the consensusState must be non-empty")
@@ -87,6 +98,18 @@ func (cs *ClientState) Initialize(ctx sdk.Context, cdc codec.BinaryCodec, client
 	if _, err := clienttypes.UnpackConsensusState(cons.ProxyConsensusState); err != nil {
 		return sdkerrors.Wrapf(clienttypes.ErrInvalidConsensus, "failed to unpack client state: %v", err)
 	}
+
+	// the proxy must have the upstream client of the upstream chain at the height of the initial consensus state
+	if cs.UpstreamClientProof == nil {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidClient, "the client state must have the proof of the upstream client on creation")
+	}
+	if err := cs.verifyUpstreamClient(cdc, cons.GetRoot(), cs.UpstreamClientProof); err != nil {
+		return err
+	}
+	// the client state has been stored with the proof, so overwrite it with a copy without the proof
+	stored := *cs
+	stored.UpstreamClientProof = nil
+	clientStore.Set(host.ClientStateKey(), clienttypes.MustMarshalClientState(cdc, &stored))
 	return nil
 }
 
@@ -101,15 +124,48 @@ func (cs *ClientState) ExportMetadata(store sdk.KVStore) []exported.GenesisMetad
 // height of the current revision is somehow encoded in the proof verification process.
 // This is to ensure that no premature upgrades occur, since upgrade plans committed to by the counterparty
 // may be cancelled or modified before the last planned height.
+// The new states are the ones of the proxy client state that the proxy chain commits,
+// and the upgraded states are wrapped with the proxy fields of the current client.
 func (cs *ClientState) VerifyUpgradeAndUpdateState(ctx sdk.Context, cdc codec.BinaryCodec, store sdk.KVStore, newClient exported.ClientState, newConsState exported.ConsensusState, proofUpgradeClient []byte, proofUpgradeConsState []byte) (exported.ClientState, exported.ConsensusState, error) {
-	return cs.GetProxyClientState().VerifyUpgradeAndUpdateState(ctx, cdc, store, newClient, newConsState, proofUpgradeClient, proofUpgradeConsState)
+	clientState, consensusState, err := cs.GetProxyClientState().VerifyUpgradeAndUpdateState(ctx, cdc, NewProxyExtractorStore(cdc, store), newClient, newConsState, proofUpgradeClient, proofUpgradeConsState)
+	if err != nil {
+		return nil, nil, err
+	}
+	anyClientState, err := clienttypes.PackClientState(clientState)
+	if err != nil {
+		return nil, nil, err
+	}
+	anyConsensusState, err := clienttypes.PackConsensusState(consensusState)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &ClientState{
+		ProxyClientState:   anyClientState,
+		UpstreamClientId:   cs.UpstreamClientId,
+		ProxyPrefix:        cs.ProxyPrefix,
+		IbcPrefix:          cs.IbcPrefix,
+		UpstreamChainId:    cs.UpstreamChainId,
+		UpstreamClientType: cs.UpstreamClientType,
+	}, NewConsensusState(anyConsensusState), nil
 }
 
 // Utility function that zeroes out any client customizable fields in client state
 // Ledger enforced fields are maintained while all custom fields are zero values
 // Used to verify upgrades
+// The proxy fields are kept as they are determined by the proxy chain, while the frozen height is zeroed.
 func (cs *ClientState) ZeroCustomFields() exported.ClientState {
-	return cs.GetProxyClientState().ZeroCustomFields()
+	anyClientState, err := clienttypes.PackClientState(cs.GetProxyClientState().ZeroCustomFields())
+	if err != nil {
+		panic(err)
+	}
+	return &ClientState{
+		ProxyClientState:   anyClientState,
+		UpstreamClientId:   cs.UpstreamClientId,
+		ProxyPrefix:        cs.ProxyPrefix,
+		IbcPrefix:          cs.IbcPrefix,
+		UpstreamChainId:    cs.UpstreamChainId,
+		UpstreamClientType: cs.UpstreamClientType,
+	}
 }
 
 // IBC verification function
@@ -124,40 +180,60 @@ func (cs *ClientState) IBCVerifyClientConsensusState(store sdk.KVStore, cdc code
 
 // State verification functions
 func (cs *ClientState) VerifyClientState(store sdk.KVStore, cdc codec.BinaryCodec, height exported.Height, prefix exported.Prefix, counterpartyClientIdentifier string, proof []byte, clientState exported.ClientState) error {
-	return cs.GetProxyClientState().VerifyClientState(NewProxyExtractorStore(cdc, store), cdc, height, newPrefix(cs.ProxyPrefix, prefix, cs.UpstreamClientId), counterpartyClientIdentifier, proof, clientState)
+	return cs.GetProxyClientState().VerifyClientState(NewProxyExtractorStore(cdc, store), cdc, height, NewProxyCommitmentPrefix(cs.ProxyPrefix, prefix, cs.UpstreamClientId), counterpartyClientIdentifier, proof, clientState)
 }
 
 func (cs *ClientState) VerifyClientConsensusState(store sdk.KVStore, cdc codec.BinaryCodec, height exported.Height, counterpartyClientIdentifier string, consensusHeight exported.Height, prefix exported.Prefix, proof []byte, consensusState exported.ConsensusState) error {
-	return cs.GetProxyClientState().VerifyClientConsensusState(NewProxyExtractorStore(cdc, store), cdc, height, counterpartyClientIdentifier, consensusHeight, newPrefix(cs.ProxyPrefix, prefix, cs.UpstreamClientId), proof, consensusState)
+	return cs.GetProxyClientState().VerifyClientConsensusState(NewProxyExtractorStore(cdc, store), cdc, height, counterpartyClientIdentifier, consensusHeight, NewProxyCommitmentPrefix(cs.ProxyPrefix, prefix, cs.UpstreamClientId), proof, consensusState)
 }
 
+// VerifyConnectionState verifies the proxied connection end, and that the upstream client still tracks the upstream chain
+// which the client is bound to at the same height. The proof must be a ConnectionProof unless the client was created
+// without the binding to the upstream chain.
 func (cs *ClientState) VerifyConnectionState(store sdk.KVStore, cdc codec.BinaryCodec, height exported.Height, prefix exported.Prefix, proof []byte, connectionID string, connectionEnd exported.ConnectionI) error {
-	return cs.GetProxyClientState().VerifyConnectionState(NewProxyExtractorStore(cdc, store), cdc, height, newPrefix(cs.ProxyPrefix, prefix, cs.UpstreamClientId), proof, connectionID, connectionEnd)
+	if len(cs.UpstreamChainId) == 0 {
+		return cs.GetProxyClientState().VerifyConnectionState(NewProxyExtractorStore(cdc, store), cdc, height, NewProxyCommitmentPrefix(cs.ProxyPrefix, prefix, cs.UpstreamClientId), proof, connectionID, connectionEnd)
+	}
+
+	var connectionProof ConnectionProof
+	if err := cdc.Unmarshal(proof, &connectionProof); err != nil {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "failed to unmarshal proof into connection proof")
+	}
+	// a plain proof of the connection end decodes into a connection proof without the upstream client
+	if connectionProof.UpstreamClient.ClientState == nil {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "connection proof must have the proof of the upstream client")
+	}
+	if err := cs.GetProxyClientState().VerifyConnectionState(
+		NewProxyExtractorStore(cdc, store), cdc, height, NewProxyCommitmentPrefix(cs.ProxyPrefix, prefix, cs.UpstreamClientId), connectionProof.Proof, connectionID, connectionEnd,
+	); err != nil {
+		return err
+	}
+	consensusState, err := GetConsensusState(store, cdc, height)
+	if err != nil {
+		return sdkerrors.Wrap(err, "please ensure the proof was constructed against a height that exists on the client")
+	}
+	return cs.verifyUpstreamClient(cdc, consensusState.GetRoot(), &connectionProof.UpstreamClient)
 }
 
 func (cs *ClientState) VerifyChannelState(store sdk.KVStore, cdc codec.BinaryCodec, height exported.Height, prefix exported.Prefix, proof []byte, portID string, channelID string, channel exported.ChannelI) error {
-	return cs.GetProxyClientState().VerifyChannelState(NewProxyExtractorStore(cdc, store), cdc, height, newPrefix(cs.ProxyPrefix, prefix, cs.UpstreamClientId), proof, portID, channelID, channel)
+	return cs.GetProxyClientState().VerifyChannelState(NewProxyExtractorStore(cdc, store), cdc, height, NewProxyCommitmentPrefix(cs.ProxyPrefix, prefix, cs.UpstreamClientId), proof, portID, channelID, channel)
 }
 
 func (cs *ClientState) VerifyPacketCommitment(ctx sdk.Context, store sdk.KVStore, cdc codec.BinaryCodec, height exported.Height, currentTimestamp uint64, delayPeriod uint64, prefix exported.Prefix, proof []byte, portID string, channelID string, sequence uint64, commitmentBytes []byte) error {
-	return cs.GetProxyClientState().VerifyPacketCommitment(ctx, NewProxyExtractorStore(cdc, store), cdc, height, currentTimestamp, delayPeriod, newPrefix(cs.ProxyPrefix, prefix, cs.UpstreamClientId), proof, portID, channelID, sequence, commitmentBytes)
+	return cs.GetProxyClientState().VerifyPacketCommitment(ctx, NewProxyExtractorStore(cdc, store), cdc, height, currentTimestamp, delayPeriod, NewProxyCommitmentPrefix(cs.ProxyPrefix, prefix, cs.UpstreamClientId), proof, portID, channelID, sequence, commitmentBytes)
 }
 
 func (cs *ClientState) VerifyPacketAcknowledgement(ctx sdk.Context, store sdk.KVStore, cdc codec.BinaryCodec, height exported.Height, currentTimestamp uint64, delayPeriod uint64, prefix exported.Prefix, proof []byte, portID string, channelID string, sequence uint64, acknowledgement []byte) error {
-	return cs.GetProxyClientState().VerifyPacketAcknowledgement(ctx, NewProxyExtractorStore(cdc, store), cdc, height, currentTimestamp, delayPeriod, newPrefix(cs.ProxyPrefix, prefix, cs.UpstreamClientId), proof, portID, channelID, sequence, acknowledgement)
+	return cs.GetProxyClientState().VerifyPacketAcknowledgement(ctx, NewProxyExtractorStore(cdc, store), cdc, height, currentTimestamp, delayPeriod, NewProxyCommitmentPrefix(cs.ProxyPrefix, prefix, cs.UpstreamClientId), proof, portID, channelID, sequence, acknowledgement)
 }
 
+// VerifyPacketReceiptAbsence verifies the existence of the marker that the proxy commits
+// after it has verified the absence of the packet receipt on the upstream. Note that a non-membership proof
+// against the proxy store would be satisfied by any packet which the proxy has never seen.
 func (cs *ClientState) VerifyPacketReceiptAbsence(ctx sdk.Context, store sdk.KVStore, cdc codec.BinaryCodec, height exported.Height, currentTimestamp uint64, delayPeriod uint64, prefix exported.Prefix, proof []byte, portID string, channelID string, sequence uint64) error {
-	return cs.GetProxyClientState().VerifyPacketReceiptAbsence(ctx, NewProxyExtractorStore(cdc, store), cdc, height, currentTimestamp, delayPeriod, newPrefix(cs.ProxyPrefix, prefix, cs.UpstreamClientId), proof, portID, channelID, sequence)
+	return cs.GetProxyClientState().VerifyPacketCommitment(ctx, NewProxyExtractorStore(cdc, store), cdc, height, currentTimestamp, delayPeriod, NewProxyReceiptAbsencePrefix(cs.ProxyPrefix, prefix, cs.UpstreamClientId), proof, portID, channelID, sequence, PacketReceiptAbsenceMarker)
 }
 
 func (cs *ClientState) VerifyNextSequenceRecv(ctx sdk.Context, store sdk.KVStore, cdc codec.BinaryCodec, height exported.Height, currentTimestamp uint64, delayPeriod uint64, prefix exported.Prefix, proof []byte, portID string, channelID string, nextSequenceRecv uint64) error {
-	return cs.GetProxyClientState().VerifyNextSequenceRecv(ctx, NewProxyExtractorStore(cdc, store), cdc, height, currentTimestamp, delayPeriod, newPrefix(cs.ProxyPrefix, prefix, cs.UpstreamClientId), proof, portID, channelID, nextSequenceRecv)
-}
-
-func newPrefix(proxyPrefix, upstreamPrefix exported.Prefix, upstreamClientID string) exported.Prefix {
-	return commitmenttypes.MultiPrefix{
-		Prefix:     proxyPrefix,
-		PathPrefix: append([]byte(upstreamClientID+"/"), upstreamPrefix.Bytes()...),
-	}
+	return cs.GetProxyClientState().VerifyNextSequenceRecv(ctx, NewProxyExtractorStore(cdc, store), cdc, height, currentTimestamp, delayPeriod, NewProxyCommitmentPrefix(cs.ProxyPrefix, prefix, cs.UpstreamClientId), proof, portID, channelID, nextSequenceRecv)
 }
@@ -0,0 +1,89 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	commitmenttypes "github.com/cosmos/ibc-go/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/modules/core/24-host"
+	"github.com/cosmos/ibc-go/modules/core/exported"
+)
+
+// NewProxyCommitmentPrefix returns the prefix of the proxy commitments of the upstream, which follows the key format of the proxy module.
+// '%' in the path prefix is escaped because each element of a merkle path is unescaped on verification,
+// so the verified key begins with the one returned by UpstreamPathPrefix whatever bytes the upstream prefix contains.
+func NewProxyCommitmentPrefix(proxyPrefix, upstreamPrefix exported.Prefix, upstreamClientID string) exported.Prefix {
+	pathPrefix := string(UpstreamPathPrefix(upstreamClientID, upstreamPrefix.Bytes()))
+	return commitmenttypes.MultiPrefix{
+		Prefix:     proxyPrefix,
+		PathPrefix: []byte(strings.ReplaceAll(pathPrefix, "%", "%25")),
+	}
+}
+
+// NewProxyReceiptAbsencePrefix returns the prefix of the markers of the packet receipt absences of the upstream.
+// A marker is committed at the packet commitment path under this prefix, so that the proxy client verifies it
+// with the packet commitment verification of the underlying client.
+func NewProxyReceiptAbsencePrefix(proxyPrefix, upstreamPrefix exported.Prefix, upstreamClientID string) exported.Prefix {
+	prefix := NewProxyCommitmentPrefix(proxyPrefix, upstreamPrefix, upstreamClientID).(commitmenttypes.MultiPrefix)
+	prefix.PathPrefix = append(prefix.PathPrefix, "/"+host.KeyPacketReceiptPrefix...)
+	return prefix
+}
+
+// PacketReceiptAbsencePath returns the path of the marker of a packet receipt absence under the upstream path prefix,
+// in the form of "receipts/commitments/ports/{port_id}/channels/{channel_id}/sequences/{sequence}"
+func PacketReceiptAbsencePath(portID, channelID string, sequence uint64) string {
+	return fmt.Sprintf("%s/%s", host.KeyPacketReceiptPrefix, host.PacketCommitmentPath(portID, channelID, sequence))
+}
+
+// UpstreamPathPrefix returns the path prefix under which the proxy commits the states of the upstream,
+// in the form of "{len(upstream_client_id)}:{upstream_client_id}/{len(upstream_prefix)}:{upstream_prefix}".
+// The lengths are written in decimal so that different pairs of a client ID and a prefix never share a path prefix.
+// This is the key format of the consensus version 2 of the proxy module.
+func UpstreamPathPrefix(upstreamClientID string, upstreamPrefix []byte) []byte {
+	prefix := appendLengthPrefixed(nil, []byte(upstreamClientID))
+	prefix = append(prefix, '/')
+	return appendLengthPrefixed(prefix, upstreamPrefix)
+}
+
+// SplitUpstreamPathPrefix splits a key that begins with an upstream path prefix into
+// the upstream client ID, the upstream prefix and the rest of the key
+func SplitUpstreamPathPrefix(key []byte) (string, []byte, []byte, error) {
+	upstreamClientID, rest, err := readLengthPrefixed(key)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	if len(rest) == 0 || rest[0] != '/' {
+		return "", nil, nil, fmt.Errorf("invalid upstream path prefix: %X", key)
+	}
+	upstreamPrefix, rest, err := readLengthPrefixed(rest[1:])
+	if err != nil {
+		return "", nil, nil, err
+	}
+	return string(upstreamClientID), upstreamPrefix, rest, nil
+}
+
+func appendLengthPrefixed(dst, bz []byte) []byte {
+	dst = append(dst, strconv.Itoa(len(bz))...)
+	dst = append(dst, ':')
+	return append(dst, bz...)
+}
+
+// readLengthPrefixed reads a value written by appendLengthPrefixed and returns it with the rest of the bytes.
+// Only the canonical decimal form of the length is accepted.
+func readLengthPrefixed(bz []byte) ([]byte, []byte, error) {
+	sep := bytes.IndexByte(bz, ':')
+	if sep <= 0 {
+		return nil, nil, fmt.Errorf("missing length prefix: %X", bz)
+	}
+	length, err := strconv.Atoi(string(bz[:sep]))
+	if err != nil || length < 0 || strconv.Itoa(length) != string(bz[:sep]) {
+		return nil, nil, fmt.Errorf("invalid length prefix: %X", bz)
+	}
+	rest := bz[sep+1:]
+	if len(rest) < length {
+		return nil, nil, fmt.Errorf("length prefix %d exceeds the remaining %d bytes", length, len(rest))
+	}
+	return rest[:length], rest[length:], nil
+}
@@ -0,0 +1,177 @@
+package types
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/modules/core/24-host"
+	"github.com/cosmos/ibc-go/modules/core/exported"
+)
+
+var _ exported.Misbehaviour = (*Misbehaviour)(nil)
+var _ codectypes.UnpackInterfacesMessage = (*Misbehaviour)(nil)
+var _ exported.Misbehaviour = (*ProxyMisbehaviour)(nil)
+
+// FrozenHeight is the height set to the proxy client frozen due to a proxy misbehaviour
+var FrozenHeight = clienttypes.NewHeight(0, 1)
+
+// NewMisbehaviour wraps the misbehaviour of the proxy chain to be submitted against the proxy client
+func NewMisbehaviour(clientID string, misbehaviour exported.Misbehaviour) (*Misbehaviour, error) {
+	anyMisbehaviour, err := clienttypes.PackMisbehaviour(misbehaviour)
+	if err != nil {
+		return nil, err
+	}
+	return &Misbehaviour{ClientId: clientID, ProxyMisbehaviour: anyMisbehaviour}, nil
+}
+
+// ClientType is the proxy client
+func (misbehaviour *Misbehaviour) ClientType() string {
+	return ProxyClientType
+}
+
+// GetClientID returns the ID of the proxy client, which may differ from the one of the wrapped misbehaviour.
+func (misbehaviour *Misbehaviour) GetClientID() string {
+	return misbehaviour.ClientId
+}
+
+func (misbehaviour *Misbehaviour) GetProxyMisbehaviour() exported.Misbehaviour {
+	m, err := clienttypes.UnpackMisbehaviour(misbehaviour.ProxyMisbehaviour)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (misbehaviour *Misbehaviour) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if err := unpacker.UnpackAny(misbehaviour.ProxyMisbehaviour, new(exported.Misbehaviour)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ValidateBasic implements Misbehaviour interface
+func (misbehaviour *Misbehaviour) ValidateBasic() error {
+	if err := host.ClientIdentifierValidator(misbehaviour.ClientId); err != nil {
+		return sdkerrors.Wrap(err, "misbehaviour client ID is invalid")
+	}
+	m, err := clienttypes.UnpackMisbehaviour(misbehaviour.ProxyMisbehaviour)
+	if err != nil {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidMisbehaviour, err.Error())
+	}
+	switch m.(type) {
+	case *Misbehaviour, *ProxyMisbehaviour:
+		return sdkerrors.Wrapf(clienttypes.ErrInvalidMisbehaviour, "misbehaviour of the proxy chain cannot be %T", m)
+	}
+	return m.ValidateBasic()
+}
+
+// ClientType is the proxy client
+func (misbehaviour ProxyMisbehaviour) ClientType() string {
+	return ProxyClientType
+}
+
+// GetClientID returns the ID of the proxy client that committed a misbehaviour.
+func (misbehaviour ProxyMisbehaviour) GetClientID() string {
+	return misbehaviour.ClientId
+}
+
+// ValidateBasic implements Misbehaviour interface
+// Only the packet commitments and the packet acknowledgements can be proven to be conflicting
+// because the upstream never changes them once they are written.
+// The proof heights are not compared: they are the heights of different chains, and a write-once value that differs
+// at any heights is a conflict.
+func (misbehaviour ProxyMisbehaviour) ValidateBasic() error {
+	if err := host.ClientIdentifierValidator(misbehaviour.ClientId); err != nil {
+		return sdkerrors.Wrap(err, "misbehaviour client ID is invalid")
+	}
+	if err := host.ClientIdentifierValidator(misbehaviour.UpstreamClientId); err != nil {
+		return sdkerrors.Wrap(err, "misbehaviour upstream client ID is invalid")
+	}
+	if misbehaviour.ClientId == misbehaviour.UpstreamClientId {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidMisbehaviour, "upstream client must be different from the proxy client")
+	}
+	if misbehaviour.UpstreamPrefix.Empty() {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidPrefix, "upstream prefix cannot be empty")
+	}
+	if !strings.HasPrefix(misbehaviour.Path, host.KeyPacketCommitmentPrefix+"/") && !strings.HasPrefix(misbehaviour.Path, host.KeyPacketAckPrefix+"/") {
+		return sdkerrors.Wrapf(clienttypes.ErrInvalidMisbehaviour, "path must be a packet commitment or acknowledgement path: %s", misbehaviour.Path)
+	}
+	if len(misbehaviour.ProxyValue) == 0 || len(misbehaviour.UpstreamValue) == 0 {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidMisbehaviour, "values cannot be empty")
+	}
+	if bytes.Equal(misbehaviour.ProxyValue, misbehaviour.UpstreamValue) {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidMisbehaviour, "the proxy value must be different from the upstream value")
+	}
+	if len(misbehaviour.ProxyProof) == 0 || len(misbehaviour.UpstreamProof) == 0 {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "proofs cannot be empty")
+	}
+	if misbehaviour.ProxyProofHeight.IsZero() || misbehaviour.UpstreamProofHeight.IsZero() {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidMisbehaviour, "proof heights cannot be zero")
+	}
+	return nil
+}
+
+// CheckProxyMisbehaviourAndUpdateState verifies that the proxy committed the proxy value at the path of the upstream
+// and that the upstream holds the different upstream value at the same path, then returns the frozen client state.
+// The upstream client is a direct or alternate client of the upstream on the downstream, which must be trusted by the caller.
+func (cs ClientState) CheckProxyMisbehaviourAndUpdateState(
+	cdc codec.BinaryCodec,
+	clientStore sdk.KVStore,
+	upstreamClientState exported.ClientState,
+	upstreamConsensusState exported.ConsensusState,
+	misbehaviour *ProxyMisbehaviour,
+) (exported.ClientState, error) {
+	consensusState, err := GetConsensusState(clientStore, cdc, misbehaviour.ProxyProofHeight)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "please ensure the proof was constructed against a height that exists on the client")
+	}
+	if err := verifyMembership(
+		cdc, &cs, consensusState, &misbehaviour.UpstreamPrefix,
+		misbehaviour.ProxyProofHeight, misbehaviour.ProxyProof, misbehaviour.Path, misbehaviour.ProxyValue,
+	); err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to verify the proxy value")
+	}
+	if err := verifyMembership(
+		cdc, upstreamClientState, upstreamConsensusState, &misbehaviour.UpstreamPrefix,
+		misbehaviour.UpstreamProofHeight, misbehaviour.UpstreamProof, misbehaviour.Path, misbehaviour.UpstreamValue,
+	); err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to verify the upstream value")
+	}
+
+	cs.FrozenHeight = FrozenHeight
+	return &cs, nil
+}
+
+// verifyMembership verifies the value at the path of the upstream against the consensus state of the client.
+// If the client is a proxy client, the path is the one under which the proxy committed the upstream state.
+func verifyMembership(cdc codec.BinaryCodec, clientState exported.ClientState, consensusState exported.ConsensusState, upstreamPrefix exported.Prefix, height exported.Height, proof []byte, path string, value []byte) error {
+	if clientState.GetLatestHeight().LT(height) {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidHeight,
+			"client state height < proof height (%d < %d), please ensure the client has been updated", clientState.GetLatestHeight(), height,
+		)
+	}
+
+	var merkleProof commitmenttypes.MerkleProof
+	if err := cdc.Unmarshal(proof, &merkleProof); err != nil {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "failed to unmarshal proof into commitment merkle proof")
+	}
+
+	prefix := upstreamPrefix
+	if proxyClientState, ok := clientState.(*ClientState); ok {
+		prefix = NewProxyCommitmentPrefix(proxyClientState.ProxyPrefix, upstreamPrefix, proxyClientState.UpstreamClientId)
+	}
+	merklePath, err := commitmenttypes.ApplyPrefix(prefix, commitmenttypes.NewMerklePath(path))
+	if err != nil {
+		return err
+	}
+
+	return merkleProof.VerifyMembership(clientState.GetProofSpecs(), consensusState.GetRoot(), merklePath, value)
+}
@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	types2 "github.com/cosmos/ibc-go/modules/core/02-client/types"
 	types1 "github.com/cosmos/ibc-go/modules/core/23-commitment/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
@@ -35,6 +36,15 @@ type ClientState struct {
 	ProxyPrefix *types1.MerklePrefix `protobuf:"bytes,3,opt,name=proxy_prefix,json=proxyPrefix,proto3" json:"proxy_prefix,omitempty"`
 	// the ibc commitment prefix of the proxy chain
 	IbcPrefix *types1.MerklePrefix `protobuf:"bytes,4,opt,name=ibc_prefix,json=ibcPrefix,proto3" json:"ibc_prefix,omitempty"`
+	// the height at which the client was frozen due to a proxy misbehaviour
+	FrozenHeight types2.Height `protobuf:"bytes,5,opt,name=frozen_height,json=frozenHeight,proto3" json:"frozen_height"`
+	// chain id of the upstream chain that the upstream client on the proxy tracks
+	UpstreamChainId string `protobuf:"bytes,6,opt,name=upstream_chain_id,json=upstreamChainId,proto3" json:"upstream_chain_id,omitempty"`
+	// client type of the upstream client on the proxy. Any client type is accepted if it is empty.
+	UpstreamClientType string `protobuf:"bytes,7,opt,name=upstream_client_type,json=upstreamClientType,proto3" json:"upstream_client_type,omitempty"`
+	// proof that the proxy has the upstream client of the upstream chain at the height of the initial consensus state.
+	// It is only given on creation, and the client state is stored without it.
+	UpstreamClientProof *UpstreamClientProof `protobuf:"bytes,8,opt,name=upstream_client_proof,json=upstreamClientProof,proto3" json:"upstream_client_proof,omitempty"`
 }
 
 func (m *ClientState) Reset()         { *m = ClientState{} }
@@ -109,9 +119,197 @@ func (m *ConsensusState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ConsensusState proto.InternalMessageInfo
 
+// UpstreamClientProof is a proof that the proxy has the upstream client state in its IBC store.
+type UpstreamClientProof struct {
+	// the upstream client state on the proxy
+	ClientState *types.Any `protobuf:"bytes,1,opt,name=client_state,json=clientState,proto3" json:"client_state,omitempty"`
+	// proof of the upstream client state at the height of the proof that it comes with
+	Proof []byte `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *UpstreamClientProof) Reset()         { *m = UpstreamClientProof{} }
+func (m *UpstreamClientProof) String() string { return proto.CompactTextString(m) }
+func (*UpstreamClientProof) ProtoMessage()    {}
+func (*UpstreamClientProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b548f5864814422, []int{2}
+}
+func (m *UpstreamClientProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpstreamClientProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpstreamClientProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpstreamClientProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpstreamClientProof.Merge(m, src)
+}
+func (m *UpstreamClientProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpstreamClientProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpstreamClientProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpstreamClientProof proto.InternalMessageInfo
+
+// ConnectionProof is a proof of a proxied connection end that the proxy client bound to an upstream chain verifies.
+type ConnectionProof struct {
+	// proof of the proxied connection end
+	Proof []byte `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
+	// proof of the upstream client at the same height
+	UpstreamClient UpstreamClientProof `protobuf:"bytes,2,opt,name=upstream_client,json=upstreamClient,proto3" json:"upstream_client"`
+}
+
+func (m *ConnectionProof) Reset()         { *m = ConnectionProof{} }
+func (m *ConnectionProof) String() string { return proto.CompactTextString(m) }
+func (*ConnectionProof) ProtoMessage()    {}
+func (*ConnectionProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b548f5864814422, []int{3}
+}
+func (m *ConnectionProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConnectionProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConnectionProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConnectionProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConnectionProof.Merge(m, src)
+}
+func (m *ConnectionProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConnectionProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConnectionProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConnectionProof proto.InternalMessageInfo
+
+// Misbehaviour is a misbehaviour of the chain that the proxy client verifies, such as a double-sign of the proxy chain.
+type Misbehaviour struct {
+	// client id of the proxy client on the downstream
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// misbehaviour corresponding to proxy
+	// the type must implements Misbehaviour interface
+	ProxyMisbehaviour *types.Any `protobuf:"bytes,2,opt,name=proxy_misbehaviour,json=proxyMisbehaviour,proto3" json:"proxy_misbehaviour,omitempty"`
+}
+
+func (m *Misbehaviour) Reset()         { *m = Misbehaviour{} }
+func (m *Misbehaviour) String() string { return proto.CompactTextString(m) }
+func (*Misbehaviour) ProtoMessage()    {}
+func (*Misbehaviour) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b548f5864814422, []int{4}
+}
+func (m *Misbehaviour) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Misbehaviour) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Misbehaviour.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Misbehaviour) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Misbehaviour.Merge(m, src)
+}
+func (m *Misbehaviour) XXX_Size() int {
+	return m.Size()
+}
+func (m *Misbehaviour) XXX_DiscardUnknown() {
+	xxx_messageInfo_Misbehaviour.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Misbehaviour proto.InternalMessageInfo
+
+// ProxyMisbehaviour is a proof that the proxy committed a state that the upstream doesn't have.
+// It consists of a proof that the proxy committed a value at a path of the upstream,
+// and a proof that the upstream holds a different value at the same path,
+// which is verified through a direct or alternate upstream client on the downstream.
+type ProxyMisbehaviour struct {
+	// client id of the proxy client on the downstream
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// client id corresponding to upstream on the downstream
+	UpstreamClientId string `protobuf:"bytes,2,opt,name=upstream_client_id,json=upstreamClientId,proto3" json:"upstream_client_id,omitempty"`
+	// the ibc commitment prefix of the upstream
+	UpstreamPrefix types1.MerklePrefix `protobuf:"bytes,3,opt,name=upstream_prefix,json=upstreamPrefix,proto3" json:"upstream_prefix"`
+	// the ICS-24 path of the conflicting state
+	Path string `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	// the value that the proxy committed for the upstream
+	ProxyValue []byte `protobuf:"bytes,5,opt,name=proxy_value,json=proxyValue,proto3" json:"proxy_value,omitempty"`
+	// the proof of proxy_value against the proxy client
+	ProxyProof []byte `protobuf:"bytes,6,opt,name=proxy_proof,json=proxyProof,proto3" json:"proxy_proof,omitempty"`
+	// the height of the proxy client at which proxy_proof is verified
+	ProxyProofHeight types2.Height `protobuf:"bytes,7,opt,name=proxy_proof_height,json=proxyProofHeight,proto3" json:"proxy_proof_height"`
+	// the value that the upstream holds
+	UpstreamValue []byte `protobuf:"bytes,8,opt,name=upstream_value,json=upstreamValue,proto3" json:"upstream_value,omitempty"`
+	// the proof of upstream_value against the upstream client
+	UpstreamProof []byte `protobuf:"bytes,9,opt,name=upstream_proof,json=upstreamProof,proto3" json:"upstream_proof,omitempty"`
+	// the height of the upstream client at which upstream_proof is verified
+	UpstreamProofHeight types2.Height `protobuf:"bytes,10,opt,name=upstream_proof_height,json=upstreamProofHeight,proto3" json:"upstream_proof_height"`
+}
+
+func (m *ProxyMisbehaviour) Reset()         { *m = ProxyMisbehaviour{} }
+func (m *ProxyMisbehaviour) String() string { return proto.CompactTextString(m) }
+func (*ProxyMisbehaviour) ProtoMessage()    {}
+func (*ProxyMisbehaviour) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b548f5864814422, []int{5}
+}
+func (m *ProxyMisbehaviour) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProxyMisbehaviour) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProxyMisbehaviour.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProxyMisbehaviour) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProxyMisbehaviour.Merge(m, src)
+}
+func (m *ProxyMisbehaviour) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProxyMisbehaviour) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProxyMisbehaviour.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProxyMisbehaviour proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*ClientState)(nil), "ibc.lightclients.proxy.v1.ClientState")
 	proto.RegisterType((*ConsensusState)(nil), "ibc.lightclients.proxy.v1.ConsensusState")
+	proto.RegisterType((*UpstreamClientProof)(nil), "ibc.lightclients.proxy.v1.UpstreamClientProof")
+	proto.RegisterType((*ConnectionProof)(nil), "ibc.lightclients.proxy.v1.ConnectionProof")
+	proto.RegisterType((*Misbehaviour)(nil), "ibc.lightclients.proxy.v1.Misbehaviour")
+	proto.RegisterType((*ProxyMisbehaviour)(nil), "ibc.lightclients.proxy.v1.ProxyMisbehaviour")
 }
 
 func init() {
@@ -119,32 +317,53 @@ func init() {
 }
 
 var fileDescriptor_7b548f5864814422 = []byte{
-	// 386 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xbf, 0x6e, 0xe2, 0x40,
-	0x10, 0xc6, 0x6d, 0x0e, 0x9d, 0xc4, 0x72, 0x3a, 0x21, 0x1f, 0x27, 0x01, 0x85, 0x0f, 0xa1, 0x8b,
-	0x42, 0x11, 0x76, 0x45, 0xd2, 0xa5, 0x0b, 0x48, 0xf9, 0x53, 0x44, 0x8a, 0x48, 0x97, 0x06, 0xbc,
-	0xeb, 0xc5, 0xac, 0x62, 0x7b, 0x2d, 0xef, 0x1a, 0x99, 0x37, 0x48, 0x19, 0x29, 0x2f, 0x90, 0xc7,
-	0x49, 0x49, 0x99, 0x32, 0x82, 0x17, 0x89, 0xbc, 0x83, 0x15, 0xd2, 0x25, 0xdd, 0xcc, 0xce, 0x37,
-	0xdf, 0x6f, 0x76, 0x34, 0xe8, 0x40, 0x50, 0x46, 0x42, 0x11, 0x2c, 0x34, 0x0b, 0x05, 0x8f, 0xb5,
-	0x22, 0x49, 0x2a, 0xf3, 0x15, 0x59, 0x0e, 0x21, 0xc0, 0x49, 0x2a, 0xb5, 0x74, 0xda, 0x82, 0x32,
-	0xbc, 0x2f, 0xc3, 0x50, 0x5d, 0x0e, 0x3b, 0xcd, 0x40, 0x06, 0xd2, 0xa8, 0x48, 0x11, 0x41, 0x43,
-	0xa7, 0x1d, 0x48, 0x19, 0x84, 0x9c, 0x98, 0x8c, 0x66, 0x73, 0xe2, 0xc5, 0x3b, 0xaf, 0xce, 0x61,
-	0x81, 0x64, 0x32, 0xe5, 0x84, 0xc9, 0x28, 0x12, 0x3a, 0xe2, 0xb1, 0x2e, 0x78, 0x1f, 0x19, 0x08,
-	0x7b, 0x4f, 0x15, 0x54, 0x1f, 0x1b, 0xdc, 0xad, 0xf6, 0x34, 0x77, 0x46, 0xc8, 0x31, 0xd4, 0x29,
-	0xcc, 0x30, 0x55, 0xc5, 0x6b, 0xcb, 0xee, 0xda, 0xfd, 0xfa, 0x71, 0x13, 0x03, 0x10, 0x97, 0x40,
-	0x7c, 0x16, 0xaf, 0x26, 0x0d, 0xa3, 0xdf, 0xf7, 0x38, 0x42, 0x4e, 0x96, 0x28, 0x9d, 0x72, 0x2f,
-	0x2a, 0x6d, 0x84, 0xdf, 0xaa, 0x74, 0xed, 0x7e, 0x6d, 0xd2, 0x28, 0x2b, 0xd0, 0x70, 0xe5, 0x3b,
-	0x17, 0xe8, 0x17, 0x10, 0x93, 0x94, 0xcf, 0x45, 0xde, 0xfa, 0x61, 0x58, 0xff, 0x71, 0xb1, 0x8d,
-	0xe2, 0x07, 0x78, 0x6f, 0xe6, 0xe5, 0x10, 0x5f, 0xf3, 0xf4, 0x3e, 0xe4, 0x37, 0x46, 0x3b, 0xa9,
-	0x9b, 0x4e, 0x48, 0x9c, 0x31, 0x42, 0x82, 0xb2, 0xd2, 0xa6, 0xfa, 0x0d, 0x9b, 0x9a, 0xa0, 0x0c,
-	0xc2, 0xd3, 0xea, 0xc3, 0xf3, 0x3f, 0xab, 0x37, 0x43, 0xbf, 0xc7, 0x32, 0x56, 0x3c, 0x56, 0x99,
-	0x82, 0x3f, 0x5d, 0xa2, 0xbf, 0xbb, 0xbd, 0x94, 0xef, 0x5f, 0x58, 0xcd, 0x1f, 0x58, 0xcd, 0x27,
-	0x27, 0x20, 0x8c, 0x66, 0x2f, 0x1b, 0xd7, 0x5e, 0x6f, 0x5c, 0xfb, 0x6d, 0xe3, 0xda, 0x8f, 0x5b,
-	0xd7, 0x5a, 0x6f, 0x5d, 0xeb, 0x75, 0xeb, 0x5a, 0x77, 0xe7, 0x81, 0xd0, 0x8b, 0x8c, 0x16, 0xf3,
-	0x12, 0xdf, 0xd3, 0x1e, 0x5b, 0x78, 0x22, 0x0e, 0x3d, 0x4a, 0x04, 0x65, 0x03, 0x38, 0x9c, 0x48,
-	0xfa, 0x59, 0xc8, 0x15, 0xdc, 0xd4, 0xa0, 0x3c, 0xaa, 0x3c, 0xdf, 0x95, 0xf5, 0x2a, 0xe1, 0x8a,
-	0xfe, 0x34, 0xa3, 0x9c, 0xbc, 0x07, 0x00, 0x00, 0xff, 0xff, 0x24, 0xc9, 0x0d, 0x02, 0x7e, 0x02,
-	0x00, 0x00,
+	// 727 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4d, 0x6f, 0xd3, 0x4a,
+	0x14, 0x8d, 0xd3, 0xb4, 0x4d, 0x6e, 0xd2, 0x36, 0x9d, 0xa4, 0x92, 0x9b, 0x27, 0x25, 0x55, 0xf5,
+	0xaa, 0x57, 0x3d, 0x51, 0x9b, 0xc0, 0x02, 0x89, 0x1d, 0x8d, 0x80, 0x16, 0xa9, 0xa8, 0x72, 0x0b,
+	0x0b, 0x24, 0x14, 0x6c, 0x67, 0x92, 0x0c, 0x24, 0x1e, 0xcb, 0x1f, 0x51, 0xc2, 0x96, 0x0d, 0x0b,
+	0x16, 0xfc, 0x00, 0x16, 0xfc, 0x9c, 0x2e, 0xbb, 0x64, 0x85, 0x50, 0xfb, 0x47, 0xd0, 0xcc, 0xb5,
+	0x13, 0x3b, 0x40, 0x95, 0xb2, 0x9b, 0xb9, 0x73, 0xee, 0xb9, 0xc7, 0x67, 0xee, 0xf5, 0xc0, 0x1e,
+	0xb3, 0x6c, 0x7d, 0xc0, 0x7a, 0xfd, 0xc0, 0x1e, 0x30, 0xea, 0x04, 0xbe, 0xee, 0x7a, 0x7c, 0x3c,
+	0xd1, 0x47, 0x4d, 0x5c, 0x68, 0xae, 0xc7, 0x03, 0x4e, 0xb6, 0x99, 0x65, 0x6b, 0x49, 0x98, 0x86,
+	0xa7, 0xa3, 0x66, 0xad, 0xda, 0xe3, 0x3d, 0x2e, 0x51, 0xba, 0x58, 0x61, 0x42, 0x6d, 0xbb, 0xc7,
+	0x79, 0x6f, 0x40, 0x75, 0xb9, 0xb3, 0xc2, 0xae, 0x6e, 0x3a, 0x11, 0x57, 0xad, 0x21, 0x4a, 0xda,
+	0xdc, 0xa3, 0x3a, 0x72, 0x89, 0x5a, 0xb8, 0x8a, 0x00, 0xff, 0xcd, 0x00, 0x7c, 0x38, 0x64, 0xc1,
+	0x30, 0x06, 0x4d, 0x77, 0x08, 0xdc, 0xfd, 0x92, 0x83, 0x62, 0x4b, 0x66, 0x9e, 0x05, 0x66, 0x40,
+	0xc9, 0x21, 0x10, 0x29, 0xab, 0x8d, 0x74, 0x6d, 0x5f, 0x44, 0x55, 0x65, 0x47, 0xd9, 0x2f, 0xde,
+	0xab, 0x6a, 0xa8, 0x48, 0x8b, 0x15, 0x69, 0x8f, 0x9c, 0x89, 0x51, 0x96, 0xf8, 0x24, 0xc7, 0x1d,
+	0x20, 0xa1, 0xeb, 0x07, 0x1e, 0x35, 0x87, 0x31, 0x0d, 0xeb, 0xa8, 0xd9, 0x1d, 0x65, 0xbf, 0x60,
+	0x94, 0xe3, 0x13, 0x4c, 0x38, 0xee, 0x90, 0xa7, 0x50, 0xc2, 0x8a, 0xae, 0x47, 0xbb, 0x6c, 0xac,
+	0x2e, 0xc9, 0x5a, 0xff, 0x6a, 0xc2, 0x2e, 0xf1, 0x05, 0x5a, 0x42, 0xf3, 0xa8, 0xa9, 0x9d, 0x50,
+	0xef, 0xdd, 0x80, 0x9e, 0x4a, 0xac, 0x51, 0x94, 0x99, 0xb8, 0x21, 0x2d, 0x00, 0x66, 0xd9, 0x31,
+	0x4d, 0xee, 0x16, 0x34, 0x05, 0x66, 0xd9, 0x11, 0xc9, 0x63, 0x58, 0xeb, 0x7a, 0xfc, 0x3d, 0x75,
+	0xda, 0x7d, 0x2a, 0xee, 0x4a, 0x5d, 0x96, 0x3c, 0xb5, 0x04, 0x0f, 0xfa, 0x3c, 0x6a, 0x6a, 0x47,
+	0x12, 0x71, 0x98, 0xbb, 0xf8, 0xde, 0xc8, 0x18, 0x25, 0x4c, 0xc3, 0x18, 0xf9, 0x1f, 0x36, 0x67,
+	0x16, 0xf4, 0x4d, 0xe6, 0x08, 0x07, 0x56, 0xa4, 0x03, 0x1b, 0x53, 0x07, 0x44, 0xfc, 0xb8, 0x43,
+	0xee, 0x42, 0x75, 0xde, 0xae, 0x60, 0xe2, 0x52, 0x75, 0x55, 0xc2, 0x49, 0xda, 0xb0, 0xf3, 0x89,
+	0x4b, 0x89, 0x05, 0x5b, 0xf3, 0x19, 0xae, 0xc7, 0x79, 0x57, 0xcd, 0x4b, 0xb1, 0x9a, 0xf6, 0xc7,
+	0x56, 0xd3, 0x5e, 0xa4, 0xd8, 0x4e, 0x45, 0x96, 0x51, 0x09, 0x7f, 0x0d, 0x3e, 0xcc, 0x7d, 0xfc,
+	0xda, 0xc8, 0xec, 0x76, 0x61, 0xbd, 0xc5, 0x1d, 0x9f, 0x3a, 0x7e, 0xe8, 0xe3, 0xe5, 0x1e, 0xc1,
+	0x56, 0xd4, 0x20, 0x71, 0x7c, 0x81, 0x1e, 0xa9, 0x60, 0x8f, 0xa4, 0x98, 0xb0, 0xc2, 0xb3, 0x5c,
+	0x3e, 0x5b, 0x5e, 0xda, 0x7d, 0x0b, 0x95, 0xdf, 0x28, 0x23, 0x0f, 0xa0, 0xb4, 0x70, 0x1f, 0x16,
+	0xed, 0x44, 0x0b, 0x56, 0x61, 0x19, 0x1d, 0x11, 0x5d, 0x57, 0x32, 0x70, 0x13, 0x7d, 0xd3, 0x27,
+	0x05, 0x36, 0x5a, 0xdc, 0x71, 0xa8, 0x1d, 0x30, 0xee, 0x60, 0xa1, 0x29, 0x5e, 0x49, 0xe0, 0xc9,
+	0x6b, 0xd8, 0x98, 0xf3, 0x59, 0xcd, 0xfe, 0x8d, 0xc3, 0x51, 0x8b, 0xac, 0xa7, 0x7d, 0x8e, 0xe4,
+	0x8c, 0xa0, 0x74, 0xc2, 0x7c, 0x8b, 0xf6, 0xcd, 0x11, 0xe3, 0xa1, 0x47, 0xfe, 0x81, 0xc2, 0x6c,
+	0x68, 0x14, 0xd9, 0x03, 0x79, 0x3b, 0x1e, 0x96, 0x56, 0x3c, 0x9e, 0xc3, 0x44, 0x8a, 0x9a, 0xbd,
+	0xc1, 0x96, 0x4d, 0x89, 0x4f, 0x56, 0x88, 0xea, 0x7e, 0xc8, 0xc1, 0xe6, 0xe9, 0xfc, 0xd9, 0xcd,
+	0xd5, 0x6f, 0x37, 0xd8, 0x67, 0x09, 0xf7, 0x6e, 0x3f, 0xdb, 0xf3, 0x9e, 0x45, 0xf3, 0x49, 0x20,
+	0xe7, 0x9a, 0x41, 0x5f, 0x8e, 0x77, 0xc1, 0x90, 0x6b, 0xd2, 0x00, 0xfc, 0x0f, 0xb4, 0x47, 0xe6,
+	0x20, 0xa4, 0x72, 0x62, 0x4b, 0x06, 0xc8, 0xd0, 0x4b, 0x11, 0x99, 0x01, 0xf0, 0x8e, 0x57, 0x12,
+	0x00, 0xbc, 0xfe, 0xe7, 0x40, 0x12, 0x80, 0x78, 0xf4, 0x57, 0x17, 0x1c, 0xfd, 0xf2, 0x8c, 0x29,
+	0x1a, 0xff, 0x3d, 0x98, 0xea, 0x8e, 0x44, 0xe5, 0x65, 0xcd, 0xb5, 0x38, 0x8a, 0xba, 0x92, 0x30,
+	0x94, 0x56, 0x48, 0xc3, 0x50, 0xdd, 0x39, 0x6c, 0xa5, 0x61, 0xb1, 0x40, 0x58, 0x50, 0x60, 0x25,
+	0xc5, 0x87, 0x47, 0xd8, 0x05, 0x87, 0x6f, 0x2e, 0xae, 0xea, 0xca, 0xe5, 0x55, 0x5d, 0xf9, 0x71,
+	0x55, 0x57, 0x3e, 0x5f, 0xd7, 0x33, 0x97, 0xd7, 0xf5, 0xcc, 0xb7, 0xeb, 0x7a, 0xe6, 0xd5, 0x93,
+	0x1e, 0x0b, 0xfa, 0xa1, 0x25, 0xae, 0x48, 0xef, 0x98, 0x81, 0x29, 0x7f, 0x63, 0x03, 0xd3, 0xd2,
+	0x99, 0x65, 0x1f, 0xe0, 0x0b, 0x37, 0xe4, 0x9d, 0x70, 0x40, 0x7d, 0x7c, 0xfc, 0x0e, 0xe2, 0xd7,
+	0x6f, 0x3c, 0x8e, 0x8e, 0xc5, 0x5f, 0xcc, 0xb7, 0x56, 0x64, 0x3b, 0xde, 0xff, 0x39, 0x00, 0xd5,
+	0x8d, 0x50, 0x64, 0x27, 0x07, 0x00, 0x00,
 }
 
 func (m *ClientState) Marshal() (dAtA []byte, err error) {
@@ -167,6 +386,42 @@ func (m *ClientState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UpstreamClientProof != nil {
+		{
+			size, err := m.UpstreamClientProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProxy(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.UpstreamClientType) > 0 {
+		i -= len(m.UpstreamClientType)
+		copy(dAtA[i:], m.UpstreamClientType)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.UpstreamClientType)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.UpstreamChainId) > 0 {
+		i -= len(m.UpstreamChainId)
+		copy(dAtA[i:], m.UpstreamChainId)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.UpstreamChainId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.FrozenHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProxy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	if m.IbcPrefix != nil {
 		{
 			size, err := m.IbcPrefix.MarshalToSizedBuffer(dAtA[:i])
@@ -248,6 +503,232 @@ func (m *ConsensusState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *UpstreamClientProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpstreamClientProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpstreamClientProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ClientState != nil {
+		{
+			size, err := m.ClientState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProxy(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConnectionProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConnectionProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConnectionProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.UpstreamClient.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProxy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Misbehaviour) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Misbehaviour) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Misbehaviour) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProxyMisbehaviour != nil {
+		{
+			size, err := m.ProxyMisbehaviour.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProxy(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProxyMisbehaviour) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProxyMisbehaviour) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProxyMisbehaviour) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.UpstreamProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProxy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.UpstreamProof) > 0 {
+		i -= len(m.UpstreamProof)
+		copy(dAtA[i:], m.UpstreamProof)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.UpstreamProof)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.UpstreamValue) > 0 {
+		i -= len(m.UpstreamValue)
+		copy(dAtA[i:], m.UpstreamValue)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.UpstreamValue)))
+		i--
+		dAtA[i] = 0x42
+	}
+	{
+		size, err := m.ProxyProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProxy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.ProxyProof) > 0 {
+		i -= len(m.ProxyProof)
+		copy(dAtA[i:], m.ProxyProof)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.ProxyProof)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ProxyValue) > 0 {
+		i -= len(m.ProxyValue)
+		copy(dAtA[i:], m.ProxyValue)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.ProxyValue)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.UpstreamPrefix.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProxy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.UpstreamClientId) > 0 {
+		i -= len(m.UpstreamClientId)
+		copy(dAtA[i:], m.UpstreamClientId)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.UpstreamClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintProxy(dAtA []byte, offset int, v uint64) int {
 	offset -= sovProxy(v)
 	base := offset
@@ -281,29 +762,899 @@ func (m *ClientState) Size() (n int) {
 		l = m.IbcPrefix.Size()
 		n += 1 + l + sovProxy(uint64(l))
 	}
-	return n
-}
+	l = m.FrozenHeight.Size()
+	n += 1 + l + sovProxy(uint64(l))
+	l = len(m.UpstreamChainId)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.UpstreamClientType)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	if m.UpstreamClientProof != nil {
+		l = m.UpstreamClientProof.Size()
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	return n
+}
+
+func (m *ConsensusState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProxyConsensusState != nil {
+		l = m.ProxyConsensusState.Size()
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	return n
+}
+
+func (m *UpstreamClientProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClientState != nil {
+		l = m.ClientState.Size()
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	return n
+}
+
+func (m *ConnectionProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = m.UpstreamClient.Size()
+	n += 1 + l + sovProxy(uint64(l))
+	return n
+}
+
+func (m *Misbehaviour) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	if m.ProxyMisbehaviour != nil {
+		l = m.ProxyMisbehaviour.Size()
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	return n
+}
+
+func (m *ProxyMisbehaviour) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.UpstreamClientId)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = m.UpstreamPrefix.Size()
+	n += 1 + l + sovProxy(uint64(l))
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.ProxyValue)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.ProxyProof)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = m.ProxyProofHeight.Size()
+	n += 1 + l + sovProxy(uint64(l))
+	l = len(m.UpstreamValue)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.UpstreamProof)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = m.UpstreamProofHeight.Size()
+	n += 1 + l + sovProxy(uint64(l))
+	return n
+}
+
+func sovProxy(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProxy(x uint64) (n int) {
+	return sovProxy(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ClientState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClientState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClientState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyClientState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProxyClientState == nil {
+				m.ProxyClientState = &types.Any{}
+			}
+			if err := m.ProxyClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpstreamClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyPrefix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProxyPrefix == nil {
+				m.ProxyPrefix = &types1.MerklePrefix{}
+			}
+			if err := m.ProxyPrefix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcPrefix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IbcPrefix == nil {
+				m.IbcPrefix = &types1.MerklePrefix{}
+			}
+			if err := m.IbcPrefix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FrozenHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpstreamChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpstreamClientType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpstreamClientProof == nil {
+				m.UpstreamClientProof = &UpstreamClientProof{}
+			}
+			if err := m.UpstreamClientProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsensusState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsensusState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsensusState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyConsensusState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProxyConsensusState == nil {
+				m.ProxyConsensusState = &types.Any{}
+			}
+			if err := m.ProxyConsensusState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpstreamClientProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpstreamClientProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpstreamClientProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClientState == nil {
+				m.ClientState = &types.Any{}
+			}
+			if err := m.ClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConnectionProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConnectionProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConnectionProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClient", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpstreamClient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Misbehaviour) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Misbehaviour: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Misbehaviour: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyMisbehaviour", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProxyMisbehaviour == nil {
+				m.ProxyMisbehaviour = &types.Any{}
+			}
+			if err := m.ProxyMisbehaviour.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *ConsensusState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ProxyConsensusState != nil {
-		l = m.ProxyConsensusState.Size()
-		n += 1 + l + sovProxy(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovProxy(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozProxy(x uint64) (n int) {
-	return sovProxy(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ClientState) Unmarshal(dAtA []byte) error {
+func (m *ProxyMisbehaviour) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -326,17 +1677,17 @@ func (m *ClientState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClientState: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProxyMisbehaviour: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClientState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProxyMisbehaviour: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProxyClientState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProxy
@@ -346,27 +1697,23 @@ func (m *ClientState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProxy
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProxy
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProxyClientState == nil {
-				m.ProxyClientState = &types.Any{}
-			}
-			if err := m.ProxyClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -402,7 +1749,7 @@ func (m *ClientState) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProxyPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamPrefix", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -429,16 +1776,113 @@ func (m *ClientState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProxyPrefix == nil {
-				m.ProxyPrefix = &types1.MerklePrefix{}
-			}
-			if err := m.ProxyPrefix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UpstreamPrefix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyValue", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProxyValue = append(m.ProxyValue[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProxyValue == nil {
+				m.ProxyValue = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProxyProof = append(m.ProxyProof[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProxyProof == nil {
+				m.ProxyProof = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyProofHeight", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -465,66 +1909,81 @@ func (m *ClientState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.IbcPrefix == nil {
-				m.IbcPrefix = &types1.MerklePrefix{}
-			}
-			if err := m.IbcPrefix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ProxyProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipProxy(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamValue", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
 				return ErrInvalidLengthProxy
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ConsensusState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowProxy
+			m.UpstreamValue = append(m.UpstreamValue[:0], dAtA[iNdEx:postIndex]...)
+			if m.UpstreamValue == nil {
+				m.UpstreamValue = []byte{}
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.UpstreamProof = append(m.UpstreamProof[:0], dAtA[iNdEx:postIndex]...)
+			if m.UpstreamProof == nil {
+				m.UpstreamProof = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ConsensusState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsensusState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProxyConsensusState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamProofHeight", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -551,10 +2010,7 @@ func (m *ConsensusState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProxyConsensusState == nil {
-				m.ProxyConsensusState = &types.Any{}
-			}
-			if err := m.ProxyConsensusState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UpstreamProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -35,3 +35,8 @@ func GetConsensusState(store sdk.KVStore, cdc codec.BinaryCodec, height exported
 
 	return consensusState, nil
 }
+
+// SetConsensusState stores the consensus state at the given height.
+func SetConsensusState(store sdk.KVStore, cdc codec.BinaryCodec, consensusState *ConsensusState, height exported.Height) {
+	store.Set(host.ConsensusStateKey(height), clienttypes.MustMarshalConsensusState(cdc, consensusState))
+}
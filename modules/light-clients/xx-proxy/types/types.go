@@ -1 +1,94 @@
 package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/modules/core/24-host"
+	"github.com/cosmos/ibc-go/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/modules/light-clients/07-tendermint/types"
+)
+
+var _ codectypes.UnpackInterfacesMessage = (*UpstreamClientProof)(nil)
+var _ codectypes.UnpackInterfacesMessage = (*ConnectionProof)(nil)
+
+// GetChainID returns the chain ID of the chain that the client tracks.
+// A client that wraps an underlying client, such as a multiv client, returns the chain ID of the underlying one.
+// A proxy client tracks the upstream chain that it is bound to, so a proxy client without the binding is rejected.
+func GetChainID(clientState exported.ClientState) (string, error) {
+	switch cs := clientState.(type) {
+	case *ibctmtypes.ClientState:
+		return cs.ChainId, nil
+	case *ClientState:
+		if len(cs.UpstreamChainId) == 0 {
+			return "", sdkerrors.Wrap(clienttypes.ErrInvalidClient, "proxy client is not bound to an upstream chain")
+		}
+		return cs.UpstreamChainId, nil
+	case interface {
+		GetUnderlyingClientState() exported.ClientState
+	}:
+		return GetChainID(cs.GetUnderlyingClientState())
+	default:
+		return "", sdkerrors.Wrapf(clienttypes.ErrInvalidClientType, "cannot get the chain ID of client type %s", clientState.ClientType())
+	}
+}
+
+func NewUpstreamClientProof(clientState exported.ClientState, proof []byte) (*UpstreamClientProof, error) {
+	anyClientState, err := clienttypes.PackClientState(clientState)
+	if err != nil {
+		return nil, err
+	}
+	return &UpstreamClientProof{ClientState: anyClientState, Proof: proof}, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (p *UpstreamClientProof) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpacker.UnpackAny(p.ClientState, new(exported.ClientState))
+}
+
+func NewConnectionProof(proof []byte, upstreamClientProof UpstreamClientProof) *ConnectionProof {
+	return &ConnectionProof{Proof: proof, UpstreamClient: upstreamClientProof}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (p *ConnectionProof) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return p.UpstreamClient.UnpackInterfaces(unpacker)
+}
+
+// verifyUpstreamClient verifies that the proxy had the upstream client state at the given root
+// and that the upstream client tracks the upstream chain which the client is bound to.
+func (cs *ClientState) verifyUpstreamClient(cdc codec.BinaryCodec, root exported.Root, proof *UpstreamClientProof) error {
+	upstreamClientState, err := clienttypes.UnpackClientState(proof.ClientState)
+	if err != nil {
+		return sdkerrors.Wrapf(clienttypes.ErrInvalidClient, "failed to unpack upstream client state: %v", err)
+	}
+	chainID, err := GetChainID(upstreamClientState)
+	if err != nil {
+		return err
+	}
+	if chainID != cs.UpstreamChainId {
+		return sdkerrors.Wrapf(clienttypes.ErrInvalidClient, "upstream client tracks chain %s, but the client is bound to %s", chainID, cs.UpstreamChainId)
+	}
+	if len(cs.UpstreamClientType) > 0 && upstreamClientState.ClientType() != cs.UpstreamClientType {
+		return sdkerrors.Wrapf(clienttypes.ErrInvalidClientType, "expected upstream client type %s, got %s", cs.UpstreamClientType, upstreamClientState.ClientType())
+	}
+
+	var merkleProof commitmenttypes.MerkleProof
+	if err := cdc.Unmarshal(proof.Proof, &merkleProof); err != nil {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "failed to unmarshal proof into commitment merkle proof")
+	}
+	path, err := commitmenttypes.ApplyPrefix(cs.IbcPrefix, commitmenttypes.NewMerklePath(host.FullClientStatePath(cs.UpstreamClientId)))
+	if err != nil {
+		return err
+	}
+	bz, err := clienttypes.MarshalClientState(cdc, upstreamClientState)
+	if err != nil {
+		return err
+	}
+	if err := merkleProof.VerifyMembership(cs.GetProofSpecs(), root, path, bz); err != nil {
+		return sdkerrors.Wrapf(err, "failed upstream client state verification for upstream client (%s)", cs.UpstreamClientId)
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
 	host "github.com/cosmos/ibc-go/modules/core/24-host"
 	"github.com/cosmos/ibc-go/modules/core/exported"
@@ -31,7 +32,17 @@ func (cs ClientState) CheckHeaderAndUpdateState(ctx sdk.Context, cdc codec.Binar
 }
 
 func (cs *ClientState) CheckMisbehaviourAndUpdateState(ctx sdk.Context, cdc codec.BinaryCodec, store sdk.KVStore, misbehaviour exported.Misbehaviour) (exported.ClientState, error) {
-	clientState, err := cs.GetProxyClientState().CheckMisbehaviourAndUpdateState(ctx, cdc, store, misbehaviour)
+	var proxyMisbehaviour exported.Misbehaviour
+	switch misbehaviour := misbehaviour.(type) {
+	case *Misbehaviour:
+		proxyMisbehaviour = misbehaviour.GetProxyMisbehaviour()
+	case *ProxyMisbehaviour:
+		// the proof of the upstream value needs the store of another client, which is given by the proxy module
+		return nil, sdkerrors.Wrap(clienttypes.ErrInvalidMisbehaviour, "proxy misbehaviour must be submitted through the proxy module")
+	default:
+		return nil, sdkerrors.Wrapf(clienttypes.ErrInvalidClientType, "expected type %T, got %T", &Misbehaviour{}, misbehaviour)
+	}
+	clientState, err := cs.GetProxyClientState().CheckMisbehaviourAndUpdateState(ctx, cdc, NewProxyExtractorStore(cdc, store), proxyMisbehaviour)
 	if err != nil {
 		return nil, err
 	}
@@ -43,14 +54,51 @@ func (cs *ClientState) CheckMisbehaviourAndUpdateState(ctx sdk.Context, cdc code
 	return cs, nil
 }
 
+// CheckSubstituteAndUpdateState updates the client with the state of the substitute proxy client
+// if the underlying client allows the substitution of the underlying client of the substitute.
+// The subject takes over the proxy fields of the substitute, so a proposal can deliberately change
+// the upstream client or the prefixes by choosing a substitute created with them.
+// A client bound to an upstream chain can only be substituted by a client bound to the same one.
+// A client frozen due to a proxy misbehaviour cannot be recovered as the proxy chain itself is faulty.
 func (cs *ClientState) CheckSubstituteAndUpdateState(ctx sdk.Context, cdc codec.BinaryCodec, subjectClientStore sdk.KVStore, substituteClientStore sdk.KVStore, substituteClient exported.ClientState) (exported.ClientState, error) {
-	clientState, err := cs.GetProxyClientState().CheckSubstituteAndUpdateState(ctx, cdc, subjectClientStore, substituteClientStore, substituteClient)
+	substituteClientState, ok := substituteClient.(*ClientState)
+	if !ok {
+		return nil, sdkerrors.Wrapf(clienttypes.ErrInvalidClient, "expected type %T, got %T", &ClientState{}, substituteClient)
+	}
+	if !cs.FrozenHeight.IsZero() {
+		return nil, sdkerrors.Wrap(clienttypes.ErrUpdateClientFailed, "client frozen due to a proxy misbehaviour cannot be recovered")
+	}
+	if len(cs.UpstreamChainId) > 0 && cs.UpstreamChainId != substituteClientState.UpstreamChainId {
+		return nil, sdkerrors.Wrapf(clienttypes.ErrInvalidSubstitute, "subject is bound to upstream chain %s, but substitute is bound to %q", cs.UpstreamChainId, substituteClientState.UpstreamChainId)
+	}
+
+	clientState, err := cs.GetProxyClientState().CheckSubstituteAndUpdateState(
+		ctx, cdc, NewProxyExtractorStore(cdc, subjectClientStore), NewProxyExtractorStore(cdc, substituteClientStore), substituteClientState.GetProxyClientState(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// the underlying client copies the underlying consensus state, which is replaced with the proxy one
+	height := substituteClientState.GetLatestHeight()
+	consensusState, err := GetConsensusState(substituteClientStore, cdc, height)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "unable to retrieve latest consensus state for substitute client")
+	}
+	SetConsensusState(subjectClientStore, cdc, consensusState, height)
+
 	anyClientState, err := clienttypes.PackClientState(clientState)
 	if err != nil {
 		return nil, err
 	}
-	cs.ProxyClientState = anyClientState
-	return cs, nil
+	return &ClientState{
+		ProxyClientState:   anyClientState,
+		UpstreamClientId:   substituteClientState.UpstreamClientId,
+		ProxyPrefix:        substituteClientState.ProxyPrefix,
+		IbcPrefix:          substituteClientState.IbcPrefix,
+		UpstreamChainId:    substituteClientState.UpstreamChainId,
+		UpstreamClientType: substituteClientState.UpstreamClientType,
+	}, nil
 }
 
 // proxyExtractorStore provides a store that extracts the underlying state from ProxyConsensusState
@@ -13,8 +13,23 @@ func GetQueryCmd() *cobra.Command {
 		Short:                      "IBC proxy query subcommands",
 		DisableFlagParsing:         true,
 		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
 	}
 
+	queryCmd.AddCommand(
+		GetCmdQueryProxyClientState(),
+		GetCmdQueryProxyConsensusState(),
+		GetCmdQueryProxyConnection(),
+		GetCmdQueryProxyChannel(),
+		GetCmdQueryProxyPacketCommitment(),
+		GetCmdQueryProxyPacketAcknowledgement(),
+		GetCmdQueryIncentivizedPackets(),
+		GetCmdQueryIncentivizedPacket(),
+		GetCmdQueryPausedProxies(),
+		GetCmdQueryUpstreamChain(),
+		GetCmdCommitmentPath(),
+	)
+
 	return queryCmd
 }
 
@@ -28,5 +43,34 @@ func NewTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
+	txCmd.AddCommand(
+		NewProxyClientStateCmd(),
+		NewProxyConnectionOpenTryCmd(),
+		NewProxyConnectionOpenAckCmd(),
+		NewProxyConnectionOpenConfirmCmd(),
+		NewProxyConnectionOpenFinalizeCmd(),
+		NewProxyConnectionStateCmd(),
+		NewProxyChannelOpenTryCmd(),
+		NewProxyChannelOpenAckCmd(),
+		NewProxyChannelOpenConfirmCmd(),
+		NewProxyChannelOpenFinalizeCmd(),
+		NewProxyChannelCloseConfirmCmd(),
+		NewProxyChannelStateCmd(),
+		NewProxyRecvPacketCmd(),
+		NewProxyAcknowledgePacketCmd(),
+		NewProxyPacketBatchCmd(),
+		NewProxyTimeoutPacketCmd(),
+		NewProxyTimeoutOnCloseCmd(),
+		NewPruneProxyPacketCommitmentCmd(),
+		NewProxyWithHeaderCmd(),
+		NewSubmitProxyMisbehaviourCmd(),
+		NewPayProxyPacketFeeCmd(),
+		NewRegisterUpstreamCmd(),
+		NewDeregisterUpstreamCmd(),
+		NewPauseProxyCmd(),
+		NewUnpauseProxyCmd(),
+		NewMigrateProxyClientCmd(),
+	)
+
 	return txCmd
 }
@@ -0,0 +1,411 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/modules/core/23-commitment/types"
+
+	"github.com/datachainlab/ibc-proxy/modules/proxy/client/utils"
+	"github.com/datachainlab/ibc-proxy/modules/proxy/types"
+)
+
+const FlagProxyPrefix = "proxy-prefix"
+
+// GetCmdQueryProxyClientState defines the command to query a proxy client state
+func GetCmdQueryProxyClientState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "client-state [upstream-client-id] [client-id]",
+		Short:   "Query a client state of the downstream that the upstream has",
+		Example: fmt.Sprintf("%s query ibc-proxy client-state [upstream-client-id] [client-id]", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			upstreamPrefix, err := getUpstreamPrefix(cmd)
+			if err != nil {
+				return err
+			}
+			prove, _ := cmd.Flags().GetBool(flags.FlagProve)
+
+			res, err := utils.QueryProxyClientState(clientCtx, args[0], upstreamPrefix, args[1], prove)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addProxyQueryFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryProxyConsensusState defines the command to query a proxy consensus state
+func GetCmdQueryProxyConsensusState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "consensus-state [upstream-client-id] [client-id] [height]",
+		Short:   "Query a consensus state of the downstream that the upstream has",
+		Example: fmt.Sprintf("%s query ibc-proxy consensus-state [upstream-client-id] [client-id] [height]", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			upstreamPrefix, err := getUpstreamPrefix(cmd)
+			if err != nil {
+				return err
+			}
+			height, err := clienttypes.ParseHeight(args[2])
+			if err != nil {
+				return err
+			}
+			prove, _ := cmd.Flags().GetBool(flags.FlagProve)
+
+			res, err := utils.QueryProxyConsensusState(clientCtx, args[0], upstreamPrefix, args[1], height, prove)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addProxyQueryFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryProxyConnection defines the command to query a proxy connection end
+func GetCmdQueryProxyConnection() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "connection [upstream-client-id] [connection-id]",
+		Short:   "Query a connection end that the upstream has",
+		Example: fmt.Sprintf("%s query ibc-proxy connection [upstream-client-id] [connection-id]", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			upstreamPrefix, err := getUpstreamPrefix(cmd)
+			if err != nil {
+				return err
+			}
+			prove, _ := cmd.Flags().GetBool(flags.FlagProve)
+
+			res, err := utils.QueryProxyConnection(clientCtx, args[0], upstreamPrefix, args[1], prove)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addProxyQueryFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryProxyChannel defines the command to query a proxy channel end
+func GetCmdQueryProxyChannel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "channel [upstream-client-id] [port-id] [channel-id]",
+		Short:   "Query a channel end that the upstream has",
+		Example: fmt.Sprintf("%s query ibc-proxy channel [upstream-client-id] [port-id] [channel-id]", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			upstreamPrefix, err := getUpstreamPrefix(cmd)
+			if err != nil {
+				return err
+			}
+			prove, _ := cmd.Flags().GetBool(flags.FlagProve)
+
+			res, err := utils.QueryProxyChannel(clientCtx, args[0], upstreamPrefix, args[1], args[2], prove)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addProxyQueryFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryProxyPacketCommitment defines the command to query a proxy packet commitment
+func GetCmdQueryProxyPacketCommitment() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "packet-commitment [upstream-client-id] [port-id] [channel-id] [sequence]",
+		Short:   "Query a packet commitment that the upstream has",
+		Example: fmt.Sprintf("%s query ibc-proxy packet-commitment [upstream-client-id] [port-id] [channel-id] [sequence]", version.AppName),
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			upstreamPrefix, err := getUpstreamPrefix(cmd)
+			if err != nil {
+				return err
+			}
+			sequence, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+			prove, _ := cmd.Flags().GetBool(flags.FlagProve)
+
+			res, err := utils.QueryProxyPacketCommitment(clientCtx, args[0], upstreamPrefix, args[1], args[2], sequence, prove)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addProxyQueryFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryProxyPacketAcknowledgement defines the command to query a proxy packet acknowledgement
+func GetCmdQueryProxyPacketAcknowledgement() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "packet-ack [upstream-client-id] [port-id] [channel-id] [sequence]",
+		Short:   "Query a packet acknowledgement that the upstream has",
+		Example: fmt.Sprintf("%s query ibc-proxy packet-ack [upstream-client-id] [port-id] [channel-id] [sequence]", version.AppName),
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			upstreamPrefix, err := getUpstreamPrefix(cmd)
+			if err != nil {
+				return err
+			}
+			sequence, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+			prove, _ := cmd.Flags().GetBool(flags.FlagProve)
+
+			res, err := utils.QueryProxyPacketAcknowledgement(clientCtx, args[0], upstreamPrefix, args[1], args[2], sequence, prove)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addProxyQueryFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryIncentivizedPackets defines the command to query the packets that have fees escrowed
+func GetCmdQueryIncentivizedPackets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "incentivized-packets [upstream-client-id]",
+		Short:   "Query the packets that have fees escrowed for relaying them through the proxy",
+		Long:    "Query the packets that have fees escrowed for relaying them through the proxy. If the upstream client ID is omitted, the packets of all the upstreams are returned.",
+		Example: fmt.Sprintf("%s query ibc-proxy incentivized-packets [upstream-client-id]", version.AppName),
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &types.QueryIncentivizedPacketsRequest{Pagination: pageReq}
+			if len(args) > 0 {
+				req.UpstreamClientId = args[0]
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.IncentivizedPackets(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "incentivized packets")
+
+	return cmd
+}
+
+// GetCmdQueryIncentivizedPacket defines the command to query the fees escrowed for a packet
+func GetCmdQueryIncentivizedPacket() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "incentivized-packet [upstream-client-id] [port] [channel] [sequence]",
+		Short:   "Query the fees escrowed for relaying a packet through the proxy",
+		Long:    "Query the fees escrowed for relaying a packet through the proxy, identified by its port, channel and sequence on the upstream side",
+		Example: fmt.Sprintf("%s query ibc-proxy incentivized-packet [upstream-client-id] [port] [channel] [sequence] --to-upstream", version.AppName),
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			sequence, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+			upstreamPrefix, err := getUpstreamPrefix(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.IncentivizedPacket(cmd.Context(), &types.QueryIncentivizedPacketRequest{
+				UpstreamClientId: args[0],
+				UpstreamPrefix:   upstreamPrefix,
+				PortId:           args[1],
+				ChannelId:        args[2],
+				Sequence:         sequence,
+				Direction:        getPacketDirection(cmd),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addPacketDirectionFlag(cmd)
+	addUpstreamPrefixFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPausedProxies defines the command to query the upstream clients and channels for which proxying is paused
+func GetCmdQueryPausedProxies() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "paused-proxies",
+		Short:   "Query the upstream clients and channels for which proxying is paused",
+		Example: fmt.Sprintf("%s query ibc-proxy paused-proxies", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PausedProxies(cmd.Context(), &types.QueryPausedProxiesRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "paused proxies")
+
+	return cmd
+}
+
+// GetCmdQueryUpstreamChain defines the command to query the upstream chain which a proxy client is bound to
+func GetCmdQueryUpstreamChain() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "upstream-chain [client-id]",
+		Short:   "Query the upstream chain which a proxy client of the downstream is bound to",
+		Example: fmt.Sprintf("%s query ibc-proxy upstream-chain [client-id]", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.UpstreamChain(cmd.Context(), &types.QueryUpstreamChainRequest{ClientId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdCommitmentPath defines the command to print the path of a proxy state that the proxy client verifies
+func GetCmdCommitmentPath() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commitment-path [upstream-client-id] [path]",
+		Short: "Print the path of a proxy state that the proxy client verifies",
+		Long: `Print the path of a proxy state in the form of /{proxy_prefix}/{upstream_client_id}/{upstream_prefix}/{path}.
+The path is an ICS-24 host path such as 'commitments/ports/transfer/channels/channel-0/sequences/1'.`,
+		Example: fmt.Sprintf("%s query ibc-proxy commitment-path 07-tendermint-0 connections/connection-0 --upstream-prefix ibc", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			upstreamPrefix, err := getUpstreamPrefix(cmd)
+			if err != nil {
+				return err
+			}
+			proxyPrefix, err := cmd.Flags().GetString(FlagProxyPrefix)
+			if err != nil {
+				return err
+			}
+			if len(proxyPrefix) == 0 {
+				return fmt.Errorf("proxy prefix cannot be empty")
+			}
+
+			path, err := types.ProxyCommitmentPath(commitmenttypes.NewMerklePrefix([]byte(proxyPrefix)), upstreamPrefix, args[0], args[1])
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintString(path + "\n")
+		},
+	}
+
+	addUpstreamPrefixFlag(cmd)
+	cmd.Flags().String(FlagProxyPrefix, types.StoreKey, "the key prefix of the proxy store")
+
+	return cmd
+}
+
+func addProxyQueryFlags(cmd *cobra.Command) {
+	addUpstreamPrefixFlag(cmd)
+	cmd.Flags().Bool(flags.FlagProve, true, "show proofs for the query results")
+	flags.AddQueryFlagsToCmd(cmd)
+}
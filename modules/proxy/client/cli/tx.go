@@ -0,0 +1,1357 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/modules/core/exported"
+
+	"github.com/datachainlab/ibc-proxy/modules/proxy/client/utils"
+	"github.com/datachainlab/ibc-proxy/modules/proxy/types"
+)
+
+const (
+	FlagUpstreamPrefix = "upstream-prefix"
+	FlagUpstreamHops   = "upstream-hops"
+	FlagOrdered        = "ordered"
+	FlagBatchProof     = "batch-proof"
+	FlagAtomic         = "atomic"
+	FlagToUpstream     = "to-upstream"
+)
+
+// NewProxyClientStateCmd defines the command to submit a MsgProxyClientState
+func NewProxyClientStateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "client-state [upstream-client-id] [counterparty-client-id] [path/to/client_state.json] [path/to/consensus_state.json] [path/to/proof_client.json] [path/to/proof_consensus.json] [proof-height] [consensus-height]",
+		Short: "proxy a client state and a consensus state of the upstream",
+		Long:  "proxy a client state of the downstream that the upstream has and its consensus state after verifying them with the proofs",
+		Example: fmt.Sprintf(
+			"%s tx ibc-proxy client-state [upstream-client-id] [counterparty-client-id] [path/to/client_state.json] [path/to/consensus_state.json] [path/to/proof_client.json] [path/to/proof_consensus.json] [proof-height] [consensus-height] --from node0",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(8),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			upstreamPrefix, err := getUpstreamPrefix(cmd)
+			if err != nil {
+				return err
+			}
+			clientState, err := utils.ParseClientState(cdc, args[2])
+			if err != nil {
+				return err
+			}
+			consensusState, err := utils.ParseConsensusState(cdc, args[3])
+			if err != nil {
+				return err
+			}
+			proofClient, err := utils.ParseProof(cdc, args[4])
+			if err != nil {
+				return err
+			}
+			proofConsensus, err := utils.ParseProof(cdc, args[5])
+			if err != nil {
+				return err
+			}
+			proofHeight, err := clienttypes.ParseHeight(args[6])
+			if err != nil {
+				return err
+			}
+			consensusHeight, err := clienttypes.ParseHeight(args[7])
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgProxyClientState(
+				args[0], upstreamPrefix, clientState, consensusState, proofClient, proofConsensus, proofHeight, consensusHeight,
+				clientCtx.GetFromAddress().String(),
+			)
+			if err != nil {
+				return err
+			}
+			msg.CounterpartyClientId = args[1]
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addUpstreamPrefixFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewProxyConnectionOpenTryCmd defines the command to submit a MsgProxyConnectionOpenTry
+func NewProxyConnectionOpenTryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "connection-open-try [connection-id] [path/to/connection.json] [path/to/downstream_client_state.json] [path/to/downstream_consensus_state.json] [path/to/proxy_client_state.json] [path/to/proof_init.json] [path/to/proof_client.json] [path/to/proof_consensus.json] [proof-height] [consensus-height] [path/to/proof_proxy_client.json] [path/to/proof_proxy_consensus.json] [proof-proxy-height] [proxy-consensus-height]",
+		Short: "proxy the connection end that the upstream has initialized",
+		Args:  cobra.ExactArgs(14),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			upstreamPrefix, err := getUpstreamPrefix(cmd)
+			if err != nil {
+				return err
+			}
+			p, err := parseProxyConnectionArgs(cdc, args)
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgProxyConnectionOpenTry(
+				p.connectionID, upstreamPrefix, p.connection, p.downstreamClientState, p.downstreamConsensusState, p.proxyClientState,
+				p.proofHandshake, p.proofClient, p.proofConsensus, p.proofHeight, p.consensusHeight,
+				p.proofProxyClient, p.proofProxyConsensus, p.proofProxyHeight, p.proxyConsensusHeight,
+				clientCtx.GetFromAddress().String(),
+			)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addUpstreamPrefixFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewProxyConnectionOpenAckCmd defines the command to submit a MsgProxyConnectionOpenAck
+func NewProxyConnectionOpenAckCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "connection-open-ack [connection-id] [path/to/connection.json] [path/to/downstream_client_state.json] [path/to/downstream_consensus_state.json] [path/to/proxy_client_state.json] [path/to/proof_try.json] [path/to/proof_client.json] [path/to/proof_consensus.json] [proof-height] [consensus-height] [path/to/proof_proxy_client.json] [path/to/proof_proxy_consensus.json] [proof-proxy-height] [proxy-consensus-height]",
+		Short: "proxy the connection end that the upstream has opened with TRYOPEN",
+		Args:  cobra.ExactArgs(14),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			upstreamPrefix, err := getUpstreamPrefix(cmd)
+			if err != nil {
+				return err
+			}
+			p, err := parseProxyConnectionArgs(cdc, args)
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgProxyConnectionOpenAck(
+				p.connectionID, upstreamPrefix, p.connection, p.downstreamClientState, p.downstreamConsensusState, p.proxyClientState,
+				p.proofHandshake, p.proofClient, p.proofConsensus, p.proofHeight, p.consensusHeight,
+				p.proofProxyClient, p.proofProxyConsensus, p.proofProxyHeight, p.proxyConsensusHeight,
+				clientCtx.GetFromAddress().String(),
+			)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addUpstreamPrefixFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewProxyConnectionOpenConfirmCmd defines the command to submit a MsgProxyConnectionOpenConfirm
+func NewProxyConnectionOpenConfirmCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "connection-open-confirm [connection-id] [upstream-client-id] [counterparty-connection-id] [path/to/proof_ack.json] [proof-height]",
+		Short: "proxy the connection end that the upstream has opened with ACK",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			upstreamPrefix, err := getUpstreamPrefix(cmd)
+			if err != nil {
+				return err
+			}
+			proofAck, err := utils.ParseProof(cdc, args[3])
+			if err != nil {
+				return err
+			}
+			proofHeight, err := clienttypes.ParseHeight(args[4])
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgProxyConnectionOpenConfirm(args[0], args[1], upstreamPrefix, args[2], proofAck, proofHeight, clientCtx.GetFromAddress().String())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addUpstreamPrefixFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewProxyConnectionOpenFinalizeCmd defines the command to submit a MsgProxyConnectionOpenFinalize
+func NewProxyConnectionOpenFinalizeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "connection-open-finalize [connection-id] [upstream-client-id] [path/to/proof_confirm.json] [proof-height]",
+		Short: "proxy the connection end that the upstream has opened with CONFIRM",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			upstreamPrefix, err := getUpstreamPrefix(cmd)
+			if err != nil {
+				return err
+			}
+			proofConfirm, err := utils.ParseProof(cdc, args[2])
+			if err != nil {
+				return err
+			}
+			proofHeight, err := clienttypes.ParseHeight(args[3])
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgProxyConnectionOpenFinalize(args[0], args[1], upstreamPrefix, proofConfirm, proofHeight, clientCtx.GetFromAddress().String())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addUpstreamPrefixFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewProxyConnectionStateCmd defines the command to submit a MsgProxyConnectionState
+func NewProxyConnectionStateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "connection-state [connection-id] [upstream-client-id] [path/to/connection.json] [path/to/proof.json] [proof-height]",
+		Short: "proxy a connection end that the upstream has already opened",
+		Long:  "proxy a connection end that the upstream has already opened under another upstream client, e.g. to migrate the proxy clients of the downstream to it",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			upstreamPrefix, err := getUpstreamPrefix(cmd)
+			if err != nil {
+				return err
+			}
+			connection, err := utils.ParseConnectionEnd(cdc, args[2])
+			if err != nil {
+				return err
+			}
+			proof, err := utils.ParseProof(cdc, args[3])
+			if err != nil {
+				return err
+			}
+			proofHeight, err := clienttypes.ParseHeight(args[4])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgProxyConnectionState(args[0], args[1], upstreamPrefix, connection, proof, proofHeight, clientCtx.GetFromAddress().String())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addUpstreamPrefixFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewProxyChannelOpenTryCmd defines the command to submit a MsgProxyChannelOpenTry
+func NewProxyChannelOpenTryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "channel-open-try [upstream-client-id] [connection-hops] [port-id] [channel-id] [downstream-port-id] [version] [path/to/proof_init.json] [proof-height]",
+		Short: "proxy the channel end that the upstream has initialized",
+		Long:  "proxy the channel end that the upstream has initialized. The connection hops are separated by commas.",
+		Args:  cobra.ExactArgs(8),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			upstreamPrefix, err := getUpstreamPrefix(cmd)
+			if err != nil {
+				return err
+			}
+			upstreamHops, err := getUpstreamHops(cmd)
+			if err != nil {
+				return err
+			}
+			proofInit, err := utils.ParseProof(cdc, args[6])
+			if err != nil {
+				return err
+			}
+			proofHeight, err := clienttypes.ParseHeight(args[7])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgProxyChannelOpenTry{
+				UpstreamClientId: args[0],
+				UpstreamPrefix:   upstreamPrefix,
+				Order:            getOrder(cmd),
+				ConnectionHops:   strings.Split(args[1], ","),
+				PortId:           args[2],
+				ChannelId:        args[3],
+				DownstreamPortId: args[4],
+				Version:          args[5],
+				ProofInit:        proofInit,
+				ProofHeight:      proofHeight,
+				Signer:           clientCtx.GetFromAddress().String(),
+				UpstreamHops:     upstreamHops,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addUpstreamPrefixFlag(cmd)
+	addChannelFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewProxyChannelOpenAckCmd defines the command to submit a MsgProxyChannelOpenAck
+func NewProxyChannelOpenAckCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "channel-open-ack [upstream-client-id] [connection-hops] [port-id] [channel-id] [downstream-port-id] [downstream-channel-id] [version] [path/to/proof_try.json] [proof-height]",
+		Short: "proxy the channel end that the upstream has opened with TRYOPEN",
+		Long:  "proxy the channel end that the upstream has opened with TRYOPEN. The connection hops are separated by commas.",
+		Args:  cobra.ExactArgs(9),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			upstreamPrefix, err := getUpstreamPrefix(cmd)
+			if err != nil {
+				return err
+			}
+			upstreamHops, err := getUpstreamHops(cmd)
+			if err != nil {
+				return err
+			}
+			proofTry, err := utils.ParseProof(cdc, args[7])
+			if err != nil {
+				return err
+			}
+			proofHeight, err := clienttypes.ParseHeight(args[8])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgProxyChannelOpenAck{
+				UpstreamClientId:    args[0],
+				UpstreamPrefix:      upstreamPrefix,
+				Order:               getOrder(cmd),
+				ConnectionHops:      strings.Split(args[1], ","),
+				PortId:              args[2],
+				ChannelId:           args[3],
+				DownstreamPortId:    args[4],
+				DownstreamChannelId: args[5],
+				Version:             args[6],
+				ProofTry:            proofTry,
+				ProofHeight:         proofHeight,
+				Signer:              clientCtx.GetFromAddress().String(),
+				UpstreamHops:        upstreamHops,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addUpstreamPrefixFlag(cmd)
+	addChannelFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewProxyChannelOpenConfirmCmd defines the command to submit a MsgProxyChannelOpenConfirm
+func NewProxyChannelOpenConfirmCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "channel-open-confirm [upstream-client-id] [port-id] [channel-id] [downstream-channel-id] [path/to/proof_ack.json] [proof-height]",
+		Short: "proxy the channel end that the upstream has opened with ACK",
+		Args:  cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			upstreamPrefix, err := getUpstreamPrefix(cmd)
+			if err != nil {
+				return err
+			}
+			proofAck, err := utils.ParseProof(cdc, args[4])
+			if err != nil {
+				return err
+			}
+			proofHeight, err := clienttypes.ParseHeight(args[5])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgProxyChannelOpenConfirm{
+				UpstreamClientId:    args[0],
+				UpstreamPrefix:      upstreamPrefix,
+				PortId:              args[1],
+				ChannelId:           args[2],
+				DownstreamChannelId: args[3],
+				ProofAck:            proofAck,
+				ProofHeight:         proofHeight,
+				Signer:              clientCtx.GetFromAddress().String(),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addUpstreamPrefixFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewProxyChannelOpenFinalizeCmd defines the command to submit a MsgProxyChannelOpenFinalize
+func NewProxyChannelOpenFinalizeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "channel-open-finalize [upstream-client-id] [port-id] [channel-id] [path/to/proof_confirm.json] [proof-height]",
+		Short: "proxy the channel end that the upstream has opened with CONFIRM",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			upstreamPrefix, err := getUpstreamPrefix(cmd)
+			if err != nil {
+				return err
+			}
+			proofConfirm, err := utils.ParseProof(cdc, args[3])
+			if err != nil {
+				return err
+			}
+			proofHeight, err := clienttypes.ParseHeight(args[4])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgProxyChannelOpenFinalize{
+				UpstreamClientId: args[0],
+				UpstreamPrefix:   upstreamPrefix,
+				PortId:           args[1],
+				ChannelId:        args[2],
+				ProofConfirm:     proofConfirm,
+				ProofHeight:      proofHeight,
+				Signer:           clientCtx.GetFromAddress().String(),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addUpstreamPrefixFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewProxyChannelCloseConfirmCmd defines the command to submit a MsgProxyChannelCloseConfirm
+func NewProxyChannelCloseConfirmCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "channel-close-confirm [upstream-client-id] [port-id] [channel-id] [path/to/proof_init.json] [proof-height]",
+		Short: "proxy the channel end that the upstream has closed",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			upstreamPrefix, err := getUpstreamPrefix(cmd)
+			if err != nil {
+				return err
+			}
+			proofInit, err := utils.ParseProof(cdc, args[3])
+			if err != nil {
+				return err
+			}
+			proofHeight, err := clienttypes.ParseHeight(args[4])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgProxyChannelCloseConfirm{
+				UpstreamClientId: args[0],
+				UpstreamPrefix:   upstreamPrefix,
+				PortId:           args[1],
+				ChannelId:        args[2],
+				ProofInit:        proofInit,
+				ProofHeight:      proofHeight,
+				Signer:           clientCtx.GetFromAddress().String(),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addUpstreamPrefixFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewProxyChannelStateCmd defines the command to submit a MsgProxyChannelState
+func NewProxyChannelStateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "channel-state [upstream-client-id] [port-id] [channel-id] [path/to/channel.json] [path/to/proof.json] [proof-height]",
+		Short: "proxy a channel end that the upstream has already opened",
+		Long:  "proxy a channel end that the upstream has already opened under another upstream client. The connections of the channel must have been proxied, and the connection hops after the first are located by the upstream hops.",
+		Args:  cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			upstreamPrefix, err := getUpstreamPrefix(cmd)
+			if err != nil {
+				return err
+			}
+			upstreamHops, err := getUpstreamHops(cmd)
+			if err != nil {
+				return err
+			}
+			channel, err := utils.ParseChannel(cdc, args[3])
+			if err != nil {
+				return err
+			}
+			proof, err := utils.ParseProof(cdc, args[4])
+			if err != nil {
+				return err
+			}
+			proofHeight, err := clienttypes.ParseHeight(args[5])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgProxyChannelState{
+				UpstreamClientId: args[0],
+				UpstreamPrefix:   upstreamPrefix,
+				PortId:           args[1],
+				ChannelId:        args[2],
+				Channel:          channel,
+				Proof:            proof,
+				ProofHeight:      proofHeight,
+				Signer:           clientCtx.GetFromAddress().String(),
+				UpstreamHops:     upstreamHops,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addUpstreamPrefixFlag(cmd)
+	addUpstreamHopsFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewProxyRecvPacketCmd defines the command to submit a MsgProxyRecvPacket
+func NewProxyRecvPacketCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recv-packet [upstream-client-id] [path/to/packet.json] [path/to/proof.json] [proof-height]",
+		Short: "proxy the commitment of a packet that the upstream has sent",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			upstreamPrefix, err := getUpstreamPrefix(cmd)
+			if err != nil {
+				return err
+			}
+			packet, err := utils.ParsePacket(cdc, args[1])
+			if err != nil {
+				return err
+			}
+			proof, err := utils.ParseProof(cdc, args[2])
+			if err != nil {
+				return err
+			}
+			proofHeight, err := clienttypes.ParseHeight(args[3])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgProxyRecvPacket{
+				UpstreamClientId: args[0],
+				UpstreamPrefix:   upstreamPrefix,
+				Packet:           packet,
+				Proof:            proof,
+				ProofHeight:      proofHeight,
+				Signer:           clientCtx.GetFromAddress().String(),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addUpstreamPrefixFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewProxyAcknowledgePacketCmd defines the command to submit a MsgProxyAcknowledgePacket
+func NewProxyAcknowledgePacketCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "acknowledge-packet [upstream-client-id] [path/to/packet.json] [path/to/acknowledgement] [path/to/proof.json] [proof-height]",
+		Short: "proxy the acknowledgement of a packet that the upstream has written",
+		Long:  "proxy the acknowledgement of a packet that the upstream has written. The acknowledgement file must contain the raw acknowledgement bytes.",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			upstreamPrefix, err := getUpstreamPrefix(cmd)
+			if err != nil {
+				return err
+			}
+			packet, err := utils.ParsePacket(cdc, args[1])
+			if err != nil {
+				return err
+			}
+			acknowledgement, err := ioutil.ReadFile(args[2])
+			if err != nil {
+				return err
+			}
+			proof, err := utils.ParseProof(cdc, args[3])
+			if err != nil {
+				return err
+			}
+			proofHeight, err := clienttypes.ParseHeight(args[4])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgProxyAcknowledgePacket{
+				UpstreamClientId: args[0],
+				UpstreamPrefix:   upstreamPrefix,
+				Packet:           packet,
+				Acknowledgement:  acknowledgement,
+				Proof:            proof,
+				ProofHeight:      proofHeight,
+				Signer:           clientCtx.GetFromAddress().String(),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addUpstreamPrefixFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewProxyPacketBatchCmd defines the command to submit a MsgProxyPacketBatch
+func NewProxyPacketBatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "packet-batch [upstream-client-id] [path/to/items.json] [proof-height]",
+		Short: "proxy the commitments and the acknowledgements of many packets at the same proof height",
+		Long: `proxy the commitments and the acknowledgements of many packets at the same proof height.
+The items file must contain a JSON object that has the items field of MsgProxyPacketBatch.
+The items without proofs are proven by the batch proof.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			upstreamPrefix, err := getUpstreamPrefix(cmd)
+			if err != nil {
+				return err
+			}
+			items, err := utils.ParsePacketBatchItems(cdc, args[1])
+			if err != nil {
+				return err
+			}
+			proofHeight, err := clienttypes.ParseHeight(args[2])
+			if err != nil {
+				return err
+			}
+			var batchProof []byte
+			if arg, _ := cmd.Flags().GetString(FlagBatchProof); len(arg) > 0 {
+				batchProof, err = utils.ParseProof(cdc, arg)
+				if err != nil {
+					return err
+				}
+			}
+			atomic, _ := cmd.Flags().GetBool(FlagAtomic)
+
+			msg := &types.MsgProxyPacketBatch{
+				UpstreamClientId: args[0],
+				UpstreamPrefix:   upstreamPrefix,
+				Items:            items,
+				BatchProof:       batchProof,
+				ProofHeight:      proofHeight,
+				Atomic:           atomic,
+				Signer:           clientCtx.GetFromAddress().String(),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addUpstreamPrefixFlag(cmd)
+	cmd.Flags().String(FlagBatchProof, "", "the merkle proof JSON or the path to it whose lowest proof is an ICS-23 batch proof of the items without proofs")
+	cmd.Flags().Bool(FlagAtomic, false, "revert the whole batch if any item fails")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewProxyTimeoutPacketCmd defines the command to submit a MsgProxyTimeoutPacket
+func NewProxyTimeoutPacketCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "timeout-packet [upstream-client-id] [path/to/packet.json] [path/to/proof_unreceived.json] [proof-height] [next-sequence-recv]",
+		Short: "proxy the absence of the receipt of a packet on the upstream",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			upstreamPrefix, err := getUpstreamPrefix(cmd)
+			if err != nil {
+				return err
+			}
+			packet, err := utils.ParsePacket(cdc, args[1])
+			if err != nil {
+				return err
+			}
+			proofUnreceived, err := utils.ParseProof(cdc, args[2])
+			if err != nil {
+				return err
+			}
+			proofHeight, err := clienttypes.ParseHeight(args[3])
+			if err != nil {
+				return err
+			}
+			nextSequenceRecv, err := strconv.ParseUint(args[4], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgProxyTimeoutPacket{
+				UpstreamClientId: args[0],
+				UpstreamPrefix:   upstreamPrefix,
+				Packet:           packet,
+				ProofUnreceived:  proofUnreceived,
+				ProofHeight:      proofHeight,
+				NextSequenceRecv: nextSequenceRecv,
+				Signer:           clientCtx.GetFromAddress().String(),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addUpstreamPrefixFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewProxyTimeoutOnCloseCmd defines the command to submit a MsgProxyTimeoutOnClose
+func NewProxyTimeoutOnCloseCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "timeout-on-close [upstream-client-id] [path/to/packet.json] [path/to/proof_unreceived.json] [path/to/proof_close.json] [proof-height] [next-sequence-recv]",
+		Short: "proxy the absence of the receipt of a packet on the closed channel of the upstream",
+		Args:  cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			upstreamPrefix, err := getUpstreamPrefix(cmd)
+			if err != nil {
+				return err
+			}
+			packet, err := utils.ParsePacket(cdc, args[1])
+			if err != nil {
+				return err
+			}
+			proofUnreceived, err := utils.ParseProof(cdc, args[2])
+			if err != nil {
+				return err
+			}
+			proofClose, err := utils.ParseProof(cdc, args[3])
+			if err != nil {
+				return err
+			}
+			proofHeight, err := clienttypes.ParseHeight(args[4])
+			if err != nil {
+				return err
+			}
+			nextSequenceRecv, err := strconv.ParseUint(args[5], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgProxyTimeoutOnClose{
+				UpstreamClientId: args[0],
+				UpstreamPrefix:   upstreamPrefix,
+				Packet:           packet,
+				ProofUnreceived:  proofUnreceived,
+				ProofClose:       proofClose,
+				ProofHeight:      proofHeight,
+				NextSequenceRecv: nextSequenceRecv,
+				Signer:           clientCtx.GetFromAddress().String(),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addUpstreamPrefixFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewPruneProxyPacketCommitmentCmd defines the command to submit a MsgPruneProxyPacketCommitment
+func NewPruneProxyPacketCommitmentCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "prune-packet-commitment [upstream-client-id] [port-id] [channel-id] [sequence] [path/to/proof_absence.json] [proof-height]",
+		Short:   "prune a proxied packet commitment that the upstream has deleted",
+		Long:    "Prune a proxied packet commitment with a proof of its absence on the upstream at the latest height of the upstream client.",
+		Example: fmt.Sprintf("%s tx ibc-proxy prune-packet-commitment 07-tendermint-0 transfer channel-0 1 proof.json 0-100", version.AppName),
+		Args:    cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			upstreamPrefix, err := getUpstreamPrefix(cmd)
+			if err != nil {
+				return err
+			}
+			sequence, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+			proofAbsence, err := utils.ParseProof(cdc, args[4])
+			if err != nil {
+				return err
+			}
+			proofHeight, err := clienttypes.ParseHeight(args[5])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPruneProxyPacketCommitment(args[0], upstreamPrefix, args[1], args[2], sequence, proofAbsence, proofHeight, clientCtx.GetFromAddress().String())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addUpstreamPrefixFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewProxyWithHeaderCmd defines the command to submit a MsgProxyWithHeader
+func NewProxyWithHeaderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "with-header [upstream-client-id] [path/to/header.json] [path/to/msg.json]",
+		Short: "update the upstream client with a header and proxy a message with the proofs at the height of the header",
+		Long: `update the upstream client with a header and proxy a message with the proofs at the height of the header.
+The message file must contain a proxy message such as MsgProxyRecvPacket in the JSON format with its type URL.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			header, err := utils.ParseHeader(cdc, args[1])
+			if err != nil {
+				return err
+			}
+			proxyMsg, err := utils.ParseMsg(cdc, args[2])
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgProxyWithHeader(args[0], header, proxyMsg, clientCtx.GetFromAddress().String())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSubmitProxyMisbehaviourCmd defines the command to submit a MsgSubmitProxyMisbehaviour
+func NewSubmitProxyMisbehaviourCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "misbehaviour [path/to/misbehaviour.json]",
+		Short: "submit a proxy misbehaviour to freeze the proxy client",
+		Long: `submit a proxy misbehaviour to freeze the proxy client.
+The misbehaviour proves that the proxy committed a packet commitment or acknowledgement which is different from the one of the upstream.
+The upstream value is verified through the upstream client on this chain, which must be registered to the allowlist.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			misbehaviour, err := utils.ParseProxyMisbehaviour(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSubmitProxyMisbehaviour(misbehaviour, clientCtx.GetFromAddress().String())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewPayProxyPacketFeeCmd defines the command to submit a MsgPayProxyPacketFee
+func NewPayProxyPacketFeeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pay-packet-fee [upstream-client-id] [port] [channel] [sequence] [fee]",
+		Short: "escrow a fee for relaying a packet through the proxy",
+		Long: `escrow a fee for relaying a packet through the proxy.
+The packet is identified by its port, channel and sequence on the upstream side: the source of a packet from the upstream,
+or the destination of a packet to the upstream if --to-upstream is set.
+The fee is paid to the relayer that proxies the packet commitment or the acknowledgement of the packet,
+or refunded to the signer if the proxy proxies a timeout of the packet or the deletion of the packet commitment.
+The fee is rejected if the proxy has already proxied the packet commitment or the acknowledgement.`,
+		Example: fmt.Sprintf("%s tx ibc-proxy pay-packet-fee 07-tendermint-0 transfer channel-0 1 100stake", version.AppName),
+		Args:    cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			sequence, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+			fee, err := sdk.ParseCoinsNormalized(args[4])
+			if err != nil {
+				return err
+			}
+			upstreamPrefix, err := getUpstreamPrefix(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPayProxyPacketFee(args[0], upstreamPrefix, getPacketDirection(cmd), args[1], args[2], sequence, fee, clientCtx.GetFromAddress().String())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addPacketDirectionFlag(cmd)
+	addUpstreamPrefixFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRegisterUpstreamCmd defines the command to submit a MsgRegisterUpstream
+func NewRegisterUpstreamCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-upstream [upstream-client-id]",
+		Short: "add an upstream client to the allowlist",
+		Long:  "add an upstream client to the allowlist. The signer must be the authority of the proxy module, or the guardian if the guardian paused it.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterUpstream(clientCtx.GetFromAddress().String(), args[0])
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewDeregisterUpstreamCmd defines the command to submit a MsgDeregisterUpstream
+func NewDeregisterUpstreamCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deregister-upstream [upstream-client-id]",
+		Short: "remove an upstream client from the allowlist",
+		Long:  "remove an upstream client from the allowlist. The signer must be the authority of the proxy module.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeregisterUpstream(clientCtx.GetFromAddress().String(), args[0])
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewPauseProxyCmd defines the command to submit a MsgPauseProxy
+func NewPauseProxyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause-proxy [upstream-client-id] [[port-id] [channel-id]]",
+		Short: "pause proxying for an upstream client or a channel of it",
+		Long:  "pause proxying for an upstream client, or for a port and channel of the upstream if they are given. The signer must be the authority or the guardian of the proxy module.",
+		Args:  pausedProxyArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pp := parsePausedProxyArgs(args)
+			msg := types.NewMsgPauseProxy(clientCtx.GetFromAddress().String(), pp.UpstreamClientId, pp.PortId, pp.ChannelId)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewUnpauseProxyCmd defines the command to submit a MsgUnpauseProxy
+func NewUnpauseProxyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unpause-proxy [upstream-client-id] [[port-id] [channel-id]]",
+		Short: "resume proxying for an upstream client or a channel of it",
+		Long:  "resume proxying for an upstream client, or for a port and channel of the upstream if they are given. The signer must be the authority of the proxy module.",
+		Args:  pausedProxyArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pp := parsePausedProxyArgs(args)
+			msg := types.NewMsgUnpauseProxy(clientCtx.GetFromAddress().String(), pp.UpstreamClientId, pp.PortId, pp.ChannelId)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMigrateProxyClientCmd defines the command to submit a MsgMigrateProxyClient
+func NewMigrateProxyClientCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-client [client-id] [new-client-id] [path/to/upstream_client_state.json] [path/to/new_upstream_client_state.json] [path/to/upstream_consensus_state.json] [upstream-consensus-height] [path/to/proof_upstream_client.json] [path/to/proof_upstream_consensus.json] [proof-height] [path/to/proof_new_upstream_client.json] [path/to/proof_new_upstream_consensus.json] [new-proof-height]",
+		Short: "migrate a proxy client to the proxy chain and the upstream client of another proxy client",
+		Long:  "migrate a proxy client to the proxy chain and the upstream client of another proxy client. The proofs must show that both upstream clients track the same upstream chain. The signer must be the authority of the proxy module.",
+		Args:  cobra.ExactArgs(12),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			upstreamClientState, err := utils.ParseClientState(cdc, args[2])
+			if err != nil {
+				return err
+			}
+			newUpstreamClientState, err := utils.ParseClientState(cdc, args[3])
+			if err != nil {
+				return err
+			}
+			upstreamConsensusState, err := utils.ParseConsensusState(cdc, args[4])
+			if err != nil {
+				return err
+			}
+			upstreamConsensusHeight, err := clienttypes.ParseHeight(args[5])
+			if err != nil {
+				return err
+			}
+			proofUpstreamClient, err := utils.ParseProof(cdc, args[6])
+			if err != nil {
+				return err
+			}
+			proofUpstreamConsensus, err := utils.ParseProof(cdc, args[7])
+			if err != nil {
+				return err
+			}
+			proofHeight, err := clienttypes.ParseHeight(args[8])
+			if err != nil {
+				return err
+			}
+			proofNewUpstreamClient, err := utils.ParseProof(cdc, args[9])
+			if err != nil {
+				return err
+			}
+			proofNewUpstreamConsensus, err := utils.ParseProof(cdc, args[10])
+			if err != nil {
+				return err
+			}
+			newProofHeight, err := clienttypes.ParseHeight(args[11])
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgMigrateProxyClient(
+				clientCtx.GetFromAddress().String(), args[0], args[1],
+				upstreamClientState, newUpstreamClientState, upstreamConsensusState, upstreamConsensusHeight,
+				proofUpstreamClient, proofUpstreamConsensus, proofHeight,
+				proofNewUpstreamClient, proofNewUpstreamConsensus, newProofHeight,
+			)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// pausedProxyArgs accepts either an upstream client ID alone or an upstream client ID with a port ID and a channel ID
+func pausedProxyArgs(cmd *cobra.Command, args []string) error {
+	if len(args) != 1 && len(args) != 3 {
+		return fmt.Errorf("accepts 1 or 3 arg(s), received %d", len(args))
+	}
+	return nil
+}
+
+// parsePausedProxyArgs returns the paused proxy identified by the arguments accepted by pausedProxyArgs
+func parsePausedProxyArgs(args []string) types.PausedProxy {
+	if len(args) == 1 {
+		return types.NewPausedProxy(args[0], "", "")
+	}
+	return types.NewPausedProxy(args[0], args[1], args[2])
+}
+
+// proxyConnectionArgs are the parsed arguments shared by MsgProxyConnectionOpenTry and MsgProxyConnectionOpenAck
+type proxyConnectionArgs struct {
+	connectionID             string
+	connection               connectiontypes.ConnectionEnd
+	downstreamClientState    exported.ClientState
+	downstreamConsensusState exported.ConsensusState
+	proxyClientState         exported.ClientState
+	proofHandshake           []byte
+	proofClient              []byte
+	proofConsensus           []byte
+	proofHeight              clienttypes.Height
+	consensusHeight          clienttypes.Height
+	proofProxyClient         []byte
+	proofProxyConsensus      []byte
+	proofProxyHeight         clienttypes.Height
+	proxyConsensusHeight     clienttypes.Height
+}
+
+// parseProxyConnectionArgs parses the arguments shared by the connection-open-try and connection-open-ack commands
+func parseProxyConnectionArgs(cdc codec.Codec, args []string) (p proxyConnectionArgs, err error) {
+	p.connectionID = args[0]
+	if p.connection, err = utils.ParseConnectionEnd(cdc, args[1]); err != nil {
+		return
+	}
+	if p.downstreamClientState, err = utils.ParseClientState(cdc, args[2]); err != nil {
+		return
+	}
+	if p.downstreamConsensusState, err = utils.ParseConsensusState(cdc, args[3]); err != nil {
+		return
+	}
+	if p.proxyClientState, err = utils.ParseClientState(cdc, args[4]); err != nil {
+		return
+	}
+	if p.proofHandshake, err = utils.ParseProof(cdc, args[5]); err != nil {
+		return
+	}
+	if p.proofClient, err = utils.ParseProof(cdc, args[6]); err != nil {
+		return
+	}
+	if p.proofConsensus, err = utils.ParseProof(cdc, args[7]); err != nil {
+		return
+	}
+	if p.proofHeight, err = clienttypes.ParseHeight(args[8]); err != nil {
+		return
+	}
+	if p.consensusHeight, err = clienttypes.ParseHeight(args[9]); err != nil {
+		return
+	}
+	if p.proofProxyClient, err = utils.ParseProof(cdc, args[10]); err != nil {
+		return
+	}
+	if p.proofProxyConsensus, err = utils.ParseProof(cdc, args[11]); err != nil {
+		return
+	}
+	if p.proofProxyHeight, err = clienttypes.ParseHeight(args[12]); err != nil {
+		return
+	}
+	p.proxyConsensusHeight, err = clienttypes.ParseHeight(args[13])
+	return
+}
+
+func addUpstreamPrefixFlag(cmd *cobra.Command) {
+	cmd.Flags().String(FlagUpstreamPrefix, "ibc", "the key prefix of the IBC store of the upstream")
+}
+
+func addChannelFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool(FlagOrdered, true, "Pass flag for opening ordered channels")
+	addUpstreamHopsFlag(cmd)
+}
+
+func addUpstreamHopsFlag(cmd *cobra.Command) {
+	cmd.Flags().StringSlice(FlagUpstreamHops, nil, "the upstream client ID and the key prefix of each connection hop after the first, formatted as [upstream-client-id]:[upstream-prefix]")
+}
+
+func getUpstreamPrefix(cmd *cobra.Command) (commitmenttypes.MerklePrefix, error) {
+	prefix, err := cmd.Flags().GetString(FlagUpstreamPrefix)
+	if err != nil {
+		return commitmenttypes.MerklePrefix{}, err
+	}
+	if len(prefix) == 0 {
+		return commitmenttypes.MerklePrefix{}, fmt.Errorf("upstream prefix cannot be empty")
+	}
+	return commitmenttypes.NewMerklePrefix([]byte(prefix)), nil
+}
+
+func getUpstreamHops(cmd *cobra.Command) ([]types.ProxyConnectionHop, error) {
+	hops, err := cmd.Flags().GetStringSlice(FlagUpstreamHops)
+	if err != nil {
+		return nil, err
+	}
+	var upstreamHops []types.ProxyConnectionHop
+	for _, hop := range hops {
+		ss := strings.SplitN(hop, ":", 2)
+		if len(ss) != 2 || len(ss[0]) == 0 || len(ss[1]) == 0 {
+			return nil, fmt.Errorf("invalid upstream hop '%s': expected [upstream-client-id]:[upstream-prefix]", hop)
+		}
+		upstreamHops = append(upstreamHops, types.ProxyConnectionHop{
+			UpstreamClientId: ss[0],
+			UpstreamPrefix:   commitmenttypes.NewMerklePrefix([]byte(ss[1])),
+		})
+	}
+	return upstreamHops, nil
+}
+
+func addPacketDirectionFlag(cmd *cobra.Command) {
+	cmd.Flags().Bool(FlagToUpstream, false, "the packet is sent to the upstream, which is its destination")
+}
+
+func getPacketDirection(cmd *cobra.Command) types.PacketDirection {
+	if toUpstream, _ := cmd.Flags().GetBool(FlagToUpstream); toUpstream {
+		return types.PacketToUpstream
+	}
+	return types.PacketFromUpstream
+}
+
+func getOrder(cmd *cobra.Command) channeltypes.Order {
+	if ordered, _ := cmd.Flags().GetBool(FlagOrdered); ordered {
+		return channeltypes.ORDERED
+	}
+	return channeltypes.UNORDERED
+}
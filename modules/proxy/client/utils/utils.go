@@ -0,0 +1,550 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/modules/core/exported"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	proxytypes "github.com/datachainlab/ibc-proxy/modules/light-clients/xx-proxy/types"
+	"github.com/datachainlab/ibc-proxy/modules/proxy/types"
+)
+
+// QueryProxyClientState returns a client state of the downstream that the upstream has. If prove is true,
+// it performs an ABCI store query in order to retrieve the merkle proof. Otherwise, it uses the gRPC query client.
+func QueryProxyClientState(
+	clientCtx client.Context, upstreamClientID string, upstreamPrefix commitmenttypes.MerklePrefix, clientID string, prove bool,
+) (*types.QueryProxyClientStateResponse, error) {
+	if prove {
+		return QueryProxyClientStateABCI(clientCtx, upstreamClientID, upstreamPrefix, clientID)
+	}
+
+	queryClient := types.NewQueryClient(clientCtx)
+	req := &types.QueryProxyClientStateRequest{
+		UpstreamClientId: upstreamClientID,
+		UpstreamPrefix:   upstreamPrefix,
+		ClientId:         clientID,
+	}
+
+	return queryClient.ProxyClientState(context.Background(), req)
+}
+
+// QueryProxyClientStateABCI queries the proxy store to get the client state and a merkle proof.
+func QueryProxyClientStateABCI(
+	clientCtx client.Context, upstreamClientID string, upstreamPrefix commitmenttypes.MerklePrefix, clientID string,
+) (*types.QueryProxyClientStateResponse, error) {
+	key := types.ProxyClientStateKey(&upstreamPrefix, upstreamClientID, clientID)
+
+	value, proofBz, proofHeight, err := QueryProxyProof(clientCtx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	// check if client exists
+	if len(value) == 0 {
+		return nil, sdkerrors.Wrapf(clienttypes.ErrClientNotFound, "upstream-client-id: %s, client-id: %s", upstreamClientID, clientID)
+	}
+
+	cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+	clientState, err := clienttypes.UnmarshalClientState(cdc, value)
+	if err != nil {
+		return nil, err
+	}
+
+	anyClientState, err := clienttypes.PackClientState(clientState)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewQueryProxyClientStateResponse(anyClientState, proofBz, proofHeight), nil
+}
+
+// QueryProxyConsensusState returns a consensus state of the downstream that the upstream has. If prove is true,
+// it performs an ABCI store query in order to retrieve the merkle proof. Otherwise, it uses the gRPC query client.
+func QueryProxyConsensusState(
+	clientCtx client.Context, upstreamClientID string, upstreamPrefix commitmenttypes.MerklePrefix, clientID string, height exported.Height, prove bool,
+) (*types.QueryProxyConsensusStateResponse, error) {
+	if prove {
+		return QueryProxyConsensusStateABCI(clientCtx, upstreamClientID, upstreamPrefix, clientID, height)
+	}
+
+	queryClient := types.NewQueryClient(clientCtx)
+	req := &types.QueryProxyConsensusStateRequest{
+		UpstreamClientId: upstreamClientID,
+		UpstreamPrefix:   upstreamPrefix,
+		ClientId:         clientID,
+		RevisionNumber:   height.GetRevisionNumber(),
+		RevisionHeight:   height.GetRevisionHeight(),
+	}
+
+	return queryClient.ProxyConsensusState(context.Background(), req)
+}
+
+// QueryProxyConsensusStateABCI queries the proxy store to get the consensus state and a merkle proof.
+func QueryProxyConsensusStateABCI(
+	clientCtx client.Context, upstreamClientID string, upstreamPrefix commitmenttypes.MerklePrefix, clientID string, height exported.Height,
+) (*types.QueryProxyConsensusStateResponse, error) {
+	key := types.ProxyConsensusStateKey(&upstreamPrefix, upstreamClientID, clientID, height)
+
+	value, proofBz, proofHeight, err := QueryProxyProof(clientCtx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	// check if consensus state exists
+	if len(value) == 0 {
+		return nil, sdkerrors.Wrapf(clienttypes.ErrConsensusStateNotFound, "upstream-client-id: %s, client-id: %s, height: %s", upstreamClientID, clientID, height)
+	}
+
+	cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+	cs, err := clienttypes.UnmarshalConsensusState(cdc, value)
+	if err != nil {
+		return nil, err
+	}
+
+	anyConsensusState, err := clienttypes.PackConsensusState(cs)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewQueryProxyConsensusStateResponse(anyConsensusState, proofBz, proofHeight), nil
+}
+
+// QueryProxyConnection returns a connection end that the upstream has. If prove is true,
+// it performs an ABCI store query in order to retrieve the merkle proof. Otherwise, it uses the gRPC query client.
+func QueryProxyConnection(
+	clientCtx client.Context, upstreamClientID string, upstreamPrefix commitmenttypes.MerklePrefix, connectionID string, prove bool,
+) (*types.QueryProxyConnectionResponse, error) {
+	if prove {
+		return QueryProxyConnectionABCI(clientCtx, upstreamClientID, upstreamPrefix, connectionID)
+	}
+
+	queryClient := types.NewQueryClient(clientCtx)
+	req := &types.QueryProxyConnectionRequest{
+		UpstreamClientId: upstreamClientID,
+		UpstreamPrefix:   upstreamPrefix,
+		ConnectionId:     connectionID,
+	}
+
+	return queryClient.ProxyConnection(context.Background(), req)
+}
+
+// QueryProxyConnectionABCI queries the proxy store to get the connection end and a merkle proof.
+func QueryProxyConnectionABCI(
+	clientCtx client.Context, upstreamClientID string, upstreamPrefix commitmenttypes.MerklePrefix, connectionID string,
+) (*types.QueryProxyConnectionResponse, error) {
+	key := types.ProxyConnectionKey(&upstreamPrefix, upstreamClientID, connectionID)
+
+	value, proofBz, proofHeight, err := QueryProxyProof(clientCtx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	// check if connection exists
+	if len(value) == 0 {
+		return nil, sdkerrors.Wrapf(connectiontypes.ErrConnectionNotFound, "upstream-client-id: %s, connection-id: %s", upstreamClientID, connectionID)
+	}
+
+	cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+	var connection connectiontypes.ConnectionEnd
+	if err := cdc.Unmarshal(value, &connection); err != nil {
+		return nil, err
+	}
+
+	return types.NewQueryProxyConnectionResponse(connection, proofBz, proofHeight), nil
+}
+
+// QueryProxyChannel returns a channel end that the upstream has. If prove is true,
+// it performs an ABCI store query in order to retrieve the merkle proof. Otherwise, it uses the gRPC query client.
+func QueryProxyChannel(
+	clientCtx client.Context, upstreamClientID string, upstreamPrefix commitmenttypes.MerklePrefix, portID, channelID string, prove bool,
+) (*types.QueryProxyChannelResponse, error) {
+	if prove {
+		return QueryProxyChannelABCI(clientCtx, upstreamClientID, upstreamPrefix, portID, channelID)
+	}
+
+	queryClient := types.NewQueryClient(clientCtx)
+	req := &types.QueryProxyChannelRequest{
+		UpstreamClientId: upstreamClientID,
+		UpstreamPrefix:   upstreamPrefix,
+		PortId:           portID,
+		ChannelId:        channelID,
+	}
+
+	return queryClient.ProxyChannel(context.Background(), req)
+}
+
+// QueryProxyChannelABCI queries the proxy store to get the channel end and a merkle proof.
+func QueryProxyChannelABCI(
+	clientCtx client.Context, upstreamClientID string, upstreamPrefix commitmenttypes.MerklePrefix, portID, channelID string,
+) (*types.QueryProxyChannelResponse, error) {
+	key := types.ProxyChannelKey(&upstreamPrefix, upstreamClientID, portID, channelID)
+
+	value, proofBz, proofHeight, err := QueryProxyProof(clientCtx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	// check if channel exists
+	if len(value) == 0 {
+		return nil, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "upstream-client-id: %s, port-id: %s, channel-id: %s", upstreamClientID, portID, channelID)
+	}
+
+	cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+	var channel channeltypes.Channel
+	if err := cdc.Unmarshal(value, &channel); err != nil {
+		return nil, err
+	}
+
+	return types.NewQueryProxyChannelResponse(channel, proofBz, proofHeight), nil
+}
+
+// QueryProxyPacketCommitment returns a packet commitment that the upstream has. If prove is true,
+// it performs an ABCI store query in order to retrieve the merkle proof. Otherwise, it uses the gRPC query client.
+func QueryProxyPacketCommitment(
+	clientCtx client.Context, upstreamClientID string, upstreamPrefix commitmenttypes.MerklePrefix, portID, channelID string, sequence uint64, prove bool,
+) (*types.QueryProxyPacketCommitmentResponse, error) {
+	if prove {
+		return QueryProxyPacketCommitmentABCI(clientCtx, upstreamClientID, upstreamPrefix, portID, channelID, sequence)
+	}
+
+	queryClient := types.NewQueryClient(clientCtx)
+	req := &types.QueryProxyPacketCommitmentRequest{
+		UpstreamClientId: upstreamClientID,
+		UpstreamPrefix:   upstreamPrefix,
+		PortId:           portID,
+		ChannelId:        channelID,
+		Sequence:         sequence,
+	}
+
+	return queryClient.ProxyPacketCommitment(context.Background(), req)
+}
+
+// QueryProxyPacketCommitmentABCI queries the proxy store to get the packet commitment and a merkle proof.
+func QueryProxyPacketCommitmentABCI(
+	clientCtx client.Context, upstreamClientID string, upstreamPrefix commitmenttypes.MerklePrefix, portID, channelID string, sequence uint64,
+) (*types.QueryProxyPacketCommitmentResponse, error) {
+	key := types.ProxyPacketCommitmentKey(&upstreamPrefix, upstreamClientID, portID, channelID, sequence)
+
+	value, proofBz, proofHeight, err := QueryProxyProof(clientCtx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	// check if packet commitment exists
+	if len(value) == 0 {
+		return nil, sdkerrors.Wrapf(channeltypes.ErrPacketCommitmentNotFound, "upstream-client-id: %s, port-id: %s, channel-id: %s, sequence: %d", upstreamClientID, portID, channelID, sequence)
+	}
+
+	return types.NewQueryProxyPacketCommitmentResponse(value, proofBz, proofHeight), nil
+}
+
+// QueryProxyPacketAcknowledgement returns a packet acknowledgement that the upstream has. If prove is true,
+// it performs an ABCI store query in order to retrieve the merkle proof. Otherwise, it uses the gRPC query client.
+func QueryProxyPacketAcknowledgement(
+	clientCtx client.Context, upstreamClientID string, upstreamPrefix commitmenttypes.MerklePrefix, portID, channelID string, sequence uint64, prove bool,
+) (*types.QueryProxyPacketAcknowledgementResponse, error) {
+	if prove {
+		return QueryProxyPacketAcknowledgementABCI(clientCtx, upstreamClientID, upstreamPrefix, portID, channelID, sequence)
+	}
+
+	queryClient := types.NewQueryClient(clientCtx)
+	req := &types.QueryProxyPacketAcknowledgementRequest{
+		UpstreamClientId: upstreamClientID,
+		UpstreamPrefix:   upstreamPrefix,
+		PortId:           portID,
+		ChannelId:        channelID,
+		Sequence:         sequence,
+	}
+
+	return queryClient.ProxyPacketAcknowledgement(context.Background(), req)
+}
+
+// QueryProxyPacketAcknowledgementABCI queries the proxy store to get the packet acknowledgement and a merkle proof.
+func QueryProxyPacketAcknowledgementABCI(
+	clientCtx client.Context, upstreamClientID string, upstreamPrefix commitmenttypes.MerklePrefix, portID, channelID string, sequence uint64,
+) (*types.QueryProxyPacketAcknowledgementResponse, error) {
+	key := types.ProxyAcknowledgementKey(&upstreamPrefix, upstreamClientID, portID, channelID, sequence)
+
+	value, proofBz, proofHeight, err := QueryProxyProof(clientCtx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	// check if packet acknowledgement exists
+	if len(value) == 0 {
+		return nil, sdkerrors.Wrapf(channeltypes.ErrInvalidAcknowledgement, "acknowledgement not found: upstream-client-id: %s, port-id: %s, channel-id: %s, sequence: %d", upstreamClientID, portID, channelID, sequence)
+	}
+
+	return types.NewQueryProxyPacketAcknowledgementResponse(value, proofBz, proofHeight), nil
+}
+
+// QueryProxyPacketReceiptAbsence returns whether the proxy has verified the absence of a packet receipt on the upstream.
+// If prove is true, it performs an ABCI store query in order to retrieve the merkle proof. Otherwise, it uses the gRPC query client.
+func QueryProxyPacketReceiptAbsence(
+	clientCtx client.Context, upstreamClientID string, upstreamPrefix commitmenttypes.MerklePrefix, portID, channelID string, sequence uint64, prove bool,
+) (*types.QueryProxyPacketReceiptAbsenceResponse, error) {
+	if prove {
+		return QueryProxyPacketReceiptAbsenceABCI(clientCtx, upstreamClientID, upstreamPrefix, portID, channelID, sequence)
+	}
+
+	queryClient := types.NewQueryClient(clientCtx)
+	req := &types.QueryProxyPacketReceiptAbsenceRequest{
+		UpstreamClientId: upstreamClientID,
+		UpstreamPrefix:   upstreamPrefix,
+		PortId:           portID,
+		ChannelId:        channelID,
+		Sequence:         sequence,
+	}
+
+	return queryClient.ProxyPacketReceiptAbsence(context.Background(), req)
+}
+
+// QueryProxyPacketReceiptAbsenceABCI queries the proxy store to get the packet receipt absence and a merkle proof
+// of its existence or non-existence.
+func QueryProxyPacketReceiptAbsenceABCI(
+	clientCtx client.Context, upstreamClientID string, upstreamPrefix commitmenttypes.MerklePrefix, portID, channelID string, sequence uint64,
+) (*types.QueryProxyPacketReceiptAbsenceResponse, error) {
+	key := types.ProxyPacketReceiptKey(&upstreamPrefix, upstreamClientID, portID, channelID, sequence)
+
+	value, proofBz, proofHeight, err := QueryProxyProof(clientCtx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewQueryProxyPacketReceiptAbsenceResponse(len(value) != 0, proofBz, proofHeight), nil
+}
+
+// QueryProxyNextSequenceRecv returns the next receive sequence that the upstream has. If prove is true,
+// it performs an ABCI store query in order to retrieve the merkle proof. Otherwise, it uses the gRPC query client.
+func QueryProxyNextSequenceRecv(
+	clientCtx client.Context, upstreamClientID string, upstreamPrefix commitmenttypes.MerklePrefix, portID, channelID string, prove bool,
+) (*types.QueryProxyNextSequenceRecvResponse, error) {
+	if prove {
+		return QueryProxyNextSequenceRecvABCI(clientCtx, upstreamClientID, upstreamPrefix, portID, channelID)
+	}
+
+	queryClient := types.NewQueryClient(clientCtx)
+	req := &types.QueryProxyNextSequenceRecvRequest{
+		UpstreamClientId: upstreamClientID,
+		UpstreamPrefix:   upstreamPrefix,
+		PortId:           portID,
+		ChannelId:        channelID,
+	}
+
+	return queryClient.ProxyNextSequenceRecv(context.Background(), req)
+}
+
+// QueryProxyNextSequenceRecvABCI queries the proxy store to get the next receive sequence and a merkle proof.
+func QueryProxyNextSequenceRecvABCI(
+	clientCtx client.Context, upstreamClientID string, upstreamPrefix commitmenttypes.MerklePrefix, portID, channelID string,
+) (*types.QueryProxyNextSequenceRecvResponse, error) {
+	key := types.ProxyNextSequenceRecvKey(&upstreamPrefix, upstreamClientID, portID, channelID)
+
+	value, proofBz, proofHeight, err := QueryProxyProof(clientCtx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	// check if next sequence receive exists
+	if len(value) == 0 {
+		return nil, sdkerrors.Wrapf(channeltypes.ErrSequenceReceiveNotFound, "upstream-client-id: %s, port-id: %s, channel-id: %s", upstreamClientID, portID, channelID)
+	}
+
+	return types.NewQueryProxyNextSequenceRecvResponse(sdk.BigEndianToUint64(value), proofBz, proofHeight), nil
+}
+
+// QueryProxyProof performs an ABCI query with the given key on the proxy store and returns
+// the value of the query, the proto encoded merkle proof, and the height of the Tendermint
+// block containing the state root. The desired tendermint height to perform the query
+// should be set in the client context. The query will be performed at one below this
+// height (at the IAVL version) in order to obtain the correct merkle proof.
+func QueryProxyProof(clientCtx client.Context, key []byte) ([]byte, []byte, clienttypes.Height, error) {
+	height := clientCtx.Height
+
+	// ABCI queries at heights 1, 2 or less than or equal to 0 are not supported.
+	// Base app does not support queries for height less than or equal to 1.
+	// Therefore, a query at height 2 would be equivalent to a query at height 3.
+	// A height of 0 will query with the lastest state.
+	if height != 0 && height <= 2 {
+		return nil, nil, clienttypes.Height{}, fmt.Errorf("proof queries at height <= 2 are not supported")
+	}
+
+	// Use the IAVL height if a valid tendermint height is passed in.
+	// A height of 0 will query with the latest state.
+	if height != 0 {
+		height--
+	}
+
+	req := abci.RequestQuery{
+		Path:   fmt.Sprintf("store/%s/key", types.StoreKey),
+		Height: height,
+		Data:   key,
+		Prove:  true,
+	}
+
+	res, err := clientCtx.QueryABCI(req)
+	if err != nil {
+		return nil, nil, clienttypes.Height{}, err
+	}
+
+	merkleProof, err := commitmenttypes.ConvertProofs(res.ProofOps)
+	if err != nil {
+		return nil, nil, clienttypes.Height{}, err
+	}
+
+	cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+	proofBz, err := cdc.Marshal(&merkleProof)
+	if err != nil {
+		return nil, nil, clienttypes.Height{}, err
+	}
+
+	revision := clienttypes.ParseChainID(clientCtx.ChainID)
+	return res.Value, proofBz, clienttypes.NewHeight(revision, uint64(res.Height)+1), nil
+}
+
+// ParseClientState unmarshals a cmd input argument from a JSON string to a client state.
+// If the input is not a JSON, it looks for a path to the JSON file.
+func ParseClientState(cdc codec.JSONCodec, arg string) (exported.ClientState, error) {
+	var clientState exported.ClientState
+	if err := unmarshalInterfaceJSONArg(cdc, arg, &clientState); err != nil {
+		return nil, sdkerrors.Wrap(err, "error unmarshalling client state")
+	}
+	return clientState, nil
+}
+
+// ParseConsensusState unmarshals a cmd input argument from a JSON string to a consensus state.
+// If the input is not a JSON, it looks for a path to the JSON file.
+func ParseConsensusState(cdc codec.JSONCodec, arg string) (exported.ConsensusState, error) {
+	var consensusState exported.ConsensusState
+	if err := unmarshalInterfaceJSONArg(cdc, arg, &consensusState); err != nil {
+		return nil, sdkerrors.Wrap(err, "error unmarshalling consensus state")
+	}
+	return consensusState, nil
+}
+
+// ParseHeader unmarshals a cmd input argument from a JSON string to a header.
+// If the input is not a JSON, it looks for a path to the JSON file.
+func ParseHeader(cdc codec.JSONCodec, arg string) (exported.Header, error) {
+	var header exported.Header
+	if err := unmarshalInterfaceJSONArg(cdc, arg, &header); err != nil {
+		return nil, sdkerrors.Wrap(err, "error unmarshalling header")
+	}
+	return header, nil
+}
+
+// ParseMsg unmarshals a cmd input argument from a JSON string to a message.
+// If the input is not a JSON, it looks for a path to the JSON file.
+func ParseMsg(cdc codec.JSONCodec, arg string) (sdk.Msg, error) {
+	var msg sdk.Msg
+	if err := unmarshalInterfaceJSONArg(cdc, arg, &msg); err != nil {
+		return nil, sdkerrors.Wrap(err, "error unmarshalling message")
+	}
+	return msg, nil
+}
+
+// ParseConnectionEnd unmarshals a cmd input argument from a JSON string to a connection end.
+// If the input is not a JSON, it looks for a path to the JSON file.
+func ParseConnectionEnd(cdc codec.JSONCodec, arg string) (connectiontypes.ConnectionEnd, error) {
+	var connection connectiontypes.ConnectionEnd
+	if err := unmarshalJSONArg(cdc, arg, &connection); err != nil {
+		return connectiontypes.ConnectionEnd{}, sdkerrors.Wrap(err, "error unmarshalling connection end")
+	}
+	return connection, nil
+}
+
+// ParseChannel unmarshals a cmd input argument from a JSON string to a channel end.
+// If the input is not a JSON, it looks for a path to the JSON file.
+func ParseChannel(cdc codec.JSONCodec, arg string) (channeltypes.Channel, error) {
+	var channel channeltypes.Channel
+	if err := unmarshalJSONArg(cdc, arg, &channel); err != nil {
+		return channeltypes.Channel{}, sdkerrors.Wrap(err, "error unmarshalling channel end")
+	}
+	return channel, nil
+}
+
+// ParsePacket unmarshals a cmd input argument from a JSON string to a packet.
+// If the input is not a JSON, it looks for a path to the JSON file.
+func ParsePacket(cdc codec.JSONCodec, arg string) (channeltypes.Packet, error) {
+	var packet channeltypes.Packet
+	if err := unmarshalJSONArg(cdc, arg, &packet); err != nil {
+		return channeltypes.Packet{}, sdkerrors.Wrap(err, "error unmarshalling packet")
+	}
+	return packet, nil
+}
+
+// ParsePacketBatchItems unmarshals a cmd input argument from a JSON string to the items of a packet batch.
+// The JSON must be an object that has the items field of MsgProxyPacketBatch.
+// If the input is not a JSON, it looks for a path to the JSON file.
+func ParsePacketBatchItems(cdc codec.JSONCodec, arg string) ([]types.ProxyPacketBatchItem, error) {
+	var batch types.MsgProxyPacketBatch
+	if err := unmarshalJSONArg(cdc, arg, &batch); err != nil {
+		return nil, sdkerrors.Wrap(err, "error unmarshalling packet batch items")
+	}
+	if len(batch.Items) == 0 {
+		return nil, fmt.Errorf("packet batch items cannot be empty")
+	}
+	return batch.Items, nil
+}
+
+// ParseProxyMisbehaviour unmarshals a cmd input argument from a JSON string to a ProxyMisbehaviour.
+// If the input is not a JSON, it looks for a path to the JSON file.
+func ParseProxyMisbehaviour(cdc codec.JSONCodec, arg string) (proxytypes.ProxyMisbehaviour, error) {
+	var misbehaviour proxytypes.ProxyMisbehaviour
+	if err := unmarshalJSONArg(cdc, arg, &misbehaviour); err != nil {
+		return proxytypes.ProxyMisbehaviour{}, sdkerrors.Wrap(err, "error unmarshalling proxy misbehaviour")
+	}
+	return misbehaviour, nil
+}
+
+// ParseProof unmarshals a cmd input argument from a JSON string to a commitment
+// Proof. If the input is not a JSON, it looks for a path to the JSON file. It
+// then marshals the commitment proof into a proto encoded byte array.
+func ParseProof(cdc codec.Codec, arg string) ([]byte, error) {
+	var merkleProof commitmenttypes.MerkleProof
+	if err := unmarshalJSONArg(cdc, arg, &merkleProof); err != nil {
+		return nil, sdkerrors.Wrap(err, "error unmarshalling commitment proof")
+	}
+	return cdc.Marshal(&merkleProof)
+}
+
+// unmarshalJSONArg unmarshals the JSON string or the contents of the JSON file given as a cmd input argument
+func unmarshalJSONArg(cdc codec.JSONCodec, arg string, ptr codec.ProtoMarshaler) error {
+	if err := cdc.UnmarshalJSON([]byte(arg), ptr); err == nil {
+		return nil
+	}
+	// check for file path if JSON input is not provided
+	contents, err := ioutil.ReadFile(arg)
+	if err != nil {
+		return fmt.Errorf("neither JSON input nor path to .json file were provided")
+	}
+	return cdc.UnmarshalJSON(contents, ptr)
+}
+
+// unmarshalInterfaceJSONArg is the same as unmarshalJSONArg except that it unmarshals the JSON into an interface
+func unmarshalInterfaceJSONArg(cdc codec.JSONCodec, arg string, ptr interface{}) error {
+	if err := cdc.UnmarshalInterfaceJSON([]byte(arg), ptr); err == nil {
+		return nil
+	}
+	// check for file path if JSON input is not provided
+	contents, err := ioutil.ReadFile(arg)
+	if err != nil {
+		return fmt.Errorf("neither JSON input nor path to .json file were provided")
+	}
+	return cdc.UnmarshalInterfaceJSON(contents, ptr)
+}
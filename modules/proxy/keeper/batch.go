@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	ics23 "github.com/confio/ics23/go"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	commitmenttypes "github.com/cosmos/ibc-go/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/modules/core/24-host"
+	"github.com/cosmos/ibc-go/modules/core/exported"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/datachainlab/ibc-proxy/modules/proxy/types"
+)
+
+// PacketBatch proxies the packet commitments and the packet acknowledgements of the items at the same proof height.
+// The client state of the upstream is looked up only once for all the items.
+// If atomic is false, a failed item doesn't revert the others and its error is reported in the result.
+func (k Keeper) PacketBatch(
+	ctx sdk.Context,
+	upstreamClientID string,
+	upstreamPrefix exported.Prefix,
+	items []types.ProxyPacketBatchItem,
+	batchProof []byte, // merkle proof whose lowest proof is an ICS-23 batch proof of the items that have no proof
+	proofHeight exported.Height,
+	atomic bool,
+) ([]types.ProxyPacketBatchResult, error) {
+	targetClient, err := k.getUpstreamClientState(ctx, upstreamClientID)
+	if err != nil {
+		return nil, err
+	}
+
+	var batch *packetBatchProof
+	if len(batchProof) > 0 {
+		batch, err = k.parsePacketBatchProof(batchProof)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	results := make([]types.ProxyPacketBatchResult, len(items))
+	for i, item := range items {
+		cacheCtx, writeCache := ctx.CacheContext()
+		cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+		if err := k.proxyPacketBatchItem(cacheCtx, targetClient, upstreamClientID, upstreamPrefix, item, batch, proofHeight); err != nil {
+			if atomic {
+				return nil, sdkerrors.Wrapf(err, "item %d", i)
+			}
+			codespace, code, log := sdkerrors.ABCIInfo(err, false)
+			results[i] = types.ProxyPacketBatchResult{Codespace: codespace, Code: code, Log: log}
+			// the conflict event is kept for monitoring even though the other events of the item are reverted
+			ctx.EventManager().EmitEvents(filterEvents(cacheCtx.EventManager().Events(), conflictEventType))
+			continue
+		}
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+	return results, nil
+}
+
+// conflictEventType is the type of EventProxyCommitmentConflict
+var conflictEventType = proto.MessageName(&types.EventProxyCommitmentConflict{})
+
+// filterEvents returns the events of the given type
+func filterEvents(events sdk.Events, eventType string) sdk.Events {
+	var filtered sdk.Events
+	for _, ev := range events {
+		if ev.Type == eventType {
+			filtered = append(filtered, ev)
+		}
+	}
+	return filtered
+}
+
+func (k Keeper) proxyPacketBatchItem(
+	ctx sdk.Context,
+	targetClient exported.ClientState,
+	upstreamClientID string,
+	upstreamPrefix exported.Prefix,
+	item types.ProxyPacketBatchItem,
+	batch *packetBatchProof,
+	proofHeight exported.Height,
+) error {
+	packet := item.Packet
+	isAck := len(item.Acknowledgement) > 0
+
+	proof := item.Proof
+	if len(proof) == 0 {
+		var path string
+		if isAck {
+			path = host.PacketAcknowledgementPath(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+		} else {
+			path = host.PacketCommitmentPath(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+		}
+		var err error
+		proof, err = k.getPacketBatchItemProof(batch, upstreamPrefix, path)
+		if err != nil {
+			return err
+		}
+	}
+
+	if isAck {
+		return k.acknowledgePacket(ctx, targetClient, upstreamClientID, upstreamPrefix, packet, item.Acknowledgement, proof, proofHeight)
+	}
+	return k.recvPacket(ctx, targetClient, upstreamClientID, upstreamPrefix, packet, proof, proofHeight)
+}
+
+// packetBatchProof is a merkle proof whose lowest proof is an ICS-23 batch proof
+type packetBatchProof struct {
+	// existence proofs in the batch proof by their keys
+	exists map[string]*ics23.ExistenceProof
+	// proofs of the upper stores
+	upperProofs []*ics23.CommitmentProof
+}
+
+func (k Keeper) parsePacketBatchProof(bz []byte) (*packetBatchProof, error) {
+	var merkleProof commitmenttypes.MerkleProof
+	if err := k.cdc.Unmarshal(bz, &merkleProof); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidPacketBatch, "failed to unmarshal the batch proof: %v", err)
+	}
+	if len(merkleProof.Proofs) == 0 {
+		return nil, sdkerrors.Wrap(types.ErrInvalidPacketBatch, "the batch proof is empty")
+	}
+	batch := ics23.Decompress(merkleProof.Proofs[0]).GetBatch()
+	if batch == nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidPacketBatch, "the lowest proof must be an ICS-23 batch proof")
+	}
+	exists := make(map[string]*ics23.ExistenceProof, len(batch.Entries))
+	for _, entry := range batch.Entries {
+		if ep := entry.GetExist(); ep != nil {
+			exists[string(ep.Key)] = ep
+		}
+	}
+	return &packetBatchProof{exists: exists, upperProofs: merkleProof.Proofs[1:]}, nil
+}
+
+// getPacketBatchItemProof returns a merkle proof of the given path that consists of the existence proof in the batch and the proofs of the upper stores
+func (k Keeper) getPacketBatchItemProof(batch *packetBatchProof, upstreamPrefix exported.Prefix, path string) ([]byte, error) {
+	if batch == nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidPacketBatch, "the item has no proof and the batch proof is empty")
+	}
+	merklePath, err := commitmenttypes.ApplyPrefix(upstreamPrefix, commitmenttypes.NewMerklePath(path))
+	if err != nil {
+		return nil, err
+	}
+	key, err := merklePath.GetKey(uint64(len(merklePath.KeyPath) - 1))
+	if err != nil {
+		return nil, err
+	}
+	ep, found := batch.exists[string(key)]
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidPacketBatch, "the batch proof doesn't contain the path: %s", path)
+	}
+	proofs := append([]*ics23.CommitmentProof{{Proof: &ics23.CommitmentProof_Exist{Exist: ep}}}, batch.upperProofs...)
+	return k.cdc.Marshal(&commitmenttypes.MerkleProof{Proofs: proofs})
+}
@@ -0,0 +1,163 @@
+package keeper_test
+
+import (
+	ics23 "github.com/confio/ics23/go"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/modules/core/24-host"
+	"github.com/cosmos/ibc-go/modules/core/exported"
+
+	"github.com/datachainlab/ibc-proxy/modules/proxy/types"
+	ibctesting "github.com/datachainlab/ibc-proxy/testing"
+	"github.com/datachainlab/ibc-proxy/testing/simapp"
+)
+
+// A(C) -> B, B -> A
+// A: downstream, B: upstream, C: proxy
+func (suite *KeeperTestSuite) TestProxyPacketBatch() {
+	suite.Require().NoError(suite.coordinator.IncrementClientSequence(suite.chainC, suite.chainB, exported.Tendermint, 1))
+	suite.Require().NoError(suite.coordinator.IncrementClientSequence(suite.chainB, suite.chainA, exported.Tendermint, 2))
+
+	clientCB, err := suite.coordinator.CreateClient2(suite.chainC, suite.chainB, exported.Tendermint, false, 0)
+	suite.Require().NoError(err)
+
+	clientBA, err := suite.coordinator.CreateMultiVClient(suite.chainB, suite.chainA, exported.Tendermint, 0)
+	suite.Require().NoError(err)
+
+	clientAC, err := suite.coordinator.CreateProxyClient(suite.chainA, suite.chainC, exported.Tendermint, clientCB)
+	suite.Require().NoError(err)
+
+	ppair := ibctesting.ProxyPair{{Chain: suite.chainC, ClientID: clientAC, UpstreamClientID: clientCB, UpstreamPrefix: suite.chainB.GetPrefix()}, nil}
+	connA, connB := suite.coordinator.CreateConnectionWithProxy(suite.chainA, suite.chainB, clientAC, clientBA, ibctesting.TransferVersion, ppair)
+	chanA, chanB := suite.coordinator.CreateChannelWithProxy(suite.chainA, suite.chainB, connA, connB, ibctesting.TransferPort, ibctesting.TransferPort, channeltypes.UNORDERED, ppair)
+
+	// B writes the acknowledgement of the packet from A
+	suite.testHandleMsgTransfer(connA, connB, chanA, chanB, ppair)
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	timeoutHeight := clienttypes.NewHeight(0, 110)
+	ackPacket := channeltypes.NewPacket(
+		transfertypes.NewFungibleTokenPacketData(coin.Denom, coin.Amount.Uint64(), suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String()).GetBytes(),
+		1, chanA.PortID, chanA.ID, chanB.PortID, chanB.ID, timeoutHeight, 0,
+	)
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()
+
+	// B sends packets to A without relaying them
+	var packets []channeltypes.Packet
+	fungibleTokenPacket := transfertypes.NewFungibleTokenPacketData(coin.Denom, coin.Amount.Uint64(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String())
+	for seq := uint64(1); seq <= 3; seq++ {
+		msg := transfertypes.NewMsgTransfer(chanB.PortID, chanB.ID, coin, suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), timeoutHeight, 0)
+		_, err := suite.chainB.SendMsgs(msg)
+		suite.Require().NoError(err)
+		packets = append(packets, channeltypes.NewPacket(fungibleTokenPacket.GetBytes(), seq, chanB.PortID, chanB.ID, chanA.PortID, chanA.ID, timeoutHeight, 0))
+	}
+	suite.Require().NoError(suite.coordinator.UpdateClient(suite.chainC, suite.chainB, clientCB, exported.Tendermint))
+
+	var proofs [][]byte
+	var proofHeight clienttypes.Height
+	for _, packet := range packets {
+		var proof []byte
+		proof, proofHeight = suite.chainB.QueryProof(host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+		proofs = append(proofs, proof)
+	}
+	proofAck, _ := suite.chainB.QueryProof(host.PacketAcknowledgementKey(ackPacket.GetDestPort(), ackPacket.GetDestChannel(), ackPacket.GetSequence()))
+
+	proxyKeeper := suite.chainC.App.(*simapp.SimApp).IBCProxyKeeper
+	prefix := suite.chainB.GetPrefix()
+	found := func(ctx sdk.Context, packet channeltypes.Packet) bool {
+		_, found := proxyKeeper.GetProxyPacketCommitment(ctx, &prefix, clientCB, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+		return found
+	}
+
+	// the second item has the proof of another packet
+	items := []types.ProxyPacketBatchItem{
+		{Packet: packets[0], Proof: proofs[0]},
+		{Packet: packets[1], Proof: proofs[2]},
+		{Packet: packets[2], Proof: proofs[2]},
+	}
+
+	// atomic mode fails with the bad item
+	cacheCtx, _ := suite.chainC.GetContext().CacheContext()
+	_, err = proxyKeeper.PacketBatch(cacheCtx, clientCB, &prefix, items, nil, proofHeight, true)
+	suite.Require().Error(err)
+
+	// non-atomic mode reports the bad item only
+	ctx := suite.chainC.GetContext()
+	results, err := proxyKeeper.PacketBatch(ctx, clientCB, &prefix, items, nil, proofHeight, false)
+	suite.Require().NoError(err)
+	suite.Require().Len(results, 3)
+	suite.Require().Zero(results[0].Code)
+	suite.Require().NotZero(results[1].Code)
+	suite.Require().Zero(results[2].Code)
+	suite.Require().True(found(ctx, packets[0]))
+	suite.Require().False(found(ctx, packets[1]))
+	suite.Require().True(found(ctx, packets[2]))
+
+	// the items without proofs are taken from the batch proof of the commitment and the acknowledgement
+	var mp1, mp2 commitmenttypes.MerkleProof
+	suite.Require().NoError(suite.chainB.App.AppCodec().Unmarshal(proofs[1], &mp1))
+	suite.Require().NoError(suite.chainB.App.AppCodec().Unmarshal(proofAck, &mp2))
+	batch, err := ics23.CombineProofs([]*ics23.CommitmentProof{mp1.Proofs[0], mp2.Proofs[0]})
+	suite.Require().NoError(err)
+	batchProof, err := suite.chainB.App.AppCodec().Marshal(&commitmenttypes.MerkleProof{Proofs: append([]*ics23.CommitmentProof{batch}, mp1.Proofs[1:]...)})
+	suite.Require().NoError(err)
+
+	unknownPacket := packets[2]
+	unknownPacket.Sequence = 4
+	items = []types.ProxyPacketBatchItem{
+		{Packet: packets[1]},
+		{Packet: ackPacket, Acknowledgement: ack},
+		{Packet: unknownPacket},
+	}
+	results, err = proxyKeeper.PacketBatch(ctx, clientCB, &prefix, items, batchProof, proofHeight, false)
+	suite.Require().NoError(err)
+	suite.Require().Len(results, 3)
+	suite.Require().Zero(results[0].Code)
+	suite.Require().Zero(results[1].Code)
+	suite.Require().Equal(types.ErrInvalidPacketBatch.ABCICode(), results[2].Code)
+	suite.Require().True(found(ctx, packets[1]))
+	_, ok := proxyKeeper.GetProxyPacketAcknowledgement(ctx, &prefix, clientCB, ackPacket.GetDestPort(), ackPacket.GetDestChannel(), ackPacket.GetSequence())
+	suite.Require().True(ok)
+
+	// a conflicting item is reverted except for its conflict event
+	cacheCtx, _ = ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+	commitmentKey := host.PacketCommitmentKey(packets[0].GetSourcePort(), packets[0].GetSourceChannel(), packets[0].GetSequence())
+	proxyKeeper.ProxyStore(cacheCtx, &prefix, clientCB).Set(commitmentKey, []byte("other"))
+	results, err = proxyKeeper.PacketBatch(cacheCtx, clientCB, &prefix, []types.ProxyPacketBatchItem{{Packet: packets[0], Proof: proofs[0]}}, nil, proofHeight, false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.ErrConflictingProxyCommitment.ABCICode(), results[0].Code)
+	events := cacheCtx.EventManager().Events()
+	suite.Require().Len(events, 1)
+	var ev types.EventProxyCommitmentConflict
+	suite.Require().NoError(ibctesting.ParseProxyEventFromEvents(events, &ev))
+	suite.Require().Equal(types.EventProxyCommitmentConflict{
+		UpstreamClientId: clientCB,
+		UpstreamPrefix:   prefix,
+		Path:             string(commitmentKey),
+		ExistingValue:    []byte("other"),
+		NewValue:         channeltypes.CommitPacket(suite.chainB.App.AppCodec(), packets[0]),
+	}, ev)
+
+	// the batch proof must be an ICS-23 batch proof
+	_, err = proxyKeeper.PacketBatch(ctx, clientCB, &prefix, items, proofs[0], proofHeight, false)
+	suite.Require().ErrorIs(err, types.ErrInvalidPacketBatch)
+
+	// the message is delivered through the msg server
+	res, err := suite.chainC.SendMsgs(&types.MsgProxyPacketBatch{
+		UpstreamClientId: clientCB,
+		UpstreamPrefix:   prefix,
+		Items:            items[:2],
+		BatchProof:       batchProof,
+		ProofHeight:      proofHeight,
+		Signer:           suite.chainC.SenderAccount.GetAddress().String(),
+	})
+	suite.Require().NoError(err)
+	var txMsgData sdk.TxMsgData
+	suite.Require().NoError(suite.chainC.App.AppCodec().Unmarshal(res.Data, &txMsgData))
+	var resBatch types.MsgProxyPacketBatchResponse
+	suite.Require().NoError(suite.chainC.App.AppCodec().Unmarshal(txMsgData.Data[0].Data, &resBatch))
+	suite.Require().Equal([]types.ProxyPacketBatchResult{{}, {}}, resBatch.Results)
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -8,6 +9,8 @@ import (
 	connectiontypes "github.com/cosmos/ibc-go/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
 	"github.com/cosmos/ibc-go/modules/core/exported"
+
+	"github.com/datachainlab/ibc-proxy/modules/proxy/types"
 )
 
 // upstream: chainA, downstream: chainB
@@ -19,6 +22,7 @@ func (k Keeper) ChanOpenTry(
 
 	order channeltypes.Order, // the channel order on chainA
 	connectionHops []string, // hops from upstream
+	upstreamHops []types.ProxyConnectionHop, // where the proxy stores the connection ends of connectionHops[1:]
 	upstreamPortID string, // the portID on chainA
 	upstreamChannelID string, // the channelID on chainA
 	downstreamPortID string, // the portID on chainB
@@ -27,16 +31,9 @@ func (k Keeper) ChanOpenTry(
 	proofInit []byte, // proof that chainA stored channel in state
 	proofHeight exported.Height, // height at which relayer constructs proof of chainA storing channel in state
 ) error {
-	if l := len(connectionHops); l != 1 {
-		return fmt.Errorf("hops length must be 1, but got %v", l)
-	}
-
-	connectionEnd, found := k.GetProxyConnection(ctx, upstreamPrefix, upstreamClientID, connectionHops[0])
-	if !found {
-		return sdkerrors.Wrapf(
-			connectiontypes.ErrConnectionNotFound,
-			"connection '%#v:%v:%v' not found", upstreamPrefix, upstreamClientID, connectionHops[0],
-		)
+	connectionEnd, err := k.getConnectionHops(ctx, upstreamPrefix, upstreamClientID, connectionHops, upstreamHops)
+	if err != nil {
+		return err
 	}
 	if err := k.validateChannelOrder(order, connectionEnd); err != nil {
 		return err
@@ -58,7 +55,7 @@ func (k Keeper) ChanOpenTry(
 		return err
 	}
 
-	return nil
+	return ctx.EventManager().EmitTypedEvent(types.NewEventProxyChannelOpenTry(upstreamClientID, upstreamPrefix, upstreamPortID, upstreamChannelID, expectedChannel, proofHeight))
 }
 
 // source: downstream, counterparty: upstream
@@ -70,6 +67,7 @@ func (k Keeper) ChanOpenAck(
 
 	order channeltypes.Order, // the channel order on chainA
 	connectionHops []string, // hops from upstream
+	upstreamHops []types.ProxyConnectionHop, // where the proxy stores the connection ends of connectionHops[1:]
 
 	upstreamPortID string, // the portID on chainA
 	upstreamChannelID string, // the channelID on chainA
@@ -80,16 +78,9 @@ func (k Keeper) ChanOpenAck(
 	proofTry []byte, // proof that chainA stored channel in state
 	proofHeight exported.Height, // height at which relayer constructs proof of chainA storing channel in state
 ) error {
-	if l := len(connectionHops); l != 1 {
-		return fmt.Errorf("hops length must be 1, but got %v", l)
-	}
-
-	connectionEnd, found := k.GetProxyConnection(ctx, upstreamPrefix, upstreamClientID, connectionHops[0])
-	if !found {
-		return sdkerrors.Wrapf(
-			connectiontypes.ErrConnectionNotFound,
-			"connection '%#v:%v:%v' not found", upstreamPrefix, upstreamClientID, connectionHops[0],
-		)
+	connectionEnd, err := k.getConnectionHops(ctx, upstreamPrefix, upstreamClientID, connectionHops, upstreamHops)
+	if err != nil {
+		return err
 	}
 	if connectionEnd.GetState() != int32(connectiontypes.OPEN) {
 		return sdkerrors.Wrapf(
@@ -115,7 +106,7 @@ func (k Keeper) ChanOpenAck(
 		return err
 	}
 
-	return nil
+	return ctx.EventManager().EmitTypedEvent(types.NewEventProxyChannelOpenAck(upstreamClientID, upstreamPrefix, upstreamPortID, upstreamChannelID, expectedChannel, proofHeight))
 }
 
 // source: downstream, counterparty: upstream
@@ -167,7 +158,7 @@ func (k Keeper) ChanOpenConfirm(
 		return err
 	}
 
-	return nil
+	return ctx.EventManager().EmitTypedEvent(types.NewEventProxyChannelOpenConfirm(upstreamClientID, upstreamPrefix, upstreamPortID, upstreamChannelID, channel, proofHeight))
 }
 
 // source: downstream, counterparty: upstream
@@ -215,7 +206,105 @@ func (k Keeper) ChanOpenFinalize(
 		return err
 	}
 
-	return nil
+	return ctx.EventManager().EmitTypedEvent(types.NewEventProxyChannelOpenFinalize(upstreamClientID, upstreamPrefix, upstreamPortID, upstreamChannelID, channel, proofHeight))
+}
+
+// source: downstream, counterparty: upstream
+func (k Keeper) ChanCloseConfirm(
+	ctx sdk.Context,
+
+	upstreamClientID string, // the client ID corresponding to light client for chainA on chainB
+	upstreamPrefix exported.Prefix, // store prefix on chainA
+
+	upstreamPortID string, // the portID on chainA
+	upstreamChannelID string, // the channelID on chainA
+
+	proofInit []byte, // proof that chainA stored channel in state
+	proofHeight exported.Height, // height at which relayer constructs proof of chainA storing channel in state
+) error {
+
+	channel, found := k.GetProxyChannel(ctx, upstreamPrefix, upstreamClientID, upstreamPortID, upstreamChannelID)
+	if !found {
+		return fmt.Errorf("channel '%#v:%v:%v:%v' not found", upstreamPrefix, upstreamClientID, upstreamPortID, upstreamChannelID)
+	} else if channel.State == channeltypes.CLOSED {
+		return sdkerrors.Wrap(channeltypes.ErrInvalidChannelState, "channel is already CLOSED")
+	}
+
+	connectionEnd, found := k.GetProxyConnection(ctx, upstreamPrefix, upstreamClientID, channel.ConnectionHops[0])
+	if !found {
+		return sdkerrors.Wrapf(
+			connectiontypes.ErrConnectionNotFound,
+			"connection '%#v:%v:%v' not found", upstreamPrefix, upstreamClientID, channel.ConnectionHops[0],
+		)
+	}
+	if connectionEnd.GetState() != int32(connectiontypes.OPEN) {
+		return sdkerrors.Wrapf(
+			connectiontypes.ErrInvalidConnectionState,
+			"connection state is not OPEN (got %s)", connectiontypes.State(connectionEnd.GetState()).String(),
+		)
+	}
+
+	channel.State = channeltypes.CLOSED
+
+	if err := k.VerifyAndProxyChannelState(
+		ctx, upstreamClientID, upstreamPrefix,
+		proofHeight, proofInit,
+		upstreamPortID, upstreamChannelID, channel,
+	); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(types.NewEventProxyChannelCloseConfirm(upstreamClientID, upstreamPrefix, upstreamPortID, upstreamChannelID, channel, proofHeight))
+}
+
+// source: downstream, counterparty: upstream
+// ChannelState proxies the channel that chainA has already opened for an upstream client that doesn't have it yet,
+// so that a downstream can migrate its proxy client to the upstream client after the handshake.
+// It never overwrites a proxied channel, so a closed channel cannot be reopened.
+func (k Keeper) ChannelState(
+	ctx sdk.Context,
+
+	upstreamClientID string, // the client ID corresponding to light client for chainA on chainB
+	upstreamPrefix exported.Prefix, // store prefix on chainA
+
+	upstreamPortID string, // the portID on chainA
+	upstreamChannelID string, // the channelID on chainA
+	channel channeltypes.Channel, // the channel on chainA (its state must be OPEN)
+	upstreamHops []types.ProxyConnectionHop, // where the proxy stores the connection ends of channel.ConnectionHops[1:]
+
+	proof []byte, // proof that chainA stored channel in state
+	proofHeight exported.Height, // height at which relayer constructs proof of chainA storing channel in state
+) error {
+
+	if _, found := k.GetProxyChannel(ctx, upstreamPrefix, upstreamClientID, upstreamPortID, upstreamChannelID); found {
+		return sdkerrors.Wrapf(
+			channeltypes.ErrChannelExists,
+			"channel '%#v:%v:%v:%v' already proxied", upstreamPrefix, upstreamClientID, upstreamPortID, upstreamChannelID,
+		)
+	} else if channel.State != channeltypes.OPEN {
+		return sdkerrors.Wrapf(channeltypes.ErrInvalidChannelState, "channel state must be %s", channeltypes.OPEN)
+	}
+
+	connectionEnd, err := k.getConnectionHops(ctx, upstreamPrefix, upstreamClientID, channel.ConnectionHops, upstreamHops)
+	if err != nil {
+		return err
+	}
+	if connectionEnd.GetState() != int32(connectiontypes.OPEN) {
+		return sdkerrors.Wrapf(
+			connectiontypes.ErrInvalidConnectionState,
+			"connection state is not OPEN (got %s)", connectiontypes.State(connectionEnd.GetState()).String(),
+		)
+	}
+
+	if err := k.VerifyAndProxyChannelState(
+		ctx, upstreamClientID, upstreamPrefix,
+		proofHeight, proof,
+		upstreamPortID, upstreamChannelID, channel,
+	); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(types.NewEventProxyChannelState(upstreamClientID, upstreamPrefix, upstreamPortID, upstreamChannelID, channel, proofHeight))
 }
 
 func (k Keeper) validateChannelOrder(order channeltypes.Order, connectionEnd connectiontypes.ConnectionEnd) error {
@@ -236,3 +325,78 @@ func (k Keeper) validateChannelOrder(order channeltypes.Order, connectionEnd con
 	}
 	return nil
 }
+
+// getConnectionHops checks that the proxy has the connection end of every hop and that the hops form a path,
+// and then returns the connection end of the first hop.
+// The first hop is on the upstream and each subsequent hop must be OPEN on the counterparty chain of the previous one,
+// where the proxy must also have the counterparty connection end of the previous hop.
+func (k Keeper) getConnectionHops(
+	ctx sdk.Context,
+	upstreamPrefix exported.Prefix,
+	upstreamClientID string,
+	connectionHops []string,
+	upstreamHops []types.ProxyConnectionHop,
+) (connectiontypes.ConnectionEnd, error) {
+	if len(connectionHops) == 0 {
+		return connectiontypes.ConnectionEnd{}, fmt.Errorf("connection hops cannot be empty")
+	} else if l := len(upstreamHops); l != len(connectionHops)-1 {
+		return connectiontypes.ConnectionEnd{}, fmt.Errorf("upstream hops length must be %v, but got %v", len(connectionHops)-1, l)
+	}
+
+	connectionEnd, found := k.GetProxyConnection(ctx, upstreamPrefix, upstreamClientID, connectionHops[0])
+	if !found {
+		return connectiontypes.ConnectionEnd{}, sdkerrors.Wrapf(
+			connectiontypes.ErrConnectionNotFound,
+			"connection '%#v:%v:%v' not found", upstreamPrefix, upstreamClientID, connectionHops[0],
+		)
+	}
+
+	prev := connectionEnd
+	for i, hop := range upstreamHops {
+		connectionID := connectionHops[i+1]
+		hopEnd, found := k.GetProxyConnection(ctx, &hop.UpstreamPrefix, hop.UpstreamClientId, connectionID)
+		if !found {
+			return connectiontypes.ConnectionEnd{}, sdkerrors.Wrapf(
+				connectiontypes.ErrConnectionNotFound,
+				"connection '%#v:%v:%v' of hop %v not found", hop.UpstreamPrefix, hop.UpstreamClientId, connectionID, i+1,
+			)
+		}
+		if hopEnd.GetState() != int32(connectiontypes.OPEN) {
+			return connectiontypes.ConnectionEnd{}, sdkerrors.Wrapf(
+				connectiontypes.ErrInvalidConnectionState,
+				"connection state of hop %v is not OPEN (got %s)", i+1, connectiontypes.State(hopEnd.GetState()).String(),
+			)
+		}
+		if !bytes.Equal(prev.Counterparty.Prefix.Bytes(), hop.UpstreamPrefix.Bytes()) {
+			return connectiontypes.ConnectionEnd{}, sdkerrors.Wrapf(
+				connectiontypes.ErrInvalidConnection,
+				"hop %v must be on the counterparty of hop %v: prefix mismatch (%X ≠ %X)", i+1, i, hop.UpstreamPrefix.Bytes(), prev.Counterparty.Prefix.Bytes(),
+			)
+		}
+		if prev.Counterparty.ConnectionId == connectionID {
+			return connectiontypes.ConnectionEnd{}, sdkerrors.Wrapf(
+				connectiontypes.ErrInvalidConnection,
+				"hop %v goes back through the counterparty connection %v of hop %v", i+1, connectionID, i,
+			)
+		}
+		// the counterparty of the previous hop must be proxied under the same upstream and point back to the previous hop
+		counterpartyEnd, found := k.GetProxyConnection(ctx, &hop.UpstreamPrefix, hop.UpstreamClientId, prev.Counterparty.ConnectionId)
+		if !found {
+			return connectiontypes.ConnectionEnd{}, sdkerrors.Wrapf(
+				connectiontypes.ErrConnectionNotFound,
+				"counterparty connection '%#v:%v:%v' of hop %v not found", hop.UpstreamPrefix, hop.UpstreamClientId, prev.Counterparty.ConnectionId, i,
+			)
+		}
+		if counterpartyEnd.Counterparty.ConnectionId != connectionHops[i] ||
+			counterpartyEnd.Counterparty.ClientId != prev.ClientId ||
+			counterpartyEnd.ClientId != prev.Counterparty.ClientId {
+			return connectiontypes.ConnectionEnd{}, sdkerrors.Wrapf(
+				connectiontypes.ErrInvalidConnection,
+				"counterparty connection %v of hop %v doesn't point back to it", prev.Counterparty.ConnectionId, i,
+			)
+		}
+		prev = hopEnd
+	}
+
+	return connectionEnd, nil
+}
@@ -3,6 +3,8 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/ibc-go/modules/core/exported"
+
+	"github.com/datachainlab/ibc-proxy/modules/proxy/types"
 )
 
 // caller: B
@@ -32,5 +34,18 @@ func (k Keeper) ClientState(
 		return err
 	}
 
-	return nil
+	return ctx.EventManager().EmitTypedEvent(types.NewEventProxyClientState(upstreamClientID, upstreamPrefix, counterpartyClientID, proofHeight, consensusHeight))
+}
+
+// UpdateUpstreamClient updates the upstream client with the header.
+// If the client already has the consensus state at the height of the header, it doesn't update the client
+// because another relayer has already submitted the header.
+func (k Keeper) UpdateUpstreamClient(ctx sdk.Context, upstreamClientID string, header exported.Header) error {
+	if _, err := k.getUpstreamClientState(ctx, upstreamClientID); err != nil {
+		return err
+	}
+	if _, found := k.clientKeeper.GetClientConsensusState(ctx, upstreamClientID, header.GetHeight()); found {
+		return nil
+	}
+	return k.clientKeeper.UpdateClient(ctx, upstreamClientID, header)
 }
@@ -0,0 +1,133 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/modules/core/24-host"
+	"github.com/cosmos/ibc-go/modules/core/exported"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	proxyclienttypes "github.com/datachainlab/ibc-proxy/modules/light-clients/xx-proxy/types"
+	"github.com/datachainlab/ibc-proxy/modules/proxy/keeper"
+	"github.com/datachainlab/ibc-proxy/modules/proxy/types"
+	ibctesting "github.com/datachainlab/ibc-proxy/testing"
+	"github.com/datachainlab/ibc-proxy/testing/simapp"
+)
+
+// A(C) -> B, B -> A
+// A: downstream, B: upstream, C: proxy, D: another upstream of C
+func (suite *KeeperTestSuite) TestBindProxyClient() {
+	clientCB, err := suite.coordinator.CreateClient2(suite.chainC, suite.chainB, exported.Tendermint, false, 0)
+	suite.Require().NoError(err)
+	clientCD, err := suite.coordinator.CreateClient2(suite.chainC, suite.chainD, exported.Tendermint, false, 0)
+	suite.Require().NoError(err)
+	clientBA, err := suite.coordinator.CreateMultiVClient(suite.chainB, suite.chainA, exported.Tendermint, 0)
+	suite.Require().NoError(err)
+
+	// the proxy client must be created with the proof of the upstream client of the bound upstream chain
+	suite.coordinator.CommitBlock(suite.chainC)
+	clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+	newStates := func() (*proxyclienttypes.ClientState, *proxyclienttypes.ConsensusState) {
+		msg := suite.chainA.ConstructMsgCreateClient(suite.chainC, "", exported.Tendermint)
+		upstreamClientProof, err := suite.chainC.QueryUpstreamClientProof(clientCB)
+		suite.Require().NoError(err)
+		ibcPrefix := commitmenttypes.NewMerklePrefix([]byte(host.StoreKey))
+		proxyPrefix := commitmenttypes.NewMerklePrefix([]byte(types.StoreKey))
+		clientState := &proxyclienttypes.ClientState{
+			ProxyClientState:    msg.ClientState,
+			UpstreamClientId:    clientCB,
+			IbcPrefix:           &ibcPrefix,
+			ProxyPrefix:         &proxyPrefix,
+			UpstreamChainId:     suite.chainB.ChainID,
+			UpstreamClientProof: upstreamClientProof,
+		}
+		return clientState, proxyclienttypes.NewConsensusState(msg.ConsensusState)
+	}
+	create := func(malleate func(*proxyclienttypes.ClientState)) error {
+		clientState, consensusState := newStates()
+		malleate(clientState)
+		cacheCtx, _ := suite.chainA.GetContext().CacheContext()
+		_, err := clientKeeper.CreateClient(cacheCtx, clientState, consensusState)
+		return err
+	}
+	suite.Require().Error(create(func(cs *proxyclienttypes.ClientState) {
+		cs.UpstreamChainId = ""
+	}))
+	suite.Require().ErrorIs(create(func(cs *proxyclienttypes.ClientState) {
+		cs.UpstreamChainId = suite.chainD.ChainID
+	}), clienttypes.ErrInvalidClient)
+	suite.Require().ErrorIs(create(func(cs *proxyclienttypes.ClientState) {
+		cs.UpstreamClientType = exported.Solomachine
+	}), clienttypes.ErrInvalidClientType)
+	suite.Require().ErrorIs(create(func(cs *proxyclienttypes.ClientState) {
+		cs.UpstreamClientProof = nil
+	}), clienttypes.ErrInvalidClient)
+	suite.Require().Error(create(func(cs *proxyclienttypes.ClientState) {
+		otherProof, err := suite.chainC.QueryUpstreamClientProof(clientCD)
+		suite.Require().NoError(err)
+		cs.UpstreamClientProof.Proof = otherProof.Proof
+	}))
+
+	// the client state is stored without the proof, and the given one is left as it is
+	clientState, consensusState := newStates()
+	cacheCtx, _ := suite.chainA.GetContext().CacheContext()
+	clientID, err := clientKeeper.CreateClient(cacheCtx, clientState, consensusState)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(clientState.UpstreamClientProof)
+	stored, found := clientKeeper.GetClientState(cacheCtx, clientID)
+	suite.Require().True(found)
+	suite.Require().Nil(stored.(*proxyclienttypes.ClientState).UpstreamClientProof)
+	suite.Require().Equal(clientState.UpstreamChainId, stored.(*proxyclienttypes.ClientState).UpstreamChainId)
+
+	clientAC, err := suite.coordinator.CreateProxyClient(suite.chainA, suite.chainC, exported.Tendermint, clientCB)
+	suite.Require().NoError(err)
+	clientState = suite.chainA.GetClientState(clientAC).(*proxyclienttypes.ClientState)
+	suite.Require().Equal(suite.chainB.ChainID, clientState.UpstreamChainId)
+	suite.Require().Nil(clientState.UpstreamClientProof)
+
+	// the connection handshake also verifies that the proxy still has the upstream client of the bound upstream chain
+	ppair := ibctesting.ProxyPair{{Chain: suite.chainC, ClientID: clientAC, UpstreamClientID: clientCB, UpstreamPrefix: suite.chainB.GetPrefix()}, nil}
+	_, connB := suite.coordinator.CreateConnectionWithProxy(suite.chainA, suite.chainB, clientAC, clientBA, ibctesting.TransferVersion, ppair)
+	suite.coordinator.CommitBlock(suite.chainC)
+	proof, proofHeight := suite.chainC.QueryProxyConnectionStateProof(connB.ID, suite.chainB.GetPrefix(), clientCB)
+	plainProof, _ := suite.chainC.QueryProxyProof(types.ProxyConnectionKey(suite.chainB.GetPrefix(), clientCB, connB.ID))
+	otherUpstreamClientProof, err := suite.chainC.QueryUpstreamClientProof(clientCD)
+	suite.Require().NoError(err)
+	cdc := suite.chainA.App.AppCodec()
+	otherProof, err := cdc.Marshal(proxyclienttypes.NewConnectionProof(plainProof, *otherUpstreamClientProof))
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.chainA.UpdateProxyClient(suite.chainC, clientAC))
+
+	ctx := suite.chainA.GetContext()
+	clientState = suite.chainA.GetClientState(clientAC).(*proxyclienttypes.ClientState)
+	connection := suite.chainB.GetConnection(connB)
+	verify := func(proof []byte) error {
+		return clientState.VerifyConnectionState(clientKeeper.ClientStore(ctx, clientAC), cdc, proofHeight, suite.chainB.GetPrefix(), proof, connB.ID, connection)
+	}
+	suite.Require().NoError(verify(proof))
+	// a plain merkle proof of the connection end lacks the proof of the upstream client
+	suite.Require().ErrorIs(verify(plainProof), commitmenttypes.ErrInvalidProof)
+	suite.Require().ErrorIs(verify(otherProof), clienttypes.ErrInvalidClient)
+
+	// the binding can be queried on the downstream
+	querier := keeper.Querier{Keeper: suite.chainA.App.(*simapp.SimApp).IBCProxyKeeper}
+	res, err := querier.UpstreamChain(sdk.WrapSDKContext(ctx), &types.QueryUpstreamChainRequest{ClientId: clientAC})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.QueryUpstreamChainResponse{
+		ProxyChainId:     suite.chainC.ChainID,
+		UpstreamClientId: clientCB,
+		UpstreamChainId:  suite.chainB.ChainID,
+	}, *res)
+
+	notProxy, err := suite.coordinator.CreateClient(suite.chainA, suite.chainC, exported.Tendermint)
+	suite.Require().NoError(err)
+	ctx = suite.chainA.GetContext()
+	_, err = querier.UpstreamChain(sdk.WrapSDKContext(ctx), &types.QueryUpstreamChainRequest{ClientId: notProxy})
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+	_, err = querier.UpstreamChain(sdk.WrapSDKContext(ctx), &types.QueryUpstreamChainRequest{ClientId: "07-tendermint-100"})
+	suite.Require().Equal(codes.NotFound, status.Code(err))
+	_, err = querier.UpstreamChain(sdk.WrapSDKContext(ctx), nil)
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
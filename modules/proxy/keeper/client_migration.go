@@ -0,0 +1,180 @@
+package keeper
+
+import (
+	"bytes"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/modules/core/24-host"
+	"github.com/cosmos/ibc-go/modules/core/exported"
+
+	proxytypes "github.com/datachainlab/ibc-proxy/modules/light-clients/xx-proxy/types"
+	"github.com/datachainlab/ibc-proxy/modules/proxy/types"
+)
+
+// caller: downstream
+// MigrateClient migrates the proxy client to the proxy chain and the upstream client of the new proxy client.
+// The proofs must show that the current upstream client and the new one track the same upstream chain,
+// i.e. both have the same chain ID and the same consensus state at the upstream consensus height.
+// The client takes over the states of the new proxy client and keeps its ID, so the connections on it keep working.
+func (k Keeper) MigrateClient(
+	ctx sdk.Context,
+	clientID string, // the proxy client to be migrated
+	newClientID string, // the proxy client of the new proxy chain and upstream client
+	upstreamClientState exported.ClientState, // the current upstream client on the proxy chain of the client
+	newUpstreamClientState exported.ClientState, // the new upstream client on the proxy chain of the new client
+	upstreamConsensusState exported.ConsensusState, // the consensus state that both upstream clients have
+	upstreamConsensusHeight exported.Height, // the height of the upstream at which both upstream clients have the consensus state
+	proofUpstreamClient []byte, // proof that the proxy chain of the client stored the current upstream client
+	proofUpstreamConsensus []byte, // proof that the proxy chain of the client stored the consensus state
+	proofHeight exported.Height, // height of the client at which the proofs of the current upstream client are verified
+	proofNewUpstreamClient []byte, // proof that the proxy chain of the new client stored the new upstream client
+	proofNewUpstreamConsensus []byte, // proof that the proxy chain of the new client stored the consensus state
+	newProofHeight exported.Height, // height of the new client at which the proofs of the new upstream client are verified
+) error {
+	clientState, clientStore, err := k.getProxyClient(ctx, clientID)
+	if err != nil {
+		return err
+	}
+	if status := clientState.Status(ctx, clientStore, k.cdc); status == exported.Frozen {
+		return sdkerrors.Wrapf(clienttypes.ErrClientFrozen, "cannot migrate client (%s)", clientID)
+	}
+	newClientState, newClientStore, err := k.getProxyClient(ctx, newClientID)
+	if err != nil {
+		return err
+	}
+	if status := newClientState.Status(ctx, newClientStore, k.cdc); status != exported.Active {
+		return sdkerrors.Wrapf(clienttypes.ErrClientNotActive, "cannot migrate to client (%s) with status %s", newClientID, status)
+	}
+
+	chainID, err := proxytypes.GetChainID(upstreamClientState)
+	if err != nil {
+		return err
+	}
+	newChainID, err := proxytypes.GetChainID(newUpstreamClientState)
+	if err != nil {
+		return err
+	}
+	if chainID != newChainID {
+		return sdkerrors.Wrapf(types.ErrInvalidClientMigration, "upstream chain ID mismatch: %s != %s", chainID, newChainID)
+	}
+	// the client takes over the binding of the new client, which must be the same upstream chain unless the client has none
+	if newClientState.UpstreamChainId != chainID {
+		return sdkerrors.Wrapf(types.ErrInvalidClientMigration, "client (%s) is bound to upstream chain %q, not %s", newClientID, newClientState.UpstreamChainId, chainID)
+	}
+	if len(clientState.UpstreamChainId) > 0 && clientState.UpstreamChainId != chainID {
+		return sdkerrors.Wrapf(types.ErrInvalidClientMigration, "client (%s) is bound to upstream chain %s, not %s", clientID, clientState.UpstreamChainId, chainID)
+	}
+
+	if err := clientState.IBCVerifyClientState(
+		clientStore, k.cdc, proofHeight, clientState.IbcPrefix, clientState.UpstreamClientId, proofUpstreamClient, upstreamClientState,
+	); err != nil {
+		return sdkerrors.Wrapf(err, "failed upstream client state verification for client (%s)", clientID)
+	}
+	if err := clientState.IBCVerifyClientConsensusState(
+		clientStore, k.cdc, proofHeight, clientState.UpstreamClientId, upstreamConsensusHeight, clientState.IbcPrefix, proofUpstreamConsensus, upstreamConsensusState,
+	); err != nil {
+		return sdkerrors.Wrapf(err, "failed upstream consensus state verification for client (%s)", clientID)
+	}
+	if err := newClientState.IBCVerifyClientState(
+		newClientStore, k.cdc, newProofHeight, newClientState.IbcPrefix, newClientState.UpstreamClientId, proofNewUpstreamClient, newUpstreamClientState,
+	); err != nil {
+		return sdkerrors.Wrapf(err, "failed upstream client state verification for client (%s)", newClientID)
+	}
+	if err := newClientState.IBCVerifyClientConsensusState(
+		newClientStore, k.cdc, newProofHeight, newClientState.UpstreamClientId, upstreamConsensusHeight, newClientState.IbcPrefix, proofNewUpstreamConsensus, upstreamConsensusState,
+	); err != nil {
+		return sdkerrors.Wrapf(err, "failed upstream consensus state verification for client (%s)", newClientID)
+	}
+
+	// the consensus states of the current proxy chain are removed so that no proof is verified against them any longer
+	copyClientStore(clientStore, newClientStore)
+	k.clientKeeper.SetClientState(ctx, clientID, newClientState)
+
+	k.Logger(ctx).Info(
+		"proxy client migrated", "client-id", clientID, "new-client-id", newClientID,
+		"upstream-client-id", clientState.UpstreamClientId, "new-upstream-client-id", newClientState.UpstreamClientId,
+	)
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMigrateProxyClient(
+		clientID, newClientID, clientState.UpstreamClientId, newClientState.UpstreamClientId, upstreamConsensusHeight,
+	))
+}
+
+// MigrateClientProposal migrates the proxy client as the passed governance proposal requests.
+func (k Keeper) MigrateClientProposal(ctx sdk.Context, p *types.MigrateProxyClientProposal) error {
+	return k.migrateClientWithAnys(
+		ctx, p.ClientId, p.NewClientId, p.UpstreamClientState, p.NewUpstreamClientState,
+		p.UpstreamConsensusState, p.UpstreamConsensusHeight,
+		p.ProofUpstreamClient, p.ProofUpstreamConsensus, p.ProofHeight,
+		p.ProofNewUpstreamClient, p.ProofNewUpstreamConsensus, p.NewProofHeight,
+	)
+}
+
+// migrateClientWithAnys unpacks the upstream client states and consensus state, and calls MigrateClient with them
+func (k Keeper) migrateClientWithAnys(
+	ctx sdk.Context,
+	clientID, newClientID string,
+	anyUpstreamClient, anyNewUpstreamClient, anyUpstreamConsensus *codectypes.Any,
+	upstreamConsensusHeight exported.Height,
+	proofUpstreamClient, proofUpstreamConsensus []byte, proofHeight exported.Height,
+	proofNewUpstreamClient, proofNewUpstreamConsensus []byte, newProofHeight exported.Height,
+) error {
+	upstreamClientState, err := clienttypes.UnpackClientState(anyUpstreamClient)
+	if err != nil {
+		return err
+	}
+	newUpstreamClientState, err := clienttypes.UnpackClientState(anyNewUpstreamClient)
+	if err != nil {
+		return err
+	}
+	upstreamConsensusState, err := clienttypes.UnpackConsensusState(anyUpstreamConsensus)
+	if err != nil {
+		return err
+	}
+	return k.MigrateClient(
+		ctx, clientID, newClientID, upstreamClientState, newUpstreamClientState,
+		upstreamConsensusState, upstreamConsensusHeight,
+		proofUpstreamClient, proofUpstreamConsensus, proofHeight,
+		proofNewUpstreamClient, proofNewUpstreamConsensus, newProofHeight,
+	)
+}
+
+// getProxyClient returns the proxy client state and the client store of the client
+func (k Keeper) getProxyClient(ctx sdk.Context, clientID string) (*proxytypes.ClientState, sdk.KVStore, error) {
+	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return nil, nil, sdkerrors.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+	proxyClientState, ok := clientState.(*proxytypes.ClientState)
+	if !ok {
+		return nil, nil, sdkerrors.Wrapf(clienttypes.ErrInvalidClientType, "expected %s client, got %s", proxytypes.ProxyClientType, clientState.ClientType())
+	}
+	return proxyClientState, k.clientKeeper.ClientStore(ctx, clientID), nil
+}
+
+// copyClientStore replaces the consensus states and the metadata in the client store with the ones in the source store.
+// The client state is left to the caller.
+func copyClientStore(store, src sdk.KVStore) {
+	for _, key := range clientStoreKeys(store) {
+		store.Delete(key)
+	}
+	for _, key := range clientStoreKeys(src) {
+		store.Set(key, src.Get(key))
+	}
+}
+
+// clientStoreKeys returns the keys in the client store except the one of the client state
+func clientStoreKeys(store sdk.KVStore) [][]byte {
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		if !bytes.Equal(iterator.Key(), host.ClientStateKey()) {
+			keys = append(keys, iterator.Key())
+		}
+	}
+	return keys
+}
@@ -0,0 +1,165 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/modules/light-clients/07-tendermint/types"
+
+	proxyclienttypes "github.com/datachainlab/ibc-proxy/modules/light-clients/xx-proxy/types"
+	"github.com/datachainlab/ibc-proxy/modules/proxy/types"
+	ibctesting "github.com/datachainlab/ibc-proxy/testing"
+	"github.com/datachainlab/ibc-proxy/testing/simapp"
+)
+
+// A(C) -> B, B -> A, and then A(C) -> B through another upstream client
+// A: downstream, B: upstream, C: proxy
+func (suite *KeeperTestSuite) TestMigrateProxyClientToUpstreamClient() {
+	suite.testMigrateProxyClient(suite.chainC)
+}
+
+// A(C) -> B, B -> A, and then A(D) -> B
+// A: downstream, B: upstream, C and D: proxy
+func (suite *KeeperTestSuite) TestMigrateProxyClientToProxy() {
+	suite.testMigrateProxyClient(suite.chainD)
+}
+
+// testMigrateProxyClient migrates the proxy client of A to an upstream client of B on the new proxy chain
+// while a packet from B is in flight
+func (suite *KeeperTestSuite) testMigrateProxyClient(newProxyChain *ibctesting.TestChain) {
+	clientCB, err := suite.coordinator.CreateClient2(suite.chainC, suite.chainB, exported.Tendermint, false, 0)
+	suite.Require().NoError(err)
+	clientBA, err := suite.coordinator.CreateMultiVClient(suite.chainB, suite.chainA, exported.Tendermint, 0)
+	suite.Require().NoError(err)
+	clientAC, err := suite.coordinator.CreateProxyClient(suite.chainA, suite.chainC, exported.Tendermint, clientCB)
+	suite.Require().NoError(err)
+
+	proxy := &ibctesting.ProxyInfo{Chain: suite.chainC, ClientID: clientAC, UpstreamClientID: clientCB, UpstreamPrefix: suite.chainB.GetPrefix()}
+	ppair := ibctesting.ProxyPair{proxy, nil}
+	connA, connB := suite.coordinator.CreateConnectionWithProxy(suite.chainA, suite.chainB, clientAC, clientBA, ibctesting.TransferVersion, ppair)
+	chanA, chanB := suite.coordinator.CreateChannelWithProxy(suite.chainA, suite.chainB, connA, connB, ibctesting.TransferPort, ibctesting.TransferPort, channeltypes.UNORDERED, ppair)
+
+	// B sends a packet, which A receives after the migration
+	timeoutHeight := clienttypes.NewHeight(0, 110)
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	msgTransfer := transfertypes.NewMsgTransfer(chanB.PortID, chanB.ID, coin, suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), timeoutHeight, 0)
+	suite.Require().NoError(suite.coordinator.SendPacketWithProxy(suite.chainB, suite.chainA, connB, connA, ppair.Swap(), msgTransfer))
+	packet := channeltypes.NewPacket(
+		transfertypes.NewFungibleTokenPacketData(coin.Denom, coin.Amount.Uint64(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String()).GetBytes(),
+		1, chanB.PortID, chanB.ID, chanA.PortID, chanA.ID, timeoutHeight, 0,
+	)
+
+	// the new proxy proxies the opened channel under the new upstream client, but the old one never overwrites its states
+	newClientB, err := suite.coordinator.CreateClient2(newProxyChain, suite.chainB, exported.Tendermint, false, 0)
+	suite.Require().NoError(err)
+	newProxy := &ibctesting.ProxyInfo{Chain: newProxyChain, UpstreamClientID: newClientB, UpstreamPrefix: suite.chainB.GetPrefix()}
+	suite.Require().NoError(suite.coordinator.ProxyOpenChannel(suite.chainB, connB, *chanB, newProxy))
+	proxyKeeper := suite.chainC.App.(*simapp.SimApp).IBCProxyKeeper
+	connection := suite.chainB.GetConnection(connB)
+	err = proxyKeeper.ConnectionState(suite.chainC.GetContext(), connB.ID, clientCB, proxy.UpstreamPrefix, connection, nil, clienttypes.ZeroHeight())
+	suite.Require().ErrorIs(err, connectiontypes.ErrConnectionExists)
+	channel := suite.chainB.GetChannel(*chanB)
+	err = proxyKeeper.ChannelState(suite.chainC.GetContext(), clientCB, proxy.UpstreamPrefix, chanB.PortID, chanB.ID, channel, nil, nil, clienttypes.ZeroHeight())
+	suite.Require().ErrorIs(err, channeltypes.ErrChannelExists)
+
+	newProxy.ClientID, err = suite.coordinator.CreateProxyClient(suite.chainA, newProxyChain, exported.Tendermint, newClientB)
+	suite.Require().NoError(err)
+	notProxy, err := suite.coordinator.CreateClient(suite.chainA, newProxyChain, exported.Tendermint)
+	suite.Require().NoError(err)
+
+	downstreamKeeper := suite.chainA.App.(*simapp.SimApp).IBCProxyKeeper
+	msg, err := suite.coordinator.MigrateProxyClientMsg(suite.chainA, suite.chainB, proxy, newProxy, downstreamKeeper.GetAuthority())
+	suite.Require().NoError(err)
+	migrate := func(msg types.MsgMigrateProxyClient) error {
+		cacheCtx, _ := suite.chainA.GetContext().CacheContext()
+		_, err := downstreamKeeper.MigrateProxyClient(sdk.WrapSDKContext(cacheCtx), &msg)
+		return err
+	}
+
+	// only the authority can migrate the client
+	invalidMsg := *msg
+	invalidMsg.Authority = suite.chainA.SenderAccount.GetAddress().String()
+	suite.Require().ErrorIs(migrate(invalidMsg), sdkerrors.ErrUnauthorized)
+
+	// the client can be migrated only to a proxy client
+	invalidMsg = *msg
+	invalidMsg.NewClientId = notProxy
+	suite.Require().ErrorIs(migrate(invalidMsg), clienttypes.ErrInvalidClientType)
+
+	// the upstream clients must track the chains of the same chain ID
+	otherChainClientState := *msg.NewUpstreamClientState.GetCachedValue().(*ibctmtypes.ClientState)
+	otherChainClientState.ChainId = suite.chainD.ChainID
+	invalidMsg = *msg
+	invalidMsg.NewUpstreamClientState, err = clienttypes.PackClientState(&otherChainClientState)
+	suite.Require().NoError(err)
+	suite.Require().ErrorIs(migrate(invalidMsg), types.ErrInvalidClientMigration)
+
+	// the upstream clients must have the consensus state that the proxy chains committed
+	otherConsensusState := *msg.UpstreamConsensusState.GetCachedValue().(*ibctmtypes.ConsensusState)
+	otherConsensusState.NextValidatorsHash = []byte("next_vals_hash")
+	invalidMsg = *msg
+	invalidMsg.UpstreamConsensusState, err = clienttypes.PackConsensusState(&otherConsensusState)
+	suite.Require().NoError(err)
+	suite.Require().Error(migrate(invalidMsg))
+
+	// the proofs of the upstream clients must not be swapped
+	invalidMsg = *msg
+	invalidMsg.ProofUpstreamClient, invalidMsg.ProofNewUpstreamClient = msg.ProofNewUpstreamClient, msg.ProofUpstreamClient
+	suite.Require().Error(migrate(invalidMsg))
+
+	// governance migrates the client through the proposal as well
+	proposal, err := types.NewMigrateProxyClientProposal(
+		"title", "description", msg.ClientId, msg.NewClientId,
+		msg.UpstreamClientState.GetCachedValue().(exported.ClientState), msg.NewUpstreamClientState.GetCachedValue().(exported.ClientState),
+		msg.UpstreamConsensusState.GetCachedValue().(exported.ConsensusState), msg.UpstreamConsensusHeight,
+		msg.ProofUpstreamClient, msg.ProofUpstreamConsensus, msg.ProofHeight,
+		msg.ProofNewUpstreamClient, msg.ProofNewUpstreamConsensus, msg.NewProofHeight,
+	)
+	suite.Require().NoError(err)
+	app := suite.chainA.App.(*simapp.SimApp)
+	cacheCtx, _ := suite.chainA.GetContext().CacheContext()
+	_, err = app.GovKeeper.SubmitProposal(cacheCtx, proposal)
+	suite.Require().NoError(err)
+	handler := app.GovKeeper.Router().GetRoute(types.RouterKey)
+	suite.Require().NoError(handler(cacheCtx, proposal))
+	migratedClientState, found := app.IBCKeeper.ClientKeeper.GetClientState(cacheCtx, clientAC)
+	suite.Require().True(found)
+	suite.Require().Equal(suite.chainA.GetClientState(newProxy.ClientID), migratedClientState)
+	invalidProposal := *proposal
+	invalidProposal.ProofUpstreamClient, invalidProposal.ProofNewUpstreamClient = proposal.ProofNewUpstreamClient, proposal.ProofUpstreamClient
+	cacheCtx, _ = suite.chainA.GetContext().CacheContext()
+	suite.Require().Error(handler(cacheCtx, &invalidProposal))
+
+	ctx := suite.chainA.GetContext().WithEventManager(sdk.NewEventManager())
+	_, err = downstreamKeeper.MigrateProxyClient(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().NoError(err)
+	var ev types.EventMigrateProxyClient
+	suite.Require().NoError(ibctesting.ParseProxyEventFromEvents(ctx.EventManager().Events(), &ev))
+	suite.Require().Equal(types.EventMigrateProxyClient{
+		ClientId:                clientAC,
+		NewClientId:             newProxy.ClientID,
+		UpstreamClientId:        clientCB,
+		NewUpstreamClientId:     newClientB,
+		UpstreamConsensusHeight: msg.UpstreamConsensusHeight,
+	}, ev)
+
+	// the client takes over the states of the new proxy client
+	clientState := suite.chainA.GetClientState(clientAC).(*proxyclienttypes.ClientState)
+	suite.Require().Equal(suite.chainA.GetClientState(newProxy.ClientID), clientState)
+	suite.Require().Equal(newProxyChain.ChainID, clientState.GetProxyClientState().(*ibctmtypes.ClientState).ChainId)
+	consensusState, found := suite.chainA.GetConsensusState(clientAC, clientState.GetLatestHeight())
+	suite.Require().True(found)
+	newConsensusState, _ := suite.chainA.GetConsensusState(newProxy.ClientID, clientState.GetLatestHeight())
+	suite.Require().Equal(newConsensusState, consensusState)
+
+	// the packets are relayed through the new proxy on the same connection and channel
+	newPpair := ibctesting.ProxyPair{&ibctesting.ProxyInfo{Chain: newProxyChain, ClientID: clientAC, UpstreamClientID: newClientB, UpstreamPrefix: suite.chainB.GetPrefix()}, nil}
+	suite.Require().NoError(suite.coordinator.RecvPacketWithProxy(suite.chainA, suite.chainB, connA, connB, packet, newPpair))
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	suite.Require().NoError(suite.coordinator.AcknowledgePacketWithProxy(suite.chainB, suite.chainA, chanB, chanA, connB, connA, packet, ack.Acknowledgement(), newPpair.Swap()))
+	suite.testHandleMsgTransfer(connA, connB, chanA, chanB, newPpair)
+}
@@ -1,13 +1,20 @@
 package keeper
 
 import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/modules/core/24-host"
 	"github.com/cosmos/ibc-go/modules/core/exported"
+	proxytypes "github.com/datachainlab/ibc-proxy/modules/light-clients/xx-proxy/types"
+	"github.com/datachainlab/ibc-proxy/modules/proxy/types"
 )
 
 func (k Keeper) GetProxyClientState(
@@ -72,6 +79,65 @@ func (k Keeper) GetProxyChannel(
 	return channel, true
 }
 
+func (k Keeper) GetProxyPacketCommitment(
+	ctx sdk.Context,
+	upstreamPrefix exported.Prefix,
+	upstreamClientID string,
+	portID,
+	channelID string,
+	sequence uint64,
+) ([]byte, bool) {
+	store := k.ProxyStore(ctx, upstreamPrefix, upstreamClientID)
+	bz := store.Get(host.PacketCommitmentKey(portID, channelID, sequence))
+	if len(bz) == 0 {
+		return nil, false
+	}
+	return bz, true
+}
+
+func (k Keeper) GetProxyPacketAcknowledgement(
+	ctx sdk.Context,
+	upstreamPrefix exported.Prefix,
+	upstreamClientID string,
+	portID,
+	channelID string,
+	sequence uint64,
+) ([]byte, bool) {
+	store := k.ProxyStore(ctx, upstreamPrefix, upstreamClientID)
+	bz := store.Get(host.PacketAcknowledgementKey(portID, channelID, sequence))
+	if len(bz) == 0 {
+		return nil, false
+	}
+	return bz, true
+}
+
+func (k Keeper) HasProxyPacketReceiptAbsence(
+	ctx sdk.Context,
+	upstreamPrefix exported.Prefix,
+	upstreamClientID string,
+	portID,
+	channelID string,
+	sequence uint64,
+) bool {
+	store := k.ProxyStore(ctx, upstreamPrefix, upstreamClientID)
+	return store.Has([]byte(proxytypes.PacketReceiptAbsencePath(portID, channelID, sequence)))
+}
+
+func (k Keeper) GetProxyNextSequenceRecv(
+	ctx sdk.Context,
+	upstreamPrefix exported.Prefix,
+	upstreamClientID string,
+	portID,
+	channelID string,
+) (uint64, bool) {
+	store := k.ProxyStore(ctx, upstreamPrefix, upstreamClientID)
+	bz := store.Get(host.NextSequenceRecvKey(portID, channelID))
+	if len(bz) == 0 {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(bz), true
+}
+
 func (k Keeper) SetProxyClientState(
 	ctx sdk.Context,
 	upstreamPrefix exported.Prefix, // upstream's prefix
@@ -108,6 +174,21 @@ func (k Keeper) SetProxyConnection(
 ) error {
 	store := k.ProxyStore(ctx, upstreamPrefix, upstreamClientID)
 	bz := k.cdc.MustMarshal(&connectionEnd)
+	if prev, found := k.GetProxyConnection(ctx, upstreamPrefix, upstreamClientID, connectionID); found {
+		if bytes.Equal(k.cdc.MustMarshal(&prev), bz) {
+			return nil
+		}
+		// the state must move forward: INIT or TRYOPEN to OPEN
+		if connectionEnd.State <= prev.State {
+			return sdkerrors.Wrapf(
+				connectiontypes.ErrInvalidConnectionState,
+				"connection '%v' cannot move from %s to %s", connectionID, prev.State, connectionEnd.State,
+			)
+		}
+		if connectionEnd.ClientId != prev.ClientId || connectionEnd.Counterparty.ClientId != prev.Counterparty.ClientId {
+			return sdkerrors.Wrapf(connectiontypes.ErrInvalidConnection, "the clients of connection '%v' cannot be changed", connectionID)
+		}
+	}
 	store.Set(host.ConnectionKey(connectionID), bz)
 	return nil
 }
@@ -123,6 +204,21 @@ func (k Keeper) SetProxyChannel(
 	store := k.ProxyStore(ctx, upstreamPrefix, upstreamClientID)
 	channel := channelEnd.(channeltypes.Channel)
 	bz := k.cdc.MustMarshal(&channel)
+	if prev, found := k.GetProxyChannel(ctx, upstreamPrefix, upstreamClientID, portID, channelID); found {
+		if bytes.Equal(k.cdc.MustMarshal(&prev), bz) {
+			return nil
+		}
+		// the state must move forward: INIT or TRYOPEN to OPEN, and any state to CLOSED
+		if channel.State <= prev.State {
+			return sdkerrors.Wrapf(
+				channeltypes.ErrInvalidChannelState,
+				"channel '%v:%v' cannot move from %s to %s", portID, channelID, prev.State, channel.State,
+			)
+		}
+		if channel.Ordering != prev.Ordering || channel.Counterparty.PortId != prev.Counterparty.PortId || !equalStrings(channel.ConnectionHops, prev.ConnectionHops) {
+			return sdkerrors.Wrapf(channeltypes.ErrInvalidChannel, "the ordering, the counterparty port and the connection hops of channel '%v:%v' cannot be changed", portID, channelID)
+		}
+	}
 	store.Set(host.ChannelKey(portID, channelID), bz)
 	return nil
 }
@@ -137,7 +233,12 @@ func (k Keeper) SetProxyPacketCommitment(
 	commitmentBytes []byte,
 ) error {
 	store := k.ProxyStore(ctx, upstreamPrefix, upstreamClientID)
-	store.Set(host.PacketCommitmentKey(portID, channelID, sequence), commitmentBytes)
+	key := host.PacketCommitmentKey(portID, channelID, sequence)
+	if bz := store.Get(key); bz != nil {
+		return k.checkProxyCommitmentConflict(ctx, upstreamPrefix, upstreamClientID, key, bz, commitmentBytes)
+	}
+	// the commitment is pruned by VerifyAndPruneProxyPacketCommitment once the upstream deletes it
+	store.Set(key, commitmentBytes)
 	return nil
 }
 
@@ -150,9 +251,7 @@ func (k Keeper) SetProxyPacketAcknowledgement(
 	sequence uint64,
 	acknowledgement []byte,
 ) error {
-	store := k.ProxyStore(ctx, upstreamPrefix, upstreamClientID)
-	store.Set(host.PacketAcknowledgementKey(portID, channelID, sequence), channeltypes.CommitAcknowledgement(acknowledgement))
-	return nil
+	return k.setPrunableProxyState(ctx, upstreamPrefix, upstreamClientID, host.PacketAcknowledgementKey(portID, channelID, sequence), channeltypes.CommitAcknowledgement(acknowledgement))
 }
 
 func (k Keeper) SetProxyPacketReceiptAbsence(
@@ -164,7 +263,11 @@ func (k Keeper) SetProxyPacketReceiptAbsence(
 	sequence uint64,
 ) error {
 	store := k.ProxyStore(ctx, upstreamPrefix, upstreamClientID)
-	store.Set(host.PacketReceiptKey(portID, channelID, sequence), []byte{byte(1)})
+	key := []byte(proxytypes.PacketReceiptAbsencePath(portID, channelID, sequence))
+	if bz := store.Get(key); bz != nil {
+		return k.checkProxyCommitmentConflict(ctx, upstreamPrefix, upstreamClientID, key, bz, proxytypes.PacketReceiptAbsenceMarker)
+	}
+	store.Set(key, proxytypes.PacketReceiptAbsenceMarker)
 	return nil
 }
 
@@ -177,7 +280,50 @@ func (k Keeper) SetProxyNextSequenceRecv(
 	nextSequenceRecv uint64,
 ) error {
 	store := k.ProxyStore(ctx, upstreamPrefix, upstreamClientID)
-	bz := sdk.Uint64ToBigEndian(nextSequenceRecv)
-	store.Set(host.NextSequenceRecvKey(portID, channelID), bz)
+	key := host.NextSequenceRecvKey(portID, channelID)
+	// the next sequence receive of the upstream never decreases, so a lower one is just proven at an older height
+	if prev := store.Get(key); prev != nil && sdk.BigEndianToUint64(prev) >= nextSequenceRecv {
+		return nil
+	}
+	store.Set(key, sdk.Uint64ToBigEndian(nextSequenceRecv))
 	return nil
 }
+
+// checkProxyCommitmentConflict returns nil if the existing value equals the new value.
+// Otherwise, it logs the conflict, counts it in the telemetry, emits EventProxyCommitmentConflict and returns ErrConflictingProxyCommitment.
+// The event is discarded with the failed transaction, but PacketBatch keeps it for a failed item of a non-atomic batch.
+func (k Keeper) checkProxyCommitmentConflict(
+	ctx sdk.Context,
+	upstreamPrefix exported.Prefix,
+	upstreamClientID string,
+	key, existingValue, newValue []byte,
+) error {
+	if bytes.Equal(existingValue, newValue) {
+		return nil
+	}
+	k.Logger(ctx).Error(
+		"conflicting proxy commitment",
+		"upstream_client_id", upstreamClientID, "upstream_prefix", string(upstreamPrefix.Bytes()), "path", string(key),
+		"existing_value", fmt.Sprintf("%X", existingValue), "new_value", fmt.Sprintf("%X", newValue),
+	)
+	telemetry.IncrCounter(1, types.ModuleName, "commitment_conflict")
+	if err := ctx.EventManager().EmitTypedEvent(types.NewEventProxyCommitmentConflict(upstreamClientID, upstreamPrefix, string(key), existingValue, newValue)); err != nil {
+		return err
+	}
+	return sdkerrors.Wrapf(
+		types.ErrConflictingProxyCommitment,
+		"upstream client '%v' path '%v': %X != %X", upstreamClientID, string(key), existingValue, newValue,
+	)
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
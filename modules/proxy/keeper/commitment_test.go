@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	connectiontypes "github.com/cosmos/ibc-go/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/modules/core/24-host"
+
+	"github.com/datachainlab/ibc-proxy/modules/proxy/types"
+	ibctesting "github.com/datachainlab/ibc-proxy/testing"
+	"github.com/datachainlab/ibc-proxy/testing/simapp"
+)
+
+func (suite *KeeperTestSuite) TestWriteOnceProxyCommitments() {
+	proxyKeeper := suite.chainC.App.(*simapp.SimApp).IBCProxyKeeper
+	ctx := suite.chainC.GetContext().WithEventManager(sdk.NewEventManager())
+	prefix := commitmenttypes.NewMerklePrefix([]byte("ibc"))
+	upstreamClientID, portID, channelID := "07-tendermint-0", "transfer", "channel-0"
+
+	// packet commitments
+	suite.Require().NoError(proxyKeeper.SetProxyPacketCommitment(ctx, &prefix, upstreamClientID, portID, channelID, 1, []byte("commitment")))
+	suite.Require().NoError(proxyKeeper.SetProxyPacketCommitment(ctx, &prefix, upstreamClientID, portID, channelID, 1, []byte("commitment")))
+	suite.Require().Empty(ctx.EventManager().Events())
+	err := proxyKeeper.SetProxyPacketCommitment(ctx, &prefix, upstreamClientID, portID, channelID, 1, []byte("other"))
+	suite.Require().ErrorIs(err, types.ErrConflictingProxyCommitment)
+	commitment, _ := proxyKeeper.GetProxyPacketCommitment(ctx, &prefix, upstreamClientID, portID, channelID, 1)
+	suite.Require().Equal([]byte("commitment"), commitment)
+
+	var ev types.EventProxyCommitmentConflict
+	suite.Require().NoError(ibctesting.ParseProxyEventFromEvents(ctx.EventManager().Events(), &ev))
+	suite.Require().Equal(types.EventProxyCommitmentConflict{
+		UpstreamClientId: upstreamClientID,
+		UpstreamPrefix:   prefix,
+		Path:             host.PacketCommitmentPath(portID, channelID, 1),
+		ExistingValue:    []byte("commitment"),
+		NewValue:         []byte("other"),
+	}, ev)
+
+	// packet acknowledgements
+	suite.Require().NoError(proxyKeeper.SetProxyPacketAcknowledgement(ctx, &prefix, upstreamClientID, portID, channelID, 1, []byte("ack")))
+	suite.Require().NoError(proxyKeeper.SetProxyPacketAcknowledgement(ctx, &prefix, upstreamClientID, portID, channelID, 1, []byte("ack")))
+	err = proxyKeeper.SetProxyPacketAcknowledgement(ctx, &prefix, upstreamClientID, portID, channelID, 1, []byte("other"))
+	suite.Require().ErrorIs(err, types.ErrConflictingProxyCommitment)
+
+	// packet receipts
+	suite.Require().NoError(proxyKeeper.SetProxyPacketReceiptAbsence(ctx, &prefix, upstreamClientID, portID, channelID, 1))
+	suite.Require().NoError(proxyKeeper.SetProxyPacketReceiptAbsence(ctx, &prefix, upstreamClientID, portID, channelID, 1))
+
+	// next sequence receives never decrease, and a stale one is ignored
+	suite.Require().NoError(proxyKeeper.SetProxyNextSequenceRecv(ctx, &prefix, upstreamClientID, portID, channelID, 2))
+	suite.Require().NoError(proxyKeeper.SetProxyNextSequenceRecv(ctx, &prefix, upstreamClientID, portID, channelID, 2))
+	suite.Require().NoError(proxyKeeper.SetProxyNextSequenceRecv(ctx, &prefix, upstreamClientID, portID, channelID, 3))
+	suite.Require().NoError(proxyKeeper.SetProxyNextSequenceRecv(ctx, &prefix, upstreamClientID, portID, channelID, 1))
+	nextSequenceRecv, _ := proxyKeeper.GetProxyNextSequenceRecv(ctx, &prefix, upstreamClientID, portID, channelID)
+	suite.Require().Equal(uint64(3), nextSequenceRecv)
+}
+
+func (suite *KeeperTestSuite) TestProxyStateTransitions() {
+	proxyKeeper := suite.chainC.App.(*simapp.SimApp).IBCProxyKeeper
+	ctx := suite.chainC.GetContext()
+	prefix := commitmenttypes.NewMerklePrefix([]byte("ibc"))
+	upstreamClientID, connectionID, portID, channelID := "07-tendermint-0", "connection-0", "transfer", "channel-0"
+
+	connection := func(state connectiontypes.State, clientID, counterpartyConnectionID string) connectiontypes.ConnectionEnd {
+		return connectiontypes.NewConnectionEnd(
+			state, clientID, connectiontypes.NewCounterparty("07-tendermint-1", counterpartyConnectionID, prefix),
+			[]*connectiontypes.Version{connectiontypes.DefaultIBCVersion}, 0,
+		)
+	}
+	suite.Require().NoError(proxyKeeper.SetProxyConnection(ctx, &prefix, upstreamClientID, connectionID, connection(connectiontypes.INIT, "07-tendermint-2", "")))
+	suite.Require().NoError(proxyKeeper.SetProxyConnection(ctx, &prefix, upstreamClientID, connectionID, connection(connectiontypes.INIT, "07-tendermint-2", "")))
+	suite.Require().Error(proxyKeeper.SetProxyConnection(ctx, &prefix, upstreamClientID, connectionID, connection(connectiontypes.INIT, "07-tendermint-2", "connection-1")))
+	suite.Require().Error(proxyKeeper.SetProxyConnection(ctx, &prefix, upstreamClientID, connectionID, connection(connectiontypes.OPEN, "07-tendermint-3", "connection-1")))
+	suite.Require().NoError(proxyKeeper.SetProxyConnection(ctx, &prefix, upstreamClientID, connectionID, connection(connectiontypes.OPEN, "07-tendermint-2", "connection-1")))
+	suite.Require().Error(proxyKeeper.SetProxyConnection(ctx, &prefix, upstreamClientID, connectionID, connection(connectiontypes.TRYOPEN, "07-tendermint-2", "connection-1")))
+	conn, _ := proxyKeeper.GetProxyConnection(ctx, &prefix, upstreamClientID, connectionID)
+	suite.Require().Equal(connectiontypes.OPEN, conn.State)
+
+	channel := func(state channeltypes.State, order channeltypes.Order) channeltypes.Channel {
+		return channeltypes.NewChannel(state, order, channeltypes.NewCounterparty(portID, "channel-1"), []string{connectionID}, "ics20-1")
+	}
+	suite.Require().NoError(proxyKeeper.SetProxyChannel(ctx, &prefix, upstreamClientID, portID, channelID, channel(channeltypes.TRYOPEN, channeltypes.UNORDERED)))
+	suite.Require().Error(proxyKeeper.SetProxyChannel(ctx, &prefix, upstreamClientID, portID, channelID, channel(channeltypes.INIT, channeltypes.UNORDERED)))
+	suite.Require().Error(proxyKeeper.SetProxyChannel(ctx, &prefix, upstreamClientID, portID, channelID, channel(channeltypes.OPEN, channeltypes.ORDERED)))
+	suite.Require().NoError(proxyKeeper.SetProxyChannel(ctx, &prefix, upstreamClientID, portID, channelID, channel(channeltypes.OPEN, channeltypes.UNORDERED)))
+	suite.Require().NoError(proxyKeeper.SetProxyChannel(ctx, &prefix, upstreamClientID, portID, channelID, channel(channeltypes.CLOSED, channeltypes.UNORDERED)))
+	suite.Require().NoError(proxyKeeper.SetProxyChannel(ctx, &prefix, upstreamClientID, portID, channelID, channel(channeltypes.CLOSED, channeltypes.UNORDERED)))
+	suite.Require().Error(proxyKeeper.SetProxyChannel(ctx, &prefix, upstreamClientID, portID, channelID, channel(channeltypes.OPEN, channeltypes.UNORDERED)))
+}
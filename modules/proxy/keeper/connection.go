@@ -17,6 +17,7 @@ import (
 
 	multivtypes "github.com/datachainlab/ibc-proxy/modules/light-clients/xx-multiv/types"
 	proxytypes "github.com/datachainlab/ibc-proxy/modules/light-clients/xx-proxy/types"
+	"github.com/datachainlab/ibc-proxy/modules/proxy/types"
 )
 
 // upstream: chainA, downstream: chainB
@@ -94,7 +95,7 @@ func (k Keeper) ConnOpenTry(
 		return err
 	}
 
-	return nil
+	return ctx.EventManager().EmitTypedEvent(types.NewEventProxyConnectionOpenTry(upstreamClientID, upstreamPrefix, connectionID, connection, proofHeight))
 }
 
 // upstream: chainA, downstream: chainB
@@ -175,7 +176,7 @@ func (k Keeper) ConnOpenAck(
 		return err
 	}
 
-	return nil
+	return ctx.EventManager().EmitTypedEvent(types.NewEventProxyConnectionOpenAck(upstreamClientID, upstreamPrefix, connectionID, connectionEnd, proofHeight))
 }
 
 // upstream: chainA, downstream: chainB
@@ -216,7 +217,7 @@ func (k Keeper) ConnOpenConfirm(
 		return err
 	}
 
-	return nil
+	return ctx.EventManager().EmitTypedEvent(types.NewEventProxyConnectionOpenConfirm(upstreamClientID, upstreamPrefix, connectionID, connectionEnd, proofHeight))
 }
 
 // upstream: chainA, downstream: chainB
@@ -255,7 +256,46 @@ func (k Keeper) ConnOpenFinalize(
 		return err
 	}
 
-	return nil
+	return ctx.EventManager().EmitTypedEvent(types.NewEventProxyConnectionOpenFinalize(upstreamClientID, upstreamPrefix, connectionID, connectionEnd, proofHeight))
+}
+
+// upstream: chainA, downstream: chainB
+// ConnectionState proxies the connection end that chainA has already opened for an upstream client that doesn't have it yet,
+// so that a downstream can migrate its proxy client to the upstream client after the handshake.
+// It never overwrites a proxied connection end, which the handshake or this function has proxied.
+func (k Keeper) ConnectionState(
+	ctx sdk.Context,
+
+	connectionID string, // the connection ID corresponding to chainB on chainA
+	upstreamClientID string, // the client ID corresponding to light client for chainA on chainB
+	upstreamPrefix exported.Prefix, // store prefix on chainA
+	connection connectiontypes.ConnectionEnd, // the connection corresponding to chainB on chainA (its state must be OPEN)
+
+	proof []byte, // proof that chainA stored connection in state
+	proofHeight exported.Height, // height that relayer constructed proof
+) error {
+
+	if _, found := k.GetProxyConnection(ctx, upstreamPrefix, upstreamClientID, connectionID); found {
+		return sdkerrors.Wrapf(
+			connectiontypes.ErrConnectionExists,
+			"connection '%#v:%v:%v' already proxied", upstreamPrefix, upstreamClientID, connectionID,
+		)
+	}
+
+	if connection.State != connectiontypes.OPEN {
+		return sdkerrors.Wrapf(
+			connectiontypes.ErrInvalidConnectionState,
+			"connection state is not OPEN (got %s)", connection.State.String(),
+		)
+	}
+
+	if err := k.VerifyAndProxyConnectionState(
+		ctx, upstreamClientID, upstreamPrefix, connection, proofHeight, proof, connectionID,
+	); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(types.NewEventProxyConnectionState(upstreamClientID, upstreamPrefix, connectionID, connection, proofHeight))
 }
 
 func (k Keeper) produceVerificationArgs(ctx sdk.Context, proxyClientState exported.ClientState, proxyConsensusHeight exported.Height) (*proxytypes.ClientState, *proxytypes.ConsensusState, string, error) {
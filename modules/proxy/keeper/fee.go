@@ -0,0 +1,178 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	commitmenttypes "github.com/cosmos/ibc-go/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/modules/core/24-host"
+	"github.com/cosmos/ibc-go/modules/core/exported"
+
+	"github.com/datachainlab/ibc-proxy/modules/proxy/types"
+)
+
+// GetPacketFees returns the fees escrowed for the packet with the given direction and the port, channel and sequence on the upstream side
+func (k Keeper) GetPacketFees(ctx sdk.Context, upstreamPrefix exported.Prefix, upstreamClientID string, direction types.PacketDirection, portID, channelID string, sequence uint64) (types.PacketFees, bool) {
+	bz := ctx.KVStore(k.proxyStoreKey).Get(types.PacketFeesKey(upstreamPrefix, upstreamClientID, direction, portID, channelID, sequence))
+	if bz == nil {
+		return types.PacketFees{}, false
+	}
+	var fees types.PacketFees
+	k.cdc.MustUnmarshal(bz, &fees)
+	return fees, true
+}
+
+// SetPacketFees sets the fees escrowed for the packet with the given direction and the port, channel and sequence on the upstream side
+func (k Keeper) SetPacketFees(ctx sdk.Context, upstreamPrefix exported.Prefix, upstreamClientID string, direction types.PacketDirection, portID, channelID string, sequence uint64, fees types.PacketFees) {
+	bz := k.cdc.MustMarshal(&fees)
+	ctx.KVStore(k.proxyStoreKey).Set(types.PacketFeesKey(upstreamPrefix, upstreamClientID, direction, portID, channelID, sequence), bz)
+}
+
+func (k Keeper) deletePacketFees(ctx sdk.Context, upstreamPrefix exported.Prefix, upstreamClientID string, direction types.PacketDirection, portID, channelID string, sequence uint64) {
+	ctx.KVStore(k.proxyStoreKey).Delete(types.PacketFeesKey(upstreamPrefix, upstreamClientID, direction, portID, channelID, sequence))
+}
+
+// upstreamPacketID returns the port, channel and sequence of the packet on the upstream side:
+// the source of a packet from the upstream, or the destination of a packet to the upstream
+func upstreamPacketID(packet exported.PacketI, direction types.PacketDirection) (string, string, uint64) {
+	if direction == types.PacketToUpstream {
+		return packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()
+	}
+	return packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()
+}
+
+// hasProxiedPacket returns true if the proxy has the state that the fees of the packet are paid for:
+// the packet commitment of a packet from the upstream, or the acknowledgement of a packet to the upstream
+func (k Keeper) hasProxiedPacket(ctx sdk.Context, upstreamPrefix exported.Prefix, upstreamClientID string, direction types.PacketDirection, portID, channelID string, sequence uint64) bool {
+	key := host.PacketCommitmentKey(portID, channelID, sequence)
+	if direction == types.PacketToUpstream {
+		key = host.PacketAcknowledgementKey(portID, channelID, sequence)
+	}
+	return k.ProxyStore(ctx, upstreamPrefix, upstreamClientID).Has(key)
+}
+
+// EscrowPacketFee transfers the fee from the refund address to the module account and adds it to the fees of the packet.
+// The packet is identified by its direction and its port, channel and sequence on the upstream side.
+// The fee is rejected if the proxy already has the state that it would be paid for, as nobody could earn it.
+func (k Keeper) EscrowPacketFee(ctx sdk.Context, upstreamPrefix exported.Prefix, upstreamClientID string, direction types.PacketDirection, portID, channelID string, sequence uint64, packetFee types.PacketFee) error {
+	if err := direction.Validate(); err != nil {
+		return err
+	}
+	if err := packetFee.Validate(); err != nil {
+		return err
+	}
+	if _, err := k.getUpstreamClientState(ctx, upstreamClientID); err != nil {
+		return err
+	}
+	if k.hasProxiedPacket(ctx, upstreamPrefix, upstreamClientID, direction, portID, channelID, sequence) {
+		return sdkerrors.Wrapf(types.ErrInvalidPacketFee, "packet has already been proxied: port: %s, channel: %s, sequence: %d", portID, channelID, sequence)
+	}
+	refundAddr, err := sdk.AccAddressFromBech32(packetFee.RefundAddress)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "failed to parse the refund address: %v", err)
+	}
+	if k.bankKeeper.BlockedAddr(refundAddr) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive the refund", refundAddr)
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, refundAddr, types.ModuleName, packetFee.Fee); err != nil {
+		return err
+	}
+
+	fees, _ := k.GetPacketFees(ctx, upstreamPrefix, upstreamClientID, direction, portID, channelID, sequence)
+	fees.PacketFees = append(fees.PacketFees, packetFee)
+	k.SetPacketFees(ctx, upstreamPrefix, upstreamClientID, direction, portID, channelID, sequence, fees)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventPayProxyPacketFee{
+		UpstreamClientId: upstreamClientID,
+		UpstreamPrefix:   commitmenttypes.NewMerklePrefix(upstreamPrefix.Bytes()),
+		PortId:           portID,
+		ChannelId:        channelID,
+		Sequence:         sequence,
+		Fee:              packetFee.Fee,
+		RefundAddress:    packetFee.RefundAddress,
+		Direction:        direction,
+	})
+}
+
+// DistributePacketFees pays the fees escrowed for the packet to the relayer that proxied it.
+// The caller must call it only if the relayer has written the state that the fees are paid for.
+func (k Keeper) DistributePacketFees(ctx sdk.Context, relayer sdk.AccAddress, upstreamPrefix exported.Prefix, upstreamClientID string, direction types.PacketDirection, packet exported.PacketI) error {
+	portID, channelID, sequence := upstreamPacketID(packet, direction)
+	fees, found := k.GetPacketFees(ctx, upstreamPrefix, upstreamClientID, direction, portID, channelID, sequence)
+	if !found {
+		return nil
+	}
+	total := fees.Total()
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, relayer, total); err != nil {
+		return sdkerrors.Wrapf(err, "failed to pay the packet fees to the relayer %s", relayer)
+	}
+	k.deletePacketFees(ctx, upstreamPrefix, upstreamClientID, direction, portID, channelID, sequence)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventDistributeProxyPacketFee{
+		UpstreamClientId: upstreamClientID,
+		UpstreamPrefix:   commitmenttypes.NewMerklePrefix(upstreamPrefix.Bytes()),
+		PortId:           portID,
+		ChannelId:        channelID,
+		Sequence:         sequence,
+		Fee:              total,
+		Relayer:          relayer.String(),
+		Direction:        direction,
+	})
+}
+
+// RefundPacketFees returns the fees escrowed for the packet to their refund addresses:
+// a packet to the upstream that timed out, or a packet from the upstream whose commitment was deleted on the upstream
+func (k Keeper) RefundPacketFees(ctx sdk.Context, upstreamPrefix exported.Prefix, upstreamClientID string, direction types.PacketDirection, portID, channelID string, sequence uint64) error {
+	fees, found := k.GetPacketFees(ctx, upstreamPrefix, upstreamClientID, direction, portID, channelID, sequence)
+	if !found {
+		return nil
+	}
+	for _, pf := range fees.PacketFees {
+		refundAddr, err := sdk.AccAddressFromBech32(pf.RefundAddress)
+		if err != nil {
+			return err
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, refundAddr, pf.Fee); err != nil {
+			return sdkerrors.Wrapf(err, "failed to refund the packet fee to %s", refundAddr)
+		}
+	}
+	k.deletePacketFees(ctx, upstreamPrefix, upstreamClientID, direction, portID, channelID, sequence)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventRefundProxyPacketFee{
+		UpstreamClientId: upstreamClientID,
+		UpstreamPrefix:   commitmenttypes.NewMerklePrefix(upstreamPrefix.Bytes()),
+		PortId:           portID,
+		ChannelId:        channelID,
+		Sequence:         sequence,
+		Fee:              fees.Total(),
+		Direction:        direction,
+	})
+}
+
+// GetAllIncentivizedPackets returns all the packets that have fees escrowed
+func (k Keeper) GetAllIncentivizedPackets(ctx sdk.Context) []types.IncentivizedPacket {
+	var packets []types.IncentivizedPacket
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.proxyStoreKey), types.KeyPacketFeesPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		packet, err := k.unmarshalIncentivizedPacket(iterator.Key(), iterator.Value())
+		if err != nil {
+			panic(err)
+		}
+		packets = append(packets, packet)
+	}
+	return packets
+}
+
+// unmarshalIncentivizedPacket returns the incentivized packet of a key and a value of the packet fees
+func (k Keeper) unmarshalIncentivizedPacket(key, value []byte) (types.IncentivizedPacket, error) {
+	upstreamClientID, upstreamPrefix, direction, portID, channelID, sequence, err := types.ParsePacketFeesKey(key)
+	if err != nil {
+		return types.IncentivizedPacket{}, err
+	}
+	var fees types.PacketFees
+	if err := k.cdc.Unmarshal(value, &fees); err != nil {
+		return types.IncentivizedPacket{}, err
+	}
+	return types.NewIncentivizedPacket(upstreamClientID, upstreamPrefix, direction, portID, channelID, sequence, fees.PacketFees), nil
+}
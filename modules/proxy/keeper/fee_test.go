@@ -0,0 +1,284 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	transfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/modules/core/24-host"
+	"github.com/cosmos/ibc-go/modules/core/exported"
+
+	"github.com/datachainlab/ibc-proxy/modules/proxy/keeper"
+	"github.com/datachainlab/ibc-proxy/modules/proxy/types"
+	ibctesting "github.com/datachainlab/ibc-proxy/testing"
+	"github.com/datachainlab/ibc-proxy/testing/simapp"
+)
+
+// A(C) -> B, B -> A
+// A: downstream, B: upstream, C: proxy
+func (suite *KeeperTestSuite) TestProxyPacketFee() {
+	suite.Require().NoError(suite.coordinator.IncrementClientSequence(suite.chainC, suite.chainB, exported.Tendermint, 1))
+	suite.Require().NoError(suite.coordinator.IncrementClientSequence(suite.chainB, suite.chainA, exported.Tendermint, 2))
+
+	clientCB, err := suite.coordinator.CreateClient2(suite.chainC, suite.chainB, exported.Tendermint, false, 0)
+	suite.Require().NoError(err)
+	clientBA, err := suite.coordinator.CreateMultiVClient(suite.chainB, suite.chainA, exported.Tendermint, 0)
+	suite.Require().NoError(err)
+	clientAC, err := suite.coordinator.CreateProxyClient(suite.chainA, suite.chainC, exported.Tendermint, clientCB)
+	suite.Require().NoError(err)
+
+	ppair := ibctesting.ProxyPair{{Chain: suite.chainC, ClientID: clientAC, UpstreamClientID: clientCB, UpstreamPrefix: suite.chainB.GetPrefix()}, nil}
+	connA, connB := suite.coordinator.CreateConnectionWithProxy(suite.chainA, suite.chainB, clientAC, clientBA, ibctesting.TransferVersion, ppair)
+	chanA, chanB := suite.coordinator.CreateChannelWithProxy(suite.chainA, suite.chainB, connA, connB, ibctesting.TransferPort, ibctesting.TransferPort, channeltypes.UNORDERED, ppair)
+
+	// B sends a packet to A
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	timeoutHeight := clienttypes.NewHeight(0, 110)
+	_, err = suite.chainB.SendMsgs(transfertypes.NewMsgTransfer(chanB.PortID, chanB.ID, coin, suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), timeoutHeight, 0))
+	suite.Require().NoError(err)
+	packet := channeltypes.NewPacket(
+		transfertypes.NewFungibleTokenPacketData(coin.Denom, coin.Amount.Uint64(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String()).GetBytes(),
+		1, chanB.PortID, chanB.ID, chanA.PortID, chanA.ID, timeoutHeight, 0,
+	)
+
+	app := suite.chainC.App.(*simapp.SimApp)
+	proxyKeeper := app.IBCProxyKeeper
+	sender := suite.chainC.SenderAccount.GetAddress()
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	balance := app.BankKeeper.GetBalance(suite.chainC.GetContext(), sender, sdk.DefaultBondDenom)
+	fee := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10)))
+	prefix := suite.chainB.GetPrefix()
+
+	// the upstream client must exist
+	cacheCtx, _ := suite.chainC.GetContext().CacheContext()
+	err = proxyKeeper.EscrowPacketFee(cacheCtx, &prefix, "07-tendermint-100", types.PacketFromUpstream, chanB.PortID, chanB.ID, 1, types.NewPacketFee(fee, sender.String()))
+	suite.Require().ErrorIs(err, clienttypes.ErrClientNotFound)
+
+	// the direction must be known
+	err = proxyKeeper.EscrowPacketFee(cacheCtx, &prefix, clientCB, 2, chanB.PortID, chanB.ID, 1, types.NewPacketFee(fee, sender.String()))
+	suite.Require().ErrorIs(err, types.ErrInvalidPacketFee)
+
+	// the fees of the same packet are accumulated
+	res, err := suite.chainC.SendMsgs(types.NewMsgPayProxyPacketFee(clientCB, prefix, types.PacketFromUpstream, chanB.PortID, chanB.ID, 1, fee, sender.String()))
+	suite.Require().NoError(err)
+	var evPay types.EventPayProxyPacketFee
+	suite.Require().NoError(ibctesting.ParseProxyEventFromEvents(res.GetEvents(), &evPay))
+	suite.Require().Equal(types.EventPayProxyPacketFee{
+		UpstreamClientId: clientCB,
+		UpstreamPrefix:   prefix,
+		PortId:           chanB.PortID,
+		ChannelId:        chanB.ID,
+		Sequence:         1,
+		Fee:              fee,
+		RefundAddress:    sender.String(),
+		Direction:        types.PacketFromUpstream,
+	}, evPay)
+	_, err = suite.chainC.SendMsgs(types.NewMsgPayProxyPacketFee(clientCB, prefix, types.PacketFromUpstream, chanB.PortID, chanB.ID, 1, fee, sender.String()))
+	suite.Require().NoError(err)
+
+	ctx := suite.chainC.GetContext()
+	suite.Require().Equal(balance.SubAmount(sdk.NewInt(20)), app.BankKeeper.GetBalance(ctx, sender, sdk.DefaultBondDenom))
+	suite.Require().Equal(fee.Add(fee...), app.BankKeeper.GetAllBalances(ctx, moduleAddr))
+
+	querier := keeper.Querier{Keeper: proxyKeeper}
+	expected := types.NewIncentivizedPacket(clientCB, prefix, types.PacketFromUpstream, chanB.PortID, chanB.ID, 1, []types.PacketFee{types.NewPacketFee(fee, sender.String()), types.NewPacketFee(fee, sender.String())})
+	resPackets, err := querier.IncentivizedPackets(sdk.WrapSDKContext(ctx), &types.QueryIncentivizedPacketsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.IncentivizedPacket{expected}, resPackets.IncentivizedPackets)
+	resPackets, err = querier.IncentivizedPackets(sdk.WrapSDKContext(ctx), &types.QueryIncentivizedPacketsRequest{UpstreamClientId: "07-tendermint-100"})
+	suite.Require().NoError(err)
+	suite.Require().Empty(resPackets.IncentivizedPackets)
+	resPacket, err := querier.IncentivizedPacket(sdk.WrapSDKContext(ctx), &types.QueryIncentivizedPacketRequest{UpstreamClientId: clientCB, UpstreamPrefix: prefix, PortId: chanB.PortID, ChannelId: chanB.ID, Sequence: 1})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expected, resPacket.IncentivizedPacket)
+	_, err = querier.IncentivizedPacket(sdk.WrapSDKContext(ctx), &types.QueryIncentivizedPacketRequest{UpstreamClientId: clientCB, UpstreamPrefix: prefix, PortId: chanB.PortID, ChannelId: chanB.ID, Sequence: 2})
+	suite.Require().Error(err)
+	_, err = querier.IncentivizedPacket(sdk.WrapSDKContext(ctx), &types.QueryIncentivizedPacketRequest{UpstreamClientId: clientCB, UpstreamPrefix: prefix, PortId: chanB.PortID, ChannelId: chanB.ID, Sequence: 1, Direction: types.PacketToUpstream})
+	suite.Require().Error(err)
+	// the fees are keyed by the upstream prefix as well as the upstream client
+	otherPrefix := commitmenttypes.NewMerklePrefix([]byte("other"))
+	_, err = querier.IncentivizedPacket(sdk.WrapSDKContext(ctx), &types.QueryIncentivizedPacketRequest{UpstreamClientId: clientCB, UpstreamPrefix: otherPrefix, PortId: chanB.PortID, ChannelId: chanB.ID, Sequence: 1})
+	suite.Require().Error(err)
+	_, err = querier.IncentivizedPacket(sdk.WrapSDKContext(ctx), &types.QueryIncentivizedPacketRequest{UpstreamClientId: clientCB, PortId: chanB.PortID, ChannelId: chanB.ID, Sequence: 1})
+	suite.Require().Error(err)
+
+	// the escrowed fees are exported
+	suite.Require().Equal([]types.IncentivizedPacket{expected}, proxyKeeper.ExportGenesis(ctx).IncentivizedPackets)
+
+	// the relayer that proxies the packet commitment gets the fees
+	suite.Require().NoError(suite.coordinator.UpdateClient(suite.chainC, suite.chainB, clientCB, exported.Tendermint))
+	proof, proofHeight := suite.chainB.QueryProof(host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+	res, err = suite.chainC.SendMsgs(&types.MsgProxyRecvPacket{
+		UpstreamClientId: clientCB,
+		UpstreamPrefix:   prefix,
+		Packet:           packet,
+		Proof:            proof,
+		ProofHeight:      proofHeight,
+		Signer:           sender.String(),
+	})
+	suite.Require().NoError(err)
+	var evDistribute types.EventDistributeProxyPacketFee
+	suite.Require().NoError(ibctesting.ParseProxyEventFromEvents(res.GetEvents(), &evDistribute))
+	suite.Require().Equal(types.EventDistributeProxyPacketFee{
+		UpstreamClientId: clientCB,
+		UpstreamPrefix:   prefix,
+		PortId:           chanB.PortID,
+		ChannelId:        chanB.ID,
+		Sequence:         1,
+		Fee:              fee.Add(fee...),
+		Relayer:          sender.String(),
+		Direction:        types.PacketFromUpstream,
+	}, evDistribute)
+
+	ctx = suite.chainC.GetContext()
+	suite.Require().Equal(balance, app.BankKeeper.GetBalance(ctx, sender, sdk.DefaultBondDenom))
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, moduleAddr).IsZero())
+	_, found := proxyKeeper.GetPacketFees(ctx, &prefix, clientCB, types.PacketFromUpstream, chanB.PortID, chanB.ID, 1)
+	suite.Require().False(found)
+
+	// nobody can earn a fee for the packet that has been proxied
+	cacheCtx, _ = ctx.CacheContext()
+	err = proxyKeeper.EscrowPacketFee(cacheCtx, &prefix, clientCB, types.PacketFromUpstream, chanB.PortID, chanB.ID, 1, types.NewPacketFee(fee, sender.String()))
+	suite.Require().ErrorIs(err, types.ErrInvalidPacketFee)
+
+	// proxying the packet commitment again doesn't earn the fees left for it
+	suite.Require().NoError(app.BankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, fee))
+	proxyKeeper.SetPacketFees(ctx, &prefix, clientCB, types.PacketFromUpstream, chanB.PortID, chanB.ID, 1, types.PacketFees{PacketFees: []types.PacketFee{types.NewPacketFee(fee, sender.String())}})
+	item := types.ProxyPacketBatchItem{Packet: packet, Proof: proof}
+	for _, msg := range []sdk.Msg{
+		&types.MsgProxyRecvPacket{UpstreamClientId: clientCB, UpstreamPrefix: prefix, Packet: packet, Proof: proof, ProofHeight: proofHeight, Signer: sender.String()},
+		&types.MsgProxyPacketBatch{UpstreamClientId: clientCB, UpstreamPrefix: prefix, Items: []types.ProxyPacketBatchItem{item}, ProofHeight: proofHeight, Signer: sender.String()},
+	} {
+		res, err = suite.chainC.SendMsgs(msg)
+		suite.Require().NoError(err)
+		suite.Require().Error(ibctesting.ParseProxyEventFromEvents(res.GetEvents(), &evDistribute))
+		_, found = proxyKeeper.GetPacketFees(suite.chainC.GetContext(), &prefix, clientCB, types.PacketFromUpstream, chanB.PortID, chanB.ID, 1)
+		suite.Require().True(found)
+	}
+}
+
+// A(C) -> B, B -> A
+// A: downstream, B: upstream, C: proxy
+func (suite *KeeperTestSuite) TestProxyPacketFeeToUpstream() {
+	suite.Require().NoError(suite.coordinator.IncrementClientSequence(suite.chainC, suite.chainB, exported.Tendermint, 1))
+	suite.Require().NoError(suite.coordinator.IncrementClientSequence(suite.chainB, suite.chainA, exported.Tendermint, 2))
+
+	clientCB, err := suite.coordinator.CreateClient2(suite.chainC, suite.chainB, exported.Tendermint, false, 0)
+	suite.Require().NoError(err)
+	clientBA, err := suite.coordinator.CreateMultiVClient(suite.chainB, suite.chainA, exported.Tendermint, 0)
+	suite.Require().NoError(err)
+	clientAC, err := suite.coordinator.CreateProxyClient(suite.chainA, suite.chainC, exported.Tendermint, clientCB)
+	suite.Require().NoError(err)
+
+	ppair := ibctesting.ProxyPair{{Chain: suite.chainC, ClientID: clientAC, UpstreamClientID: clientCB, UpstreamPrefix: suite.chainB.GetPrefix()}, nil}
+	connA, connB := suite.coordinator.CreateConnectionWithProxy(suite.chainA, suite.chainB, clientAC, clientBA, ibctesting.TransferVersion, ppair)
+	chanA, chanB := suite.coordinator.CreateChannelWithProxy(suite.chainA, suite.chainB, connA, connB, ibctesting.TransferPort, ibctesting.TransferPort, channeltypes.UNORDERED, ppair)
+
+	app := suite.chainC.App.(*simapp.SimApp)
+	proxyKeeper := app.IBCProxyKeeper
+	sender := suite.chainC.SenderAccount.GetAddress()
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	balance := app.BankKeeper.GetBalance(suite.chainC.GetContext(), sender, sdk.DefaultBondDenom)
+	fee := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10)))
+	prefix := suite.chainB.GetPrefix()
+
+	// the fees of a packet from A to B are keyed by the destination on B,
+	// so they don't collide with the fees of the packet from B with the same sequence
+	_, err = suite.chainC.SendMsgs(
+		types.NewMsgPayProxyPacketFee(clientCB, prefix, types.PacketToUpstream, chanB.PortID, chanB.ID, 1, fee, sender.String()),
+		types.NewMsgPayProxyPacketFee(clientCB, prefix, types.PacketToUpstream, chanB.PortID, chanB.ID, 2, fee, sender.String()),
+		types.NewMsgPayProxyPacketFee(clientCB, prefix, types.PacketFromUpstream, chanB.PortID, chanB.ID, 1, fee, sender.String()),
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(balance.SubAmount(sdk.NewInt(30)), app.BankKeeper.GetBalance(suite.chainC.GetContext(), sender, sdk.DefaultBondDenom))
+
+	// the relayer that proxies the acknowledgement of the packet gets the fees
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	timeoutHeight := clienttypes.NewHeight(0, 110)
+	msg := transfertypes.NewMsgTransfer(chanA.PortID, chanA.ID, coin, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), timeoutHeight, 0)
+	suite.Require().NoError(suite.coordinator.SendPacketWithProxy(suite.chainA, suite.chainB, connA, connB, ppair, msg))
+	packet := channeltypes.NewPacket(
+		transfertypes.NewFungibleTokenPacketData(coin.Denom, coin.Amount.Uint64(), suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String()).GetBytes(),
+		1, chanA.PortID, chanA.ID, chanB.PortID, chanB.ID, timeoutHeight, 0,
+	)
+	suite.Require().NoError(suite.coordinator.RecvPacketWithProxy(suite.chainB, suite.chainA, connB, connA, packet, ppair.Swap()))
+
+	suite.chainC.TxEvents = nil
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	suite.Require().NoError(suite.coordinator.AcknowledgePacketWithProxy(suite.chainA, suite.chainB, chanA, chanB, connA, connB, packet, ack.Acknowledgement(), ppair))
+	var evDistribute types.EventDistributeProxyPacketFee
+	suite.Require().NoError(ibctesting.ParseProxyEventFromEvents(suite.chainC.TxEvents, &evDistribute))
+	suite.Require().Equal(types.EventDistributeProxyPacketFee{
+		UpstreamClientId: clientCB,
+		UpstreamPrefix:   prefix,
+		PortId:           chanB.PortID,
+		ChannelId:        chanB.ID,
+		Sequence:         1,
+		Fee:              fee,
+		Relayer:          sender.String(),
+		Direction:        types.PacketToUpstream,
+	}, evDistribute)
+
+	ctx := suite.chainC.GetContext()
+	suite.Require().Equal(balance.SubAmount(sdk.NewInt(20)), app.BankKeeper.GetBalance(ctx, sender, sdk.DefaultBondDenom))
+	_, found := proxyKeeper.GetPacketFees(ctx, &prefix, clientCB, types.PacketToUpstream, chanB.PortID, chanB.ID, 1)
+	suite.Require().False(found)
+	_, found = proxyKeeper.GetPacketFees(ctx, &prefix, clientCB, types.PacketFromUpstream, chanB.PortID, chanB.ID, 1)
+	suite.Require().True(found)
+
+	// the fees of the packet that times out on B are refunded
+	timeoutTimestamp := uint64(suite.chainB.GetContext().BlockTime().Add(time.Minute).UnixNano())
+	msg = transfertypes.NewMsgTransfer(chanA.PortID, chanA.ID, coin, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), clienttypes.ZeroHeight(), timeoutTimestamp)
+	suite.Require().NoError(suite.coordinator.SendPacketWithProxy(suite.chainA, suite.chainB, connA, connB, ppair, msg))
+	packet = channeltypes.NewPacket(
+		transfertypes.NewFungibleTokenPacketData(coin.Denom, coin.Amount.Uint64(), suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String()).GetBytes(),
+		2, chanA.PortID, chanA.ID, chanB.PortID, chanB.ID, clienttypes.ZeroHeight(), timeoutTimestamp,
+	)
+	suite.coordinator.IncrementTimeBy(2 * time.Minute)
+
+	suite.chainC.TxEvents = nil
+	suite.Require().NoError(suite.coordinator.TimeoutPacketWithProxy(suite.chainA, suite.chainB, connA, connB, packet, ppair))
+	var evRefund types.EventRefundProxyPacketFee
+	suite.Require().NoError(ibctesting.ParseProxyEventFromEvents(suite.chainC.TxEvents, &evRefund))
+	suite.Require().Equal(types.EventRefundProxyPacketFee{
+		UpstreamClientId: clientCB,
+		UpstreamPrefix:   prefix,
+		PortId:           chanB.PortID,
+		ChannelId:        chanB.ID,
+		Sequence:         2,
+		Fee:              fee,
+		Direction:        types.PacketToUpstream,
+	}, evRefund)
+
+	ctx = suite.chainC.GetContext()
+	suite.Require().Equal(balance.SubAmount(sdk.NewInt(10)), app.BankKeeper.GetBalance(ctx, sender, sdk.DefaultBondDenom))
+	suite.Require().Equal(fee, app.BankKeeper.GetAllBalances(ctx, moduleAddr))
+	_, found = proxyKeeper.GetPacketFees(ctx, &prefix, clientCB, types.PacketToUpstream, chanB.PortID, chanB.ID, 2)
+	suite.Require().False(found)
+
+	// the fees of the packet from B that nobody proxied are refunded when its commitment is proven absent on B
+	suite.Require().NoError(suite.coordinator.UpdateClient(suite.chainC, suite.chainB, clientCB, exported.Tendermint))
+	proof, proofHeight := suite.chainB.QueryProof(host.PacketCommitmentKey(chanB.PortID, chanB.ID, 1))
+	res, err := suite.chainC.SendMsgs(types.NewMsgPruneProxyPacketCommitment(clientCB, prefix, chanB.PortID, chanB.ID, 1, proof, proofHeight, sender.String()))
+	suite.Require().NoError(err)
+	suite.Require().NoError(ibctesting.ParseProxyEventFromEvents(res.GetEvents(), &evRefund))
+	suite.Require().Equal(types.EventRefundProxyPacketFee{
+		UpstreamClientId: clientCB,
+		UpstreamPrefix:   prefix,
+		PortId:           chanB.PortID,
+		ChannelId:        chanB.ID,
+		Sequence:         1,
+		Fee:              fee,
+		Direction:        types.PacketFromUpstream,
+	}, evRefund)
+
+	ctx = suite.chainC.GetContext()
+	suite.Require().Equal(balance, app.BankKeeper.GetBalance(ctx, sender, sdk.DefaultBondDenom))
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, moduleAddr).IsZero())
+	_, found = proxyKeeper.GetPacketFees(ctx, &prefix, clientCB, types.PacketFromUpstream, chanB.PortID, chanB.ID, 1)
+	suite.Require().False(found)
+}
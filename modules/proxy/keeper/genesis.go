@@ -1,12 +1,193 @@
 package keeper
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/modules/core/24-host"
+
 	"github.com/datachainlab/ibc-proxy/modules/proxy/types"
 )
 
-func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {}
+// InitGenesis sets the params and writes the proxy states of each upstream into the store
+func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
+	k.SetParams(ctx, state.Params)
+	for _, upstream := range state.Upstreams {
+		upstreamClientID, upstreamPrefix := upstream.UpstreamClientId, &upstream.UpstreamPrefix
 
+		for _, client := range upstream.Clients {
+			clientState, err := clienttypes.UnpackClientState(client.ClientState)
+			if err != nil {
+				panic(err)
+			}
+			if err := k.SetProxyClientState(ctx, upstreamPrefix, client.ClientId, upstreamClientID, clientState); err != nil {
+				panic(err)
+			}
+		}
+		for _, cc := range upstream.ClientsConsensus {
+			for _, cs := range cc.ConsensusStates {
+				consensusState, err := clienttypes.UnpackConsensusState(cs.ConsensusState)
+				if err != nil {
+					panic(err)
+				}
+				if err := k.SetProxyClientConsensusState(ctx, upstreamPrefix, cc.ClientId, upstreamClientID, cs.Height, consensusState); err != nil {
+					panic(err)
+				}
+			}
+		}
+		for _, conn := range upstream.Connections {
+			connection := connectiontypes.NewConnectionEnd(conn.State, conn.ClientId, conn.Counterparty, conn.Versions, conn.DelayPeriod)
+			if err := k.SetProxyConnection(ctx, upstreamPrefix, upstreamClientID, conn.Id, connection); err != nil {
+				panic(err)
+			}
+		}
+		for _, ch := range upstream.Channels {
+			channel := channeltypes.NewChannel(ch.State, ch.Ordering, ch.Counterparty, ch.ConnectionHops, ch.Version)
+			if err := k.SetProxyChannel(ctx, upstreamPrefix, upstreamClientID, ch.PortId, ch.ChannelId, channel); err != nil {
+				panic(err)
+			}
+		}
+		for _, commitment := range upstream.Commitments {
+			if err := k.SetProxyPacketCommitment(ctx, upstreamPrefix, upstreamClientID, commitment.PortId, commitment.ChannelId, commitment.Sequence, commitment.Data); err != nil {
+				panic(err)
+			}
+		}
+		for _, ack := range upstream.Acknowledgements {
+			// the data is already the commitment of the acknowledgement
+			if err := k.setPrunableProxyState(ctx, upstreamPrefix, upstreamClientID, host.PacketAcknowledgementKey(ack.PortId, ack.ChannelId, ack.Sequence), ack.Data); err != nil {
+				panic(err)
+			}
+		}
+		for _, receipt := range upstream.Receipts {
+			if err := k.SetProxyPacketReceiptAbsence(ctx, upstreamPrefix, upstreamClientID, receipt.PortId, receipt.ChannelId, receipt.Sequence); err != nil {
+				panic(err)
+			}
+		}
+		for _, rs := range upstream.RecvSequences {
+			if err := k.SetProxyNextSequenceRecv(ctx, upstreamPrefix, upstreamClientID, rs.PortId, rs.ChannelId, rs.Sequence); err != nil {
+				panic(err)
+			}
+		}
+	}
+	// the escrowed fees are in the balance of the module account
+	for _, packet := range state.IncentivizedPackets {
+		k.SetPacketFees(ctx, &packet.UpstreamPrefix, packet.UpstreamClientId, packet.Direction, packet.PortId, packet.ChannelId, packet.Sequence, types.PacketFees{PacketFees: packet.PacketFees})
+	}
+	for _, pp := range state.PausedProxies {
+		k.SetPausedProxy(ctx, pp)
+	}
+}
+
+// ExportGenesis walks the proxy store and returns the params, the proxy states of each upstream, the escrowed packet fees
+// and the paused proxies
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
-	return &types.GenesisState{}
+	var upstreams []types.UpstreamGenesisState
+	index := make(map[string]int)
+	clientConsensus := make(map[string]map[string]int)
+	getUpstream := func(upstreamClientID string, upstreamPrefix commitmenttypes.MerklePrefix) (*types.UpstreamGenesisState, string) {
+		upstreamKey := string(types.ProxyKey(&upstreamPrefix, upstreamClientID, nil))
+		i, ok := index[upstreamKey]
+		if !ok {
+			i = len(upstreams)
+			index[upstreamKey] = i
+			clientConsensus[upstreamKey] = make(map[string]int)
+			upstreams = append(upstreams, types.NewUpstreamGenesisState(upstreamClientID, upstreamPrefix))
+		}
+		return &upstreams[i], upstreamKey
+	}
+
+	// the pruning queue is rebuilt by InitGenesis, and the packet fees and the paused proxies are exported separately
+	iterator := types.ProxyStateIterator(ctx.KVStore(k.proxyStoreKey))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		upstreamClientID, upstreamPrefix, path, err := types.ParseProxyKey(iterator.Key())
+		if err != nil {
+			panic(err)
+		}
+		upstream, upstreamKey := getUpstream(upstreamClientID, upstreamPrefix)
+		if err := k.exportProxyState(upstream, clientConsensus[upstreamKey], path, iterator.Value()); err != nil {
+			panic(fmt.Errorf("failed to export the proxy state '%s' of the upstream %s: %w", path, upstreamClientID, err))
+		}
+	}
+
+	gs := types.NewGenesisState(upstreams, k.GetParams(ctx))
+	gs.IncentivizedPackets = k.GetAllIncentivizedPackets(ctx)
+	gs.PausedProxies = k.GetAllPausedProxies(ctx)
+	return gs
+}
+
+// exportProxyState adds the value stored at the path of the ICS-24 host to the genesis state of the upstream
+func (k Keeper) exportProxyState(upstream *types.UpstreamGenesisState, clientConsensus map[string]int, path string, value []byte) error {
+	keys := strings.Split(path, "/")
+	// the marker of a packet receipt absence is stored at the packet commitment path under the receipt prefix
+	isReceipt := len(keys) == 8 && keys[0] == host.KeyPacketReceiptPrefix && keys[1] == host.KeyPacketCommitmentPrefix
+	if isReceipt {
+		keys = keys[1:]
+	}
+	switch {
+	case len(keys) == 3 && keys[0] == string(host.KeyClientStorePrefix) && keys[2] == host.KeyClientState:
+		clientState, err := clienttypes.UnmarshalClientState(k.cdc, value)
+		if err != nil {
+			return err
+		}
+		upstream.Clients = append(upstream.Clients, clienttypes.NewIdentifiedClientState(keys[1], clientState))
+	case len(keys) == 4 && keys[0] == string(host.KeyClientStorePrefix) && keys[2] == host.KeyConsensusStatePrefix:
+		consensusState, err := clienttypes.UnmarshalConsensusState(k.cdc, value)
+		if err != nil {
+			return err
+		}
+		height, err := clienttypes.ParseHeight(keys[3])
+		if err != nil {
+			return err
+		}
+		j, ok := clientConsensus[keys[1]]
+		if !ok {
+			j = len(upstream.ClientsConsensus)
+			clientConsensus[keys[1]] = j
+			upstream.ClientsConsensus = append(upstream.ClientsConsensus, clienttypes.NewClientConsensusStates(keys[1], nil))
+		}
+		upstream.ClientsConsensus[j].ConsensusStates = append(
+			upstream.ClientsConsensus[j].ConsensusStates, clienttypes.NewConsensusStateWithHeight(height, consensusState),
+		)
+	case len(keys) == 2 && keys[0] == host.KeyConnectionPrefix:
+		var connection connectiontypes.ConnectionEnd
+		if err := k.cdc.Unmarshal(value, &connection); err != nil {
+			return err
+		}
+		upstream.Connections = append(upstream.Connections, connectiontypes.NewIdentifiedConnection(keys[1], connection))
+	case len(keys) == 5 && keys[0] == host.KeyChannelEndPrefix:
+		var channel channeltypes.Channel
+		if err := k.cdc.Unmarshal(value, &channel); err != nil {
+			return err
+		}
+		upstream.Channels = append(upstream.Channels, channeltypes.NewIdentifiedChannel(keys[2], keys[4], channel))
+	case len(keys) == 5 && keys[0] == host.KeyNextSeqRecvPrefix:
+		upstream.RecvSequences = append(upstream.RecvSequences, channeltypes.NewPacketSequence(keys[2], keys[4], sdk.BigEndianToUint64(value)))
+	case len(keys) == 7 && keys[5] == host.KeySequencePrefix:
+		sequence, err := strconv.ParseUint(keys[6], 10, 64)
+		if err != nil {
+			return err
+		}
+		state := channeltypes.NewPacketState(keys[2], keys[4], sequence, value)
+		switch {
+		case isReceipt:
+			upstream.Receipts = append(upstream.Receipts, state)
+		case keys[0] == host.KeyPacketCommitmentPrefix:
+			upstream.Commitments = append(upstream.Commitments, state)
+		case keys[0] == host.KeyPacketAckPrefix:
+			upstream.Acknowledgements = append(upstream.Acknowledgements, state)
+		default:
+			return fmt.Errorf("unknown path")
+		}
+	default:
+		return fmt.Errorf("unknown path")
+	}
+	return nil
 }
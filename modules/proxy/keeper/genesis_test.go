@@ -0,0 +1,169 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/modules/core/23-commitment/types"
+
+	"github.com/datachainlab/ibc-proxy/modules/proxy/types"
+	ibctesting "github.com/datachainlab/ibc-proxy/testing"
+	"github.com/datachainlab/ibc-proxy/testing/simapp"
+)
+
+// A(C) -> B, B(D) -> A
+// A: upstream/downstream, B: downstream/upstream, C: proxy for A, D: proxy for B
+func (suite *KeeperTestSuite) TestGenesis() {
+	connA, connB, ppair := suite.createBothSideProxyConnection()
+
+	// packet commitments and acknowledgements
+	chanA, chanB := suite.coordinator.CreateChannelWithProxy(suite.chainA, suite.chainB, connA, connB, ibctesting.TransferPort, ibctesting.TransferPort, channeltypes.UNORDERED, ppair)
+	suite.testHandleMsgTransfer(connA, connB, chanA, chanB, ppair)
+	timeoutHeight := clienttypes.NewHeight(0, 110)
+	coinToSendToA := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	msg := transfertypes.NewMsgTransfer(chanB.PortID, chanB.ID, coinToSendToA, suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), timeoutHeight, 0)
+	suite.Require().NoError(suite.coordinator.SendPacketWithProxy(suite.chainB, suite.chainA, connB, connA, ppair.Swap(), msg))
+	fungibleTokenPacket := transfertypes.NewFungibleTokenPacketData(coinToSendToA.Denom, coinToSendToA.Amount.Uint64(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String())
+	packet := channeltypes.NewPacket(fungibleTokenPacket.GetBytes(), 1, chanB.PortID, chanB.ID, chanA.PortID, chanA.ID, timeoutHeight, 0)
+	suite.Require().NoError(suite.coordinator.RecvPacketWithProxy(suite.chainA, suite.chainB, connA, connB, packet, ppair))
+
+	// packet receipt absences
+	timeoutTimestamp := uint64(suite.chainB.GetContext().BlockTime().Add(time.Minute).UnixNano())
+	coinToSendToB := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	msg = transfertypes.NewMsgTransfer(chanA.PortID, chanA.ID, coinToSendToB, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), clienttypes.ZeroHeight(), timeoutTimestamp)
+	suite.Require().NoError(suite.coordinator.SendPacketWithProxy(suite.chainA, suite.chainB, connA, connB, ppair, msg))
+	fungibleTokenPacket = transfertypes.NewFungibleTokenPacketData(coinToSendToB.Denom, coinToSendToB.Amount.Uint64(), suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String())
+	packet = channeltypes.NewPacket(fungibleTokenPacket.GetBytes(), 2, chanA.PortID, chanA.ID, chanB.PortID, chanB.ID, clienttypes.ZeroHeight(), timeoutTimestamp)
+	suite.coordinator.IncrementTimeBy(2 * time.Minute)
+	suite.Require().NoError(suite.coordinator.TimeoutPacketWithProxy(suite.chainA, suite.chainB, connA, connB, packet, ppair))
+
+	// next sequence receives
+	chanA, chanB = suite.coordinator.CreateChannelWithProxy(suite.chainA, suite.chainB, connA, connB, ibctesting.MockPort, ibctesting.MockPort, channeltypes.ORDERED, ppair)
+	timeoutTimestamp = uint64(suite.chainB.GetContext().BlockTime().Add(time.Minute).UnixNano())
+	packet = channeltypes.NewPacket(suite.chainA.GetPacketData(suite.chainB), 1, chanA.PortID, chanA.ID, chanB.PortID, chanB.ID, clienttypes.ZeroHeight(), timeoutTimestamp)
+	suite.Require().NoError(suite.chainA.SendPacket(packet))
+	suite.coordinator.IncrementTimeBy(2 * time.Minute)
+	suite.Require().NoError(suite.coordinator.TimeoutPacketWithProxy(suite.chainA, suite.chainB, connA, connB, packet, ppair))
+
+	proxy := ppair[0]
+	proxyKeeper := proxy.Chain.App.(*simapp.SimApp).IBCProxyKeeper
+	genesis := proxyKeeper.ExportGenesis(proxy.Chain.GetContext())
+	suite.Require().NoError(genesis.Validate())
+	suite.Require().Len(genesis.Upstreams, 1)
+
+	upstream := genesis.Upstreams[0]
+	suite.Require().Equal(proxy.UpstreamClientID, upstream.UpstreamClientId)
+	suite.Require().Equal(proxy.UpstreamPrefix.(commitmenttypes.MerklePrefix), upstream.UpstreamPrefix)
+	suite.Require().NotEmpty(upstream.Clients)
+	suite.Require().NotEmpty(upstream.ClientsConsensus)
+	suite.Require().NotEmpty(upstream.Connections)
+	suite.Require().Len(upstream.Channels, 2)
+	suite.Require().NotEmpty(upstream.Commitments)
+	suite.Require().NotEmpty(upstream.Acknowledgements)
+	suite.Require().NotEmpty(upstream.Receipts)
+	suite.Require().NotEmpty(upstream.RecvSequences)
+
+	// the genesis state must survive the JSON encoding used by genesis files
+	cdc := proxy.Chain.App.AppCodec()
+	bz := cdc.MustMarshalJSON(genesis)
+	var imported types.GenesisState
+	cdc.MustUnmarshalJSON(bz, &imported)
+	suite.Require().NoError(imported.Validate())
+
+	// import into a chain that has no proxy states yet
+	importKeeper := suite.chainA.App.(*simapp.SimApp).IBCProxyKeeper
+	ctx := suite.chainA.GetContext()
+	suite.Require().Empty(importKeeper.ExportGenesis(ctx).Upstreams)
+	importKeeper.InitGenesis(ctx, imported)
+	suite.Require().Equal(bz, cdc.MustMarshalJSON(importKeeper.ExportGenesis(ctx)))
+
+	prefix := proxy.UpstreamPrefix.(commitmenttypes.MerklePrefix)
+	channel, found := importKeeper.GetProxyChannel(ctx, &prefix, proxy.UpstreamClientID, chanB.PortID, chanB.ID)
+	suite.Require().True(found)
+	expected, _ := proxyKeeper.GetProxyChannel(proxy.Chain.GetContext(), &prefix, proxy.UpstreamClientID, chanB.PortID, chanB.ID)
+	suite.Require().Equal(expected, channel)
+
+	// a proxy state that conflicts with the stored one can't be imported
+	imported.Upstreams[0].Commitments[0].Data = []byte("conflict")
+	suite.Require().Panics(func() { importKeeper.InitGenesis(ctx, imported) })
+}
+
+func (suite *KeeperTestSuite) TestGenesisValidate() {
+	prefix := commitmenttypes.NewMerklePrefix([]byte("ibc"))
+	upstream := types.NewUpstreamGenesisState("07-tendermint-0", prefix)
+	fee := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10)))
+	packet := types.NewIncentivizedPacket("07-tendermint-0", prefix, types.PacketFromUpstream, ibctesting.TransferPort, "channel-0", 1, []types.PacketFee{types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String())})
+
+	testCases := []struct {
+		name     string
+		genesis  *types.GenesisState
+		expValid bool
+	}{
+		{"default", types.DefaultGenesisState(), true},
+		{"valid upstream", types.NewGenesisState([]types.UpstreamGenesisState{upstream}, types.DefaultParams()), true},
+		{"invalid upstream client ID", types.NewGenesisState([]types.UpstreamGenesisState{types.NewUpstreamGenesisState("(clientID)", prefix)}, types.DefaultParams()), false},
+		{"empty upstream prefix", types.NewGenesisState([]types.UpstreamGenesisState{types.NewUpstreamGenesisState("07-tendermint-0", commitmenttypes.MerklePrefix{})}, types.DefaultParams()), false},
+		{"allowlist params", types.NewGenesisState(nil, types.NewParams(types.UpstreamModeAllowlist, []string{"07-tendermint-0"}, time.Hour, time.Second, "")), true},
+		{"invalid allowed upstream client", types.NewGenesisState(nil, types.NewParams(types.UpstreamModeAllowlist, []string{"(clientID)"}, 0, time.Second, "")), false},
+		{"invalid guardian", types.NewGenesisState(nil, types.NewParams(types.UpstreamModeOpen, nil, 0, time.Second, "guardian")), false},
+		{"negative retention period", types.NewGenesisState(nil, types.NewParams(types.UpstreamModeOpen, nil, -time.Hour, time.Second, "")), false},
+		{"zero max expected time per block", types.NewGenesisState(nil, types.NewParams(types.UpstreamModeOpen, nil, 0, 0, "")), false},
+		{"duplicate upstreams", types.NewGenesisState([]types.UpstreamGenesisState{upstream, upstream}, types.DefaultParams()), false},
+		{"invalid channel identifier", types.NewGenesisState([]types.UpstreamGenesisState{{
+			UpstreamClientId: "07-tendermint-0",
+			UpstreamPrefix:   prefix,
+			Commitments:      []channeltypes.PacketState{channeltypes.NewPacketState(ibctesting.TransferPort, "(channelID)", 1, []byte("hash"))},
+		}}, types.DefaultParams()), false},
+		{"zero sequence", types.NewGenesisState([]types.UpstreamGenesisState{{
+			UpstreamClientId: "07-tendermint-0",
+			UpstreamPrefix:   prefix,
+			RecvSequences:    []channeltypes.PacketSequence{channeltypes.NewPacketSequence(ibctesting.TransferPort, "channel-0", 0)},
+		}}, types.DefaultParams()), false},
+		{"valid incentivized packet", withIncentivizedPackets(types.DefaultGenesisState(), packet), true},
+		{"duplicate incentivized packets", withIncentivizedPackets(types.DefaultGenesisState(), packet, packet), false},
+		{"incentivized packets of different upstream prefixes", withIncentivizedPackets(types.DefaultGenesisState(), packet, withUpstreamPrefix(packet, commitmenttypes.NewMerklePrefix([]byte("other")))), true},
+		{"incentivized packet without upstream prefix", withIncentivizedPackets(types.DefaultGenesisState(), withUpstreamPrefix(packet, commitmenttypes.MerklePrefix{})), false},
+		{"incentivized packets in both directions", withIncentivizedPackets(types.DefaultGenesisState(), packet, withDirection(packet, types.PacketToUpstream)), true},
+		{"unknown packet direction", withIncentivizedPackets(types.DefaultGenesisState(), withDirection(packet, 2)), false},
+		{"incentivized packet without fees", withIncentivizedPackets(types.DefaultGenesisState(), types.NewIncentivizedPacket("07-tendermint-0", prefix, types.PacketFromUpstream, ibctesting.TransferPort, "channel-0", 1, nil)), false},
+		{"invalid refund address", withIncentivizedPackets(types.DefaultGenesisState(), types.NewIncentivizedPacket("07-tendermint-0", prefix, types.PacketFromUpstream, ibctesting.TransferPort, "channel-0", 1, []types.PacketFee{types.NewPacketFee(fee, "address")})), false},
+		{"valid paused proxies", withPausedProxies(types.DefaultGenesisState(), types.NewPausedProxy("07-tendermint-0", "", ""), types.NewPausedProxy("07-tendermint-0", ibctesting.TransferPort, "channel-0")), true},
+		{"duplicate paused proxies", withPausedProxies(types.DefaultGenesisState(), types.NewPausedProxy("07-tendermint-0", "", ""), types.NewPausedProxy("07-tendermint-0", "", "")), false},
+		{"paused proxy without channel", withPausedProxies(types.DefaultGenesisState(), types.NewPausedProxy("07-tendermint-0", ibctesting.TransferPort, "")), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			err := tc.genesis.Validate()
+			if tc.expValid {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func withIncentivizedPackets(gs *types.GenesisState, packets ...types.IncentivizedPacket) *types.GenesisState {
+	gs.IncentivizedPackets = packets
+	return gs
+}
+
+func withDirection(packet types.IncentivizedPacket, direction types.PacketDirection) types.IncentivizedPacket {
+	packet.Direction = direction
+	return packet
+}
+
+func withUpstreamPrefix(packet types.IncentivizedPacket, upstreamPrefix commitmenttypes.MerklePrefix) types.IncentivizedPacket {
+	packet.UpstreamPrefix = upstreamPrefix
+	return packet
+}
+
+func withPausedProxies(gs *types.GenesisState, pausedProxies ...types.PausedProxy) *types.GenesisState {
+	gs.PausedProxies = pausedProxies
+	return gs
+}
@@ -0,0 +1,390 @@
+package keeper
+
+import (
+	"context"
+
+	storeprefix "github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/modules/core/24-host"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	proxytypes "github.com/datachainlab/ibc-proxy/modules/light-clients/xx-proxy/types"
+	"github.com/datachainlab/ibc-proxy/modules/proxy/types"
+)
+
+// Querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over keeper
+type Querier struct {
+	Keeper
+}
+
+var _ types.QueryServer = Querier{}
+
+// ProxyClientState implements the Query/ProxyClientState gRPC method
+func (q Querier) ProxyClientState(c context.Context, req *types.QueryProxyClientStateRequest) (*types.QueryProxyClientStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := validategRPCUpstream(req.UpstreamClientId, req.UpstreamPrefix); err != nil {
+		return nil, err
+	}
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	clientState, found := q.GetProxyClientState(ctx, &req.UpstreamPrefix, req.ClientId, req.UpstreamClientId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(clienttypes.ErrClientNotFound, "upstream-client-id: %s, client-id: %s", req.UpstreamClientId, req.ClientId).Error(),
+		)
+	}
+
+	anyClientState, err := clienttypes.PackClientState(clientState)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return types.NewQueryProxyClientStateResponse(anyClientState, nil, selfHeight), nil
+}
+
+// ProxyConsensusState implements the Query/ProxyConsensusState gRPC method
+func (q Querier) ProxyConsensusState(c context.Context, req *types.QueryProxyConsensusStateRequest) (*types.QueryProxyConsensusStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := validategRPCUpstream(req.UpstreamClientId, req.UpstreamPrefix); err != nil {
+		return nil, err
+	}
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	height := clienttypes.NewHeight(req.RevisionNumber, req.RevisionHeight)
+	consensusState, found := q.GetProxyClientConsensusState(ctx, &req.UpstreamPrefix, req.ClientId, req.UpstreamClientId, height)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(clienttypes.ErrConsensusStateNotFound, "upstream-client-id: %s, client-id: %s, height: %s", req.UpstreamClientId, req.ClientId, height).Error(),
+		)
+	}
+
+	anyConsensusState, err := clienttypes.PackConsensusState(consensusState)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return types.NewQueryProxyConsensusStateResponse(anyConsensusState, nil, selfHeight), nil
+}
+
+// ProxyConnection implements the Query/ProxyConnection gRPC method
+func (q Querier) ProxyConnection(c context.Context, req *types.QueryProxyConnectionRequest) (*types.QueryProxyConnectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := validategRPCUpstream(req.UpstreamClientId, req.UpstreamPrefix); err != nil {
+		return nil, err
+	}
+	if err := host.ConnectionIdentifierValidator(req.ConnectionId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	connection, found := q.GetProxyConnection(ctx, &req.UpstreamPrefix, req.UpstreamClientId, req.ConnectionId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(connectiontypes.ErrConnectionNotFound, "upstream-client-id: %s, connection-id: %s", req.UpstreamClientId, req.ConnectionId).Error(),
+		)
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return types.NewQueryProxyConnectionResponse(connection, nil, selfHeight), nil
+}
+
+// ProxyChannel implements the Query/ProxyChannel gRPC method
+func (q Querier) ProxyChannel(c context.Context, req *types.QueryProxyChannelRequest) (*types.QueryProxyChannelResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := validategRPCUpstream(req.UpstreamClientId, req.UpstreamPrefix); err != nil {
+		return nil, err
+	}
+	if err := validategRPCChannel(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	channel, found := q.GetProxyChannel(ctx, &req.UpstreamPrefix, req.UpstreamClientId, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "upstream-client-id: %s, port-id: %s, channel-id: %s", req.UpstreamClientId, req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return types.NewQueryProxyChannelResponse(channel, nil, selfHeight), nil
+}
+
+// ProxyPacketCommitment implements the Query/ProxyPacketCommitment gRPC method
+func (q Querier) ProxyPacketCommitment(c context.Context, req *types.QueryProxyPacketCommitmentRequest) (*types.QueryProxyPacketCommitmentResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := validategRPCUpstream(req.UpstreamClientId, req.UpstreamPrefix); err != nil {
+		return nil, err
+	}
+	if err := validategRPCChannel(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+	if req.Sequence == 0 {
+		return nil, status.Error(codes.InvalidArgument, "packet sequence cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	commitment, found := q.GetProxyPacketCommitment(ctx, &req.UpstreamPrefix, req.UpstreamClientId, req.PortId, req.ChannelId, req.Sequence)
+	if !found {
+		return nil, status.Error(codes.NotFound, "packet commitment hash not found")
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return types.NewQueryProxyPacketCommitmentResponse(commitment, nil, selfHeight), nil
+}
+
+// ProxyPacketAcknowledgement implements the Query/ProxyPacketAcknowledgement gRPC method
+func (q Querier) ProxyPacketAcknowledgement(c context.Context, req *types.QueryProxyPacketAcknowledgementRequest) (*types.QueryProxyPacketAcknowledgementResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := validategRPCUpstream(req.UpstreamClientId, req.UpstreamPrefix); err != nil {
+		return nil, err
+	}
+	if err := validategRPCChannel(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+	if req.Sequence == 0 {
+		return nil, status.Error(codes.InvalidArgument, "packet sequence cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	acknowledgement, found := q.GetProxyPacketAcknowledgement(ctx, &req.UpstreamPrefix, req.UpstreamClientId, req.PortId, req.ChannelId, req.Sequence)
+	if !found {
+		return nil, status.Error(codes.NotFound, "packet acknowledgement hash not found")
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return types.NewQueryProxyPacketAcknowledgementResponse(acknowledgement, nil, selfHeight), nil
+}
+
+// ProxyPacketReceiptAbsence implements the Query/ProxyPacketReceiptAbsence gRPC method
+func (q Querier) ProxyPacketReceiptAbsence(c context.Context, req *types.QueryProxyPacketReceiptAbsenceRequest) (*types.QueryProxyPacketReceiptAbsenceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := validategRPCUpstream(req.UpstreamClientId, req.UpstreamPrefix); err != nil {
+		return nil, err
+	}
+	if err := validategRPCChannel(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+	if req.Sequence == 0 {
+		return nil, status.Error(codes.InvalidArgument, "packet sequence cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	absence := q.HasProxyPacketReceiptAbsence(ctx, &req.UpstreamPrefix, req.UpstreamClientId, req.PortId, req.ChannelId, req.Sequence)
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return types.NewQueryProxyPacketReceiptAbsenceResponse(absence, nil, selfHeight), nil
+}
+
+// ProxyNextSequenceRecv implements the Query/ProxyNextSequenceRecv gRPC method
+func (q Querier) ProxyNextSequenceRecv(c context.Context, req *types.QueryProxyNextSequenceRecvRequest) (*types.QueryProxyNextSequenceRecvResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := validategRPCUpstream(req.UpstreamClientId, req.UpstreamPrefix); err != nil {
+		return nil, err
+	}
+	if err := validategRPCChannel(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	sequence, found := q.GetProxyNextSequenceRecv(ctx, &req.UpstreamPrefix, req.UpstreamClientId, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(channeltypes.ErrSequenceReceiveNotFound, "upstream-client-id: %s, port-id: %s, channel-id: %s", req.UpstreamClientId, req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return types.NewQueryProxyNextSequenceRecvResponse(sequence, nil, selfHeight), nil
+}
+
+// ProxyPrunablePackets implements the Query/ProxyPrunablePackets gRPC method
+func (q Querier) ProxyPrunablePackets(c context.Context, req *types.QueryProxyPrunablePacketsRequest) (*types.QueryProxyPrunablePacketsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := validategRPCUpstream(req.UpstreamClientId, req.UpstreamPrefix); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryProxyPrunablePacketsResponse{
+		Acknowledgements: q.GetPrunableProxyAcknowledgementCount(ctx, &req.UpstreamPrefix, req.UpstreamClientId),
+	}, nil
+}
+
+// IncentivizedPackets implements the Query/IncentivizedPackets gRPC method
+func (q Querier) IncentivizedPackets(c context.Context, req *types.QueryIncentivizedPacketsRequest) (*types.QueryIncentivizedPacketsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	prefix := types.KeyPacketFeesPrefix
+	if req.UpstreamClientId != "" {
+		if err := host.ClientIdentifierValidator(req.UpstreamClientId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		prefix = types.PacketFeesPrefixKey(req.UpstreamClientId)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	var packets []types.IncentivizedPacket
+	store := storeprefix.NewStore(ctx.KVStore(q.proxyStoreKey), prefix)
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		packet, err := q.unmarshalIncentivizedPacket(append(append([]byte{}, prefix...), key...), value)
+		if err != nil {
+			return err
+		}
+		packets = append(packets, packet)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryIncentivizedPacketsResponse{
+		IncentivizedPackets: packets,
+		Pagination:          pageRes,
+	}, nil
+}
+
+// IncentivizedPacket implements the Query/IncentivizedPacket gRPC method
+func (q Querier) IncentivizedPacket(c context.Context, req *types.QueryIncentivizedPacketRequest) (*types.QueryIncentivizedPacketResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := validategRPCUpstream(req.UpstreamClientId, req.UpstreamPrefix); err != nil {
+		return nil, err
+	}
+	if err := validategRPCChannel(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+	if req.Sequence == 0 {
+		return nil, status.Error(codes.InvalidArgument, "packet sequence cannot be 0")
+	}
+	if err := req.Direction.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	fees, found := q.GetPacketFees(ctx, &req.UpstreamPrefix, req.UpstreamClientId, req.Direction, req.PortId, req.ChannelId, req.Sequence)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrInvalidPacketFee, "no fees for the packet: upstream-client-id: %s, upstream-prefix: %X, direction: %s, port-id: %s, channel-id: %s, sequence: %d", req.UpstreamClientId, req.UpstreamPrefix.Bytes(), req.Direction, req.PortId, req.ChannelId, req.Sequence).Error(),
+		)
+	}
+
+	return &types.QueryIncentivizedPacketResponse{
+		IncentivizedPacket: types.NewIncentivizedPacket(req.UpstreamClientId, req.UpstreamPrefix, req.Direction, req.PortId, req.ChannelId, req.Sequence, fees.PacketFees),
+	}, nil
+}
+
+func validategRPCUpstream(upstreamClientID string, upstreamPrefix commitmenttypes.MerklePrefix) error {
+	if err := host.ClientIdentifierValidator(upstreamClientID); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if upstreamPrefix.Empty() {
+		return status.Error(codes.InvalidArgument, "upstream prefix cannot be empty")
+	}
+	return nil
+}
+
+func validategRPCChannel(portID, channelID string) error {
+	if err := host.PortIdentifierValidator(portID); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := host.ChannelIdentifierValidator(channelID); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return nil
+}
+
+// PausedProxies implements the Query/PausedProxies gRPC method
+func (q Querier) PausedProxies(c context.Context, req *types.QueryPausedProxiesRequest) (*types.QueryPausedProxiesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	var pausedProxies []types.PausedProxy
+	store := storeprefix.NewStore(ctx.KVStore(q.proxyStoreKey), types.KeyPausedProxyPrefix)
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var pausedProxy types.PausedProxy
+		if err := q.cdc.Unmarshal(value, &pausedProxy); err != nil {
+			return err
+		}
+		pausedProxies = append(pausedProxies, pausedProxy)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPausedProxiesResponse{
+		PausedProxies: pausedProxies,
+		Pagination:    pageRes,
+	}, nil
+}
+
+// UpstreamChain implements the Query/UpstreamChain gRPC method
+func (q Querier) UpstreamChain(c context.Context, req *types.QueryUpstreamChainRequest) (*types.QueryUpstreamChainResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	clientState, _, err := q.getProxyClient(ctx, req.ClientId)
+	if sdkerrors.IsOf(err, clienttypes.ErrClientNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	} else if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	// the proxy chain ID is left empty if the proxy client type has no chain ID
+	proxyChainID, _ := proxytypes.GetChainID(clientState.GetProxyClientState())
+
+	return &types.QueryUpstreamChainResponse{
+		ProxyChainId:       proxyChainID,
+		UpstreamClientId:   clientState.UpstreamClientId,
+		UpstreamChainId:    clientState.UpstreamChainId,
+		UpstreamClientType: clientState.UpstreamClientType,
+	}, nil
+}
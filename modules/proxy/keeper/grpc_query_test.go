@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/modules/core/exported"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/datachainlab/ibc-proxy/modules/proxy/keeper"
+	"github.com/datachainlab/ibc-proxy/modules/proxy/types"
+	ibctesting "github.com/datachainlab/ibc-proxy/testing"
+	"github.com/datachainlab/ibc-proxy/testing/simapp"
+)
+
+// A(C) -> B, B -> A
+// A: downstream, B: upstream, C: proxy
+func (suite *KeeperTestSuite) TestGRPCQuery() {
+	suite.Require().NoError(suite.coordinator.IncrementClientSequence(suite.chainC, suite.chainB, exported.Tendermint, 1))
+	suite.Require().NoError(suite.coordinator.IncrementClientSequence(suite.chainB, suite.chainA, exported.Tendermint, 2))
+
+	clientCB, err := suite.coordinator.CreateClient2(suite.chainC, suite.chainB, exported.Tendermint, false, 0)
+	suite.Require().NoError(err)
+
+	clientBA, err := suite.coordinator.CreateMultiVClient(suite.chainB, suite.chainA, exported.Tendermint, 0)
+	suite.Require().NoError(err)
+
+	clientAC, err := suite.coordinator.CreateProxyClient(suite.chainA, suite.chainC, exported.Tendermint, clientCB)
+	suite.Require().NoError(err)
+
+	ppair := ibctesting.ProxyPair{{Chain: suite.chainC, ClientID: clientAC, UpstreamClientID: clientCB, UpstreamPrefix: suite.chainB.GetPrefix()}, nil}
+	connA, connB := suite.coordinator.CreateConnectionWithProxy(suite.chainA, suite.chainB, clientAC, clientBA, ibctesting.TransferVersion, ppair)
+	chanA, chanB := suite.coordinator.CreateChannelWithProxy(suite.chainA, suite.chainB, connA, connB, ibctesting.TransferPort, ibctesting.TransferPort, channeltypes.UNORDERED, ppair)
+	suite.testHandleMsgTransfer(connA, connB, chanA, chanB, ppair)
+
+	app := suite.chainC.App.(*simapp.SimApp)
+	querier := keeper.Querier{Keeper: app.IBCProxyKeeper}
+	ctx := sdk.WrapSDKContext(suite.chainC.GetContext())
+	prefix := suite.chainB.GetPrefix()
+	selfHeight := clienttypes.GetSelfHeight(suite.chainC.GetContext())
+
+	clientRes, err := querier.ProxyClientState(ctx, &types.QueryProxyClientStateRequest{
+		UpstreamClientId: clientCB, UpstreamPrefix: prefix, ClientId: clientBA,
+	})
+	suite.Require().NoError(err)
+	clientState, err := clienttypes.UnpackClientState(clientRes.ClientState)
+	suite.Require().NoError(err)
+	suite.Require().Equal(exported.Tendermint, clientState.ClientType())
+	suite.Require().Equal(selfHeight, clientRes.ProofHeight)
+
+	consensusRes, err := querier.ProxyConsensusState(ctx, &types.QueryProxyConsensusStateRequest{
+		UpstreamClientId: clientCB, UpstreamPrefix: prefix, ClientId: clientBA,
+		RevisionNumber: clientState.GetLatestHeight().GetRevisionNumber(), RevisionHeight: clientState.GetLatestHeight().GetRevisionHeight(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().NotNil(consensusRes.ConsensusState)
+
+	connRes, err := querier.ProxyConnection(ctx, &types.QueryProxyConnectionRequest{
+		UpstreamClientId: clientCB, UpstreamPrefix: prefix, ConnectionId: connB.ID,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.chainB.GetConnection(connB), *connRes.Connection)
+
+	chanRes, err := querier.ProxyChannel(ctx, &types.QueryProxyChannelRequest{
+		UpstreamClientId: clientCB, UpstreamPrefix: prefix, PortId: chanB.PortID, ChannelId: chanB.ID,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.chainB.GetChannel(*chanB), *chanRes.Channel)
+
+	ackRes, err := querier.ProxyPacketAcknowledgement(ctx, &types.QueryProxyPacketAcknowledgementRequest{
+		UpstreamClientId: clientCB, UpstreamPrefix: prefix, PortId: chanB.PortID, ChannelId: chanB.ID, Sequence: 1,
+	})
+	suite.Require().NoError(err)
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	suite.Require().Equal(channeltypes.CommitAcknowledgement(ack.Acknowledgement()), ackRes.Acknowledgement)
+
+	// no packet has been sent from the upstream
+	_, err = querier.ProxyPacketCommitment(ctx, &types.QueryProxyPacketCommitmentRequest{
+		UpstreamClientId: clientCB, UpstreamPrefix: prefix, PortId: chanB.PortID, ChannelId: chanB.ID, Sequence: 1,
+	})
+	suite.Require().Equal(codes.NotFound, status.Code(err))
+
+	absenceRes, err := querier.ProxyPacketReceiptAbsence(ctx, &types.QueryProxyPacketReceiptAbsenceRequest{
+		UpstreamClientId: clientCB, UpstreamPrefix: prefix, PortId: chanB.PortID, ChannelId: chanB.ID, Sequence: 1,
+	})
+	suite.Require().NoError(err)
+	suite.Require().False(absenceRes.Absence)
+
+	_, err = querier.ProxyNextSequenceRecv(ctx, &types.QueryProxyNextSequenceRecvRequest{
+		UpstreamClientId: clientCB, UpstreamPrefix: prefix, PortId: chanB.PortID, ChannelId: chanB.ID,
+	})
+	suite.Require().Equal(codes.NotFound, status.Code(err))
+
+	// unknown upstream
+	_, err = querier.ProxyConnection(ctx, &types.QueryProxyConnectionRequest{
+		UpstreamClientId: clientCB, UpstreamPrefix: commitmenttypes.NewMerklePrefix([]byte("unknown")), ConnectionId: connB.ID,
+	})
+	suite.Require().Equal(codes.NotFound, status.Code(err))
+
+	// invalid requests
+	_, err = querier.ProxyConnection(ctx, nil)
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+	_, err = querier.ProxyConnection(ctx, &types.QueryProxyConnectionRequest{
+		UpstreamClientId: clientCB, ConnectionId: connB.ID,
+	})
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+	_, err = querier.ProxyPacketCommitment(ctx, &types.QueryProxyPacketCommitmentRequest{
+		UpstreamClientId: clientCB, UpstreamPrefix: prefix, PortId: chanB.PortID, ChannelId: chanB.ID, Sequence: 0,
+	})
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	connectiontypes "github.com/cosmos/ibc-go/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/modules/core/exported"
+
+	"github.com/datachainlab/ibc-proxy/modules/proxy/types"
+)
+
+// Implements ProxyHooks interface
+var _ types.ProxyHooks = Keeper{}
+
+// AfterProxyClientState - call hook if registered
+func (k Keeper) AfterProxyClientState(ctx sdk.Context, upstreamClientID string, upstreamPrefix exported.Prefix, counterpartyClientID string, clientState exported.ClientState) {
+	if k.hooks != nil {
+		k.hooks.AfterProxyClientState(ctx, upstreamClientID, upstreamPrefix, counterpartyClientID, clientState)
+	}
+}
+
+// AfterProxyConnection - call hook if registered
+func (k Keeper) AfterProxyConnection(ctx sdk.Context, upstreamClientID string, upstreamPrefix exported.Prefix, connectionID string, connection connectiontypes.ConnectionEnd) {
+	if k.hooks != nil {
+		k.hooks.AfterProxyConnection(ctx, upstreamClientID, upstreamPrefix, connectionID, connection)
+	}
+}
+
+// AfterProxyChannel - call hook if registered
+func (k Keeper) AfterProxyChannel(ctx sdk.Context, upstreamClientID string, upstreamPrefix exported.Prefix, portID, channelID string, channel channeltypes.Channel) {
+	if k.hooks != nil {
+		k.hooks.AfterProxyChannel(ctx, upstreamClientID, upstreamPrefix, portID, channelID, channel)
+	}
+}
+
+// AfterProxyPacketCommitment - call hook if registered
+func (k Keeper) AfterProxyPacketCommitment(ctx sdk.Context, upstreamClientID string, upstreamPrefix exported.Prefix, portID, channelID string, sequence uint64, commitment []byte) {
+	if k.hooks != nil {
+		k.hooks.AfterProxyPacketCommitment(ctx, upstreamClientID, upstreamPrefix, portID, channelID, sequence, commitment)
+	}
+}
+
+// AfterProxyAcknowledgement - call hook if registered
+func (k Keeper) AfterProxyAcknowledgement(ctx sdk.Context, upstreamClientID string, upstreamPrefix exported.Prefix, portID, channelID string, sequence uint64, acknowledgement []byte) {
+	if k.hooks != nil {
+		k.hooks.AfterProxyAcknowledgement(ctx, upstreamClientID, upstreamPrefix, portID, channelID, sequence, acknowledgement)
+	}
+}
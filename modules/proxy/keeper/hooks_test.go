@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/modules/core/24-host"
+	"github.com/cosmos/ibc-go/modules/core/exported"
+
+	"github.com/datachainlab/ibc-proxy/modules/proxy/types"
+	ibctesting "github.com/datachainlab/ibc-proxy/testing"
+	"github.com/datachainlab/ibc-proxy/testing/simapp"
+)
+
+// A(C) -> B, B -> A
+// A: downstream, B: upstream, C: proxy
+func (suite *KeeperTestSuite) TestProxyHooks() {
+	suite.Require().NoError(suite.coordinator.IncrementClientSequence(suite.chainC, suite.chainB, exported.Tendermint, 1))
+	suite.Require().NoError(suite.coordinator.IncrementClientSequence(suite.chainB, suite.chainA, exported.Tendermint, 2))
+
+	clientCB, err := suite.coordinator.CreateClient2(suite.chainC, suite.chainB, exported.Tendermint, false, 0)
+	suite.Require().NoError(err)
+
+	clientBA, err := suite.coordinator.CreateMultiVClient(suite.chainB, suite.chainA, exported.Tendermint, 0)
+	suite.Require().NoError(err)
+
+	clientAC, err := suite.coordinator.CreateProxyClient(suite.chainA, suite.chainC, exported.Tendermint, clientCB)
+	suite.Require().NoError(err)
+
+	// the hooks are registered by the app before the module copies the keeper
+	app := suite.chainC.App.(*simapp.SimApp)
+	hooks := app.ProxyHooks
+	suite.Require().Panics(func() { app.IBCProxyKeeper.SetHooks(types.NewMultiProxyHooks()) })
+
+	// the handshakes call the hooks with the proxied states
+	ppair := ibctesting.ProxyPair{{Chain: suite.chainC, ClientID: clientAC, UpstreamClientID: clientCB, UpstreamPrefix: suite.chainB.GetPrefix()}, nil}
+	connA, connB := suite.coordinator.CreateConnectionWithProxy(suite.chainA, suite.chainB, clientAC, clientBA, ibctesting.TransferVersion, ppair)
+	suite.Require().Equal([]string{clientBA}, hooks.ClientStates)
+	suite.Require().NotEmpty(hooks.Connections)
+	suite.Require().Equal(suite.chainB.GetConnection(connB), hooks.Connections[len(hooks.Connections)-1])
+	chanA, chanB := suite.coordinator.CreateChannelWithProxy(suite.chainA, suite.chainB, connA, connB, ibctesting.TransferPort, ibctesting.TransferPort, channeltypes.UNORDERED, ppair)
+	suite.Require().NotEmpty(hooks.Channels)
+	suite.Require().Equal(suite.chainB.GetChannel(*chanB), hooks.Channels[len(hooks.Channels)-1])
+
+	// B writes the acknowledgement of the packet from A, which C proxies
+	suite.testHandleMsgTransfer(connA, connB, chanA, chanB, ppair)
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()
+	suite.Require().Equal([][]byte{ack}, hooks.Acks)
+
+	// B sends a packet to A, whose commitment C proxies
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	timeoutHeight := clienttypes.NewHeight(0, 110)
+	msg := transfertypes.NewMsgTransfer(chanB.PortID, chanB.ID, coin, suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), timeoutHeight, 0)
+	suite.Require().NoError(suite.coordinator.SendPacketWithProxy(suite.chainB, suite.chainA, connB, connA, ppair.Swap(), msg))
+	packet := channeltypes.NewPacket(
+		transfertypes.NewFungibleTokenPacketData(coin.Denom, coin.Amount.Uint64(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String()).GetBytes(),
+		1, chanB.PortID, chanB.ID, chanA.PortID, chanA.ID, timeoutHeight, 0,
+	)
+	suite.Require().NoError(suite.coordinator.RecvPacketWithProxy(suite.chainA, suite.chainB, connA, connB, packet, ppair))
+	commitment := channeltypes.CommitPacket(suite.chainC.App.AppCodec(), packet)
+	suite.Require().Equal([][]byte{commitment}, hooks.Commitments)
+
+	// the hooks aren't called again when another relayer proxies the same packet
+	suite.Require().NoError(suite.coordinator.UpdateClient(suite.chainC, suite.chainB, clientCB, exported.Tendermint))
+	proof, proofHeight := suite.chainB.QueryProof(host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+	_, err = suite.chainC.SendMsgs(&types.MsgProxyRecvPacket{
+		UpstreamClientId: clientCB,
+		UpstreamPrefix:   suite.chainB.GetPrefix(),
+		Packet:           packet,
+		Proof:            proof,
+		ProofHeight:      proofHeight,
+		Signer:           suite.chainC.SenderAccount.GetAddress().String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([][]byte{commitment}, hooks.Commitments)
+	suite.Require().Equal([][]byte{ack}, hooks.Acks)
+}
@@ -4,8 +4,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	storeprefix "github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	commitmenttypes "github.com/cosmos/ibc-go/modules/core/23-commitment/types"
 	"github.com/cosmos/ibc-go/modules/core/exported"
+	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/datachainlab/ibc-proxy/modules/proxy/types"
 )
@@ -14,20 +16,55 @@ type Keeper struct {
 	proxyStoreKey sdk.StoreKey
 	ibcStoreKey   sdk.StoreKey
 	cdc           codec.BinaryCodec
+	paramSpace    paramtypes.Subspace
 
 	clientKeeper types.ClientKeeper
+	bankKeeper   types.BankKeeper
+	hooks        types.ProxyHooks
+
+	// authority is the address allowed to register and deregister upstream clients with messages.
+	// Governance can't sign messages in this SDK version, so it uses the proxy proposals instead.
+	authority string
 }
 
-func NewKeeper(cdc codec.BinaryCodec, proxyStoreKey, ibcStoreKey sdk.StoreKey, clientKeeper types.ClientKeeper) Keeper {
+func NewKeeper(cdc codec.BinaryCodec, proxyStoreKey, ibcStoreKey sdk.StoreKey, paramSpace paramtypes.Subspace, clientKeeper types.ClientKeeper, bankKeeper types.BankKeeper, authority string) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return Keeper{
 		proxyStoreKey: proxyStoreKey,
 		ibcStoreKey:   ibcStoreKey,
 		cdc:           cdc,
+		paramSpace:    paramSpace,
 
 		clientKeeper: clientKeeper,
+		bankKeeper:   bankKeeper,
+
+		authority: authority,
 	}
 }
 
+// SetHooks sets the proxy hooks
+func (k *Keeper) SetHooks(ph types.ProxyHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set proxy hooks twice")
+	}
+	k.hooks = ph
+	return k
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetAuthority returns the address allowed to register and deregister upstream clients
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // GetCommitmentPrefix returns the IBC connection store prefix as a commitment
 // Prefix
 func (k Keeper) GetProxyCommitmentPrefix() exported.Prefix {
@@ -41,7 +78,7 @@ func (k Keeper) GetIBCCommitmentPrefix() exported.Prefix {
 }
 
 func (k Keeper) ProxyStore(ctx sdk.Context, upstreamPrefix exported.Prefix, upstreamClientID string) sdk.KVStore {
-	return storeprefix.NewStore(ctx.KVStore(k.proxyStoreKey), append([]byte(upstreamClientID+"/"), string(upstreamPrefix.Bytes())+"/"...))
+	return storeprefix.NewStore(ctx.KVStore(k.proxyStoreKey), types.ProxyKey(upstreamPrefix, upstreamClientID, nil))
 }
 
 func (k Keeper) ProxyClientStore(ctx sdk.Context, upstreamPrefix exported.Prefix, upstreamClientID string, counterpartyClientIdentifier string) sdk.KVStore {
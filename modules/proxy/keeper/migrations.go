@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2 "github.com/datachainlab/ibc-proxy/modules/proxy/legacy/v2"
+	"github.com/datachainlab/ibc-proxy/modules/proxy/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+// The version 1 has no parameters, so the default parameters are set.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if err := v2.MigrateStore(ctx, m.keeper.proxyStoreKey); err != nil {
+		return err
+	}
+	m.keeper.SetParams(ctx, types.DefaultParams())
+	return nil
+}
@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/modules/core/24-host"
+	"github.com/cosmos/ibc-go/modules/core/exported"
+
+	"github.com/datachainlab/ibc-proxy/modules/proxy/keeper"
+	v1 "github.com/datachainlab/ibc-proxy/modules/proxy/legacy/v1"
+	"github.com/datachainlab/ibc-proxy/modules/proxy/types"
+	ibctesting "github.com/datachainlab/ibc-proxy/testing"
+	"github.com/datachainlab/ibc-proxy/testing/simapp"
+)
+
+// A(C) -> B, B -> A
+// A: downstream, B: upstream, C: proxy
+func (suite *KeeperTestSuite) TestMigrate1to2() {
+	suite.Require().NoError(suite.coordinator.IncrementClientSequence(suite.chainC, suite.chainB, exported.Tendermint, 1))
+	suite.Require().NoError(suite.coordinator.IncrementClientSequence(suite.chainB, suite.chainA, exported.Tendermint, 2))
+
+	clientCB, err := suite.coordinator.CreateClient2(suite.chainC, suite.chainB, exported.Tendermint, false, 0)
+	suite.Require().NoError(err)
+	clientBA, err := suite.coordinator.CreateMultiVClient(suite.chainB, suite.chainA, exported.Tendermint, 0)
+	suite.Require().NoError(err)
+	clientAC, err := suite.coordinator.CreateProxyClient(suite.chainA, suite.chainC, exported.Tendermint, clientCB)
+	suite.Require().NoError(err)
+
+	app := suite.chainC.App.(*simapp.SimApp)
+	proxyKeeper := app.IBCProxyKeeper
+
+	// the proxy stores the states of the handshakes and a packet
+	ppair := ibctesting.ProxyPair{{Chain: suite.chainC, ClientID: clientAC, UpstreamClientID: clientCB, UpstreamPrefix: suite.chainB.GetPrefix()}, nil}
+	connA, connB := suite.coordinator.CreateConnectionWithProxy(suite.chainA, suite.chainB, clientAC, clientBA, ibctesting.TransferVersion, ppair)
+	chanA, chanB := suite.coordinator.CreateChannelWithProxy(suite.chainA, suite.chainB, connA, connB, ibctesting.TransferPort, ibctesting.TransferPort, channeltypes.UNORDERED, ppair)
+	suite.testHandleMsgTransfer(connA, connB, chanA, chanB, ppair)
+
+	// rewrite the store with the keys of the version 1
+	ctx := suite.chainC.GetContext()
+	prefix := suite.chainB.GetPrefix()
+	suite.Require().NoError(proxyKeeper.SetProxyPacketReceiptAbsence(ctx, &prefix, clientCB, chanB.PortID, chanB.ID, 2))
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	expectedGenesis := proxyKeeper.ExportGenesis(ctx)
+	// the version 1 has no pruning queue
+	var expected [][2][]byte
+	for _, entry := range proxyStoreEntries(store) {
+		store.Delete(entry[0])
+		if entry[0][0] == types.KeyPruningQueuePrefix[0] {
+			continue
+		}
+		expected = append(expected, entry)
+		store.Set(downgradeProxyStoreEntry(entry[0]), entry[1])
+	}
+	suite.Require().NotEmpty(expected)
+	suite.Require().NotEqual(expected, proxyStoreEntries(store))
+
+	suite.Require().NoError(keeper.NewMigrator(proxyKeeper).Migrate1to2(ctx))
+	suite.Require().Equal(expected, proxyStoreEntries(store))
+	suite.Require().Equal(expectedGenesis, proxyKeeper.ExportGenesis(ctx))
+	suite.Require().Equal(types.DefaultParams(), proxyKeeper.GetParams(ctx))
+
+	// the proxy keeps working with the migrated store
+	timeoutHeight := clienttypes.NewHeight(0, 110)
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	msg := transfertypes.NewMsgTransfer(chanB.PortID, chanB.ID, coin, suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), timeoutHeight, 0)
+	suite.Require().NoError(suite.coordinator.SendPacketWithProxy(suite.chainB, suite.chainA, connB, connA, ppair.Swap(), msg))
+	packet := channeltypes.NewPacket(
+		transfertypes.NewFungibleTokenPacketData(coin.Denom, coin.Amount.Uint64(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String()).GetBytes(),
+		1, chanB.PortID, chanB.ID, chanA.PortID, chanA.ID, timeoutHeight, 0,
+	)
+	suite.Require().NoError(suite.coordinator.RecvPacketWithProxy(suite.chainA, suite.chainB, connA, connB, packet, ppair))
+}
+
+// proxyStoreEntries returns the key-value pairs of the proxy store
+func proxyStoreEntries(store sdk.KVStore) [][2][]byte {
+	var entries [][2][]byte
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		entries = append(entries, [2][]byte{iterator.Key(), iterator.Value()})
+	}
+	return entries
+}
+
+// downgradeProxyStoreEntry returns the key of the version 1 for a proxy state
+func downgradeProxyStoreEntry(key []byte) []byte {
+	upstreamClientID, upstreamPrefix, path, err := types.ParseProxyKey(key)
+	if err != nil {
+		panic(err)
+	}
+	// the version 1 stored the marker of a packet receipt absence at the receipt path
+	path = strings.Replace(path, host.KeyPacketReceiptPrefix+"/"+host.KeyPacketCommitmentPrefix+"/", host.KeyPacketReceiptPrefix+"/", 1)
+	return v1.ProxyKey(&upstreamPrefix, upstreamClientID, []byte(path))
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/modules/core/exported"
+
+	proxytypes "github.com/datachainlab/ibc-proxy/modules/light-clients/xx-proxy/types"
+	"github.com/datachainlab/ibc-proxy/modules/proxy/types"
+)
+
+// caller: downstream
+// CheckProxyMisbehaviourAndUpdateState freezes the proxy client if the misbehaviour proves that the proxy committed
+// a state which the upstream doesn't have.
+// The upstream value is verified through the upstream client on this chain, which must be a client of the upstream chain
+// that the proxy client is bound to so that nobody can freeze an honest proxy with a client of an arbitrary chain.
+// Therefore a proxy client created without the binding cannot be frozen by a proxy misbehaviour.
+// The upstream client may be another proxy client bound to the same upstream chain.
+func (k Keeper) CheckProxyMisbehaviourAndUpdateState(ctx sdk.Context, misbehaviour *proxytypes.ProxyMisbehaviour) error {
+	if err := misbehaviour.ValidateBasic(); err != nil {
+		return err
+	}
+
+	clientState, found := k.clientKeeper.GetClientState(ctx, misbehaviour.ClientId)
+	if !found {
+		return sdkerrors.Wrapf(clienttypes.ErrClientNotFound, "cannot check misbehaviour for client with ID %s", misbehaviour.ClientId)
+	}
+	proxyClientState, ok := clientState.(*proxytypes.ClientState)
+	if !ok {
+		return sdkerrors.Wrapf(clienttypes.ErrInvalidClientType, "expected %s client, got %s", proxytypes.ProxyClientType, clientState.ClientType())
+	}
+	if len(proxyClientState.UpstreamChainId) == 0 {
+		return sdkerrors.Wrapf(clienttypes.ErrInvalidClient, "proxy client (%s) is not bound to an upstream chain", misbehaviour.ClientId)
+	}
+	clientStore := k.clientKeeper.ClientStore(ctx, misbehaviour.ClientId)
+	if status := proxyClientState.Status(ctx, clientStore, k.cdc); status != exported.Active {
+		return sdkerrors.Wrapf(clienttypes.ErrClientNotActive, "cannot process misbehaviour for client (%s) with status %s", misbehaviour.ClientId, status)
+	}
+
+	upstreamClientState, found := k.clientKeeper.GetClientState(ctx, misbehaviour.UpstreamClientId)
+	if !found {
+		return sdkerrors.Wrapf(clienttypes.ErrClientNotFound, "upstream client with ID %s", misbehaviour.UpstreamClientId)
+	}
+	upstreamChainID, err := proxytypes.GetChainID(upstreamClientState)
+	if err != nil {
+		return err
+	}
+	if upstreamChainID != proxyClientState.UpstreamChainId {
+		return sdkerrors.Wrapf(
+			clienttypes.ErrInvalidClient,
+			"upstream client (%s) is a client of chain %s, but the proxy client is bound to %s", misbehaviour.UpstreamClientId, upstreamChainID, proxyClientState.UpstreamChainId,
+		)
+	}
+	if status := upstreamClientState.Status(ctx, k.clientKeeper.ClientStore(ctx, misbehaviour.UpstreamClientId), k.cdc); status != exported.Active {
+		return sdkerrors.Wrapf(clienttypes.ErrClientNotActive, "upstream client (%s) status is %s", misbehaviour.UpstreamClientId, status)
+	}
+	upstreamConsensusState, found := k.clientKeeper.GetClientConsensusState(ctx, misbehaviour.UpstreamClientId, misbehaviour.UpstreamProofHeight)
+	if !found {
+		return sdkerrors.Wrapf(
+			clienttypes.ErrConsensusStateNotFound,
+			"clientID (%s), height (%s)", misbehaviour.UpstreamClientId, misbehaviour.UpstreamProofHeight,
+		)
+	}
+
+	frozenClientState, err := proxyClientState.CheckProxyMisbehaviourAndUpdateState(k.cdc, clientStore, upstreamClientState, upstreamConsensusState, misbehaviour)
+	if err != nil {
+		return err
+	}
+
+	k.clientKeeper.SetClientState(ctx, misbehaviour.ClientId, frozenClientState)
+	k.Logger(ctx).Info("proxy client frozen due to misbehaviour", "client-id", misbehaviour.ClientId, "upstream-client-id", misbehaviour.UpstreamClientId, "path", misbehaviour.Path)
+
+	return ctx.EventManager().EmitTypedEvent(types.NewEventProxyMisbehaviour(misbehaviour))
+}
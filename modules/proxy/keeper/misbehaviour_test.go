@@ -0,0 +1,211 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/modules/core/24-host"
+	"github.com/cosmos/ibc-go/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/modules/light-clients/07-tendermint/types"
+
+	proxyclienttypes "github.com/datachainlab/ibc-proxy/modules/light-clients/xx-proxy/types"
+	"github.com/datachainlab/ibc-proxy/modules/proxy/types"
+	ibctesting "github.com/datachainlab/ibc-proxy/testing"
+	"github.com/datachainlab/ibc-proxy/testing/simapp"
+)
+
+// A(C) -> B, A -> B
+// A: downstream, B: upstream, C: proxy
+func (suite *KeeperTestSuite) TestProxyMisbehaviour() {
+	// A has a direct client of B besides the proxy client
+	clientAB, err := suite.coordinator.CreateClient(suite.chainA, suite.chainB, exported.Tendermint)
+	suite.Require().NoError(err)
+	clientCB, err := suite.coordinator.CreateClient2(suite.chainC, suite.chainB, exported.Tendermint, false, 0)
+	suite.Require().NoError(err)
+	clientAC, err := suite.coordinator.CreateProxyClient(suite.chainA, suite.chainC, exported.Tendermint, clientCB)
+	suite.Require().NoError(err)
+
+	// B has a packet commitment, but C commits another value for it by tampering with its store
+	portID, channelID, sequence := ibctesting.TransferPort, "channel-0", uint64(1)
+	prefix := suite.chainB.GetPrefix()
+	suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPacketCommitment(suite.chainB.GetContext(), portID, channelID, sequence, []byte("commitment"))
+	proxyKeeperC := suite.chainC.App.(*simapp.SimApp).IBCProxyKeeper
+	suite.Require().NoError(proxyKeeperC.SetProxyPacketCommitment(suite.chainC.GetContext(), &prefix, clientCB, portID, channelID, sequence, []byte("fraud")))
+
+	// the states are queryable after the blocks that commit them
+	suite.coordinator.CommitBlock(suite.chainB, suite.chainC)
+	suite.coordinator.CommitBlock(suite.chainC)
+	suite.Require().NoError(suite.chainA.UpdateProxyClient(suite.chainC, clientAC))
+	suite.Require().NoError(suite.coordinator.UpdateClient(suite.chainA, suite.chainB, clientAB, exported.Tendermint))
+
+	proxyProof, proxyProofHeight := suite.chainC.QueryProxyPacketCommitmentProof(portID, channelID, sequence, &prefix, clientCB)
+	upstreamProof, upstreamProofHeight := suite.chainB.QueryProof(host.PacketCommitmentKey(portID, channelID, sequence))
+	misbehaviour := proxyclienttypes.ProxyMisbehaviour{
+		ClientId:            clientAC,
+		UpstreamClientId:    clientAB,
+		UpstreamPrefix:      prefix,
+		Path:                host.PacketCommitmentPath(portID, channelID, sequence),
+		ProxyValue:          []byte("fraud"),
+		ProxyProof:          proxyProof,
+		ProxyProofHeight:    proxyProofHeight,
+		UpstreamValue:       []byte("commitment"),
+		UpstreamProof:       upstreamProof,
+		UpstreamProofHeight: upstreamProofHeight,
+	}
+	suite.Require().NoError(misbehaviour.ValidateBasic())
+
+	proxyKeeperA := suite.chainA.App.(*simapp.SimApp).IBCProxyKeeper
+	check := func(misbehaviour proxyclienttypes.ProxyMisbehaviour) error {
+		cacheCtx, _ := suite.chainA.GetContext().CacheContext()
+		return proxyKeeperA.CheckProxyMisbehaviourAndUpdateState(cacheCtx, &misbehaviour)
+	}
+
+	// the upstream client must be a client of the upstream chain that the proxy client is bound to
+	clientAD, err := suite.coordinator.CreateClient(suite.chainA, suite.chainD, exported.Tendermint)
+	suite.Require().NoError(err)
+	m := misbehaviour
+	m.UpstreamClientId = clientAD
+	suite.Require().ErrorIs(check(m), clienttypes.ErrInvalidClient)
+
+	// a proxy client without the binding to the upstream chain cannot be frozen
+	unbind := func(ctx sdk.Context, clientID string) {
+		clientState := suite.chainA.GetClientState(clientID).(*proxyclienttypes.ClientState)
+		clientState.UpstreamChainId = ""
+		suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(ctx, clientID, clientState)
+	}
+	cacheCtx, _ := suite.chainA.GetContext().CacheContext()
+	unbind(cacheCtx, clientAC)
+	suite.Require().ErrorIs(proxyKeeperA.CheckProxyMisbehaviourAndUpdateState(cacheCtx, &misbehaviour), clienttypes.ErrInvalidClient)
+
+	// the upstream value can be proven at any height of the upstream because it is never changed once written
+	suite.coordinator.CommitNBlocks(suite.chainB, 2)
+	suite.Require().NoError(suite.coordinator.UpdateClient(suite.chainA, suite.chainB, clientAB, exported.Tendermint))
+	m = misbehaviour
+	m.UpstreamProof, m.UpstreamProofHeight = suite.chainB.QueryProof(host.PacketCommitmentKey(portID, channelID, sequence))
+	suite.Require().True(m.UpstreamProofHeight.GT(misbehaviour.UpstreamProofHeight))
+	suite.Require().NoError(check(m))
+
+	// the upstream value can be proven through another proxy client bound to the upstream chain
+	clientDB, err := suite.coordinator.CreateClient2(suite.chainD, suite.chainB, exported.Tendermint, false, 0)
+	suite.Require().NoError(err)
+	proxyClientAD, err := suite.coordinator.CreateProxyClient(suite.chainA, suite.chainD, exported.Tendermint, clientDB)
+	suite.Require().NoError(err)
+	proxyKeeperD := suite.chainD.App.(*simapp.SimApp).IBCProxyKeeper
+	suite.Require().NoError(proxyKeeperD.SetProxyPacketCommitment(suite.chainD.GetContext(), &prefix, clientDB, portID, channelID, sequence, []byte("commitment")))
+	suite.coordinator.CommitNBlocks(suite.chainD, 2)
+	suite.Require().NoError(suite.chainA.UpdateProxyClient(suite.chainD, proxyClientAD))
+	m = misbehaviour
+	m.UpstreamClientId = proxyClientAD
+	m.UpstreamProof, m.UpstreamProofHeight = suite.chainD.QueryProxyPacketCommitmentProof(portID, channelID, sequence, &prefix, clientDB)
+	suite.Require().NoError(check(m))
+	// the other proxy client must be bound to the upstream chain too
+	cacheCtx, _ = suite.chainA.GetContext().CacheContext()
+	unbind(cacheCtx, proxyClientAD)
+	suite.Require().ErrorIs(proxyKeeperA.CheckProxyMisbehaviourAndUpdateState(cacheCtx, &m), clienttypes.ErrInvalidClient)
+
+	// invalid misbehaviours
+	for _, malleate := range []func(m *proxyclienttypes.ProxyMisbehaviour){
+		// the values are the same
+		func(m *proxyclienttypes.ProxyMisbehaviour) { m.ProxyValue = m.UpstreamValue },
+		// the proxy didn't commit the value
+		func(m *proxyclienttypes.ProxyMisbehaviour) { m.ProxyValue = []byte("other") },
+		// the upstream doesn't have the value
+		func(m *proxyclienttypes.ProxyMisbehaviour) { m.UpstreamValue = []byte("other") },
+		// the proof is for another path
+		func(m *proxyclienttypes.ProxyMisbehaviour) {
+			m.Path = host.PacketCommitmentPath(portID, channelID, sequence+1)
+		},
+		// the proxy client has no consensus state at the height
+		func(m *proxyclienttypes.ProxyMisbehaviour) {
+			m.ProxyProofHeight = m.ProxyProofHeight.Increment().(clienttypes.Height)
+		},
+		// the upstream client must not be the proxy client
+		func(m *proxyclienttypes.ProxyMisbehaviour) { m.UpstreamClientId = clientAC },
+		// only packet commitments and acknowledgements can be proven to be conflicting
+		func(m *proxyclienttypes.ProxyMisbehaviour) { m.Path = host.NextSequenceRecvPath(portID, channelID) },
+	} {
+		m := misbehaviour
+		malleate(&m)
+		suite.Require().Error(check(m))
+	}
+
+	// the misbehaviour cannot be submitted through the client keeper as it needs the upstream client
+	cacheCtx, _ = suite.chainA.GetContext().CacheContext()
+	suite.Require().Error(suite.chainA.App.GetIBCKeeper().ClientKeeper.CheckMisbehaviourAndUpdateState(cacheCtx, &misbehaviour))
+
+	res, err := suite.chainA.SendMsgs(types.NewMsgSubmitProxyMisbehaviour(misbehaviour, suite.chainA.SenderAccount.GetAddress().String()))
+	suite.Require().NoError(err)
+	var ev types.EventProxyMisbehaviour
+	suite.Require().NoError(ibctesting.ParseProxyEventFromEvents(res.GetEvents(), &ev))
+	suite.Require().Equal(types.EventProxyMisbehaviour{
+		ClientId:         clientAC,
+		UpstreamClientId: clientAB,
+		UpstreamPrefix:   prefix,
+		Path:             misbehaviour.Path,
+	}, ev)
+
+	ctx := suite.chainA.GetContext()
+	clientState := suite.chainA.GetClientState(clientAC)
+	suite.Require().Equal(proxyclienttypes.FrozenHeight, clientState.(*proxyclienttypes.ClientState).FrozenHeight)
+	status := clientState.Status(ctx, suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, clientAC), suite.chainA.App.AppCodec())
+	suite.Require().Equal(exported.Frozen, status)
+
+	// the frozen client cannot be updated or frozen again
+	suite.coordinator.CommitBlock(suite.chainC)
+	header, err := suite.chainA.ConstructUpdateTMClientHeader(suite.chainC, clientAC)
+	suite.Require().NoError(err)
+	cacheCtx, _ = ctx.CacheContext()
+	suite.Require().ErrorIs(suite.chainA.App.GetIBCKeeper().ClientKeeper.UpdateClient(cacheCtx, clientAC, header), clienttypes.ErrClientNotActive)
+	suite.Require().ErrorIs(proxyKeeperA.CheckProxyMisbehaviourAndUpdateState(cacheCtx.WithEventManager(sdk.NewEventManager()), &misbehaviour), clienttypes.ErrClientNotActive)
+}
+
+// A(C) -> B
+// A: downstream, B: upstream, C: proxy
+func (suite *KeeperTestSuite) TestProxyClientTMMisbehaviour() {
+	clientCB, err := suite.coordinator.CreateClient2(suite.chainC, suite.chainB, exported.Tendermint, false, 0)
+	suite.Require().NoError(err)
+	suite.coordinator.CommitBlock(suite.chainA, suite.chainC)
+	clientAC, err := suite.coordinator.CreateProxyClient(suite.chainA, suite.chainC, exported.Tendermint, clientCB)
+	suite.Require().NoError(err)
+
+	// C double-signs the headers at the next height of the proxy client
+	trustedHeight := suite.chainA.GetClientState(clientAC).GetLatestHeight().(clienttypes.Height)
+	height := int64(trustedHeight.RevisionHeight + 1)
+	timestamp := suite.chainC.CurrentHeader.Time
+	header1 := suite.chainC.CreateTMClientHeader(suite.chainC.ChainID, height, trustedHeight, timestamp, suite.chainC.Vals, suite.chainC.Vals, suite.chainC.Signers)
+	header2 := suite.chainC.CreateTMClientHeader(suite.chainC.ChainID, height, trustedHeight, timestamp.Add(time.Second), suite.chainC.Vals, suite.chainC.Vals, suite.chainC.Signers)
+	tmMisbehaviour := ibctmtypes.NewMisbehaviour(clientAC, header1, header2)
+
+	clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+	check := func(misbehaviour exported.Misbehaviour) error {
+		cacheCtx, _ := suite.chainA.GetContext().CacheContext()
+		return clientKeeper.CheckMisbehaviourAndUpdateState(cacheCtx, misbehaviour)
+	}
+
+	// the misbehaviour must be wrapped
+	suite.Require().ErrorIs(check(tmMisbehaviour), clienttypes.ErrInvalidClientType)
+	// the headers are the same
+	misbehaviour, err := proxyclienttypes.NewMisbehaviour(clientAC, ibctmtypes.NewMisbehaviour(clientAC, header1, header1))
+	suite.Require().NoError(err)
+	suite.Require().ErrorIs(check(misbehaviour), clienttypes.ErrInvalidMisbehaviour)
+	// a proxy misbehaviour cannot be wrapped
+	misbehaviour, err = proxyclienttypes.NewMisbehaviour(clientAC, &proxyclienttypes.ProxyMisbehaviour{ClientId: clientAC})
+	suite.Require().NoError(err)
+	suite.Require().ErrorIs(check(misbehaviour), clienttypes.ErrInvalidMisbehaviour)
+
+	misbehaviour, err = proxyclienttypes.NewMisbehaviour(clientAC, tmMisbehaviour)
+	suite.Require().NoError(err)
+	msg, err := clienttypes.NewMsgSubmitMisbehaviour(clientAC, misbehaviour, suite.chainA.SenderAccount.GetAddress().String())
+	suite.Require().NoError(err)
+	_, err = suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	// the tendermint client in the proxy client is frozen
+	ctx := suite.chainA.GetContext()
+	clientState := suite.chainA.GetClientState(clientAC).(*proxyclienttypes.ClientState)
+	suite.Require().True(clientState.FrozenHeight.IsZero())
+	suite.Require().False(clientState.GetProxyClientState().(*ibctmtypes.ClientState).FrozenHeight.IsZero())
+	suite.Require().Equal(exported.Frozen, clientState.Status(ctx, clientKeeper.ClientStore(ctx, clientAC), suite.chainA.App.AppCodec()))
+	suite.Require().ErrorIs(check(misbehaviour), clienttypes.ErrClientNotActive)
+}
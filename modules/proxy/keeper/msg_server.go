@@ -3,8 +3,14 @@ package keeper
 import (
 	"context"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/modules/core/exported"
+	"github.com/gogo/protobuf/proto"
+
+	proxytypes "github.com/datachainlab/ibc-proxy/modules/light-clients/xx-proxy/types"
 	"github.com/datachainlab/ibc-proxy/modules/proxy/types"
 )
 
@@ -100,11 +106,22 @@ func (k *Keeper) ProxyConnectionOpenFinalize(goCtx context.Context, msg *types.M
 	return &types.MsgProxyConnectionOpenFinalizeResponse{}, nil
 }
 
+// ProxyConnectionState implements types.MsgServer
+func (k *Keeper) ProxyConnectionState(goCtx context.Context, msg *types.MsgProxyConnectionState) (*types.MsgProxyConnectionStateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.ConnectionState(ctx, msg.ConnectionId, msg.UpstreamClientId, &msg.UpstreamPrefix, msg.Connection, msg.Proof, msg.ProofHeight)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgProxyConnectionStateResponse{}, nil
+}
+
 // ProxyConnectionOpenTry implements types.MsgServer
 func (k *Keeper) ProxyChannelOpenTry(goCtx context.Context, msg *types.MsgProxyChannelOpenTry) (*types.MsgProxyChannelOpenTryResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	err := k.ChanOpenTry(ctx, msg.UpstreamClientId, &msg.UpstreamPrefix, msg.Order, msg.ConnectionHops, msg.PortId, msg.ChannelId, msg.DownstreamPortId, msg.Version, msg.ProofInit, msg.ProofHeight)
+	err := k.ChanOpenTry(ctx, msg.UpstreamClientId, &msg.UpstreamPrefix, msg.Order, msg.ConnectionHops, msg.UpstreamHops, msg.PortId, msg.ChannelId, msg.DownstreamPortId, msg.Version, msg.ProofInit, msg.ProofHeight)
 	if err != nil {
 		return nil, err
 	}
@@ -115,7 +132,7 @@ func (k *Keeper) ProxyChannelOpenTry(goCtx context.Context, msg *types.MsgProxyC
 func (k *Keeper) ProxyChannelOpenAck(goCtx context.Context, msg *types.MsgProxyChannelOpenAck) (*types.MsgProxyChannelOpenAckResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	err := k.ChanOpenAck(ctx, msg.UpstreamClientId, &msg.UpstreamPrefix, msg.Order, msg.ConnectionHops, msg.PortId, msg.ChannelId, msg.DownstreamPortId, msg.DownstreamChannelId, msg.Version, msg.ProofTry, msg.ProofHeight)
+	err := k.ChanOpenAck(ctx, msg.UpstreamClientId, &msg.UpstreamPrefix, msg.Order, msg.ConnectionHops, msg.UpstreamHops, msg.PortId, msg.ChannelId, msg.DownstreamPortId, msg.DownstreamChannelId, msg.Version, msg.ProofTry, msg.ProofHeight)
 	if err != nil {
 		return nil, err
 	}
@@ -144,14 +161,47 @@ func (k *Keeper) ProxyChannelOpenFinalize(goCtx context.Context, msg *types.MsgP
 	return &types.MsgProxyChannelOpenFinalizeResponse{}, nil
 }
 
+// ProxyChannelCloseConfirm implements types.MsgServer
+func (k *Keeper) ProxyChannelCloseConfirm(goCtx context.Context, msg *types.MsgProxyChannelCloseConfirm) (*types.MsgProxyChannelCloseConfirmResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.ChanCloseConfirm(ctx, msg.UpstreamClientId, &msg.UpstreamPrefix, msg.PortId, msg.ChannelId, msg.ProofInit, msg.ProofHeight)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgProxyChannelCloseConfirmResponse{}, nil
+}
+
+// ProxyChannelState implements types.MsgServer
+func (k *Keeper) ProxyChannelState(goCtx context.Context, msg *types.MsgProxyChannelState) (*types.MsgProxyChannelStateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.ChannelState(ctx, msg.UpstreamClientId, &msg.UpstreamPrefix, msg.PortId, msg.ChannelId, msg.Channel, msg.UpstreamHops, msg.Proof, msg.ProofHeight)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgProxyChannelStateResponse{}, nil
+}
+
 // ProxyRecvPacket implements types.MsgServer
 func (k *Keeper) ProxyRecvPacket(goCtx context.Context, msg *types.MsgProxyRecvPacket) (*types.MsgProxyRecvPacketResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// the fees are paid only to the relayer that writes the packet commitment
+	proxied := k.hasProxiedPacket(ctx, &msg.UpstreamPrefix, msg.UpstreamClientId, types.PacketFromUpstream, msg.Packet.GetSourcePort(), msg.Packet.GetSourceChannel(), msg.Packet.GetSequence())
 	err := k.RecvPacket(ctx, msg.UpstreamClientId, &msg.UpstreamPrefix, msg.Packet, msg.Proof, msg.ProofHeight)
 	if err != nil {
 		return nil, err
 	}
+	relayer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if !proxied {
+		if err := k.DistributePacketFees(ctx, relayer, &msg.UpstreamPrefix, msg.UpstreamClientId, types.PacketFromUpstream, msg.Packet); err != nil {
+			return nil, err
+		}
+	}
 	return &types.MsgProxyRecvPacketResponse{}, nil
 }
 
@@ -159,9 +209,321 @@ func (k *Keeper) ProxyRecvPacket(goCtx context.Context, msg *types.MsgProxyRecvP
 func (k *Keeper) ProxyAcknowledgePacket(goCtx context.Context, msg *types.MsgProxyAcknowledgePacket) (*types.MsgProxyAcknowledgePacketResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// the fees are paid only to the relayer that writes the acknowledgement
+	proxied := k.hasProxiedPacket(ctx, &msg.UpstreamPrefix, msg.UpstreamClientId, types.PacketToUpstream, msg.Packet.GetDestPort(), msg.Packet.GetDestChannel(), msg.Packet.GetSequence())
 	err := k.AcknowledgePacket(ctx, msg.UpstreamClientId, &msg.UpstreamPrefix, msg.Packet, msg.Acknowledgement, msg.Proof, msg.ProofHeight)
 	if err != nil {
 		return nil, err
 	}
+	relayer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if !proxied {
+		if err := k.DistributePacketFees(ctx, relayer, &msg.UpstreamPrefix, msg.UpstreamClientId, types.PacketToUpstream, msg.Packet); err != nil {
+			return nil, err
+		}
+	}
 	return &types.MsgProxyAcknowledgePacketResponse{}, nil
 }
+
+// ProxyPacketBatch implements types.MsgServer
+func (k *Keeper) ProxyPacketBatch(goCtx context.Context, msg *types.MsgProxyPacketBatch) (*types.MsgProxyPacketBatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	directions := make([]types.PacketDirection, len(msg.Items))
+	proxied := make([]bool, len(msg.Items))
+	for i, item := range msg.Items {
+		directions[i] = types.PacketFromUpstream
+		if len(item.Acknowledgement) > 0 {
+			directions[i] = types.PacketToUpstream
+		}
+		portID, channelID, sequence := upstreamPacketID(item.Packet, directions[i])
+		proxied[i] = k.hasProxiedPacket(ctx, &msg.UpstreamPrefix, msg.UpstreamClientId, directions[i], portID, channelID, sequence)
+	}
+	results, err := k.PacketBatch(ctx, msg.UpstreamClientId, &msg.UpstreamPrefix, msg.Items, msg.BatchProof, msg.ProofHeight, msg.Atomic)
+	if err != nil {
+		return nil, err
+	}
+	relayer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	for i, result := range results {
+		// the fees of the failed items are left for another relayer, and the items proxied before earn nothing
+		if result.Code != 0 || proxied[i] {
+			continue
+		}
+		if err := k.DistributePacketFees(ctx, relayer, &msg.UpstreamPrefix, msg.UpstreamClientId, directions[i], msg.Items[i].Packet); err != nil {
+			return nil, err
+		}
+	}
+	return &types.MsgProxyPacketBatchResponse{Results: results}, nil
+}
+
+// ProxyTimeoutPacket implements types.MsgServer
+func (k *Keeper) ProxyTimeoutPacket(goCtx context.Context, msg *types.MsgProxyTimeoutPacket) (*types.MsgProxyTimeoutPacketResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.TimeoutPacket(ctx, msg.UpstreamClientId, &msg.UpstreamPrefix, msg.Packet, msg.ProofUnreceived, msg.ProofHeight, msg.NextSequenceRecv)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.RefundPacketFees(ctx, &msg.UpstreamPrefix, msg.UpstreamClientId, types.PacketToUpstream, msg.Packet.GetDestPort(), msg.Packet.GetDestChannel(), msg.Packet.GetSequence()); err != nil {
+		return nil, err
+	}
+	return &types.MsgProxyTimeoutPacketResponse{}, nil
+}
+
+// ProxyTimeoutOnClose implements types.MsgServer
+func (k *Keeper) ProxyTimeoutOnClose(goCtx context.Context, msg *types.MsgProxyTimeoutOnClose) (*types.MsgProxyTimeoutOnCloseResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.TimeoutOnClose(ctx, msg.UpstreamClientId, &msg.UpstreamPrefix, msg.Packet, msg.ProofUnreceived, msg.ProofClose, msg.ProofHeight, msg.NextSequenceRecv)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.RefundPacketFees(ctx, &msg.UpstreamPrefix, msg.UpstreamClientId, types.PacketToUpstream, msg.Packet.GetDestPort(), msg.Packet.GetDestChannel(), msg.Packet.GetSequence()); err != nil {
+		return nil, err
+	}
+	return &types.MsgProxyTimeoutOnCloseResponse{}, nil
+}
+
+// PruneProxyPacketCommitment implements types.MsgServer
+func (k *Keeper) PruneProxyPacketCommitment(goCtx context.Context, msg *types.MsgPruneProxyPacketCommitment) (*types.MsgPruneProxyPacketCommitmentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.VerifyAndPruneProxyPacketCommitment(ctx, msg.UpstreamClientId, &msg.UpstreamPrefix, msg.ProofHeight, msg.ProofAbsence, msg.PortId, msg.ChannelId, msg.Sequence); err != nil {
+		return nil, err
+	}
+	return &types.MsgPruneProxyPacketCommitmentResponse{}, nil
+}
+
+// ProxyWithHeader implements types.MsgServer
+func (k *Keeper) ProxyWithHeader(goCtx context.Context, msg *types.MsgProxyWithHeader) (*types.MsgProxyWithHeaderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	header, err := clienttypes.UnpackHeader(msg.Header)
+	if err != nil {
+		return nil, err
+	}
+	proxyMsg, err := msg.GetProxyMsg()
+	if err != nil {
+		return nil, err
+	}
+	upstreamClientID, proofHeight, err := getUpstreamProofTarget(proxyMsg)
+	if err != nil {
+		return nil, err
+	}
+	if upstreamClientID != msg.UpstreamClientId {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidProxyMsg, "upstream client mismatch: %s != %s", upstreamClientID, msg.UpstreamClientId)
+	}
+	if !proofHeight.EQ(header.GetHeight()) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidProxyMsg, "proof height must be the height of the header: %s != %s", proofHeight, header.GetHeight())
+	}
+
+	if err := k.UpdateUpstreamClient(ctx, msg.UpstreamClientId, header); err != nil {
+		return nil, err
+	}
+
+	var res proto.Message
+	switch m := proxyMsg.(type) {
+	case *types.MsgProxyClientState:
+		res, err = k.ProxyClientState(goCtx, m)
+	case *types.MsgProxyConnectionOpenTry:
+		res, err = k.ProxyConnectionOpenTry(goCtx, m)
+	case *types.MsgProxyConnectionOpenAck:
+		res, err = k.ProxyConnectionOpenAck(goCtx, m)
+	case *types.MsgProxyConnectionOpenConfirm:
+		res, err = k.ProxyConnectionOpenConfirm(goCtx, m)
+	case *types.MsgProxyConnectionOpenFinalize:
+		res, err = k.ProxyConnectionOpenFinalize(goCtx, m)
+	case *types.MsgProxyConnectionState:
+		res, err = k.ProxyConnectionState(goCtx, m)
+	case *types.MsgProxyChannelOpenTry:
+		res, err = k.ProxyChannelOpenTry(goCtx, m)
+	case *types.MsgProxyChannelOpenAck:
+		res, err = k.ProxyChannelOpenAck(goCtx, m)
+	case *types.MsgProxyChannelOpenConfirm:
+		res, err = k.ProxyChannelOpenConfirm(goCtx, m)
+	case *types.MsgProxyChannelOpenFinalize:
+		res, err = k.ProxyChannelOpenFinalize(goCtx, m)
+	case *types.MsgProxyChannelCloseConfirm:
+		res, err = k.ProxyChannelCloseConfirm(goCtx, m)
+	case *types.MsgProxyChannelState:
+		res, err = k.ProxyChannelState(goCtx, m)
+	case *types.MsgProxyRecvPacket:
+		res, err = k.ProxyRecvPacket(goCtx, m)
+	case *types.MsgProxyAcknowledgePacket:
+		res, err = k.ProxyAcknowledgePacket(goCtx, m)
+	case *types.MsgProxyPacketBatch:
+		res, err = k.ProxyPacketBatch(goCtx, m)
+	case *types.MsgProxyTimeoutPacket:
+		res, err = k.ProxyTimeoutPacket(goCtx, m)
+	case *types.MsgProxyTimeoutOnClose:
+		res, err = k.ProxyTimeoutOnClose(goCtx, m)
+	case *types.MsgPruneProxyPacketCommitment:
+		res, err = k.PruneProxyPacketCommitment(goCtx, m)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	anyRes, err := codectypes.NewAnyWithValue(res)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgProxyWithHeaderResponse{MsgResponse: anyRes}, nil
+}
+
+// getUpstreamProofTarget returns the upstream client ID and the proof height of the proxy message
+func getUpstreamProofTarget(msg sdk.Msg) (string, exported.Height, error) {
+	switch m := msg.(type) {
+	case *types.MsgProxyClientState:
+		return m.UpstreamClientId, m.ProofHeight, nil
+	case *types.MsgProxyConnectionOpenTry:
+		return getProxyClientUpstream(m.ProxyClientState, m.ProofHeight)
+	case *types.MsgProxyConnectionOpenAck:
+		return getProxyClientUpstream(m.ProxyClientState, m.ProofHeight)
+	case *types.MsgProxyConnectionOpenConfirm:
+		return m.UpstreamClientId, m.ProofHeight, nil
+	case *types.MsgProxyConnectionOpenFinalize:
+		return m.UpstreamClientId, m.ProofHeight, nil
+	case *types.MsgProxyConnectionState:
+		return m.UpstreamClientId, m.ProofHeight, nil
+	case *types.MsgProxyChannelOpenTry:
+		return m.UpstreamClientId, m.ProofHeight, nil
+	case *types.MsgProxyChannelOpenAck:
+		return m.UpstreamClientId, m.ProofHeight, nil
+	case *types.MsgProxyChannelOpenConfirm:
+		return m.UpstreamClientId, m.ProofHeight, nil
+	case *types.MsgProxyChannelOpenFinalize:
+		return m.UpstreamClientId, m.ProofHeight, nil
+	case *types.MsgProxyChannelCloseConfirm:
+		return m.UpstreamClientId, m.ProofHeight, nil
+	case *types.MsgProxyChannelState:
+		return m.UpstreamClientId, m.ProofHeight, nil
+	case *types.MsgProxyRecvPacket:
+		return m.UpstreamClientId, m.ProofHeight, nil
+	case *types.MsgProxyAcknowledgePacket:
+		return m.UpstreamClientId, m.ProofHeight, nil
+	case *types.MsgProxyPacketBatch:
+		return m.UpstreamClientId, m.ProofHeight, nil
+	case *types.MsgProxyTimeoutPacket:
+		return m.UpstreamClientId, m.ProofHeight, nil
+	case *types.MsgProxyTimeoutOnClose:
+		return m.UpstreamClientId, m.ProofHeight, nil
+	case *types.MsgPruneProxyPacketCommitment:
+		return m.UpstreamClientId, m.ProofHeight, nil
+	default:
+		return "", nil, sdkerrors.Wrapf(types.ErrInvalidProxyMsg, "unsupported proxy message: %T", msg)
+	}
+}
+
+// getProxyClientUpstream returns the upstream client ID of the proxy client state in the connection handshake
+func getProxyClientUpstream(anyClientState *codectypes.Any, proofHeight exported.Height) (string, exported.Height, error) {
+	clientState, err := clienttypes.UnpackClientState(anyClientState)
+	if err != nil {
+		return "", nil, err
+	}
+	proxyClientState, ok := clientState.(*proxytypes.ClientState)
+	if !ok {
+		return "", nil, sdkerrors.Wrapf(types.ErrInvalidProxyMsg, "invalid client type '%v'", clientState.ClientType())
+	}
+	return proxyClientState.UpstreamClientId, proofHeight, nil
+}
+
+// SubmitProxyMisbehaviour implements types.MsgServer
+func (k *Keeper) SubmitProxyMisbehaviour(goCtx context.Context, msg *types.MsgSubmitProxyMisbehaviour) (*types.MsgSubmitProxyMisbehaviourResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.CheckProxyMisbehaviourAndUpdateState(ctx, &msg.Misbehaviour); err != nil {
+		return nil, err
+	}
+	return &types.MsgSubmitProxyMisbehaviourResponse{}, nil
+}
+
+// PayProxyPacketFee implements types.MsgServer
+func (k *Keeper) PayProxyPacketFee(goCtx context.Context, msg *types.MsgPayProxyPacketFee) (*types.MsgPayProxyPacketFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	packetFee := types.NewPacketFee(msg.Fee, msg.Signer)
+	if err := k.EscrowPacketFee(ctx, &msg.UpstreamPrefix, msg.UpstreamClientId, msg.Direction, msg.PortId, msg.ChannelId, msg.Sequence, packetFee); err != nil {
+		return nil, err
+	}
+	return &types.MsgPayProxyPacketFeeResponse{}, nil
+}
+
+// RegisterUpstream implements types.MsgServer
+func (k *Keeper) RegisterUpstream(goCtx context.Context, msg *types.MsgRegisterUpstream) (*types.MsgRegisterUpstreamResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != k.authority {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "expected %s, got %s", k.authority, msg.Authority)
+	}
+	if err := k.AddUpstream(ctx, msg.UpstreamClientId); err != nil {
+		return nil, err
+	}
+	return &types.MsgRegisterUpstreamResponse{}, nil
+}
+
+// DeregisterUpstream implements types.MsgServer
+func (k *Keeper) DeregisterUpstream(goCtx context.Context, msg *types.MsgDeregisterUpstream) (*types.MsgDeregisterUpstreamResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != k.authority {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "expected %s, got %s", k.authority, msg.Authority)
+	}
+	if err := k.RemoveUpstream(ctx, msg.UpstreamClientId); err != nil {
+		return nil, err
+	}
+	return &types.MsgDeregisterUpstreamResponse{}, nil
+}
+
+// PauseProxy implements types.MsgServer
+func (k *Keeper) PauseProxy(goCtx context.Context, msg *types.MsgPauseProxy) (*types.MsgPauseProxyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.isPauseAuthorized(ctx, msg.Signer) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is neither the authority nor the guardian", msg.Signer)
+	}
+	if err := k.AddPausedProxy(ctx, types.NewPausedProxy(msg.UpstreamClientId, msg.PortId, msg.ChannelId), msg.Signer); err != nil {
+		return nil, err
+	}
+	return &types.MsgPauseProxyResponse{}, nil
+}
+
+// UnpauseProxy implements types.MsgServer
+func (k *Keeper) UnpauseProxy(goCtx context.Context, msg *types.MsgUnpauseProxy) (*types.MsgUnpauseProxyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pausedProxy, found := k.GetPausedProxy(ctx, msg.UpstreamClientId, msg.PortId, msg.ChannelId)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrProxyNotPaused, "upstream client: %s, port: %s, channel: %s", msg.UpstreamClientId, msg.PortId, msg.ChannelId)
+	}
+	if !k.isUnpauseAuthorized(ctx, msg.Signer, pausedProxy) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is neither the authority nor the guardian that paused it", msg.Signer)
+	}
+	if err := k.RemovePausedProxy(ctx, pausedProxy); err != nil {
+		return nil, err
+	}
+	return &types.MsgUnpauseProxyResponse{}, nil
+}
+
+// MigrateProxyClient implements types.MsgServer
+func (k *Keeper) MigrateProxyClient(goCtx context.Context, msg *types.MsgMigrateProxyClient) (*types.MsgMigrateProxyClientResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != k.authority {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "expected %s, got %s", k.authority, msg.Authority)
+	}
+	if err := k.migrateClientWithAnys(
+		ctx, msg.ClientId, msg.NewClientId, msg.UpstreamClientState, msg.NewUpstreamClientState,
+		msg.UpstreamConsensusState, msg.UpstreamConsensusHeight,
+		msg.ProofUpstreamClient, msg.ProofUpstreamConsensus, msg.ProofHeight,
+		msg.ProofNewUpstreamClient, msg.ProofNewUpstreamConsensus, msg.NewProofHeight,
+	); err != nil {
+		return nil, err
+	}
+	return &types.MsgMigrateProxyClientResponse{}, nil
+}
@@ -0,0 +1,110 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/modules/core/24-host"
+	"github.com/cosmos/ibc-go/modules/core/exported"
+
+	"github.com/datachainlab/ibc-proxy/modules/proxy/types"
+	ibctesting "github.com/datachainlab/ibc-proxy/testing"
+	"github.com/datachainlab/ibc-proxy/testing/simapp"
+)
+
+// A(C) -> B, B -> A
+// A: downstream, B: upstream, C: proxy
+func (suite *KeeperTestSuite) TestProxyWithHeader() {
+	suite.Require().NoError(suite.coordinator.IncrementClientSequence(suite.chainC, suite.chainB, exported.Tendermint, 1))
+	suite.Require().NoError(suite.coordinator.IncrementClientSequence(suite.chainB, suite.chainA, exported.Tendermint, 2))
+
+	clientCB, err := suite.coordinator.CreateClient2(suite.chainC, suite.chainB, exported.Tendermint, false, 0)
+	suite.Require().NoError(err)
+
+	clientBA, err := suite.coordinator.CreateMultiVClient(suite.chainB, suite.chainA, exported.Tendermint, 0)
+	suite.Require().NoError(err)
+
+	clientAC, err := suite.coordinator.CreateProxyClient(suite.chainA, suite.chainC, exported.Tendermint, clientCB)
+	suite.Require().NoError(err)
+
+	ppair := ibctesting.ProxyPair{{Chain: suite.chainC, ClientID: clientAC, UpstreamClientID: clientCB, UpstreamPrefix: suite.chainB.GetPrefix()}, nil}
+	connA, connB := suite.coordinator.CreateConnectionWithProxy(suite.chainA, suite.chainB, clientAC, clientBA, ibctesting.TransferVersion, ppair)
+	chanA, chanB := suite.coordinator.CreateChannelWithProxy(suite.chainA, suite.chainB, connA, connB, ibctesting.TransferPort, ibctesting.TransferPort, channeltypes.UNORDERED, ppair)
+
+	// B sends two packets to A in a block
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	timeoutHeight := clienttypes.NewHeight(0, 110)
+	msg := transfertypes.NewMsgTransfer(chanB.PortID, chanB.ID, coin, suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), timeoutHeight, 0)
+	_, err = suite.chainB.SendMsgs(msg, msg)
+	suite.Require().NoError(err)
+	fungibleTokenPacket := transfertypes.NewFungibleTokenPacketData(coin.Denom, coin.Amount.Uint64(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String())
+	packet1 := channeltypes.NewPacket(fungibleTokenPacket.GetBytes(), 1, chanB.PortID, chanB.ID, chanA.PortID, chanA.ID, timeoutHeight, 0)
+	packet2 := channeltypes.NewPacket(fungibleTokenPacket.GetBytes(), 2, chanB.PortID, chanB.ID, chanA.PortID, chanA.ID, timeoutHeight, 0)
+
+	// the client on C doesn't know the height of the proofs yet
+	suite.coordinator.CommitBlock(suite.chainB)
+	header, err := suite.chainC.ConstructUpdateTMClientHeader(suite.chainB, clientCB)
+	suite.Require().NoError(err)
+	proof1, proofHeight := suite.chainB.QueryProof(host.PacketCommitmentKey(packet1.GetSourcePort(), packet1.GetSourceChannel(), packet1.GetSequence()))
+	proof2, _ := suite.chainB.QueryProof(host.PacketCommitmentKey(packet2.GetSourcePort(), packet2.GetSourceChannel(), packet2.GetSequence()))
+	suite.Require().Equal(header.GetHeight(), proofHeight)
+	_, found := suite.chainC.App.GetIBCKeeper().ClientKeeper.GetClientConsensusState(suite.chainC.GetContext(), clientCB, proofHeight)
+	suite.Require().False(found)
+
+	prefix := suite.chainB.GetPrefix()
+	signer := suite.chainC.SenderAccount.GetAddress().String()
+	recvPacket := func(packet channeltypes.Packet, proof []byte, proofHeight clienttypes.Height) *types.MsgProxyRecvPacket {
+		return &types.MsgProxyRecvPacket{UpstreamClientId: clientCB, UpstreamPrefix: prefix, Packet: packet, Proof: proof, ProofHeight: proofHeight, Signer: signer}
+	}
+	proxyKeeper := suite.chainC.App.(*simapp.SimApp).IBCProxyKeeper
+
+	// invalid messages
+	for _, tc := range []struct {
+		upstreamClientID string
+		msg              sdk.Msg
+	}{
+		{clientCB, recvPacket(packet1, proof1, proofHeight.Increment().(clienttypes.Height))},
+		{"07-tendermint-100", recvPacket(packet1, proof1, proofHeight)},
+		{clientCB, types.NewMsgRegisterUpstream(signer, clientCB)},
+	} {
+		msg, err := types.NewMsgProxyWithHeader(tc.upstreamClientID, header, tc.msg, signer)
+		suite.Require().NoError(err)
+		cacheCtx, _ := suite.chainC.GetContext().CacheContext()
+		_, err = proxyKeeper.ProxyWithHeader(sdk.WrapSDKContext(cacheCtx), msg)
+		suite.Require().Error(err)
+	}
+
+	// the proxy message must be signed by the signer of the message
+	otherSigner := suite.chainA.SenderAccount.GetAddress().String()
+	msgWithHeader, err := types.NewMsgProxyWithHeader(clientCB, header, recvPacket(packet1, proof1, proofHeight), otherSigner)
+	suite.Require().NoError(err)
+	suite.Require().ErrorIs(msgWithHeader.ValidateBasic(), sdkerrors.ErrUnauthorized)
+
+	// C updates the client and proxies the packet in a transaction
+	msgWithHeader, err = types.NewMsgProxyWithHeader(clientCB, header, recvPacket(packet1, proof1, proofHeight), signer)
+	suite.Require().NoError(err)
+	suite.Require().NoError(msgWithHeader.ValidateBasic())
+	res, err := suite.chainC.SendMsgs(msgWithHeader)
+	suite.Require().NoError(err)
+	var txMsgData sdk.TxMsgData
+	suite.Require().NoError(suite.chainC.App.AppCodec().Unmarshal(res.Data, &txMsgData))
+	var resWithHeader types.MsgProxyWithHeaderResponse
+	suite.Require().NoError(suite.chainC.App.AppCodec().Unmarshal(txMsgData.Data[0].Data, &resWithHeader))
+	suite.Require().Equal("/ibc.proxy.v1.MsgProxyRecvPacketResponse", resWithHeader.MsgResponse.TypeUrl)
+
+	ctx := suite.chainC.GetContext()
+	_, found = suite.chainC.App.GetIBCKeeper().ClientKeeper.GetClientConsensusState(ctx, clientCB, proofHeight)
+	suite.Require().True(found)
+	_, found = proxyKeeper.GetProxyPacketCommitment(ctx, &prefix, clientCB, packet1.GetSourcePort(), packet1.GetSourceChannel(), packet1.GetSequence())
+	suite.Require().True(found)
+
+	// another relayer submits the same header, which has already been applied
+	msgWithHeader, err = types.NewMsgProxyWithHeader(clientCB, header, recvPacket(packet2, proof2, proofHeight), signer)
+	suite.Require().NoError(err)
+	_, err = suite.chainC.SendMsgs(msgWithHeader)
+	suite.Require().NoError(err)
+	_, found = proxyKeeper.GetProxyPacketCommitment(suite.chainC.GetContext(), &prefix, clientCB, packet2.GetSourcePort(), packet2.GetSourceChannel(), packet2.GetSequence())
+	suite.Require().True(found)
+}
@@ -3,9 +3,12 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
 	"github.com/cosmos/ibc-go/modules/core/exported"
+
+	"github.com/datachainlab/ibc-proxy/modules/proxy/types"
 )
 
 // upstream: chainA, downstream: chainB
@@ -16,6 +19,23 @@ func (k Keeper) RecvPacket(
 	packet exported.PacketI, // packet
 	proof []byte, // proof that chanA stored packet in state
 	proofHeight exported.Height, // height at which relayer constructs proof of chainA storing packet in state
+) error {
+	targetClient, err := k.getUpstreamClientState(ctx, upstreamClientID)
+	if err != nil {
+		return err
+	}
+	return k.recvPacket(ctx, targetClient, upstreamClientID, upstreamPrefix, packet, proof, proofHeight)
+}
+
+// recvPacket is the same as RecvPacket except that it uses the given client state of the upstream
+func (k Keeper) recvPacket(
+	ctx sdk.Context,
+	targetClient exported.ClientState,
+	upstreamClientID string,
+	upstreamPrefix exported.Prefix,
+	packet exported.PacketI,
+	proof []byte,
+	proofHeight exported.Height,
 ) error {
 	channel, found := k.GetProxyChannel(ctx, upstreamPrefix, upstreamClientID, packet.GetSourcePort(), packet.GetSourceChannel())
 	if !found {
@@ -45,8 +65,9 @@ func (k Keeper) RecvPacket(
 		return sdkerrors.Wrap(connectiontypes.ErrConnectionNotFound, channel.ConnectionHops[0])
 	}
 
-	if err := k.VerifyAndProxyPacketCommitment(
+	if err := k.verifyAndProxyPacketCommitment(
 		ctx,
+		targetClient,
 		upstreamClientID,
 		upstreamPrefix,
 		connectionEnd,
@@ -57,7 +78,7 @@ func (k Keeper) RecvPacket(
 		return err
 	}
 
-	return nil
+	return ctx.EventManager().EmitTypedEvent(types.NewEventProxyRecvPacket(upstreamClientID, upstreamPrefix, packet, channel.ConnectionHops[0], proofHeight))
 }
 
 // upstream: chainA, downstream: chainB
@@ -69,6 +90,24 @@ func (k Keeper) AcknowledgePacket(
 	acknowledgement []byte, // ack
 	proof []byte, // proof that chanA stored packet in state
 	proofHeight exported.Height, // height at which relayer constructs proof of chainA storing packet in state
+) error {
+	targetClient, err := k.getUpstreamClientState(ctx, upstreamClientID)
+	if err != nil {
+		return err
+	}
+	return k.acknowledgePacket(ctx, targetClient, upstreamClientID, upstreamPrefix, packet, acknowledgement, proof, proofHeight)
+}
+
+// acknowledgePacket is the same as AcknowledgePacket except that it uses the given client state of the upstream
+func (k Keeper) acknowledgePacket(
+	ctx sdk.Context,
+	targetClient exported.ClientState,
+	upstreamClientID string,
+	upstreamPrefix exported.Prefix,
+	packet exported.PacketI,
+	acknowledgement []byte,
+	proof []byte,
+	proofHeight exported.Height,
 ) error {
 	channel, found := k.GetProxyChannel(ctx, upstreamPrefix, upstreamClientID, packet.GetDestPort(), packet.GetDestChannel())
 	if !found {
@@ -86,8 +125,9 @@ func (k Keeper) AcknowledgePacket(
 		return sdkerrors.Wrap(connectiontypes.ErrConnectionNotFound, channel.ConnectionHops[0])
 	}
 
-	if err := k.VerifyAndProxyPacketAcknowledgement(
+	if err := k.verifyAndProxyPacketAcknowledgement(
 		ctx,
+		targetClient,
 		upstreamClientID,
 		upstreamPrefix,
 		connectionEnd,
@@ -98,5 +138,152 @@ func (k Keeper) AcknowledgePacket(
 		return err
 	}
 
-	return nil
+	return ctx.EventManager().EmitTypedEvent(types.NewEventProxyAcknowledgePacket(upstreamClientID, upstreamPrefix, packet, channel.ConnectionHops[0], proofHeight))
+}
+
+// upstream: chainA, downstream: chainB
+func (k Keeper) TimeoutPacket(
+	ctx sdk.Context,
+	upstreamClientID string, // the client ID corresponding to light client for chainA on chainB
+	upstreamPrefix exported.Prefix, // store prefix on chainA
+	packet exported.PacketI, // packet sent from chainB to chainA
+	proof []byte, // proof that chainA didn't receive the packet
+	proofHeight exported.Height, // height at which relayer constructs proof of chainA not receiving the packet
+	nextSequenceRecv uint64, // the next sequence receive of chainA's channel
+) error {
+	channel, connectionEnd, err := k.getPacketDestination(ctx, upstreamClientID, upstreamPrefix, packet)
+	if err != nil {
+		return err
+	}
+
+	// check that timeout height or timeout timestamp has passed on chainA
+	consensusState, found := k.clientKeeper.GetClientConsensusState(ctx, upstreamClientID, proofHeight)
+	if !found {
+		return sdkerrors.Wrapf(
+			clienttypes.ErrConsensusStateNotFound,
+			"clientID (%s), height (%s)", upstreamClientID, proofHeight,
+		)
+	}
+	proofTimestamp := consensusState.GetTimestamp()
+
+	timeoutHeight := packet.GetTimeoutHeight()
+	if (timeoutHeight.IsZero() || proofHeight.LT(timeoutHeight)) &&
+		(packet.GetTimeoutTimestamp() == 0 || proofTimestamp < packet.GetTimeoutTimestamp()) {
+		return sdkerrors.Wrap(channeltypes.ErrPacketTimeout, "packet timeout has not been reached for height or timestamp")
+	}
+
+	if err := k.verifyAndProxyPacketUnreceived(ctx, upstreamClientID, upstreamPrefix, channel, connectionEnd, packet, proof, proofHeight, nextSequenceRecv); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(types.NewEventProxyTimeoutPacket(upstreamClientID, upstreamPrefix, packet, channel.ConnectionHops[0], proofHeight))
+}
+
+// upstream: chainA, downstream: chainB
+func (k Keeper) TimeoutOnClose(
+	ctx sdk.Context,
+	upstreamClientID string, // the client ID corresponding to light client for chainA on chainB
+	upstreamPrefix exported.Prefix, // store prefix on chainA
+	packet exported.PacketI, // packet sent from chainB to chainA
+	proof []byte, // proof that chainA didn't receive the packet
+	proofClosed []byte, // proof that chainA stored the CLOSED channel in state
+	proofHeight exported.Height, // height at which relayer constructs proofs of chainA
+	nextSequenceRecv uint64, // the next sequence receive of chainA's channel
+) error {
+	channel, connectionEnd, err := k.getPacketDestination(ctx, upstreamClientID, upstreamPrefix, packet)
+	if err != nil {
+		return err
+	}
+
+	channel.State = channeltypes.CLOSED
+
+	if err := k.VerifyAndProxyChannelState(
+		ctx, upstreamClientID, upstreamPrefix,
+		proofHeight, proofClosed,
+		packet.GetDestPort(), packet.GetDestChannel(), channel,
+	); err != nil {
+		return err
+	}
+
+	if err := k.verifyAndProxyPacketUnreceived(ctx, upstreamClientID, upstreamPrefix, channel, connectionEnd, packet, proof, proofHeight, nextSequenceRecv); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(types.NewEventProxyTimeoutOnClose(upstreamClientID, upstreamPrefix, packet, channel.ConnectionHops[0], proofHeight))
+}
+
+// getPacketDestination returns the channel and connection of chainA that the packet is sent to
+func (k Keeper) getPacketDestination(
+	ctx sdk.Context,
+	upstreamClientID string,
+	upstreamPrefix exported.Prefix,
+	packet exported.PacketI,
+) (channeltypes.Channel, connectiontypes.ConnectionEnd, error) {
+	channel, found := k.GetProxyChannel(ctx, upstreamPrefix, upstreamClientID, packet.GetDestPort(), packet.GetDestChannel())
+	if !found {
+		return channel, connectiontypes.ConnectionEnd{}, sdkerrors.Wrapf(
+			channeltypes.ErrChannelNotFound,
+			"port ID (%s) channel ID (%s)", packet.GetDestPort(), packet.GetDestChannel(),
+		)
+	}
+
+	// packet must come from the channel's counterparty
+	if packet.GetSourcePort() != channel.Counterparty.PortId {
+		return channel, connectiontypes.ConnectionEnd{}, sdkerrors.Wrapf(
+			channeltypes.ErrInvalidPacket,
+			"packet source port doesn't match the counterparty's port (%s ≠ %s)", packet.GetSourcePort(), channel.Counterparty.PortId,
+		)
+	}
+
+	if packet.GetSourceChannel() != channel.Counterparty.ChannelId {
+		return channel, connectiontypes.ConnectionEnd{}, sdkerrors.Wrapf(
+			channeltypes.ErrInvalidPacket,
+			"packet source channel doesn't match the counterparty's channel (%s ≠ %s)", packet.GetSourceChannel(), channel.Counterparty.ChannelId,
+		)
+	}
+
+	connectionEnd, found := k.GetProxyConnection(ctx, upstreamPrefix, upstreamClientID, channel.ConnectionHops[0])
+	if !found {
+		return channel, connectionEnd, sdkerrors.Wrap(connectiontypes.ErrConnectionNotFound, channel.ConnectionHops[0])
+	}
+
+	return channel, connectionEnd, nil
+}
+
+// verifyAndProxyPacketUnreceived verifies that chainA hasn't received the packet and proxies the proof of it.
+// An ORDERED channel proves it with the next sequence receive, and an UNORDERED channel with the absence of the packet receipt.
+func (k Keeper) verifyAndProxyPacketUnreceived(
+	ctx sdk.Context,
+	upstreamClientID string,
+	upstreamPrefix exported.Prefix,
+	channel channeltypes.Channel,
+	connectionEnd connectiontypes.ConnectionEnd,
+	packet exported.PacketI,
+	proof []byte,
+	proofHeight exported.Height,
+	nextSequenceRecv uint64,
+) error {
+	switch channel.Ordering {
+	case channeltypes.ORDERED:
+		// check that packet has not been received
+		if nextSequenceRecv > packet.GetSequence() {
+			return sdkerrors.Wrapf(
+				channeltypes.ErrPacketReceived,
+				"packet already received, next sequence receive > packet sequence (%d > %d)", nextSequenceRecv, packet.GetSequence(),
+			)
+		}
+		return k.VerifyAndProxyNextSequenceRecv(
+			ctx, upstreamClientID, upstreamPrefix, connectionEnd,
+			proofHeight, proof,
+			packet.GetDestPort(), packet.GetDestChannel(), nextSequenceRecv,
+		)
+	case channeltypes.UNORDERED:
+		return k.VerifyAndProxyPacketReceiptAbsence(
+			ctx, upstreamClientID, upstreamPrefix, connectionEnd,
+			proofHeight, proof,
+			packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(),
+		)
+	default:
+		return sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, channel.Ordering.String())
+	}
 }
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/modules/core/exported"
+
+	"github.com/datachainlab/ibc-proxy/modules/proxy/types"
+)
+
+// GetParams returns the total set of proxy parameters.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	var params types.Params
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of proxy parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// IsUpstreamAllowed returns true if the proxy may serve the upstream client
+func (k Keeper) IsUpstreamAllowed(ctx sdk.Context, upstreamClientID string) bool {
+	return k.GetParams(ctx).IsUpstreamAllowed(upstreamClientID)
+}
+
+// AddUpstream adds the upstream client to the allowlist
+func (k Keeper) AddUpstream(ctx sdk.Context, upstreamClientID string) error {
+	params := k.GetParams(ctx)
+	for _, id := range params.AllowedUpstreamClients {
+		if id == upstreamClientID {
+			return sdkerrors.Wrap(types.ErrUpstreamAlreadyRegistered, upstreamClientID)
+		}
+	}
+	params.AllowedUpstreamClients = append(params.AllowedUpstreamClients, upstreamClientID)
+	k.SetParams(ctx, params)
+	return ctx.EventManager().EmitTypedEvent(&types.EventRegisterUpstream{UpstreamClientId: upstreamClientID})
+}
+
+// RemoveUpstream removes the upstream client from the allowlist
+func (k Keeper) RemoveUpstream(ctx sdk.Context, upstreamClientID string) error {
+	params := k.GetParams(ctx)
+	for i, id := range params.AllowedUpstreamClients {
+		if id != upstreamClientID {
+			continue
+		}
+		params.AllowedUpstreamClients = append(params.AllowedUpstreamClients[:i:i], params.AllowedUpstreamClients[i+1:]...)
+		k.SetParams(ctx, params)
+		return ctx.EventManager().EmitTypedEvent(&types.EventDeregisterUpstream{UpstreamClientId: upstreamClientID})
+	}
+	return sdkerrors.Wrap(types.ErrUpstreamNotRegistered, upstreamClientID)
+}
+
+// getUpstreamClientState returns the client state of the upstream if the proxy is allowed to serve it
+func (k Keeper) getUpstreamClientState(ctx sdk.Context, upstreamClientID string) (exported.ClientState, error) {
+	if !k.IsUpstreamAllowed(ctx, upstreamClientID) {
+		return nil, sdkerrors.Wrap(types.ErrUpstreamNotAllowed, upstreamClientID)
+	}
+	clientState, found := k.clientKeeper.GetClientState(ctx, upstreamClientID)
+	if !found {
+		return nil, sdkerrors.Wrap(clienttypes.ErrClientNotFound, upstreamClientID)
+	}
+	return clientState, nil
+}
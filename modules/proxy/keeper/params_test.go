@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/modules/core/24-host"
+	"github.com/cosmos/ibc-go/modules/core/exported"
+
+	"github.com/datachainlab/ibc-proxy/modules/proxy/types"
+	ibctesting "github.com/datachainlab/ibc-proxy/testing"
+	"github.com/datachainlab/ibc-proxy/testing/simapp"
+)
+
+// A(C) -> B, B -> A
+// A: downstream, B: upstream, C: proxy
+func (suite *KeeperTestSuite) TestUpstreamAllowlist() {
+	suite.Require().NoError(suite.coordinator.IncrementClientSequence(suite.chainC, suite.chainB, exported.Tendermint, 1))
+	suite.Require().NoError(suite.coordinator.IncrementClientSequence(suite.chainB, suite.chainA, exported.Tendermint, 2))
+
+	clientCB, err := suite.coordinator.CreateClient2(suite.chainC, suite.chainB, exported.Tendermint, false, 0)
+	suite.Require().NoError(err)
+
+	clientBA, err := suite.coordinator.CreateMultiVClient(suite.chainB, suite.chainA, exported.Tendermint, 0)
+	suite.Require().NoError(err)
+
+	clientAC, err := suite.coordinator.CreateProxyClient(suite.chainA, suite.chainC, exported.Tendermint, clientCB)
+	suite.Require().NoError(err)
+
+	proxyKeeper := suite.chainC.App.(*simapp.SimApp).IBCProxyKeeper
+	authority := proxyKeeper.GetAuthority()
+	suite.Require().Equal(types.DefaultParams(), proxyKeeper.GetParams(suite.chainC.GetContext()))
+
+	// only the authority can change the allowlist
+	_, err = proxyKeeper.RegisterUpstream(sdk.WrapSDKContext(suite.chainC.GetContext()), types.NewMsgRegisterUpstream(suite.chainC.SenderAccount.GetAddress().String(), clientCB))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// the upstream isn't registered yet
+	ctx := suite.chainC.GetContext()
+	proxyKeeper.SetParams(ctx, types.NewParams(types.UpstreamModeAllowlist, nil, 0, types.DefaultMaxExpectedTimePerBlock, ""))
+	err = proxyKeeper.VerifyConnectionState(ctx, clientCB, suite.chainB.GetPrefix(), connectiontypes.ConnectionEnd{}, clienttypes.NewHeight(0, 1), []byte("proof"), "connection-0")
+	suite.Require().ErrorIs(err, types.ErrUpstreamNotAllowed)
+
+	_, err = proxyKeeper.RegisterUpstream(sdk.WrapSDKContext(ctx), types.NewMsgRegisterUpstream(authority, clientCB))
+	suite.Require().NoError(err)
+	_, err = proxyKeeper.RegisterUpstream(sdk.WrapSDKContext(ctx), types.NewMsgRegisterUpstream(authority, clientCB))
+	suite.Require().ErrorIs(err, types.ErrUpstreamAlreadyRegistered)
+	suite.Require().True(proxyKeeper.IsUpstreamAllowed(ctx, clientCB))
+
+	// the registered upstream can be proxied
+	ppair := ibctesting.ProxyPair{{Chain: suite.chainC, ClientID: clientAC, UpstreamClientID: clientCB, UpstreamPrefix: suite.chainB.GetPrefix()}, nil}
+	connA, connB := suite.coordinator.CreateConnectionWithProxy(suite.chainA, suite.chainB, clientAC, clientBA, ibctesting.TransferVersion, ppair)
+	chanA, chanB := suite.coordinator.CreateChannelWithProxy(suite.chainA, suite.chainB, connA, connB, ibctesting.TransferPort, ibctesting.TransferPort, channeltypes.UNORDERED, ppair)
+	suite.testHandleMsgTransfer(connA, connB, chanA, chanB, ppair)
+
+	ctx = suite.chainC.GetContext()
+	_, err = proxyKeeper.DeregisterUpstream(sdk.WrapSDKContext(ctx), types.NewMsgDeregisterUpstream(authority, clientCB))
+	suite.Require().NoError(err)
+	_, err = proxyKeeper.DeregisterUpstream(sdk.WrapSDKContext(ctx), types.NewMsgDeregisterUpstream(authority, clientCB))
+	suite.Require().ErrorIs(err, types.ErrUpstreamNotRegistered)
+
+	connection := suite.chainB.GetConnection(connB)
+	proof, proofHeight := suite.chainB.QueryProof(host.ConnectionKey(connB.ID))
+	err = proxyKeeper.VerifyConnectionState(ctx, clientCB, suite.chainB.GetPrefix(), connection, proofHeight, proof, connB.ID)
+	suite.Require().ErrorIs(err, types.ErrUpstreamNotAllowed)
+
+	// the open mode allows every upstream again
+	proxyKeeper.SetParams(ctx, types.DefaultParams())
+	suite.Require().True(proxyKeeper.IsUpstreamAllowed(ctx, clientCB))
+}
+
+func (suite *KeeperTestSuite) TestUpstreamProposals() {
+	clientCB, err := suite.coordinator.CreateClient2(suite.chainC, suite.chainB, exported.Tendermint, false, 0)
+	suite.Require().NoError(err)
+
+	app := suite.chainC.App.(*simapp.SimApp)
+	proxyKeeper := app.IBCProxyKeeper
+	ctx := suite.chainC.GetContext()
+	proxyKeeper.SetParams(ctx, types.NewParams(types.UpstreamModeAllowlist, nil, 0, types.DefaultMaxExpectedTimePerBlock, ""))
+
+	// the proposals are routed to the proxy module
+	_, err = app.GovKeeper.SubmitProposal(ctx, types.NewRegisterUpstreamProposal("title", "description", clientCB))
+	suite.Require().NoError(err)
+
+	handler := app.GovKeeper.Router().GetRoute(types.RouterKey)
+	suite.Require().NoError(handler(ctx, types.NewRegisterUpstreamProposal("title", "description", clientCB)))
+	suite.Require().True(proxyKeeper.IsUpstreamAllowed(ctx, clientCB))
+	var evRegister types.EventRegisterUpstream
+	suite.Require().NoError(ibctesting.ParseProxyEventFromEvents(ctx.EventManager().Events(), &evRegister))
+	suite.Require().Equal(types.EventRegisterUpstream{UpstreamClientId: clientCB}, evRegister)
+	suite.Require().ErrorIs(handler(ctx, types.NewRegisterUpstreamProposal("title", "description", clientCB)), types.ErrUpstreamAlreadyRegistered)
+
+	suite.Require().NoError(handler(ctx, types.NewDeregisterUpstreamProposal("title", "description", clientCB)))
+	suite.Require().False(proxyKeeper.IsUpstreamAllowed(ctx, clientCB))
+	suite.Require().ErrorIs(handler(ctx, types.NewDeregisterUpstreamProposal("title", "description", clientCB)), types.ErrUpstreamNotRegistered)
+
+	suite.Require().ErrorIs(handler(ctx, clienttypes.NewClientUpdateProposal("title", "description", clientCB, clientCB)), sdkerrors.ErrUnknownRequest)
+}
@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/datachainlab/ibc-proxy/modules/proxy/types"
+)
+
+// IsProxyPaused returns true if proxying is paused for the upstream client, or for the port and channel of the upstream if they are given.
+// It doesn't take into account the pause of the whole upstream when a port and channel are given.
+func (k Keeper) IsProxyPaused(ctx sdk.Context, upstreamClientID, portID, channelID string) bool {
+	return ctx.KVStore(k.proxyStoreKey).Has(types.PausedProxyKey(upstreamClientID, portID, channelID))
+}
+
+// GetPausedProxy returns the pause of proxying for the upstream client, or for the port and channel of the upstream if they are given
+func (k Keeper) GetPausedProxy(ctx sdk.Context, upstreamClientID, portID, channelID string) (types.PausedProxy, bool) {
+	bz := ctx.KVStore(k.proxyStoreKey).Get(types.PausedProxyKey(upstreamClientID, portID, channelID))
+	if bz == nil {
+		return types.PausedProxy{}, false
+	}
+	var pausedProxy types.PausedProxy
+	k.cdc.MustUnmarshal(bz, &pausedProxy)
+	return pausedProxy, true
+}
+
+// AddPausedProxy pauses proxying for the upstream client or the channel of it, recording the signer that paused it
+func (k Keeper) AddPausedProxy(ctx sdk.Context, pausedProxy types.PausedProxy, signer string) error {
+	pausedProxy.Signer = signer
+	if err := pausedProxy.Validate(); err != nil {
+		return err
+	}
+	if k.IsProxyPaused(ctx, pausedProxy.UpstreamClientId, pausedProxy.PortId, pausedProxy.ChannelId) {
+		return sdkerrors.Wrapf(types.ErrProxyPaused, "upstream client: %s, port: %s, channel: %s", pausedProxy.UpstreamClientId, pausedProxy.PortId, pausedProxy.ChannelId)
+	}
+	k.SetPausedProxy(ctx, pausedProxy)
+	return ctx.EventManager().EmitTypedEvent(&types.EventPauseProxy{
+		UpstreamClientId: pausedProxy.UpstreamClientId,
+		PortId:           pausedProxy.PortId,
+		ChannelId:        pausedProxy.ChannelId,
+		Signer:           signer,
+	})
+}
+
+// SetPausedProxy stores the pause of proxying for the upstream client or the channel of it
+func (k Keeper) SetPausedProxy(ctx sdk.Context, pausedProxy types.PausedProxy) {
+	ctx.KVStore(k.proxyStoreKey).Set(types.PausedProxyKey(pausedProxy.UpstreamClientId, pausedProxy.PortId, pausedProxy.ChannelId), k.cdc.MustMarshal(&pausedProxy))
+}
+
+// RemovePausedProxy resumes proxying for the upstream client or the channel of it
+func (k Keeper) RemovePausedProxy(ctx sdk.Context, pausedProxy types.PausedProxy) error {
+	if !k.IsProxyPaused(ctx, pausedProxy.UpstreamClientId, pausedProxy.PortId, pausedProxy.ChannelId) {
+		return sdkerrors.Wrapf(types.ErrProxyNotPaused, "upstream client: %s, port: %s, channel: %s", pausedProxy.UpstreamClientId, pausedProxy.PortId, pausedProxy.ChannelId)
+	}
+	ctx.KVStore(k.proxyStoreKey).Delete(types.PausedProxyKey(pausedProxy.UpstreamClientId, pausedProxy.PortId, pausedProxy.ChannelId))
+	return ctx.EventManager().EmitTypedEvent(&types.EventUnpauseProxy{
+		UpstreamClientId: pausedProxy.UpstreamClientId,
+		PortId:           pausedProxy.PortId,
+		ChannelId:        pausedProxy.ChannelId,
+	})
+}
+
+// GetAllPausedProxies returns all the upstream clients and channels for which proxying is paused
+func (k Keeper) GetAllPausedProxies(ctx sdk.Context) []types.PausedProxy {
+	var pausedProxies []types.PausedProxy
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.proxyStoreKey), types.KeyPausedProxyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var pausedProxy types.PausedProxy
+		k.cdc.MustUnmarshal(iterator.Value(), &pausedProxy)
+		pausedProxies = append(pausedProxies, pausedProxy)
+	}
+	return pausedProxies
+}
+
+// checkProxyNotPaused returns an error if proxying is paused for the upstream client or, if the port and channel are given, for the channel
+func (k Keeper) checkProxyNotPaused(ctx sdk.Context, upstreamClientID, portID, channelID string) error {
+	if k.IsProxyPaused(ctx, upstreamClientID, "", "") {
+		return sdkerrors.Wrapf(types.ErrProxyPaused, "upstream client: %s", upstreamClientID)
+	}
+	if portID != "" && k.IsProxyPaused(ctx, upstreamClientID, portID, channelID) {
+		return sdkerrors.Wrapf(types.ErrProxyPaused, "upstream client: %s, port: %s, channel: %s", upstreamClientID, portID, channelID)
+	}
+	return nil
+}
+
+// isPauseAuthorized returns true if the signer may pause proxying, which is the authority or the guardian
+func (k Keeper) isPauseAuthorized(ctx sdk.Context, signer string) bool {
+	if signer == k.authority {
+		return true
+	}
+	guardian := k.GetParams(ctx).Guardian
+	return guardian != "" && signer == guardian
+}
+
+// isUnpauseAuthorized returns true if the signer may resume the paused proxying, which is the authority,
+// or the guardian if the guardian paused it
+func (k Keeper) isUnpauseAuthorized(ctx sdk.Context, signer string, pausedProxy types.PausedProxy) bool {
+	if signer == k.authority {
+		return true
+	}
+	guardian := k.GetParams(ctx).Guardian
+	return guardian != "" && signer == guardian && pausedProxy.Signer == guardian
+}
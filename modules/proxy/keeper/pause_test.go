@@ -0,0 +1,129 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/modules/core/24-host"
+	"github.com/cosmos/ibc-go/modules/core/exported"
+
+	"github.com/datachainlab/ibc-proxy/modules/proxy/keeper"
+	"github.com/datachainlab/ibc-proxy/modules/proxy/types"
+	ibctesting "github.com/datachainlab/ibc-proxy/testing"
+	"github.com/datachainlab/ibc-proxy/testing/simapp"
+)
+
+// A(C) -> B, B -> A
+// A: downstream, B: upstream, C: proxy
+func (suite *KeeperTestSuite) TestPauseProxy() {
+	suite.Require().NoError(suite.coordinator.IncrementClientSequence(suite.chainC, suite.chainB, exported.Tendermint, 1))
+	suite.Require().NoError(suite.coordinator.IncrementClientSequence(suite.chainB, suite.chainA, exported.Tendermint, 2))
+
+	clientCB, err := suite.coordinator.CreateClient2(suite.chainC, suite.chainB, exported.Tendermint, false, 0)
+	suite.Require().NoError(err)
+	clientBA, err := suite.coordinator.CreateMultiVClient(suite.chainB, suite.chainA, exported.Tendermint, 0)
+	suite.Require().NoError(err)
+	clientAC, err := suite.coordinator.CreateProxyClient(suite.chainA, suite.chainC, exported.Tendermint, clientCB)
+	suite.Require().NoError(err)
+
+	ppair := ibctesting.ProxyPair{{Chain: suite.chainC, ClientID: clientAC, UpstreamClientID: clientCB, UpstreamPrefix: suite.chainB.GetPrefix()}, nil}
+	connA, connB := suite.coordinator.CreateConnectionWithProxy(suite.chainA, suite.chainB, clientAC, clientBA, ibctesting.TransferVersion, ppair)
+	_, chanB := suite.coordinator.CreateChannelWithProxy(suite.chainA, suite.chainB, connA, connB, ibctesting.TransferPort, ibctesting.TransferPort, channeltypes.UNORDERED, ppair)
+
+	suite.Require().NoError(suite.coordinator.UpdateClient(suite.chainC, suite.chainB, clientCB, exported.Tendermint))
+	connection := suite.chainB.GetConnection(connB)
+	proofConnection, proofHeight := suite.chainB.QueryProof(host.ConnectionKey(connB.ID))
+	channel := suite.chainB.GetChannel(*chanB)
+	proofChannel, _ := suite.chainB.QueryProof(host.ChannelKey(chanB.PortID, chanB.ID))
+
+	proxyKeeper := suite.chainC.App.(*simapp.SimApp).IBCProxyKeeper
+	authority := proxyKeeper.GetAuthority()
+	guardian := suite.chainC.SenderAccount.GetAddress().String()
+	prefix := suite.chainB.GetPrefix()
+	verifyConnection := func(ctx sdk.Context) error {
+		return proxyKeeper.VerifyConnectionState(ctx, clientCB, prefix, connection, proofHeight, proofConnection, connB.ID)
+	}
+	verifyChannel := func(ctx sdk.Context) error {
+		return proxyKeeper.VerifyChannelState(ctx, clientCB, prefix, proofHeight, proofChannel, chanB.PortID, chanB.ID, channel)
+	}
+
+	// only the authority can pause proxying unless the guardian is set
+	ctx := suite.chainC.GetContext()
+	_, err = proxyKeeper.PauseProxy(sdk.WrapSDKContext(ctx), types.NewMsgPauseProxy(guardian, clientCB, "", ""))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// the guardian pauses the channel
+	params := proxyKeeper.GetParams(ctx)
+	params.Guardian = guardian
+	proxyKeeper.SetParams(ctx, params)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = proxyKeeper.PauseProxy(sdk.WrapSDKContext(ctx), types.NewMsgPauseProxy(guardian, clientCB, chanB.PortID, chanB.ID))
+	suite.Require().NoError(err)
+	var evPause types.EventPauseProxy
+	suite.Require().NoError(ibctesting.ParseProxyEventFromEvents(ctx.EventManager().Events(), &evPause))
+	suite.Require().Equal(types.EventPauseProxy{UpstreamClientId: clientCB, PortId: chanB.PortID, ChannelId: chanB.ID, Signer: guardian}, evPause)
+	_, err = proxyKeeper.PauseProxy(sdk.WrapSDKContext(ctx), types.NewMsgPauseProxy(guardian, clientCB, chanB.PortID, chanB.ID))
+	suite.Require().ErrorIs(err, types.ErrProxyPaused)
+
+	// only the states of the channel are blocked
+	suite.Require().ErrorIs(verifyChannel(ctx), types.ErrProxyPaused)
+	suite.Require().NoError(verifyConnection(ctx))
+
+	// the whole upstream is paused
+	_, err = proxyKeeper.PauseProxy(sdk.WrapSDKContext(ctx), types.NewMsgPauseProxy(authority, clientCB, "", ""))
+	suite.Require().NoError(err)
+	suite.Require().ErrorIs(verifyConnection(ctx), types.ErrProxyPaused)
+
+	expected := []types.PausedProxy{
+		{UpstreamClientId: clientCB, Signer: authority},
+		{UpstreamClientId: clientCB, PortId: chanB.PortID, ChannelId: chanB.ID, Signer: guardian},
+	}
+	querier := keeper.Querier{Keeper: proxyKeeper}
+	res, err := querier.PausedProxies(sdk.WrapSDKContext(ctx), &types.QueryPausedProxiesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expected, res.PausedProxies)
+	suite.Require().Equal(expected, proxyKeeper.ExportGenesis(ctx).PausedProxies)
+
+	// the guardian can't unpause what the authority paused
+	_, err = proxyKeeper.UnpauseProxy(sdk.WrapSDKContext(ctx), types.NewMsgUnpauseProxy(guardian, clientCB, "", ""))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = proxyKeeper.UnpauseProxy(sdk.WrapSDKContext(ctx), types.NewMsgUnpauseProxy(authority, clientCB, "", ""))
+	suite.Require().NoError(err)
+	var evUnpause types.EventUnpauseProxy
+	suite.Require().NoError(ibctesting.ParseProxyEventFromEvents(ctx.EventManager().Events(), &evUnpause))
+	suite.Require().Equal(types.EventUnpauseProxy{UpstreamClientId: clientCB}, evUnpause)
+	_, err = proxyKeeper.UnpauseProxy(sdk.WrapSDKContext(ctx), types.NewMsgUnpauseProxy(authority, clientCB, "", ""))
+	suite.Require().ErrorIs(err, types.ErrProxyNotPaused)
+
+	// the channel stays paused until it is unpaused by itself, which the guardian can do as it paused it
+	suite.Require().NoError(verifyConnection(ctx))
+	suite.Require().ErrorIs(verifyChannel(ctx), types.ErrProxyPaused)
+	_, err = proxyKeeper.UnpauseProxy(sdk.WrapSDKContext(ctx), types.NewMsgUnpauseProxy(guardian, clientCB, chanB.PortID, chanB.ID))
+	suite.Require().NoError(err)
+	suite.Require().NoError(verifyChannel(ctx))
+	suite.Require().Empty(proxyKeeper.GetAllPausedProxies(ctx))
+}
+
+func (suite *KeeperTestSuite) TestUnpauseProxyProposal() {
+	clientCB, err := suite.coordinator.CreateClient2(suite.chainC, suite.chainB, exported.Tendermint, false, 0)
+	suite.Require().NoError(err)
+
+	app := suite.chainC.App.(*simapp.SimApp)
+	proxyKeeper := app.IBCProxyKeeper
+	ctx := suite.chainC.GetContext()
+	suite.Require().NoError(proxyKeeper.AddPausedProxy(ctx, types.NewPausedProxy(clientCB, "", ""), proxyKeeper.GetAuthority()))
+
+	// the proposal is routed to the proxy module
+	_, err = app.GovKeeper.SubmitProposal(ctx, types.NewUnpauseProxyProposal("title", "description", clientCB, "", ""))
+	suite.Require().NoError(err)
+
+	handler := app.GovKeeper.Router().GetRoute(types.RouterKey)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	suite.Require().NoError(handler(ctx, types.NewUnpauseProxyProposal("title", "description", clientCB, "", "")))
+	suite.Require().False(proxyKeeper.IsProxyPaused(ctx, clientCB, "", ""))
+	var evUnpause types.EventUnpauseProxy
+	suite.Require().NoError(ibctesting.ParseProxyEventFromEvents(ctx.EventManager().Events(), &evUnpause))
+	suite.Require().Equal(types.EventUnpauseProxy{UpstreamClientId: clientCB}, evUnpause)
+	suite.Require().ErrorIs(handler(ctx, types.NewUnpauseProxyProposal("title", "description", clientCB, "", "")), types.ErrProxyNotPaused)
+}
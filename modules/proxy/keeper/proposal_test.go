@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/modules/core/exported"
+
+	proxyclienttypes "github.com/datachainlab/ibc-proxy/modules/light-clients/xx-proxy/types"
+	ibctesting "github.com/datachainlab/ibc-proxy/testing"
+	"github.com/datachainlab/ibc-proxy/testing/simapp"
+)
+
+// A(C) -> B
+// A: downstream, B: upstream, C: proxy
+func (suite *KeeperTestSuite) TestRecoverProxyClient() {
+	clientCB, err := suite.coordinator.CreateClient2(suite.chainC, suite.chainB, exported.Tendermint, false, 0)
+	suite.Require().NoError(err)
+	otherClientCB, err := suite.coordinator.CreateClient2(suite.chainC, suite.chainB, exported.Tendermint, false, 0)
+	suite.Require().NoError(err)
+	clientCD, err := suite.coordinator.CreateClient2(suite.chainC, suite.chainD, exported.Tendermint, false, 0)
+	suite.Require().NoError(err)
+	suite.coordinator.CommitBlock(suite.chainA, suite.chainC)
+	config := ibctesting.NewTendermintConfig()
+	config.AllowUpdateAfterExpiry = true
+	subject, err := suite.coordinator.CreateProxyClientWithConfig(suite.chainA, suite.chainC, config, clientCB)
+	suite.Require().NoError(err)
+
+	// the subject expires, and then the substitutes are created
+	suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod)
+	suite.coordinator.CommitBlock(suite.chainA, suite.chainC)
+	substitute, err := suite.coordinator.CreateProxyClientWithConfig(suite.chainA, suite.chainC, config, clientCB)
+	suite.Require().NoError(err)
+	otherUpstream, err := suite.coordinator.CreateProxyClientWithConfig(suite.chainA, suite.chainC, config, otherClientCB)
+	suite.Require().NoError(err)
+	otherChain, err := suite.coordinator.CreateProxyClientWithConfig(suite.chainA, suite.chainC, config, clientCD)
+	suite.Require().NoError(err)
+	notMatching, err := suite.coordinator.CreateProxyClient(suite.chainA, suite.chainC, exported.Tendermint, clientCB)
+	suite.Require().NoError(err)
+	notProxy, err := suite.coordinator.CreateClient(suite.chainA, suite.chainC, exported.Tendermint)
+	suite.Require().NoError(err)
+	// the substitutes have the processed heights of their latest consensus states after the updates
+	suite.coordinator.CommitBlock(suite.chainC)
+	for _, clientID := range []string{substitute, otherUpstream, notMatching} {
+		suite.Require().NoError(suite.chainA.UpdateProxyClient(suite.chainC, clientID))
+	}
+
+	clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+	status := func(clientID string) exported.Status {
+		ctx := suite.chainA.GetContext()
+		return suite.chainA.GetClientState(clientID).Status(ctx, clientKeeper.ClientStore(ctx, clientID), suite.chainA.App.AppCodec())
+	}
+	suite.Require().Equal(exported.Expired, status(subject))
+
+	handler := suite.chainA.App.(*simapp.SimApp).GovKeeper.Router().GetRoute(clienttypes.RouterKey)
+	propose := func(substituteClientID string) error {
+		cacheCtx, write := suite.chainA.GetContext().CacheContext()
+		if err := handler(cacheCtx, clienttypes.NewClientUpdateProposal("title", "description", subject, substituteClientID)); err != nil {
+			return err
+		}
+		write()
+		return nil
+	}
+
+	// the substitute must be a proxy client whose underlying client matches the one of the subject
+	suite.Require().ErrorIs(propose(notProxy), clienttypes.ErrInvalidClient)
+	suite.Require().ErrorIs(propose(notMatching), clienttypes.ErrInvalidSubstitute)
+	// the substitute must be bound to the same upstream chain as the subject
+	suite.Require().ErrorIs(propose(otherChain), clienttypes.ErrInvalidSubstitute)
+
+	// the proxy fields are taken over from the substitute
+	cacheCtx, _ := suite.chainA.GetContext().CacheContext()
+	suite.Require().NoError(handler(cacheCtx, clienttypes.NewClientUpdateProposal("title", "description", subject, otherUpstream)))
+	clientState, found := clientKeeper.GetClientState(cacheCtx, subject)
+	suite.Require().True(found)
+	suite.Require().Equal(otherClientCB, clientState.(*proxyclienttypes.ClientState).UpstreamClientId)
+
+	suite.Require().NoError(propose(substitute))
+	suite.Require().Equal(exported.Active, status(subject))
+	substituteClientState := suite.chainA.GetClientState(substitute).(*proxyclienttypes.ClientState)
+	subjectClientState := suite.chainA.GetClientState(subject).(*proxyclienttypes.ClientState)
+	suite.Require().Equal(clientCB, subjectClientState.UpstreamClientId)
+	suite.Require().Equal(substituteClientState.GetLatestHeight(), subjectClientState.GetLatestHeight())
+
+	// the consensus state is copied in the proxy one
+	ctx := suite.chainA.GetContext()
+	consensusState, found := clientKeeper.GetClientConsensusState(ctx, subject, subjectClientState.GetLatestHeight())
+	suite.Require().True(found)
+	substituteConsensusState, found := clientKeeper.GetClientConsensusState(ctx, substitute, substituteClientState.GetLatestHeight())
+	suite.Require().True(found)
+	suite.Require().IsType(&proxyclienttypes.ConsensusState{}, consensusState)
+	suite.Require().Equal(substituteConsensusState, consensusState)
+
+	// the recovered client can be updated
+	suite.coordinator.CommitBlock(suite.chainC)
+	suite.Require().NoError(suite.chainA.UpdateProxyClient(suite.chainC, subject))
+}
@@ -1,10 +1,15 @@
 package keeper
 
 import (
+	"bytes"
+	"math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/modules/core/24-host"
 	"github.com/cosmos/ibc-go/modules/core/exported"
 )
 
@@ -17,9 +22,12 @@ func (k Keeper) VerifyClientState(
 	proof []byte,
 	clientState exported.ClientState, // the state of downstream that upstream has
 ) error {
-	targetClient, found := k.clientKeeper.GetClientState(ctx, upstreamClientID)
-	if !found {
-		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, upstreamClientID)
+	if err := k.checkProxyNotPaused(ctx, upstreamClientID, "", ""); err != nil {
+		return err
+	}
+	targetClient, err := k.getUpstreamClientState(ctx, upstreamClientID)
+	if err != nil {
+		return err
 	}
 	if err := targetClient.VerifyClientState(
 		k.clientKeeper.ClientStore(ctx, upstreamClientID), k.cdc, height,
@@ -41,13 +49,20 @@ func (k Keeper) VerifyAndProxyClientState(
 	if err := k.VerifyClientState(ctx, upstreamClientID, upstreamPrefix, counterpartyClientID, height, proof, clientState); err != nil {
 		return err
 	}
-	return k.SetProxyClientState(
+	exists := k.hasProxyState(ctx, upstreamPrefix, upstreamClientID, host.FullClientStateKey(counterpartyClientID), clienttypes.MustMarshalClientState(k.cdc, clientState))
+	if err := k.SetProxyClientState(
 		ctx,
 		upstreamPrefix,
 		counterpartyClientID,
 		upstreamClientID,
 		clientState,
-	)
+	); err != nil {
+		return err
+	}
+	if !exists {
+		k.AfterProxyClientState(ctx, upstreamClientID, upstreamPrefix, counterpartyClientID, clientState)
+	}
+	return nil
 }
 
 func (k Keeper) VerifyClientConsensusState(
@@ -60,9 +75,12 @@ func (k Keeper) VerifyClientConsensusState(
 	proof []byte,
 	consensusState exported.ConsensusState, // the state of downstream that upstream has
 ) error {
-	targetClient, found := k.clientKeeper.GetClientState(ctx, upstreamClientID)
-	if !found {
-		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, upstreamClientID)
+	if err := k.checkProxyNotPaused(ctx, upstreamClientID, "", ""); err != nil {
+		return err
+	}
+	targetClient, err := k.getUpstreamClientState(ctx, upstreamClientID)
+	if err != nil {
+		return err
 	}
 	if err := targetClient.VerifyClientConsensusState(
 		k.clientKeeper.ClientStore(ctx, upstreamClientID), k.cdc, height,
@@ -105,9 +123,12 @@ func (k Keeper) VerifyConnectionState(
 	proof []byte,
 	connectionID string, // ID of the connection that upstream has
 ) error {
-	targetClient, found := k.clientKeeper.GetClientState(ctx, upstreamClientID)
-	if !found {
-		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, upstreamClientID)
+	if err := k.checkProxyNotPaused(ctx, upstreamClientID, "", ""); err != nil {
+		return err
+	}
+	targetClient, err := k.getUpstreamClientState(ctx, upstreamClientID)
+	if err != nil {
+		return err
 	}
 	if err := targetClient.VerifyConnectionState(
 		k.clientKeeper.ClientStore(ctx, upstreamClientID), k.cdc, height,
@@ -131,13 +152,20 @@ func (k Keeper) VerifyAndProxyConnectionState(
 	if err := k.VerifyConnectionState(ctx, upstreamClientID, upstreamPrefix, connection, height, proof, connectionID); err != nil {
 		return err
 	}
-	return k.SetProxyConnection(
+	exists := k.hasProxyState(ctx, upstreamPrefix, upstreamClientID, host.ConnectionKey(connectionID), k.cdc.MustMarshal(&connection))
+	if err := k.SetProxyConnection(
 		ctx,
 		upstreamPrefix,
 		upstreamClientID,
 		connectionID,
 		connection,
-	)
+	); err != nil {
+		return err
+	}
+	if !exists {
+		k.AfterProxyConnection(ctx, upstreamClientID, upstreamPrefix, connectionID, connection)
+	}
+	return nil
 }
 
 func (k Keeper) VerifyChannelState(
@@ -150,9 +178,12 @@ func (k Keeper) VerifyChannelState(
 	channelID string,
 	channel exported.ChannelI, // the channel of downstream that upstream has
 ) error {
-	targetClient, found := k.clientKeeper.GetClientState(ctx, upstreamClientID)
-	if !found {
-		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, upstreamClientID)
+	if err := k.checkProxyNotPaused(ctx, upstreamClientID, portID, channelID); err != nil {
+		return err
+	}
+	targetClient, err := k.getUpstreamClientState(ctx, upstreamClientID)
+	if err != nil {
+		return err
 	}
 
 	if err := targetClient.VerifyChannelState(
@@ -179,14 +210,22 @@ func (k Keeper) VerifyAndProxyChannelState(
 	if err := k.VerifyChannelState(ctx, upstreamClientID, upstreamPrefix, height, proof, portID, channelID, channel); err != nil {
 		return err
 	}
-	return k.SetProxyChannel(
+	channelEnd := channel.(channeltypes.Channel)
+	exists := k.hasProxyState(ctx, upstreamPrefix, upstreamClientID, host.ChannelKey(portID, channelID), k.cdc.MustMarshal(&channelEnd))
+	if err := k.SetProxyChannel(
 		ctx,
 		upstreamPrefix,
 		upstreamClientID,
 		portID,
 		channelID,
-		channel,
-	)
+		channelEnd,
+	); err != nil {
+		return err
+	}
+	if !exists {
+		k.AfterProxyChannel(ctx, upstreamClientID, upstreamPrefix, portID, channelID, channelEnd)
+	}
+	return nil
 }
 
 // VerifyPacketCommitment verifies a proof of an outgoing packet commitment at
@@ -203,11 +242,30 @@ func (k Keeper) VerifyPacketCommitment(
 	sequence uint64,
 	commitmentBytes []byte,
 ) error {
-	targetClient, found := k.clientKeeper.GetClientState(ctx, upstreamClientID)
-	if !found {
-		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, upstreamClientID)
+	targetClient, err := k.getUpstreamClientState(ctx, upstreamClientID)
+	if err != nil {
+		return err
 	}
+	return k.verifyPacketCommitment(ctx, targetClient, upstreamClientID, upstreamPrefix, connection, height, proof, portID, channelID, sequence, commitmentBytes)
+}
 
+// verifyPacketCommitment is the same as VerifyPacketCommitment except that it uses the given client state of the upstream
+func (k Keeper) verifyPacketCommitment(
+	ctx sdk.Context,
+	targetClient exported.ClientState,
+	upstreamClientID string,
+	upstreamPrefix exported.Prefix,
+	connection exported.ConnectionI,
+	height exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+	commitmentBytes []byte,
+) error {
+	if err := k.checkProxyNotPaused(ctx, upstreamClientID, portID, channelID); err != nil {
+		return err
+	}
 	if err := targetClient.VerifyPacketCommitment(
 		ctx, k.clientKeeper.ClientStore(ctx, upstreamClientID), k.cdc, height,
 		connection.GetDelayPeriod(), k.getBlockDelay(ctx, connection),
@@ -232,11 +290,32 @@ func (k Keeper) VerifyAndProxyPacketCommitment(
 	sequence uint64,
 	commitmentBytes []byte,
 ) error {
-	if err := k.VerifyPacketCommitment(ctx, upstreamClientID, upstreamPrefix, connection, height, proof, portID, channelID, sequence, commitmentBytes); err != nil {
+	targetClient, err := k.getUpstreamClientState(ctx, upstreamClientID)
+	if err != nil {
 		return err
 	}
+	return k.verifyAndProxyPacketCommitment(ctx, targetClient, upstreamClientID, upstreamPrefix, connection, height, proof, portID, channelID, sequence, commitmentBytes)
+}
 
-	return k.SetProxyPacketCommitment(
+// verifyAndProxyPacketCommitment is the same as VerifyAndProxyPacketCommitment except that it uses the given client state of the upstream
+func (k Keeper) verifyAndProxyPacketCommitment(
+	ctx sdk.Context,
+	targetClient exported.ClientState,
+	upstreamClientID string,
+	upstreamPrefix exported.Prefix,
+	connection exported.ConnectionI,
+	height exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+	commitmentBytes []byte,
+) error {
+	if err := k.verifyPacketCommitment(ctx, targetClient, upstreamClientID, upstreamPrefix, connection, height, proof, portID, channelID, sequence, commitmentBytes); err != nil {
+		return err
+	}
+	exists := k.hasProxyState(ctx, upstreamPrefix, upstreamClientID, host.PacketCommitmentKey(portID, channelID, sequence), commitmentBytes)
+	if err := k.SetProxyPacketCommitment(
 		ctx,
 		upstreamPrefix,
 		upstreamClientID,
@@ -244,7 +323,13 @@ func (k Keeper) VerifyAndProxyPacketCommitment(
 		channelID,
 		sequence,
 		commitmentBytes,
-	)
+	); err != nil {
+		return err
+	}
+	if !exists {
+		k.AfterProxyPacketCommitment(ctx, upstreamClientID, upstreamPrefix, portID, channelID, sequence, commitmentBytes)
+	}
+	return nil
 }
 
 // VerifyPacketAcknowledgement verifies a proof of an incoming packet
@@ -261,11 +346,30 @@ func (k Keeper) VerifyPacketAcknowledgement(
 	sequence uint64,
 	acknowledgement []byte,
 ) error {
-	targetClient, found := k.clientKeeper.GetClientState(ctx, upstreamClientID)
-	if !found {
-		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, upstreamClientID)
+	targetClient, err := k.getUpstreamClientState(ctx, upstreamClientID)
+	if err != nil {
+		return err
 	}
+	return k.verifyPacketAcknowledgement(ctx, targetClient, upstreamClientID, upstreamPrefix, connection, height, proof, portID, channelID, sequence, acknowledgement)
+}
 
+// verifyPacketAcknowledgement is the same as VerifyPacketAcknowledgement except that it uses the given client state of the upstream
+func (k Keeper) verifyPacketAcknowledgement(
+	ctx sdk.Context,
+	targetClient exported.ClientState,
+	upstreamClientID string,
+	upstreamPrefix exported.Prefix,
+	connection exported.ConnectionI,
+	height exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+	acknowledgement []byte,
+) error {
+	if err := k.checkProxyNotPaused(ctx, upstreamClientID, portID, channelID); err != nil {
+		return err
+	}
 	if err := targetClient.VerifyPacketAcknowledgement(
 		ctx, k.clientKeeper.ClientStore(ctx, upstreamClientID), k.cdc, height,
 		connection.GetDelayPeriod(), k.getBlockDelay(ctx, connection),
@@ -290,11 +394,32 @@ func (k Keeper) VerifyAndProxyPacketAcknowledgement(
 	sequence uint64,
 	acknowledgement []byte,
 ) error {
-	if err := k.VerifyPacketAcknowledgement(ctx, upstreamClientID, upstreamPrefix, connection, height, proof, portID, channelID, sequence, acknowledgement); err != nil {
+	targetClient, err := k.getUpstreamClientState(ctx, upstreamClientID)
+	if err != nil {
 		return err
 	}
+	return k.verifyAndProxyPacketAcknowledgement(ctx, targetClient, upstreamClientID, upstreamPrefix, connection, height, proof, portID, channelID, sequence, acknowledgement)
+}
 
-	return k.SetProxyPacketAcknowledgement(
+// verifyAndProxyPacketAcknowledgement is the same as VerifyAndProxyPacketAcknowledgement except that it uses the given client state of the upstream
+func (k Keeper) verifyAndProxyPacketAcknowledgement(
+	ctx sdk.Context,
+	targetClient exported.ClientState,
+	upstreamClientID string,
+	upstreamPrefix exported.Prefix,
+	connection exported.ConnectionI,
+	height exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+	acknowledgement []byte,
+) error {
+	if err := k.verifyPacketAcknowledgement(ctx, targetClient, upstreamClientID, upstreamPrefix, connection, height, proof, portID, channelID, sequence, acknowledgement); err != nil {
+		return err
+	}
+	exists := k.hasProxyState(ctx, upstreamPrefix, upstreamClientID, host.PacketAcknowledgementKey(portID, channelID, sequence), channeltypes.CommitAcknowledgement(acknowledgement))
+	if err := k.SetProxyPacketAcknowledgement(
 		ctx,
 		upstreamPrefix,
 		upstreamClientID,
@@ -302,7 +427,13 @@ func (k Keeper) VerifyAndProxyPacketAcknowledgement(
 		channelID,
 		sequence,
 		acknowledgement,
-	)
+	); err != nil {
+		return err
+	}
+	if !exists {
+		k.AfterProxyAcknowledgement(ctx, upstreamClientID, upstreamPrefix, portID, channelID, sequence, acknowledgement)
+	}
+	return nil
 }
 
 // VerifyPacketReceiptAbsence verifies a proof of the absence of an
@@ -319,9 +450,12 @@ func (k Keeper) VerifyPacketReceiptAbsence(
 	channelID string,
 	sequence uint64,
 ) error {
-	targetClient, found := k.clientKeeper.GetClientState(ctx, upstreamClientID)
-	if !found {
-		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, upstreamClientID)
+	if err := k.checkProxyNotPaused(ctx, upstreamClientID, portID, channelID); err != nil {
+		return err
+	}
+	targetClient, err := k.getUpstreamClientState(ctx, upstreamClientID)
+	if err != nil {
+		return err
 	}
 
 	if err := targetClient.VerifyPacketReceiptAbsence(
@@ -374,9 +508,12 @@ func (k Keeper) VerifyNextSequenceRecv(
 	channelID string,
 	nextSequenceRecv uint64,
 ) error {
-	targetClient, found := k.clientKeeper.GetClientState(ctx, upstreamClientID)
-	if !found {
-		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, upstreamClientID)
+	if err := k.checkProxyNotPaused(ctx, upstreamClientID, portID, channelID); err != nil {
+		return err
+	}
+	targetClient, err := k.getUpstreamClientState(ctx, upstreamClientID)
+	if err != nil {
+		return err
 	}
 
 	if err := targetClient.VerifyNextSequenceRecv(
@@ -416,7 +553,20 @@ func (k Keeper) VerifyAndProxyNextSequenceRecv(
 	)
 }
 
-// getBlockDelay always returns 0
+// hasProxyState returns true if the proxy already has the same value at the key.
+// It keeps the hooks from being called again for a state that has been proxied before.
+func (k Keeper) hasProxyState(ctx sdk.Context, upstreamPrefix exported.Prefix, upstreamClientID string, key, value []byte) bool {
+	return bytes.Equal(k.ProxyStore(ctx, upstreamPrefix, upstreamClientID).Get(key), value)
+}
+
+// getBlockDelay calculates the block delay period from the time delay of the connection
+// and the maximum expected time per block, in the same way as the connection keeper of ibc-go.
 func (k Keeper) getBlockDelay(ctx sdk.Context, connection exported.ConnectionI) uint64 {
-	return 0
+	expectedTimePerBlock := k.GetParams(ctx).MaxExpectedTimePerBlock
+	if expectedTimePerBlock == 0 {
+		return 0
+	}
+	// round up the block delay
+	timeDelay := connection.GetDelayPeriod()
+	return uint64(math.Ceil(float64(timeDelay) / float64(expectedTimePerBlock)))
 }
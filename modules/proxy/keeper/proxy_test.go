@@ -1,12 +1,22 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	transfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/modules/core/24-host"
 	"github.com/cosmos/ibc-go/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/modules/light-clients/07-tendermint/types"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/datachainlab/ibc-proxy/modules/proxy/types"
 	ibctesting "github.com/datachainlab/ibc-proxy/testing"
+	"github.com/datachainlab/ibc-proxy/testing/simapp"
 )
 
 func (suite *KeeperTestSuite) TestMultiV() {
@@ -31,7 +41,7 @@ func (suite *KeeperTestSuite) TestOneSideProxy1() {
 	clientAC, err := suite.coordinator.CreateProxyClient(suite.chainA, suite.chainC, exported.Tendermint, clientCB)
 	suite.Require().NoError(err)
 
-	ppair := ibctesting.ProxyPair{{suite.chainC, clientAC, clientCB, suite.chainB.GetPrefix()}, nil}
+	ppair := ibctesting.ProxyPair{{Chain: suite.chainC, ClientID: clientAC, UpstreamClientID: clientCB, UpstreamPrefix: suite.chainB.GetPrefix()}, nil}
 	connA, connB := suite.coordinator.CreateConnectionWithProxy(suite.chainA, suite.chainB, clientAC, clientBA, ibctesting.TransferVersion, ppair)
 	chanA, chanB := suite.coordinator.CreateChannelWithProxy(suite.chainA, suite.chainB, connA, connB, ibctesting.TransferPort, ibctesting.TransferPort, channeltypes.UNORDERED, ppair)
 	suite.testHandleMsgTransfer(connA, connB, chanA, chanB, ppair)
@@ -54,7 +64,7 @@ func (suite *KeeperTestSuite) TestOneSideProxy2() {
 	clientBC, err := suite.coordinator.CreateProxyClient(suite.chainB, suite.chainC, exported.Tendermint, clientCA)
 	suite.Require().NoError(err)
 
-	ppair := ibctesting.ProxyPair{nil, {suite.chainC, clientBC, clientCA, suite.chainA.GetPrefix()}}
+	ppair := ibctesting.ProxyPair{nil, {Chain: suite.chainC, ClientID: clientBC, UpstreamClientID: clientCA, UpstreamPrefix: suite.chainA.GetPrefix()}}
 	connA, connB := suite.coordinator.CreateConnectionWithProxy(suite.chainA, suite.chainB, clientAB, clientBC, ibctesting.TransferVersion, ppair)
 	chanA, chanB := suite.coordinator.CreateChannelWithProxy(suite.chainA, suite.chainB, connA, connB, ibctesting.TransferPort, ibctesting.TransferPort, channeltypes.UNORDERED, ppair)
 	suite.testHandleMsgTransfer(connA, connB, chanA, chanB, ppair)
@@ -63,6 +73,541 @@ func (suite *KeeperTestSuite) TestOneSideProxy2() {
 // A(C) -> B, B(D) -> A
 // A: upstream/downstream, B: downstream/upstream, C: proxy for A, D: proxy for B
 func (suite *KeeperTestSuite) TestBothSideProxy() {
+	connA, connB, ppair := suite.createBothSideProxyConnection()
+	chanA, chanB := suite.coordinator.CreateChannelWithProxy(suite.chainA, suite.chainB, connA, connB, ibctesting.TransferPort, ibctesting.TransferPort, channeltypes.UNORDERED, ppair)
+	suite.testHandleMsgTransfer(connA, connB, chanA, chanB, ppair)
+}
+
+// A(C) -> B, B(D) -> A
+// A: upstream/downstream, B: downstream/upstream, C: proxy for A, D: proxy for B
+func (suite *KeeperTestSuite) TestChannelCloseWithProxy() {
+	testCases := []struct {
+		name    string
+		initOnA bool
+	}{
+		{"close init on A", true},
+		{"close init on B", false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			connA, connB, ppair := suite.createBothSideProxyConnection()
+			chanA, chanB := suite.coordinator.CreateChannelWithProxy(suite.chainA, suite.chainB, connA, connB, ibctesting.MockPort, ibctesting.MockPort, channeltypes.UNORDERED, ppair)
+
+			var (
+				initChain   *ibctesting.TestChain
+				initChannel *ibctesting.TestChannel
+				proxy       *ibctesting.ProxyInfo
+			)
+			if tc.initOnA {
+				suite.Require().NoError(suite.coordinator.ChanCloseInitWithProxy(suite.chainA, suite.chainB, *chanA, connB, ppair))
+				suite.Require().NoError(suite.coordinator.ChanCloseConfirmWithProxy(suite.chainB, suite.chainA, *chanB, *chanA, connB, connA, ppair.Swap()))
+				initChain, initChannel, proxy = suite.chainA, chanA, ppair[1]
+			} else {
+				suite.Require().NoError(suite.coordinator.ChanCloseInitWithProxy(suite.chainB, suite.chainA, *chanB, connA, ppair.Swap()))
+				suite.Require().NoError(suite.coordinator.ChanCloseConfirmWithProxy(suite.chainA, suite.chainB, *chanA, *chanB, connA, connB, ppair))
+				initChain, initChannel, proxy = suite.chainB, chanB, ppair[0]
+			}
+
+			suite.Require().Equal(channeltypes.CLOSED, suite.chainA.GetChannel(*chanA).State)
+			suite.Require().Equal(channeltypes.CLOSED, suite.chainB.GetChannel(*chanB).State)
+
+			prefix := proxy.UpstreamPrefix.(commitmenttypes.MerklePrefix)
+			suite.Require().Equal(channeltypes.CLOSED, proxy.Chain.GetProxyChannel(prefix, proxy.UpstreamClientID, initChannel.PortID, initChannel.ID).State)
+			var ev types.EventProxyChannelCloseConfirm
+			suite.Require().NoError(ibctesting.ParseProxyEventFromEvents(proxy.Chain.TxEvents, &ev))
+			suite.Require().Equal(proxy.UpstreamClientID, ev.UpstreamClientId)
+			suite.Require().Equal(initChannel.PortID, ev.PortId)
+			suite.Require().Equal(initChannel.ID, ev.ChannelId)
+
+			// the proxy must not accept the same closing twice
+			proofInit, proofHeight := initChain.QueryProof(host.ChannelKey(initChannel.PortID, initChannel.ID))
+			err := proxy.Chain.App.(*simapp.SimApp).IBCProxyKeeper.ChanCloseConfirm(
+				proxy.Chain.GetContext(), proxy.UpstreamClientID, prefix,
+				initChannel.PortID, initChannel.ID, proofInit, proofHeight,
+			)
+			suite.Require().Error(err)
+		})
+	}
+}
+
+// A(C) -> B, B(D) -> A
+// A: upstream/downstream, B: downstream/upstream, C: proxy for A, D: proxy for B
+func (suite *KeeperTestSuite) TestTimeoutTransferWithProxy() {
+	testCases := []struct {
+		name    string
+		onClose bool
+	}{
+		{"timeout", false},
+		{"timeout on close", true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			connA, connB, ppair := suite.createBothSideProxyConnection()
+			chanA, chanB := suite.coordinator.CreateChannelWithProxy(suite.chainA, suite.chainB, connA, connB, ibctesting.TransferPort, ibctesting.TransferPort, channeltypes.UNORDERED, ppair)
+
+			sender := suite.chainA.SenderAccount.GetAddress()
+			bankKeeper := suite.chainA.App.(*simapp.SimApp).BankKeeper
+			balance := bankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+
+			timeoutTimestamp := uint64(suite.chainB.GetContext().BlockTime().Add(time.Minute).UnixNano())
+			coinToSendToB := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+			msg := transfertypes.NewMsgTransfer(chanA.PortID, chanA.ID, coinToSendToB, sender.String(), suite.chainB.SenderAccount.GetAddress().String(), clienttypes.ZeroHeight(), timeoutTimestamp)
+			suite.Require().NoError(suite.coordinator.SendPacketWithProxy(suite.chainA, suite.chainB, connA, connB, ppair, msg))
+			suite.Require().Equal(balance.Sub(coinToSendToB), bankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom))
+
+			fungibleTokenPacket := transfertypes.NewFungibleTokenPacketData(coinToSendToB.Denom, coinToSendToB.Amount.Uint64(), sender.String(), suite.chainB.SenderAccount.GetAddress().String())
+			packet := channeltypes.NewPacket(fungibleTokenPacket.GetBytes(), 1, chanA.PortID, chanA.ID, chanB.PortID, chanB.ID, clienttypes.ZeroHeight(), timeoutTimestamp)
+
+			if tc.onClose {
+				// ICS-20 doesn't allow closing a channel, so close it directly
+				suite.setChannelClosed(suite.chainB, *chanB)
+				suite.Require().NoError(suite.coordinator.TimeoutOnCloseWithProxy(suite.chainA, suite.chainB, connA, connB, packet, ppair))
+			} else {
+				// the proxy must not accept a packet that hasn't timed out yet
+				suite.Require().Error(suite.proxyTimeoutPacket(ppair[0], suite.chainB, packet, 1))
+
+				suite.coordinator.IncrementTimeBy(2 * time.Minute)
+				suite.Require().NoError(suite.coordinator.TimeoutPacketWithProxy(suite.chainA, suite.chainB, connA, connB, packet, ppair))
+			}
+
+			// the packet is refunded
+			suite.Require().Equal(balance, bankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom))
+			suite.Require().Empty(suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(suite.chainA.GetContext(), chanA.PortID, chanA.ID, 1))
+
+			prefix := ppair[0].UpstreamPrefix.(commitmenttypes.MerklePrefix)
+			suite.Require().True(suite.chainC.App.(*simapp.SimApp).IBCProxyKeeper.HasProxyPacketReceiptAbsence(suite.chainC.GetContext(), prefix, ppair[0].UpstreamClientID, chanB.PortID, chanB.ID, 1))
+
+			// C proxies the absence of the receipt on B for A
+			expected := types.EventProxyTimeoutPacket{
+				UpstreamClientId:   ppair[0].UpstreamClientID,
+				UpstreamPrefix:     prefix,
+				Sequence:           1,
+				SourcePort:         chanA.PortID,
+				SourceChannel:      chanA.ID,
+				DestinationPort:    chanB.PortID,
+				DestinationChannel: chanB.ID,
+				ConnectionId:       connB.ID,
+			}
+			if tc.onClose {
+				var ev types.EventProxyTimeoutOnClose
+				suite.Require().NoError(ibctesting.ParseProxyEventFromEvents(suite.chainC.TxEvents, &ev))
+				expected.ProofHeight = ev.ProofHeight
+				suite.Require().Equal(types.EventProxyTimeoutOnClose(expected), ev)
+			} else {
+				var ev types.EventProxyTimeoutPacket
+				suite.Require().NoError(ibctesting.ParseProxyEventFromEvents(suite.chainC.TxEvents, &ev))
+				expected.ProofHeight = ev.ProofHeight
+				suite.Require().Equal(expected, ev)
+			}
+		})
+	}
+}
+
+// A(C) -> B, B(D) -> A
+// A: upstream/downstream, B: downstream/upstream, C: proxy for A, D: proxy for B
+func (suite *KeeperTestSuite) TestTimeoutOrderedPacketWithProxy() {
+	testCases := []struct {
+		name    string
+		onClose bool
+	}{
+		{"timeout", false},
+		{"timeout on close", true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			// ICS-20 only supports UNORDERED channels, so use the mock application
+			connA, connB, ppair := suite.createBothSideProxyConnection()
+			chanA, chanB := suite.coordinator.CreateChannelWithProxy(suite.chainA, suite.chainB, connA, connB, ibctesting.MockPort, ibctesting.MockPort, channeltypes.ORDERED, ppair)
+
+			timeoutTimestamp := uint64(suite.chainB.GetContext().BlockTime().Add(time.Minute).UnixNano())
+			packet := channeltypes.NewPacket(suite.chainA.GetPacketData(suite.chainB), 1, chanA.PortID, chanA.ID, chanB.PortID, chanB.ID, clienttypes.ZeroHeight(), timeoutTimestamp)
+			suite.Require().NoError(suite.chainA.SendPacket(packet))
+			suite.Require().NoError(suite.coordinator.UpdateClient(suite.chainD, suite.chainA, ppair[1].UpstreamClientID, exported.Tendermint))
+
+			if tc.onClose {
+				suite.setChannelClosed(suite.chainB, *chanB)
+				suite.Require().NoError(suite.coordinator.TimeoutOnCloseWithProxy(suite.chainA, suite.chainB, connA, connB, packet, ppair))
+			} else {
+				suite.coordinator.IncrementTimeBy(2 * time.Minute)
+
+				// the proxy must not accept a next sequence receive that has passed the packet
+				suite.Require().Error(suite.proxyTimeoutPacket(ppair[0], suite.chainB, packet, 2))
+
+				suite.Require().NoError(suite.coordinator.TimeoutPacketWithProxy(suite.chainA, suite.chainB, connA, connB, packet, ppair))
+			}
+
+			// a timeout closes the ORDERED channel
+			suite.Require().Equal(channeltypes.CLOSED, suite.chainA.GetChannel(*chanA).State)
+			suite.Require().Empty(suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(suite.chainA.GetContext(), chanA.PortID, chanA.ID, 1))
+
+			prefix := ppair[0].UpstreamPrefix.(commitmenttypes.MerklePrefix)
+			nextSequenceRecv, found := suite.chainC.App.(*simapp.SimApp).IBCProxyKeeper.GetProxyNextSequenceRecv(suite.chainC.GetContext(), prefix, ppair[0].UpstreamClientID, chanB.PortID, chanB.ID)
+			suite.Require().True(found)
+			suite.Require().Equal(uint64(1), nextSequenceRecv)
+		})
+	}
+}
+
+// B -> D -> A
+// D(C) -> B: D: downstream, B: upstream, C: proxy
+// A(C) -> D: A: downstream, D: upstream, C: proxy
+func (suite *KeeperTestSuite) TestMultiHopChannelWithProxy() {
+	clientCB, err := suite.coordinator.CreateClient2(suite.chainC, suite.chainB, exported.Tendermint, false, 0)
+	suite.Require().NoError(err)
+	clientBD, err := suite.coordinator.CreateMultiVClient(suite.chainB, suite.chainD, exported.Tendermint, 0)
+	suite.Require().NoError(err)
+	clientDC, err := suite.coordinator.CreateProxyClient(suite.chainD, suite.chainC, exported.Tendermint, clientCB)
+	suite.Require().NoError(err)
+	proxyDB := &ibctesting.ProxyInfo{Chain: suite.chainC, ClientID: clientDC, UpstreamClientID: clientCB, UpstreamPrefix: suite.chainB.GetPrefix()}
+	connDB, connBD := suite.coordinator.CreateConnectionWithProxy(suite.chainD, suite.chainB, clientDC, clientBD, ibctesting.MockPort, ibctesting.ProxyPair{proxyDB, nil})
+
+	clientCD, err := suite.coordinator.CreateClient2(suite.chainC, suite.chainD, exported.Tendermint, false, 0)
+	suite.Require().NoError(err)
+	clientDA, err := suite.coordinator.CreateMultiVClient(suite.chainD, suite.chainA, exported.Tendermint, 0)
+	suite.Require().NoError(err)
+	clientAC, err := suite.coordinator.CreateProxyClient(suite.chainA, suite.chainC, exported.Tendermint, clientCD)
+	suite.Require().NoError(err)
+	proxyAD := &ibctesting.ProxyInfo{Chain: suite.chainC, ClientID: clientAC, UpstreamClientID: clientCD, UpstreamPrefix: suite.chainD.GetPrefix()}
+	connAD, connDA := suite.coordinator.CreateConnectionWithProxy(suite.chainA, suite.chainD, clientAC, clientDA, ibctesting.MockPort, ibctesting.ProxyPair{proxyAD, nil})
+	// the proxy also needs the counterparty connection end of the first hop
+	suite.Require().NoError(suite.coordinator.ProxyOpenConnection(suite.chainD, connDB, proxyAD))
+
+	hops := []ibctesting.ProxyHop{
+		{Chain: suite.chainB, Connection: connBD, Proxy: proxyDB},
+		{Chain: suite.chainD, Connection: connDA, Proxy: proxyAD},
+	}
+	prefix := suite.chainB.GetPrefix()
+
+	// the upstream initializes the channel
+	chanB, chanA, err := suite.coordinator.CreateMultiHopChannelWithProxy(suite.chainB, suite.chainA, connAD, ibctesting.MockPort, ibctesting.MockPort, channeltypes.UNORDERED, hops)
+	suite.Require().NoError(err)
+	channel := suite.chainC.GetProxyChannel(prefix, clientCB, chanB.PortID, chanB.ID)
+	suite.Require().Equal(channeltypes.OPEN, channel.State)
+	suite.Require().Equal([]string{connBD.ID, connDA.ID}, channel.ConnectionHops)
+	suite.Require().Equal(chanA.ID, channel.Counterparty.ChannelId)
+	suite.Require().Equal([]string{connAD.ID, connDB.ID}, suite.chainA.GetChannel(chanA).ConnectionHops)
+
+	// another upstream client of the proxy takes over the opened channel, locating the connection hops after the first
+	clientCB2, err := suite.coordinator.CreateClient2(suite.chainC, suite.chainB, exported.Tendermint, false, 0)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.coordinator.ProxyOpenConnection(suite.chainB, connBD, &ibctesting.ProxyInfo{Chain: suite.chainC, UpstreamClientID: clientCB2, UpstreamPrefix: prefix}))
+	proofChannel, proofHeight := suite.chainB.QueryProof(host.ChannelKey(chanB.PortID, chanB.ID))
+	channelState := func(upstreamHops []types.ProxyConnectionHop) error {
+		ctx, _ := suite.chainC.GetContext().CacheContext()
+		return suite.chainC.App.(*simapp.SimApp).IBCProxyKeeper.ChannelState(
+			ctx, clientCB2, &prefix, chanB.PortID, chanB.ID, suite.chainB.GetChannel(chanB), upstreamHops, proofChannel, proofHeight,
+		)
+	}
+	suite.Require().Error(channelState(nil))
+	suite.Require().ErrorIs(channelState([]types.ProxyConnectionHop{{UpstreamClientId: clientCB2, UpstreamPrefix: prefix}}), connectiontypes.ErrConnectionNotFound)
+	suite.Require().NoError(channelState([]types.ProxyConnectionHop{{UpstreamClientId: clientCD, UpstreamPrefix: suite.chainD.GetPrefix()}}))
+
+	// the downstream initializes the channel
+	chanB, _, err = suite.coordinator.ChanOpenTryWithProxyHops(suite.chainB, suite.chainA, connAD, ibctesting.MockPort, ibctesting.MockPort, channeltypes.ORDERED, hops)
+	suite.Require().NoError(err)
+	channel = suite.chainC.GetProxyChannel(prefix, clientCB, chanB.PortID, chanB.ID)
+	suite.Require().Equal(channeltypes.TRYOPEN, channel.State)
+	suite.Require().Equal([]string{connBD.ID, connDA.ID}, channel.ConnectionHops)
+
+	proxyKeeper := suite.chainC.App.(*simapp.SimApp).IBCProxyKeeper
+	ctx := suite.chainC.GetContext()
+	suite.Require().NoError(proxyKeeper.ExportGenesis(ctx).Validate())
+
+	chanOpenTry := func(connectionHops []string, upstreamHops []types.ProxyConnectionHop) error {
+		return proxyKeeper.ChanOpenTry(
+			ctx, clientCB, &prefix, channeltypes.UNORDERED, connectionHops, upstreamHops,
+			ibctesting.MockPort, "channel-100", ibctesting.MockPort, ibctesting.TransferVersion, []byte("proof"), clienttypes.NewHeight(0, 1),
+		)
+	}
+	hopDA := types.ProxyConnectionHop{UpstreamClientId: clientCD, UpstreamPrefix: suite.chainD.GetPrefix()}
+
+	// the upstream hops must locate every connection hop
+	suite.Require().Error(chanOpenTry([]string{connBD.ID, connDA.ID}, nil))
+	suite.Require().Error(chanOpenTry([]string{connBD.ID}, []types.ProxyConnectionHop{hopDA}))
+	// the connection end of the hop is stored under another upstream
+	suite.Require().ErrorIs(chanOpenTry([]string{connBD.ID, connDA.ID}, []types.ProxyConnectionHop{{UpstreamClientId: clientCB, UpstreamPrefix: prefix}}), connectiontypes.ErrConnectionNotFound)
+	// the upstream prefix of the hop is wrong
+	suite.Require().ErrorIs(chanOpenTry([]string{connBD.ID, connDA.ID}, []types.ProxyConnectionHop{{UpstreamClientId: clientCD, UpstreamPrefix: commitmenttypes.NewMerklePrefix([]byte("other"))}}), connectiontypes.ErrConnectionNotFound)
+
+	// the hop is on an unrelated chain that has the same prefix and a connection with the same ID
+	ctx, _ = ctx.CacheContext()
+	unrelated := types.ProxyConnectionHop{UpstreamClientId: "07-tendermint-100", UpstreamPrefix: suite.chainD.GetPrefix()}
+	suite.Require().NoError(proxyKeeper.SetProxyConnection(ctx, &unrelated.UpstreamPrefix, unrelated.UpstreamClientId, connDA.ID, suite.chainD.GetConnection(connDA)))
+	suite.Require().ErrorIs(chanOpenTry([]string{connBD.ID, connDA.ID}, []types.ProxyConnectionHop{unrelated}), connectiontypes.ErrConnectionNotFound)
+	// the counterparty connection of the first hop on the unrelated chain doesn't point back to the first hop
+	counterpartyEnd := suite.chainD.GetConnection(connDB)
+	counterpartyEnd.Counterparty.ConnectionId = "connection-100"
+	suite.Require().NoError(proxyKeeper.SetProxyConnection(ctx, &unrelated.UpstreamPrefix, unrelated.UpstreamClientId, connDB.ID, counterpartyEnd))
+	suite.Require().ErrorIs(chanOpenTry([]string{connBD.ID, connDA.ID}, []types.ProxyConnectionHop{unrelated}), connectiontypes.ErrInvalidConnection)
+}
+
+// A(C) -> B, B -> A
+// A: downstream, B: upstream, C: proxy
+func (suite *KeeperTestSuite) TestPacketDelayPeriodWithProxy() {
+	suite.Require().NoError(suite.coordinator.IncrementClientSequence(suite.chainC, suite.chainB, exported.Tendermint, 1))
+	suite.Require().NoError(suite.coordinator.IncrementClientSequence(suite.chainB, suite.chainA, exported.Tendermint, 2))
+
+	clientCB, err := suite.coordinator.CreateClient2(suite.chainC, suite.chainB, exported.Tendermint, false, 0)
+	suite.Require().NoError(err)
+
+	clientBA, err := suite.coordinator.CreateMultiVClient(suite.chainB, suite.chainA, exported.Tendermint, 0)
+	suite.Require().NoError(err)
+
+	clientAC, err := suite.coordinator.CreateProxyClient(suite.chainA, suite.chainC, exported.Tendermint, clientCB)
+	suite.Require().NoError(err)
+
+	// a delay of 10 seconds takes 2 blocks on the proxy
+	proxyKeeper := suite.chainC.App.(*simapp.SimApp).IBCProxyKeeper
+	params := proxyKeeper.GetParams(suite.chainC.GetContext())
+	params.MaxExpectedTimePerBlock = 5 * time.Second
+	proxyKeeper.SetParams(suite.chainC.GetContext(), params)
+	delayPeriod := 10 * time.Second
+
+	ppair := ibctesting.ProxyPair{{Chain: suite.chainC, ClientID: clientAC, UpstreamClientID: clientCB, UpstreamPrefix: suite.chainB.GetPrefix()}, nil}
+	config := ibctesting.NewConnectionConfig()
+	config.DelayPeriod = uint64(delayPeriod)
+	connA, connB := suite.coordinator.CreateConnectionWithProxyAndConfig(suite.chainA, suite.chainB, clientAC, clientBA, ibctesting.TransferVersion, config, ppair)
+	suite.Require().Equal(uint64(delayPeriod), suite.chainB.GetConnection(connB).DelayPeriod)
+	chanA, chanB := suite.coordinator.CreateChannelWithProxy(suite.chainA, suite.chainB, connA, connB, ibctesting.TransferPort, ibctesting.TransferPort, channeltypes.UNORDERED, ppair)
+
+	timeoutHeight := clienttypes.NewHeight(0, 110)
+	coinToSendToA := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	msg := transfertypes.NewMsgTransfer(chanB.PortID, chanB.ID, coinToSendToA, suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), timeoutHeight, 0)
+	suite.Require().NoError(suite.coordinator.SendPacketWithProxy(suite.chainB, suite.chainA, connB, connA, ppair.Swap(), msg))
+	fungibleTokenPacket := transfertypes.NewFungibleTokenPacketData(coinToSendToA.Denom, coinToSendToA.Amount.Uint64(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String())
+	packet := channeltypes.NewPacket(fungibleTokenPacket.GetBytes(), 1, chanB.PortID, chanB.ID, chanA.PortID, chanA.ID, timeoutHeight, 0)
+
+	prefix := suite.chainB.GetPrefix()
+	proof, proofHeight := suite.chainB.QueryProof(host.PacketCommitmentKey(packet.SourcePort, packet.SourceChannel, packet.Sequence))
+	recvPacket := func() error {
+		return proxyKeeper.RecvPacket(suite.chainC.GetContext(), clientCB, &prefix, packet, proof, proofHeight)
+	}
+
+	// the client of the upstream has just been updated
+	suite.Require().ErrorIs(recvPacket(), ibctmtypes.ErrDelayPeriodNotPassed)
+
+	// the time delay has passed, but the block delay hasn't
+	suite.coordinator.IncrementTimeBy(delayPeriod)
+	suite.Require().ErrorIs(recvPacket(), ibctmtypes.ErrDelayPeriodNotPassed)
+
+	suite.coordinator.CommitNBlocks(suite.chainC, 2)
+	suite.Require().NoError(recvPacket())
+	_, found := proxyKeeper.GetProxyPacketCommitment(suite.chainC.GetContext(), &prefix, clientCB, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	suite.Require().True(found)
+}
+
+// A(C) -> B, B -> A
+// A: downstream, B: upstream, C: proxy
+func (suite *KeeperTestSuite) TestProxyClientStateEvent() {
+	clientCB, err := suite.coordinator.CreateClient2(suite.chainC, suite.chainB, exported.Tendermint, false, 0)
+	suite.Require().NoError(err)
+	clientBA, err := suite.coordinator.CreateMultiVClient(suite.chainB, suite.chainA, exported.Tendermint, 0)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.coordinator.UpdateClient(suite.chainC, suite.chainB, clientCB, exported.Tendermint))
+
+	// C proxies the client of A on B
+	prefix := suite.chainB.GetPrefix()
+	clientState, proofClient := suite.chainB.QueryClientStateProof(clientBA)
+	proofConsensus, consensusHeight := suite.chainB.QueryConsensusStateProof(clientBA)
+	consensusState, found := suite.chainB.GetConsensusState(clientBA, consensusHeight)
+	suite.Require().True(found)
+	_, proofHeight := suite.chainB.QueryProof(host.FullClientStateKey(clientBA))
+	anyClientState, err := clienttypes.PackClientState(clientState)
+	suite.Require().NoError(err)
+	anyConsensusState, err := clienttypes.PackConsensusState(consensusState)
+	suite.Require().NoError(err)
+	res, err := suite.chainC.SendMsgs(&types.MsgProxyClientState{
+		UpstreamClientId:     clientCB,
+		UpstreamPrefix:       prefix,
+		CounterpartyClientId: clientBA,
+		ClientState:          anyClientState,
+		ConsensusState:       anyConsensusState,
+		ProofClient:          proofClient,
+		ProofConsensus:       proofConsensus,
+		ProofHeight:          proofHeight,
+		ConsensusHeight:      consensusHeight,
+		Signer:               suite.chainC.SenderAccount.GetAddress().String(),
+	})
+	suite.Require().NoError(err)
+
+	var events sdk.Events
+	for _, ev := range res.Events {
+		events = append(events, sdk.Event(ev))
+	}
+	var ev types.EventProxyClientState
+	suite.Require().NoError(ibctesting.ParseProxyEventFromEvents(events, &ev))
+	suite.Require().Equal(types.EventProxyClientState{
+		UpstreamClientId:     clientCB,
+		UpstreamPrefix:       prefix,
+		CounterpartyClientId: clientBA,
+		ProofHeight:          proofHeight,
+		ConsensusHeight:      consensusHeight,
+	}, ev)
+}
+
+// A(C) -> B, B(D) -> A
+// A: upstream/downstream, B: downstream/upstream, C: proxy for A, D: proxy for B
+func (suite *KeeperTestSuite) TestProxyEvents() {
+	connA, connB, ppair := suite.createBothSideProxyConnection()
+	chanA, chanB := suite.coordinator.CreateChannelWithProxy(suite.chainA, suite.chainB, connA, connB, ibctesting.TransferPort, ibctesting.TransferPort, channeltypes.UNORDERED, ppair)
+
+	// C proxies the handshake states of B for A, and D proxies the ones of A for B
+	prefixA, prefixB := suite.chainA.GetPrefix(), suite.chainB.GetPrefix()
+	clientCB, clientDA := ppair[0].UpstreamClientID, ppair[1].UpstreamClientID
+	parse := func(chain *ibctesting.TestChain, ev proto.Message) {
+		suite.Require().NoError(ibctesting.ParseProxyEventFromEvents(chain.TxEvents, ev))
+	}
+
+	var connTry types.EventProxyConnectionOpenTry
+	parse(suite.chainD, &connTry)
+	suite.Require().Equal(types.EventProxyConnectionOpenTry{
+		UpstreamClientId: clientDA, UpstreamPrefix: prefixA, ConnectionId: connA.ID, ClientId: connA.ClientID,
+		CounterpartyClientId: connB.ClientID, ProofHeight: connTry.ProofHeight,
+	}, connTry)
+	var connAck types.EventProxyConnectionOpenAck
+	parse(suite.chainC, &connAck)
+	suite.Require().Equal(types.EventProxyConnectionOpenAck{
+		UpstreamClientId: clientCB, UpstreamPrefix: prefixB, ConnectionId: connB.ID, ClientId: connB.ClientID,
+		CounterpartyConnectionId: connA.ID, CounterpartyClientId: connA.ClientID, ProofHeight: connAck.ProofHeight,
+	}, connAck)
+	var connConfirm types.EventProxyConnectionOpenConfirm
+	parse(suite.chainD, &connConfirm)
+	suite.Require().Equal(types.EventProxyConnectionOpenConfirm{
+		UpstreamClientId: clientDA, UpstreamPrefix: prefixA, ConnectionId: connA.ID, ClientId: connA.ClientID,
+		CounterpartyConnectionId: connB.ID, CounterpartyClientId: connB.ClientID, ProofHeight: connConfirm.ProofHeight,
+	}, connConfirm)
+	var connFinalize types.EventProxyConnectionOpenFinalize
+	parse(suite.chainC, &connFinalize)
+	suite.Require().Equal(types.EventProxyConnectionOpenFinalize{
+		UpstreamClientId: clientCB, UpstreamPrefix: prefixB, ConnectionId: connB.ID, ClientId: connB.ClientID,
+		CounterpartyConnectionId: connA.ID, CounterpartyClientId: connA.ClientID, ProofHeight: connFinalize.ProofHeight,
+	}, connFinalize)
+	suite.Require().True(connAck.ProofHeight.GT(connTry.ProofHeight))
+	suite.Require().True(connFinalize.ProofHeight.GT(connConfirm.ProofHeight))
+
+	var chanTry types.EventProxyChannelOpenTry
+	parse(suite.chainD, &chanTry)
+	suite.Require().Equal(types.EventProxyChannelOpenTry{
+		UpstreamClientId: clientDA, UpstreamPrefix: prefixA, PortId: chanA.PortID, ChannelId: chanA.ID,
+		CounterpartyPortId: chanB.PortID, ConnectionId: connA.ID, ProofHeight: chanTry.ProofHeight,
+	}, chanTry)
+	var chanAck types.EventProxyChannelOpenAck
+	parse(suite.chainC, &chanAck)
+	suite.Require().Equal(types.EventProxyChannelOpenAck{
+		UpstreamClientId: clientCB, UpstreamPrefix: prefixB, PortId: chanB.PortID, ChannelId: chanB.ID,
+		CounterpartyPortId: chanA.PortID, CounterpartyChannelId: chanA.ID, ConnectionId: connB.ID, ProofHeight: chanAck.ProofHeight,
+	}, chanAck)
+	var chanConfirm types.EventProxyChannelOpenConfirm
+	parse(suite.chainD, &chanConfirm)
+	suite.Require().Equal(types.EventProxyChannelOpenConfirm{
+		UpstreamClientId: clientDA, UpstreamPrefix: prefixA, PortId: chanA.PortID, ChannelId: chanA.ID,
+		CounterpartyPortId: chanB.PortID, CounterpartyChannelId: chanB.ID, ConnectionId: connA.ID, ProofHeight: chanConfirm.ProofHeight,
+	}, chanConfirm)
+	var chanFinalize types.EventProxyChannelOpenFinalize
+	parse(suite.chainC, &chanFinalize)
+	suite.Require().Equal(types.EventProxyChannelOpenFinalize{
+		UpstreamClientId: clientCB, UpstreamPrefix: prefixB, PortId: chanB.PortID, ChannelId: chanB.ID,
+		CounterpartyPortId: chanA.PortID, CounterpartyChannelId: chanA.ID, ConnectionId: connB.ID, ProofHeight: chanFinalize.ProofHeight,
+	}, chanFinalize)
+
+	timeoutHeight := clienttypes.NewHeight(0, 110)
+	coinToSendToA := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	msg := transfertypes.NewMsgTransfer(chanB.PortID, chanB.ID, coinToSendToA, suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), timeoutHeight, 0)
+	suite.Require().NoError(suite.coordinator.SendPacketWithProxy(suite.chainB, suite.chainA, connB, connA, ppair.Swap(), msg))
+
+	fungibleTokenPacket := transfertypes.NewFungibleTokenPacketData(coinToSendToA.Denom, coinToSendToA.Amount.Uint64(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String())
+	packet := channeltypes.NewPacket(fungibleTokenPacket.GetBytes(), 1, chanB.PortID, chanB.ID, chanA.PortID, chanA.ID, timeoutHeight, 0)
+
+	// C proxies B's packet commitment for A
+	proxy := ppair[0]
+	proof, proofHeight := suite.chainB.QueryProof(host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+	res, err := proxy.Chain.SendMsgs(&types.MsgProxyRecvPacket{
+		UpstreamClientId: proxy.UpstreamClientID,
+		UpstreamPrefix:   proxy.UpstreamPrefix.(commitmenttypes.MerklePrefix),
+		Packet:           packet,
+		Proof:            proof,
+		ProofHeight:      proofHeight,
+		Signer:           proxy.Chain.SenderAccount.GetAddress().String(),
+	})
+	suite.Require().NoError(err)
+
+	var events sdk.Events
+	for _, ev := range res.Events {
+		events = append(events, sdk.Event(ev))
+	}
+
+	var ev types.EventProxyRecvPacket
+	suite.Require().NoError(ibctesting.ParseProxyEventFromEvents(events, &ev))
+	suite.Require().Equal(types.EventProxyRecvPacket{
+		UpstreamClientId:   proxy.UpstreamClientID,
+		UpstreamPrefix:     proxy.UpstreamPrefix.(commitmenttypes.MerklePrefix),
+		Sequence:           packet.GetSequence(),
+		SourcePort:         chanB.PortID,
+		SourceChannel:      chanB.ID,
+		DestinationPort:    chanA.PortID,
+		DestinationChannel: chanA.ID,
+		ConnectionId:       connB.ID,
+		ProofHeight:        proofHeight,
+	}, ev)
+
+	suite.Require().Error(ibctesting.ParseProxyEventFromEvents(events, &types.EventProxyAcknowledgePacket{}))
+
+	// C proxies the acknowledgement of B for A
+	suite.testHandleMsgTransfer(connA, connB, chanA, chanB, ppair)
+	var ackEv types.EventProxyAcknowledgePacket
+	parse(suite.chainC, &ackEv)
+	suite.Require().Equal(types.EventProxyAcknowledgePacket{
+		UpstreamClientId:   clientCB,
+		UpstreamPrefix:     prefixB,
+		Sequence:           1,
+		SourcePort:         chanA.PortID,
+		SourceChannel:      chanA.ID,
+		DestinationPort:    chanB.PortID,
+		DestinationChannel: chanB.ID,
+		ConnectionId:       connB.ID,
+		ProofHeight:        ackEv.ProofHeight,
+	}, ackEv)
+}
+
+// setChannelClosed sets the channel state to CLOSED without the closing handshake
+func (suite *KeeperTestSuite) setChannelClosed(chain *ibctesting.TestChain, testChannel ibctesting.TestChannel) {
+	channel := chain.GetChannel(testChannel)
+	channel.State = channeltypes.CLOSED
+	chain.App.GetIBCKeeper().ChannelKeeper.SetChannel(chain.GetContext(), testChannel.PortID, testChannel.ID, channel)
+	suite.coordinator.CommitBlock(chain)
+}
+
+// proxyTimeoutPacket calls TimeoutPacket of the proxy keeper directly with the latest proof of the upstream
+func (suite *KeeperTestSuite) proxyTimeoutPacket(proxy *ibctesting.ProxyInfo, upstream *ibctesting.TestChain, packet channeltypes.Packet, nextSequenceRecv uint64) error {
+	suite.Require().NoError(suite.coordinator.UpdateClient(proxy.Chain, upstream, proxy.UpstreamClientID, exported.Tendermint))
+
+	channel, found := upstream.App.GetIBCKeeper().ChannelKeeper.GetChannel(upstream.GetContext(), packet.GetDestPort(), packet.GetDestChannel())
+	suite.Require().True(found)
+	key := host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	if channel.Ordering == channeltypes.ORDERED {
+		key = host.NextSequenceRecvKey(packet.GetDestPort(), packet.GetDestChannel())
+	}
+	proof, proofHeight := upstream.QueryProof(key)
+
+	return proxy.Chain.App.(*simapp.SimApp).IBCProxyKeeper.TimeoutPacket(
+		proxy.Chain.GetContext(), proxy.UpstreamClientID, proxy.UpstreamPrefix,
+		packet, proof, proofHeight, nextSequenceRecv,
+	)
+}
+
+func (suite *KeeperTestSuite) createBothSideProxyConnection() (connA, connB *ibctesting.TestConnection, ppair ibctesting.ProxyPair) {
 	// use different clientIDs for each chain
 	suite.Require().NoError(suite.coordinator.IncrementClientSequence(suite.chainC, suite.chainB, exported.Tendermint, 1))
 	suite.Require().NoError(suite.coordinator.IncrementClientSequence(suite.chainB, suite.chainD, exported.Tendermint, 2))
@@ -80,10 +625,12 @@ func (suite *KeeperTestSuite) TestBothSideProxy() {
 	clientBD, err := suite.coordinator.CreateProxyClient(suite.chainB, suite.chainD, exported.Tendermint, clientDA)
 	suite.Require().NoError(err)
 
-	ppair := ibctesting.ProxyPair{{suite.chainC, clientAC, clientCB, suite.chainB.GetPrefix()}, {suite.chainD, clientBD, clientDA, suite.chainA.GetPrefix()}}
-	connA, connB := suite.coordinator.CreateConnectionWithProxy(suite.chainA, suite.chainB, clientAC, clientBD, ibctesting.TransferVersion, ppair)
-	chanA, chanB := suite.coordinator.CreateChannelWithProxy(suite.chainA, suite.chainB, connA, connB, ibctesting.TransferPort, ibctesting.TransferPort, channeltypes.UNORDERED, ppair)
-	suite.testHandleMsgTransfer(connA, connB, chanA, chanB, ppair)
+	ppair = ibctesting.ProxyPair{
+		{Chain: suite.chainC, ClientID: clientAC, UpstreamClientID: clientCB, UpstreamPrefix: suite.chainB.GetPrefix()},
+		{Chain: suite.chainD, ClientID: clientBD, UpstreamClientID: clientDA, UpstreamPrefix: suite.chainA.GetPrefix()},
+	}
+	connA, connB = suite.coordinator.CreateConnectionWithProxy(suite.chainA, suite.chainB, clientAC, clientBD, ibctesting.TransferVersion, ppair)
+	return connA, connB, ppair
 }
 
 func (suite *KeeperTestSuite) testHandleMsgTransfer(connA, connB *ibctesting.TestConnection, chanA, chanB *ibctesting.TestChannel, proxies ibctesting.ProxyPair) {
@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"bytes"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/modules/core/24-host"
+	"github.com/cosmos/ibc-go/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/modules/light-clients/07-tendermint/types"
+
+	"github.com/datachainlab/ibc-proxy/modules/proxy/types"
+)
+
+// setPrunableProxyState sets the proxy state and enqueues it for pruning if it is newly written.
+// Only the states that the upstream keeps forever can be prunable, so that a pruned state can be proxied again.
+func (k Keeper) setPrunableProxyState(ctx sdk.Context, upstreamPrefix exported.Prefix, upstreamClientID string, key, value []byte) error {
+	store := k.ProxyStore(ctx, upstreamPrefix, upstreamClientID)
+	// the proxied state is write-once
+	if bz := store.Get(key); bz != nil {
+		return k.checkProxyCommitmentConflict(ctx, upstreamPrefix, upstreamClientID, key, bz, value)
+	}
+	proxyKey := types.ProxyKey(upstreamPrefix, upstreamClientID, key)
+	ctx.KVStore(k.proxyStoreKey).Set(types.PruningQueueKey(ctx.BlockTime(), proxyKey), []byte{})
+	store.Set(key, value)
+	return nil
+}
+
+// PruneProxyPackets deletes at most limit packet acknowledgements that have been kept longer than the retention period.
+// The rest of the prunable acknowledgements are left in the queue for the following blocks.
+func (k Keeper) PruneProxyPackets(ctx sdk.Context, limit int) {
+	var keys [][]byte
+	k.iteratePrunableProxyPackets(ctx, func(key []byte) bool {
+		keys = append(keys, key)
+		return len(keys) >= limit
+	})
+
+	store := ctx.KVStore(k.proxyStoreKey)
+	for _, key := range keys {
+		_, proxyKey := types.SplitPruningQueueKey(key)
+		store.Delete(proxyKey)
+		store.Delete(key)
+	}
+}
+
+// GetPrunableProxyAcknowledgementCount returns the number of the packet acknowledgements of the upstream
+// that have been kept longer than the retention period
+func (k Keeper) GetPrunableProxyAcknowledgementCount(
+	ctx sdk.Context,
+	upstreamPrefix exported.Prefix,
+	upstreamClientID string,
+) uint64 {
+	var count uint64
+	upstreamKey := types.ProxyKey(upstreamPrefix, upstreamClientID, nil)
+	k.iteratePrunableProxyPackets(ctx, func(key []byte) bool {
+		_, proxyKey := types.SplitPruningQueueKey(key)
+		if bytes.HasPrefix(proxyKey, upstreamKey) && bytes.HasPrefix(proxyKey[len(upstreamKey):], []byte(host.KeyPacketAckPrefix+"/")) {
+			count++
+		}
+		return false
+	})
+	return count
+}
+
+// iteratePrunableProxyPackets calls cb with each pruning queue key whose proxy state has outlived the retention period.
+// The iteration stops when cb returns true.
+func (k Keeper) iteratePrunableProxyPackets(ctx sdk.Context, cb func(key []byte) (stop bool)) {
+	period := k.GetParams(ctx).PacketRetentionPeriod
+	if period == 0 {
+		return
+	}
+	cutoff := ctx.BlockTime().Add(-period)
+	if cutoff.Before(time.Unix(0, 0)) {
+		return
+	}
+
+	iterator := ctx.KVStore(k.proxyStoreKey).Iterator(types.KeyPruningQueuePrefix, sdk.PrefixEndBytes(types.PruningQueueKey(cutoff, nil)))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(iterator.Key()) {
+			break
+		}
+	}
+}
+
+// VerifyAndPruneProxyPacketCommitment deletes the proxied packet commitment after verifying that the upstream has deleted it,
+// which means that the packet has been acknowledged or timed out on the upstream.
+// The fees escrowed for the packet are refunded, which are left only if nobody proxied the packet commitment.
+// The absence must be proven at the latest height of the upstream client, which is never lower than the height
+// at which the commitment was proven to be proxied. The upstream client must be a Tendermint client.
+func (k Keeper) VerifyAndPruneProxyPacketCommitment(
+	ctx sdk.Context,
+	upstreamClientID string,
+	upstreamPrefix exported.Prefix,
+	proofHeight exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+) error {
+	if err := k.checkProxyNotPaused(ctx, upstreamClientID, portID, channelID); err != nil {
+		return err
+	}
+	_, found := k.GetProxyPacketCommitment(ctx, upstreamPrefix, upstreamClientID, portID, channelID, sequence)
+	_, hasFees := k.GetPacketFees(ctx, upstreamPrefix, upstreamClientID, types.PacketFromUpstream, portID, channelID, sequence)
+	if !found && !hasFees {
+		return sdkerrors.Wrapf(types.ErrProxyStateNotFound, "packet commitment of upstream client: %s, port: %s, channel: %s, sequence: %d", upstreamClientID, portID, channelID, sequence)
+	}
+	if err := k.verifyPacketCommitmentAbsence(ctx, upstreamClientID, upstreamPrefix, proofHeight, proof, portID, channelID, sequence); err != nil {
+		return sdkerrors.Wrapf(err, "failed packet commitment absence verification for client (%s)", upstreamClientID)
+	}
+	k.ProxyStore(ctx, upstreamPrefix, upstreamClientID).Delete(host.PacketCommitmentKey(portID, channelID, sequence))
+	return k.RefundPacketFees(ctx, upstreamPrefix, upstreamClientID, types.PacketFromUpstream, portID, channelID, sequence)
+}
+
+// verifyPacketCommitmentAbsence verifies a proof of the absence of the packet commitment on the upstream
+// at the latest height of the upstream client
+func (k Keeper) verifyPacketCommitmentAbsence(
+	ctx sdk.Context,
+	upstreamClientID string,
+	upstreamPrefix exported.Prefix,
+	proofHeight exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+) error {
+	clientState, found := k.clientKeeper.GetClientState(ctx, upstreamClientID)
+	if !found {
+		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, upstreamClientID)
+	}
+	tmClientState, ok := clientState.(*ibctmtypes.ClientState)
+	if !ok {
+		return sdkerrors.Wrapf(clienttypes.ErrInvalidClientType, "expected %s client, got %s", exported.Tendermint, clientState.ClientType())
+	}
+	if status := clientState.Status(ctx, k.clientKeeper.ClientStore(ctx, upstreamClientID), k.cdc); status != exported.Active {
+		return sdkerrors.Wrapf(clienttypes.ErrClientNotActive, "upstream client (%s) status is %s", upstreamClientID, status)
+	}
+	if !proofHeight.EQ(clientState.GetLatestHeight()) {
+		return sdkerrors.Wrapf(clienttypes.ErrInvalidHeight, "proof height %s must be the latest height %s of the upstream client", proofHeight, clientState.GetLatestHeight())
+	}
+	consensusState, found := k.clientKeeper.GetClientConsensusState(ctx, upstreamClientID, proofHeight)
+	if !found {
+		return sdkerrors.Wrapf(clienttypes.ErrConsensusStateNotFound, "upstream client: %s, height: %s", upstreamClientID, proofHeight)
+	}
+	var merkleProof commitmenttypes.MerkleProof
+	if err := k.cdc.Unmarshal(proof, &merkleProof); err != nil {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "failed to unmarshal the proof into a merkle proof")
+	}
+	path, err := commitmenttypes.ApplyPrefix(upstreamPrefix, commitmenttypes.NewMerklePath(host.PacketCommitmentPath(portID, channelID, sequence)))
+	if err != nil {
+		return err
+	}
+	return merkleProof.VerifyNonMembership(tmClientState.ProofSpecs, consensusState.GetRoot(), path)
+}
@@ -0,0 +1,178 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/modules/core/24-host"
+	"github.com/cosmos/ibc-go/modules/core/exported"
+
+	"github.com/datachainlab/ibc-proxy/modules/proxy/keeper"
+	"github.com/datachainlab/ibc-proxy/modules/proxy/types"
+	ibctesting "github.com/datachainlab/ibc-proxy/testing"
+	"github.com/datachainlab/ibc-proxy/testing/simapp"
+)
+
+// setupPruning opens a transfer channel between A and B through the proxy C, and returns the upstream client of B on C
+// A(C) -> B, B -> A
+// A: downstream, B: upstream, C: proxy
+func (suite *KeeperTestSuite) setupPruning() (string, *ibctesting.TestConnection, *ibctesting.TestConnection, *ibctesting.TestChannel, *ibctesting.TestChannel, ibctesting.ProxyPair) {
+	suite.Require().NoError(suite.coordinator.IncrementClientSequence(suite.chainC, suite.chainB, exported.Tendermint, 1))
+	suite.Require().NoError(suite.coordinator.IncrementClientSequence(suite.chainB, suite.chainA, exported.Tendermint, 2))
+
+	clientCB, err := suite.coordinator.CreateClient2(suite.chainC, suite.chainB, exported.Tendermint, false, 0)
+	suite.Require().NoError(err)
+
+	clientBA, err := suite.coordinator.CreateMultiVClient(suite.chainB, suite.chainA, exported.Tendermint, 0)
+	suite.Require().NoError(err)
+
+	clientAC, err := suite.coordinator.CreateProxyClient(suite.chainA, suite.chainC, exported.Tendermint, clientCB)
+	suite.Require().NoError(err)
+
+	ppair := ibctesting.ProxyPair{{Chain: suite.chainC, ClientID: clientAC, UpstreamClientID: clientCB, UpstreamPrefix: suite.chainB.GetPrefix()}, nil}
+	connA, connB := suite.coordinator.CreateConnectionWithProxy(suite.chainA, suite.chainB, clientAC, clientBA, ibctesting.TransferVersion, ppair)
+	chanA, chanB := suite.coordinator.CreateChannelWithProxy(suite.chainA, suite.chainB, connA, connB, ibctesting.TransferPort, ibctesting.TransferPort, channeltypes.UNORDERED, ppair)
+	return clientCB, connA, connB, chanA, chanB, ppair
+}
+
+// sendPacketFromUpstream sends a packet from B to A, and the proxy keeps the packet commitment of B
+func (suite *KeeperTestSuite) sendPacketFromUpstream(connA, connB *ibctesting.TestConnection, chanA, chanB *ibctesting.TestChannel, ppair ibctesting.ProxyPair) channeltypes.Packet {
+	timeoutHeight := clienttypes.NewHeight(0, 110)
+	coinToSendToA := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	msg := transfertypes.NewMsgTransfer(chanB.PortID, chanB.ID, coinToSendToA, suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), timeoutHeight, 0)
+	suite.Require().NoError(suite.coordinator.SendPacketWithProxy(suite.chainB, suite.chainA, connB, connA, ppair.Swap(), msg))
+	fungibleTokenPacket := transfertypes.NewFungibleTokenPacketData(coinToSendToA.Denom, coinToSendToA.Amount.Uint64(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String())
+	packet := channeltypes.NewPacket(fungibleTokenPacket.GetBytes(), 1, chanB.PortID, chanB.ID, chanA.PortID, chanA.ID, timeoutHeight, 0)
+	suite.Require().NoError(suite.coordinator.RecvPacketWithProxy(suite.chainA, suite.chainB, connA, connB, packet, ppair))
+	return packet
+}
+
+func (suite *KeeperTestSuite) TestPruneProxyPackets() {
+	clientCB, connA, connB, chanA, chanB, ppair := suite.setupPruning()
+
+	proxyKeeper := suite.chainC.App.(*simapp.SimApp).IBCProxyKeeper
+	proxyKeeper.SetParams(suite.chainC.GetContext(), types.NewParams(types.UpstreamModeOpen, nil, time.Hour, types.DefaultMaxExpectedTimePerBlock, ""))
+
+	// the proxy keeps the acknowledgements and the packet commitment of B
+	suite.testHandleMsgTransfer(connA, connB, chanA, chanB, ppair)
+	prefix := suite.chainB.GetPrefix()
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()
+	suite.Require().NoError(proxyKeeper.SetProxyPacketAcknowledgement(suite.chainC.GetContext(), &prefix, clientCB, chanB.PortID, chanB.ID, 2, ack))
+	suite.sendPacketFromUpstream(connA, connB, chanA, chanB, ppair)
+
+	querier := keeper.Querier{Keeper: proxyKeeper}
+	req := &types.QueryProxyPrunablePacketsRequest{UpstreamClientId: clientCB, UpstreamPrefix: prefix}
+
+	// nothing has outlived the retention period yet
+	res, err := querier.ProxyPrunablePackets(sdk.WrapSDKContext(suite.chainC.GetContext()), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.QueryProxyPrunablePacketsResponse{}, res)
+	proxyKeeper.PruneProxyPackets(suite.chainC.GetContext(), types.MaxPrunedProxyPacketsPerBlock)
+	_, found := proxyKeeper.GetProxyPacketAcknowledgement(suite.chainC.GetContext(), &prefix, clientCB, chanB.PortID, chanB.ID, 1)
+	suite.Require().True(found)
+
+	suite.coordinator.IncrementTimeBy(2 * time.Hour)
+	ctx := suite.chainC.GetContext()
+	res, err = querier.ProxyPrunablePackets(sdk.WrapSDKContext(ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.QueryProxyPrunablePacketsResponse{Acknowledgements: 2}, res)
+
+	// the pruning is capped per block and the rest is left for the following blocks
+	proxyKeeper.PruneProxyPackets(ctx, 1)
+	res, err = querier.ProxyPrunablePackets(sdk.WrapSDKContext(ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.QueryProxyPrunablePacketsResponse{Acknowledgements: 1}, res)
+
+	proxyKeeper.PruneProxyPackets(ctx, types.MaxPrunedProxyPacketsPerBlock)
+	for _, seq := range []uint64{1, 2} {
+		_, found = proxyKeeper.GetProxyPacketAcknowledgement(ctx, &prefix, clientCB, chanB.PortID, chanB.ID, seq)
+		suite.Require().False(found)
+	}
+	res, err = querier.ProxyPrunablePackets(sdk.WrapSDKContext(ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.QueryProxyPrunablePacketsResponse{}, res)
+
+	// the packet commitment is kept until the upstream deletes it
+	_, found = proxyKeeper.GetProxyPacketCommitment(ctx, &prefix, clientCB, chanB.PortID, chanB.ID, 1)
+	suite.Require().True(found)
+	_, found = proxyKeeper.GetProxyChannel(ctx, &prefix, clientCB, chanB.PortID, chanB.ID)
+	suite.Require().True(found)
+
+	// the upstream keeps the acknowledgement, so a late relayer can proxy it again
+	suite.Require().NoError(suite.coordinator.UpdateClient(suite.chainC, suite.chainB, clientCB, exported.Tendermint))
+	fungibleTokenPacket := transfertypes.NewFungibleTokenPacketData(sdk.DefaultBondDenom, 100, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String())
+	packet := channeltypes.NewPacket(fungibleTokenPacket.GetBytes(), 1, chanA.PortID, chanA.ID, chanB.PortID, chanB.ID, clienttypes.NewHeight(0, 110), 0)
+	proof, proofHeight := suite.chainB.QueryProof(host.PacketAcknowledgementKey(chanB.PortID, chanB.ID, 1))
+	ctx = suite.chainC.GetContext()
+	_, err = proxyKeeper.ProxyAcknowledgePacket(sdk.WrapSDKContext(ctx), &types.MsgProxyAcknowledgePacket{
+		UpstreamClientId: clientCB,
+		UpstreamPrefix:   prefix,
+		Packet:           packet,
+		Acknowledgement:  ack,
+		Proof:            proof,
+		ProofHeight:      proofHeight,
+		Signer:           suite.chainC.SenderAccount.GetAddress().String(),
+	})
+	suite.Require().NoError(err)
+	_, found = proxyKeeper.GetProxyPacketAcknowledgement(ctx, &prefix, clientCB, chanB.PortID, chanB.ID, 1)
+	suite.Require().True(found)
+
+	// the proxy states are kept across the genesis export and import
+	genesis := proxyKeeper.ExportGenesis(ctx)
+	suite.Require().Len(genesis.Upstreams, 1)
+	suite.Require().NoError(genesis.Validate())
+	importKeeper := suite.chainA.App.(*simapp.SimApp).IBCProxyKeeper
+	importCtx := suite.chainA.GetContext()
+	importKeeper.InitGenesis(importCtx, *genesis)
+	suite.Require().Equal(genesis.Upstreams, importKeeper.ExportGenesis(importCtx).Upstreams)
+}
+
+func (suite *KeeperTestSuite) TestPruneProxyPacketCommitment() {
+	clientCB, connA, connB, chanA, chanB, ppair := suite.setupPruning()
+	packet := suite.sendPacketFromUpstream(connA, connB, chanA, chanB, ppair)
+
+	proxyKeeper := suite.chainC.App.(*simapp.SimApp).IBCProxyKeeper
+	prefix := suite.chainB.GetPrefix()
+	commitmentKey := host.PacketCommitmentKey(chanB.PortID, chanB.ID, 1)
+	newMsg := func() *types.MsgPruneProxyPacketCommitment {
+		proof, proofHeight := suite.chainB.QueryProof(commitmentKey)
+		return types.NewMsgPruneProxyPacketCommitment(clientCB, prefix, chanB.PortID, chanB.ID, 1, proof, proofHeight, suite.chainC.SenderAccount.GetAddress().String())
+	}
+	prune := func(msg *types.MsgPruneProxyPacketCommitment) error {
+		suite.Require().NoError(msg.ValidateBasic())
+		cacheCtx, write := suite.chainC.GetContext().CacheContext()
+		if _, err := proxyKeeper.PruneProxyPacketCommitment(sdk.WrapSDKContext(cacheCtx), msg); err != nil {
+			return err
+		}
+		write()
+		return nil
+	}
+
+	// the upstream still has the packet commitment
+	suite.Require().NoError(suite.coordinator.UpdateClient(suite.chainC, suite.chainB, clientCB, exported.Tendermint))
+	suite.Require().ErrorIs(prune(newMsg()), commitmenttypes.ErrInvalidProof)
+
+	// the upstream deletes the packet commitment when the packet is acknowledged
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()
+	suite.Require().NoError(suite.coordinator.AcknowledgePacketWithProxy(suite.chainB, suite.chainA, chanB, chanA, connB, connA, packet, ack, ppair.Swap()))
+	staleMsg := newMsg()
+
+	// the absence must be proven at the latest height of the upstream client
+	suite.Require().NoError(suite.coordinator.UpdateClient(suite.chainC, suite.chainB, clientCB, exported.Tendermint))
+	suite.Require().ErrorIs(prune(staleMsg), clienttypes.ErrInvalidHeight)
+
+	// the pruning is paused along with the proxying
+	ctx := suite.chainC.GetContext()
+	suite.Require().NoError(proxyKeeper.AddPausedProxy(ctx, types.NewPausedProxy(clientCB, "", ""), proxyKeeper.GetAuthority()))
+	suite.Require().ErrorIs(prune(newMsg()), types.ErrProxyPaused)
+	suite.Require().NoError(proxyKeeper.RemovePausedProxy(ctx, types.NewPausedProxy(clientCB, "", "")))
+
+	suite.Require().NoError(prune(newMsg()))
+	_, found := proxyKeeper.GetProxyPacketCommitment(suite.chainC.GetContext(), &prefix, clientCB, chanB.PortID, chanB.ID, 1)
+	suite.Require().False(found)
+	suite.Require().ErrorIs(prune(newMsg()), types.ErrProxyStateNotFound)
+}
@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/modules/light-clients/07-tendermint/types"
+
+	proxyclienttypes "github.com/datachainlab/ibc-proxy/modules/light-clients/xx-proxy/types"
+	ibctesting "github.com/datachainlab/ibc-proxy/testing"
+	"github.com/datachainlab/ibc-proxy/testing/simapp"
+)
+
+// A(C) -> B
+// A: downstream, B: upstream, C: proxy
+func (suite *KeeperTestSuite) TestUpgradeProxyClient() {
+	clientCB, err := suite.coordinator.CreateClient2(suite.chainC, suite.chainB, exported.Tendermint, false, 0)
+	suite.Require().NoError(err)
+	suite.coordinator.CommitBlock(suite.chainA, suite.chainC)
+	clientAC, err := suite.coordinator.CreateProxyClient(suite.chainA, suite.chainC, exported.Tendermint, clientCB)
+	suite.Require().NoError(err)
+	clientState := suite.chainA.GetClientState(clientAC).(*proxyclienttypes.ClientState)
+	tmClientState := clientState.GetProxyClientState().(*ibctmtypes.ClientState)
+
+	// C commits the states of the upgraded client at the next height, which is the last height of the current revision
+	newChainID := suite.chainC.ChainID + "-1"
+	upgradedHeight := clienttypes.NewHeight(1, 1)
+	upgradedClient := ibctmtypes.NewClientState(
+		newChainID, ibctesting.DefaultTrustLevel, tmClientState.TrustingPeriod, tmClientState.UnbondingPeriod, tmClientState.MaxClockDrift,
+		upgradedHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath, false, false,
+	).ZeroCustomFields()
+	upgradedConsState := &ibctmtypes.ConsensusState{NextValidatorsHash: []byte("next_vals_hash")}
+	cdc := suite.chainC.App.AppCodec()
+	upgradedClientBz, err := clienttypes.MarshalClientState(cdc, upgradedClient)
+	suite.Require().NoError(err)
+	upgradedConsStateBz, err := clienttypes.MarshalConsensusState(cdc, upgradedConsState)
+	suite.Require().NoError(err)
+
+	lastHeight := suite.chainC.GetContext().BlockHeight() + 1
+	upgradeKeeper := suite.chainC.App.(*simapp.SimApp).UpgradeKeeper
+	suite.Require().NoError(upgradeKeeper.SetUpgradedClient(suite.chainC.GetContext(), lastHeight, upgradedClientBz))
+	suite.Require().NoError(upgradeKeeper.SetUpgradedConsensusState(suite.chainC.GetContext(), lastHeight, upgradedConsStateBz))
+	// the states are queryable after the blocks that commit them
+	suite.coordinator.CommitBlock(suite.chainC)
+	suite.coordinator.CommitBlock(suite.chainC)
+	suite.Require().NoError(suite.chainA.UpdateProxyClient(suite.chainC, clientAC))
+
+	proofHeight := suite.chainA.GetClientState(clientAC).GetLatestHeight().GetRevisionHeight()
+	suite.Require().Equal(uint64(lastHeight), proofHeight)
+	proofUpgradedClient, _ := suite.chainC.QueryUpgradeProof(upgradetypes.UpgradedClientKey(lastHeight), proofHeight)
+	proofUpgradedConsState, _ := suite.chainC.QueryUpgradeProof(upgradetypes.UpgradedConsStateKey(lastHeight), proofHeight)
+
+	// the proofs must be of the committed states
+	cacheCtx, _ := suite.chainA.GetContext().CacheContext()
+	err = suite.chainA.App.GetIBCKeeper().ClientKeeper.UpgradeClient(cacheCtx, clientAC, upgradedClient, upgradedConsState, proofUpgradedConsState, proofUpgradedClient)
+	suite.Require().Error(err)
+
+	msg, err := clienttypes.NewMsgUpgradeClient(clientAC, upgradedClient, upgradedConsState, proofUpgradedClient, proofUpgradedConsState, suite.chainA.SenderAccount.GetAddress().String())
+	suite.Require().NoError(err)
+	_, err = suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	// the upgraded states are still the ones of the proxy client
+	newClientState, ok := suite.chainA.GetClientState(clientAC).(*proxyclienttypes.ClientState)
+	suite.Require().True(ok)
+	suite.Require().Equal(clientState.UpstreamClientId, newClientState.UpstreamClientId)
+	suite.Require().Equal(clientState.ProxyPrefix, newClientState.ProxyPrefix)
+	suite.Require().Equal(clientState.IbcPrefix, newClientState.IbcPrefix)
+	suite.Require().Equal(upgradedHeight, newClientState.GetLatestHeight())
+	newTMClientState := newClientState.GetProxyClientState().(*ibctmtypes.ClientState)
+	suite.Require().Equal(newChainID, newTMClientState.ChainId)
+	suite.Require().Equal(tmClientState.TrustLevel, newTMClientState.TrustLevel)
+
+	consensusState, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientConsensusState(suite.chainA.GetContext(), clientAC, upgradedHeight)
+	suite.Require().True(found)
+	proxyConsensusState, ok := consensusState.(*proxyclienttypes.ConsensusState)
+	suite.Require().True(ok)
+	suite.Require().Equal(upgradedConsState.NextValidatorsHash, proxyConsensusState.GetProxyConsensusState().(*ibctmtypes.ConsensusState).NextValidatorsHash)
+
+	// zeroing the custom fields of the upgraded client gives back the committed client in the proxy client
+	zeroed := newClientState.ZeroCustomFields().(*proxyclienttypes.ClientState)
+	suite.Require().Equal(upgradedClient, zeroed.GetProxyClientState())
+	suite.Require().Equal(clientState.UpstreamClientId, zeroed.UpstreamClientId)
+	suite.Require().Equal(clientState.ProxyPrefix, zeroed.ProxyPrefix)
+	suite.Require().Equal(clientState.IbcPrefix, zeroed.IbcPrefix)
+}
@@ -0,0 +1,57 @@
+package v1
+
+import (
+	"bytes"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	commitmenttypes "github.com/cosmos/ibc-go/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/modules/core/24-host"
+	"github.com/cosmos/ibc-go/modules/core/exported"
+)
+
+// ProxyKey returns the store key for a proxy state in the consensus version 1 of the proxy module,
+// in the form of "{upstream_client_id}/{upstream_prefix}/{key}"
+func ProxyKey(upstreamPrefix exported.Prefix, upstreamClientID string, key []byte) []byte {
+	return append(append([]byte(upstreamClientID+"/"), string(upstreamPrefix.Bytes())+"/"...), key...)
+}
+
+// ProxyStateIterator returns an iterator over the proxy states in the consensus version 1 of the proxy module.
+// The keys begin with the upstream client ID, whose characters are between '#' and 'z' by the ICS-24 identifier format.
+func ProxyStateIterator(store sdk.KVStore) sdk.Iterator {
+	return store.Iterator([]byte{'#'}, []byte{'z' + 1})
+}
+
+// proxyPathPrefixes are the prefixes of the ICS-24 host paths that the proxy stores
+var proxyPathPrefixes = []string{
+	string(host.KeyClientStorePrefix),
+	host.KeyConnectionPrefix,
+	host.KeyChannelEndPrefix,
+	host.KeyPacketCommitmentPrefix,
+	host.KeyPacketAckPrefix,
+	host.KeyPacketReceiptPrefix,
+	host.KeyNextSeqRecvPrefix,
+}
+
+// ParseProxyKey splits a key returned by ProxyKey into the upstream client ID, the upstream prefix and the ICS-24 host path.
+// As the upstream prefix isn't length-prefixed, the path begins at the first host path prefix after the client ID.
+func ParseProxyKey(key []byte) (string, commitmenttypes.MerklePrefix, string, error) {
+	sep := bytes.IndexByte(key, '/')
+	if sep <= 0 {
+		return "", commitmenttypes.MerklePrefix{}, "", fmt.Errorf("invalid proxy key: %X", key)
+	}
+	upstreamClientID, rest := string(key[:sep]), key[sep+1:]
+
+	for i := 1; i < len(rest); i++ {
+		if rest[i-1] != '/' {
+			continue
+		}
+		for _, p := range proxyPathPrefixes {
+			if bytes.HasPrefix(rest[i:], []byte(p+"/")) {
+				return upstreamClientID, commitmenttypes.NewMerklePrefix(rest[:i-1]), string(rest[i:]), nil
+			}
+		}
+	}
+	return "", commitmenttypes.MerklePrefix{}, "", fmt.Errorf("invalid proxy key: %X", key)
+}
@@ -0,0 +1,65 @@
+package v2
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/modules/core/24-host"
+
+	v1 "github.com/datachainlab/ibc-proxy/modules/proxy/legacy/v1"
+	"github.com/datachainlab/ibc-proxy/modules/proxy/types"
+)
+
+// migrateProxyStateKeys migrates the keys of the proxy states to be length-prefixed.
+// old key is of format:
+// upstream_client_id || "/" || upstream_prefix || "/" || path
+// new key is of format:
+// len(upstream_client_id) || ":" || upstream_client_id || "/" || len(upstream_prefix) || ":" || upstream_prefix || "/" || path
+func migrateProxyStateKeys(store sdk.KVStore) error {
+	var oldKeys, newKeys, values [][]byte
+	iterator := v1.ProxyStateIterator(store)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		newKey, err := migrateProxyKey(iterator.Key())
+		if err != nil {
+			return err
+		}
+		oldKeys = append(oldKeys, iterator.Key())
+		newKeys = append(newKeys, newKey)
+		values = append(values, iterator.Value())
+	}
+
+	for _, key := range oldKeys {
+		store.Delete(key)
+	}
+	for i, key := range newKeys {
+		store.Set(key, values[i])
+	}
+	return nil
+}
+
+// migrateProxyKey returns the new key of the proxy state stored at the old key.
+// The marker of a packet receipt absence is moved from the receipt path to the one returned by PacketReceiptAbsencePath.
+func migrateProxyKey(oldKey []byte) ([]byte, error) {
+	upstreamClientID, upstreamPrefix, path, err := v1.ParseProxyKey(oldKey)
+	if err != nil {
+		return nil, err
+	}
+	if strings.HasPrefix(path, host.KeyPacketReceiptPrefix+"/") {
+		path = fmt.Sprintf("%s/%s%s", host.KeyPacketReceiptPrefix, host.KeyPacketCommitmentPrefix, strings.TrimPrefix(path, host.KeyPacketReceiptPrefix))
+	}
+	return types.ProxyKey(&upstreamPrefix, upstreamClientID, []byte(path)), nil
+}
+
+// MigrateStore performs in-place store migrations from the consensus version 1 to 2 of the proxy module.
+// The migration includes:
+//
+// - Change the keys of the proxy states to be length-prefixed.
+// - Move the markers of the packet receipt absences to the packet commitment paths under the receipt prefix.
+//
+// The proxy clients on the downstreams verify the proxy commitments at the new keys after the migration.
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey) error {
+	return migrateProxyStateKeys(ctx.KVStore(storeKey))
+}
@@ -0,0 +1,29 @@
+package proxy
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/datachainlab/ibc-proxy/modules/proxy/keeper"
+	"github.com/datachainlab/ibc-proxy/modules/proxy/types"
+)
+
+// NewProxyProposalHandler defines the proxy proposal handler
+func NewProxyProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.RegisterUpstreamProposal:
+			return k.AddUpstream(ctx, c.UpstreamClientId)
+		case *types.DeregisterUpstreamProposal:
+			return k.RemoveUpstream(ctx, c.UpstreamClientId)
+		case *types.UnpauseProxyProposal:
+			return k.RemovePausedProxy(ctx, types.NewPausedProxy(c.UpstreamClientId, c.PortId, c.ChannelId))
+		case *types.MigrateProxyClientProposal:
+			return k.MigrateClientProposal(ctx, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized proxy proposal content type: %T", c)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/ibc-go/modules/core/exported"
 	multivtypes "github.com/datachainlab/ibc-proxy/modules/light-clients/xx-multiv/types"
 	proxytypes "github.com/datachainlab/ibc-proxy/modules/light-clients/xx-proxy/types"
@@ -19,15 +20,38 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgProxyConnectionOpenAck{},
 		&MsgProxyConnectionOpenConfirm{},
 		&MsgProxyConnectionOpenFinalize{},
+		&MsgProxyConnectionState{},
 		&MsgProxyChannelOpenTry{},
 		&MsgProxyChannelOpenAck{},
 		&MsgProxyChannelOpenConfirm{},
 		&MsgProxyChannelOpenFinalize{},
+		&MsgProxyChannelCloseConfirm{},
+		&MsgProxyChannelState{},
 		&MsgProxyRecvPacket{},
 		&MsgProxyAcknowledgePacket{},
+		&MsgProxyPacketBatch{},
+		&MsgProxyTimeoutPacket{},
+		&MsgProxyTimeoutOnClose{},
+		&MsgPruneProxyPacketCommitment{},
+		&MsgProxyWithHeader{},
+		&MsgSubmitProxyMisbehaviour{},
+		&MsgPayProxyPacketFee{},
+		&MsgRegisterUpstream{},
+		&MsgDeregisterUpstream{},
+		&MsgPauseProxy{},
+		&MsgUnpauseProxy{},
+		&MsgMigrateProxyClient{},
+	)
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&RegisterUpstreamProposal{},
+		&DeregisterUpstreamProposal{},
+		&UnpauseProxyProposal{},
+		&MigrateProxyClientProposal{},
 	)
 	registry.RegisterImplementations((*exported.ClientState)(nil), &proxytypes.ClientState{})
 	registry.RegisterImplementations((*exported.ConsensusState)(nil), &proxytypes.ConsensusState{})
+	registry.RegisterImplementations((*exported.Misbehaviour)(nil), &proxytypes.Misbehaviour{}, &proxytypes.ProxyMisbehaviour{})
 	multivtypes.RegisterInterfaces(registry)
 }
 
@@ -0,0 +1,20 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// proxy module sentinel errors
+var (
+	ErrUpstreamNotAllowed         = sdkerrors.Register(ModuleName, 2, "upstream client is not allowed")
+	ErrUpstreamAlreadyRegistered  = sdkerrors.Register(ModuleName, 3, "upstream client is already registered")
+	ErrUpstreamNotRegistered      = sdkerrors.Register(ModuleName, 4, "upstream client is not registered")
+	ErrInvalidPacketBatch         = sdkerrors.Register(ModuleName, 5, "invalid packet batch")
+	ErrInvalidProxyMsg            = sdkerrors.Register(ModuleName, 6, "invalid proxy message")
+	ErrConflictingProxyCommitment = sdkerrors.Register(ModuleName, 7, "conflicting proxy commitment")
+	ErrInvalidPacketFee           = sdkerrors.Register(ModuleName, 8, "invalid packet fee")
+	ErrProxyPaused                = sdkerrors.Register(ModuleName, 9, "proxy is paused")
+	ErrProxyNotPaused             = sdkerrors.Register(ModuleName, 10, "proxy is not paused")
+	ErrInvalidClientMigration     = sdkerrors.Register(ModuleName, 11, "invalid proxy client migration")
+	ErrProxyStateNotFound         = sdkerrors.Register(ModuleName, 12, "proxy state not found")
+)
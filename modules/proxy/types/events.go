@@ -0,0 +1,307 @@
+package types
+
+import (
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/modules/core/exported"
+
+	proxytypes "github.com/datachainlab/ibc-proxy/modules/light-clients/xx-proxy/types"
+)
+
+// NewEventProxyClientState creates a new EventProxyClientState instance.
+func NewEventProxyClientState(
+	upstreamClientID string, upstreamPrefix exported.Prefix, counterpartyClientID string, proofHeight, consensusHeight exported.Height,
+) *EventProxyClientState {
+	return &EventProxyClientState{
+		UpstreamClientId:     upstreamClientID,
+		UpstreamPrefix:       eventPrefix(upstreamPrefix),
+		CounterpartyClientId: counterpartyClientID,
+		ProofHeight:          eventHeight(proofHeight),
+		ConsensusHeight:      eventHeight(consensusHeight),
+	}
+}
+
+// NewEventProxyConnectionOpenTry creates a new EventProxyConnectionOpenTry instance.
+func NewEventProxyConnectionOpenTry(
+	upstreamClientID string, upstreamPrefix exported.Prefix, connectionID string, connection connectiontypes.ConnectionEnd, proofHeight exported.Height,
+) *EventProxyConnectionOpenTry {
+	return &EventProxyConnectionOpenTry{
+		UpstreamClientId:         upstreamClientID,
+		UpstreamPrefix:           eventPrefix(upstreamPrefix),
+		ConnectionId:             connectionID,
+		ClientId:                 connection.ClientId,
+		CounterpartyConnectionId: connection.Counterparty.ConnectionId,
+		CounterpartyClientId:     connection.Counterparty.ClientId,
+		ProofHeight:              eventHeight(proofHeight),
+	}
+}
+
+// NewEventProxyConnectionOpenAck creates a new EventProxyConnectionOpenAck instance.
+func NewEventProxyConnectionOpenAck(
+	upstreamClientID string, upstreamPrefix exported.Prefix, connectionID string, connection connectiontypes.ConnectionEnd, proofHeight exported.Height,
+) *EventProxyConnectionOpenAck {
+	return &EventProxyConnectionOpenAck{
+		UpstreamClientId:         upstreamClientID,
+		UpstreamPrefix:           eventPrefix(upstreamPrefix),
+		ConnectionId:             connectionID,
+		ClientId:                 connection.ClientId,
+		CounterpartyConnectionId: connection.Counterparty.ConnectionId,
+		CounterpartyClientId:     connection.Counterparty.ClientId,
+		ProofHeight:              eventHeight(proofHeight),
+	}
+}
+
+// NewEventProxyConnectionOpenConfirm creates a new EventProxyConnectionOpenConfirm instance.
+func NewEventProxyConnectionOpenConfirm(
+	upstreamClientID string, upstreamPrefix exported.Prefix, connectionID string, connection connectiontypes.ConnectionEnd, proofHeight exported.Height,
+) *EventProxyConnectionOpenConfirm {
+	return &EventProxyConnectionOpenConfirm{
+		UpstreamClientId:         upstreamClientID,
+		UpstreamPrefix:           eventPrefix(upstreamPrefix),
+		ConnectionId:             connectionID,
+		ClientId:                 connection.ClientId,
+		CounterpartyConnectionId: connection.Counterparty.ConnectionId,
+		CounterpartyClientId:     connection.Counterparty.ClientId,
+		ProofHeight:              eventHeight(proofHeight),
+	}
+}
+
+// NewEventProxyConnectionOpenFinalize creates a new EventProxyConnectionOpenFinalize instance.
+func NewEventProxyConnectionOpenFinalize(
+	upstreamClientID string, upstreamPrefix exported.Prefix, connectionID string, connection connectiontypes.ConnectionEnd, proofHeight exported.Height,
+) *EventProxyConnectionOpenFinalize {
+	return &EventProxyConnectionOpenFinalize{
+		UpstreamClientId:         upstreamClientID,
+		UpstreamPrefix:           eventPrefix(upstreamPrefix),
+		ConnectionId:             connectionID,
+		ClientId:                 connection.ClientId,
+		CounterpartyConnectionId: connection.Counterparty.ConnectionId,
+		CounterpartyClientId:     connection.Counterparty.ClientId,
+		ProofHeight:              eventHeight(proofHeight),
+	}
+}
+
+// NewEventProxyConnectionState creates a new EventProxyConnectionState instance.
+func NewEventProxyConnectionState(
+	upstreamClientID string, upstreamPrefix exported.Prefix, connectionID string, connection connectiontypes.ConnectionEnd, proofHeight exported.Height,
+) *EventProxyConnectionState {
+	return &EventProxyConnectionState{
+		UpstreamClientId:         upstreamClientID,
+		UpstreamPrefix:           eventPrefix(upstreamPrefix),
+		ConnectionId:             connectionID,
+		ClientId:                 connection.ClientId,
+		CounterpartyConnectionId: connection.Counterparty.ConnectionId,
+		CounterpartyClientId:     connection.Counterparty.ClientId,
+		ProofHeight:              eventHeight(proofHeight),
+	}
+}
+
+// NewEventProxyChannelOpenTry creates a new EventProxyChannelOpenTry instance.
+func NewEventProxyChannelOpenTry(
+	upstreamClientID string, upstreamPrefix exported.Prefix, portID, channelID string, channel channeltypes.Channel, proofHeight exported.Height,
+) *EventProxyChannelOpenTry {
+	return &EventProxyChannelOpenTry{
+		UpstreamClientId:      upstreamClientID,
+		UpstreamPrefix:        eventPrefix(upstreamPrefix),
+		PortId:                portID,
+		ChannelId:             channelID,
+		CounterpartyPortId:    channel.Counterparty.PortId,
+		CounterpartyChannelId: channel.Counterparty.ChannelId,
+		ConnectionId:          channel.ConnectionHops[0],
+		ProofHeight:           eventHeight(proofHeight),
+	}
+}
+
+// NewEventProxyChannelOpenAck creates a new EventProxyChannelOpenAck instance.
+func NewEventProxyChannelOpenAck(
+	upstreamClientID string, upstreamPrefix exported.Prefix, portID, channelID string, channel channeltypes.Channel, proofHeight exported.Height,
+) *EventProxyChannelOpenAck {
+	return &EventProxyChannelOpenAck{
+		UpstreamClientId:      upstreamClientID,
+		UpstreamPrefix:        eventPrefix(upstreamPrefix),
+		PortId:                portID,
+		ChannelId:             channelID,
+		CounterpartyPortId:    channel.Counterparty.PortId,
+		CounterpartyChannelId: channel.Counterparty.ChannelId,
+		ConnectionId:          channel.ConnectionHops[0],
+		ProofHeight:           eventHeight(proofHeight),
+	}
+}
+
+// NewEventProxyChannelOpenConfirm creates a new EventProxyChannelOpenConfirm instance.
+func NewEventProxyChannelOpenConfirm(
+	upstreamClientID string, upstreamPrefix exported.Prefix, portID, channelID string, channel channeltypes.Channel, proofHeight exported.Height,
+) *EventProxyChannelOpenConfirm {
+	return &EventProxyChannelOpenConfirm{
+		UpstreamClientId:      upstreamClientID,
+		UpstreamPrefix:        eventPrefix(upstreamPrefix),
+		PortId:                portID,
+		ChannelId:             channelID,
+		CounterpartyPortId:    channel.Counterparty.PortId,
+		CounterpartyChannelId: channel.Counterparty.ChannelId,
+		ConnectionId:          channel.ConnectionHops[0],
+		ProofHeight:           eventHeight(proofHeight),
+	}
+}
+
+// NewEventProxyChannelOpenFinalize creates a new EventProxyChannelOpenFinalize instance.
+func NewEventProxyChannelOpenFinalize(
+	upstreamClientID string, upstreamPrefix exported.Prefix, portID, channelID string, channel channeltypes.Channel, proofHeight exported.Height,
+) *EventProxyChannelOpenFinalize {
+	return &EventProxyChannelOpenFinalize{
+		UpstreamClientId:      upstreamClientID,
+		UpstreamPrefix:        eventPrefix(upstreamPrefix),
+		PortId:                portID,
+		ChannelId:             channelID,
+		CounterpartyPortId:    channel.Counterparty.PortId,
+		CounterpartyChannelId: channel.Counterparty.ChannelId,
+		ConnectionId:          channel.ConnectionHops[0],
+		ProofHeight:           eventHeight(proofHeight),
+	}
+}
+
+// NewEventProxyChannelCloseConfirm creates a new EventProxyChannelCloseConfirm instance.
+func NewEventProxyChannelCloseConfirm(
+	upstreamClientID string, upstreamPrefix exported.Prefix, portID, channelID string, channel channeltypes.Channel, proofHeight exported.Height,
+) *EventProxyChannelCloseConfirm {
+	return &EventProxyChannelCloseConfirm{
+		UpstreamClientId:      upstreamClientID,
+		UpstreamPrefix:        eventPrefix(upstreamPrefix),
+		PortId:                portID,
+		ChannelId:             channelID,
+		CounterpartyPortId:    channel.Counterparty.PortId,
+		CounterpartyChannelId: channel.Counterparty.ChannelId,
+		ConnectionId:          channel.ConnectionHops[0],
+		ProofHeight:           eventHeight(proofHeight),
+	}
+}
+
+// NewEventProxyChannelState creates a new EventProxyChannelState instance.
+func NewEventProxyChannelState(
+	upstreamClientID string, upstreamPrefix exported.Prefix, portID, channelID string, channel channeltypes.Channel, proofHeight exported.Height,
+) *EventProxyChannelState {
+	return &EventProxyChannelState{
+		UpstreamClientId:      upstreamClientID,
+		UpstreamPrefix:        eventPrefix(upstreamPrefix),
+		PortId:                portID,
+		ChannelId:             channelID,
+		CounterpartyPortId:    channel.Counterparty.PortId,
+		CounterpartyChannelId: channel.Counterparty.ChannelId,
+		ConnectionId:          channel.ConnectionHops[0],
+		ProofHeight:           eventHeight(proofHeight),
+	}
+}
+
+// NewEventProxyRecvPacket creates a new EventProxyRecvPacket instance.
+func NewEventProxyRecvPacket(
+	upstreamClientID string, upstreamPrefix exported.Prefix, packet exported.PacketI, connectionID string, proofHeight exported.Height,
+) *EventProxyRecvPacket {
+	return &EventProxyRecvPacket{
+		UpstreamClientId:   upstreamClientID,
+		UpstreamPrefix:     eventPrefix(upstreamPrefix),
+		Sequence:           packet.GetSequence(),
+		SourcePort:         packet.GetSourcePort(),
+		SourceChannel:      packet.GetSourceChannel(),
+		DestinationPort:    packet.GetDestPort(),
+		DestinationChannel: packet.GetDestChannel(),
+		ConnectionId:       connectionID,
+		ProofHeight:        eventHeight(proofHeight),
+	}
+}
+
+// NewEventProxyAcknowledgePacket creates a new EventProxyAcknowledgePacket instance.
+func NewEventProxyAcknowledgePacket(
+	upstreamClientID string, upstreamPrefix exported.Prefix, packet exported.PacketI, connectionID string, proofHeight exported.Height,
+) *EventProxyAcknowledgePacket {
+	return &EventProxyAcknowledgePacket{
+		UpstreamClientId:   upstreamClientID,
+		UpstreamPrefix:     eventPrefix(upstreamPrefix),
+		Sequence:           packet.GetSequence(),
+		SourcePort:         packet.GetSourcePort(),
+		SourceChannel:      packet.GetSourceChannel(),
+		DestinationPort:    packet.GetDestPort(),
+		DestinationChannel: packet.GetDestChannel(),
+		ConnectionId:       connectionID,
+		ProofHeight:        eventHeight(proofHeight),
+	}
+}
+
+// NewEventProxyTimeoutPacket creates a new EventProxyTimeoutPacket instance.
+func NewEventProxyTimeoutPacket(
+	upstreamClientID string, upstreamPrefix exported.Prefix, packet exported.PacketI, connectionID string, proofHeight exported.Height,
+) *EventProxyTimeoutPacket {
+	return &EventProxyTimeoutPacket{
+		UpstreamClientId:   upstreamClientID,
+		UpstreamPrefix:     eventPrefix(upstreamPrefix),
+		Sequence:           packet.GetSequence(),
+		SourcePort:         packet.GetSourcePort(),
+		SourceChannel:      packet.GetSourceChannel(),
+		DestinationPort:    packet.GetDestPort(),
+		DestinationChannel: packet.GetDestChannel(),
+		ConnectionId:       connectionID,
+		ProofHeight:        eventHeight(proofHeight),
+	}
+}
+
+// NewEventProxyTimeoutOnClose creates a new EventProxyTimeoutOnClose instance.
+func NewEventProxyTimeoutOnClose(
+	upstreamClientID string, upstreamPrefix exported.Prefix, packet exported.PacketI, connectionID string, proofHeight exported.Height,
+) *EventProxyTimeoutOnClose {
+	return &EventProxyTimeoutOnClose{
+		UpstreamClientId:   upstreamClientID,
+		UpstreamPrefix:     eventPrefix(upstreamPrefix),
+		Sequence:           packet.GetSequence(),
+		SourcePort:         packet.GetSourcePort(),
+		SourceChannel:      packet.GetSourceChannel(),
+		DestinationPort:    packet.GetDestPort(),
+		DestinationChannel: packet.GetDestChannel(),
+		ConnectionId:       connectionID,
+		ProofHeight:        eventHeight(proofHeight),
+	}
+}
+
+// NewEventProxyCommitmentConflict creates a new EventProxyCommitmentConflict instance.
+func NewEventProxyCommitmentConflict(
+	upstreamClientID string, upstreamPrefix exported.Prefix, path string, existingValue, newValue []byte,
+) *EventProxyCommitmentConflict {
+	return &EventProxyCommitmentConflict{
+		UpstreamClientId: upstreamClientID,
+		UpstreamPrefix:   eventPrefix(upstreamPrefix),
+		Path:             path,
+		ExistingValue:    existingValue,
+		NewValue:         newValue,
+	}
+}
+
+// NewEventProxyMisbehaviour creates a new EventProxyMisbehaviour instance.
+func NewEventProxyMisbehaviour(misbehaviour *proxytypes.ProxyMisbehaviour) *EventProxyMisbehaviour {
+	return &EventProxyMisbehaviour{
+		ClientId:         misbehaviour.ClientId,
+		UpstreamClientId: misbehaviour.UpstreamClientId,
+		UpstreamPrefix:   misbehaviour.UpstreamPrefix,
+		Path:             misbehaviour.Path,
+	}
+}
+
+// NewEventMigrateProxyClient creates a new EventMigrateProxyClient instance.
+func NewEventMigrateProxyClient(
+	clientID, newClientID, upstreamClientID, newUpstreamClientID string, upstreamConsensusHeight exported.Height,
+) *EventMigrateProxyClient {
+	return &EventMigrateProxyClient{
+		ClientId:                clientID,
+		NewClientId:             newClientID,
+		UpstreamClientId:        upstreamClientID,
+		NewUpstreamClientId:     newUpstreamClientID,
+		UpstreamConsensusHeight: eventHeight(upstreamConsensusHeight),
+	}
+}
+
+func eventPrefix(prefix exported.Prefix) commitmenttypes.MerklePrefix {
+	return commitmenttypes.NewMerklePrefix(prefix.Bytes())
+}
+
+func eventHeight(height exported.Height) clienttypes.Height {
+	return clienttypes.NewHeight(height.GetRevisionNumber(), height.GetRevisionHeight())
+}
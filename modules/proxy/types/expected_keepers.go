@@ -2,12 +2,34 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	connectiontypes "github.com/cosmos/ibc-go/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
 	"github.com/cosmos/ibc-go/modules/core/exported"
 )
 
 type ClientKeeper interface {
 	ClientStore(ctx sdk.Context, clientID string) sdk.KVStore
 	GetClientState(ctx sdk.Context, clientID string) (exported.ClientState, bool)
+	SetClientState(ctx sdk.Context, clientID string, clientState exported.ClientState)
+	GetClientConsensusState(ctx sdk.Context, clientID string, height exported.Height) (exported.ConsensusState, bool)
 	GetSelfConsensusState(ctx sdk.Context, height exported.Height) (exported.ConsensusState, bool)
 	ValidateSelfClient(ctx sdk.Context, clientState exported.ClientState) error
+	UpdateClient(ctx sdk.Context, clientID string, header exported.Header) error
+}
+
+// BankKeeper defines the expected bank keeper to escrow and distribute the packet fees
+type BankKeeper interface {
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	BlockedAddr(addr sdk.AccAddress) bool
+}
+
+// ProxyHooks event hooks for the states proxied from the upstream
+// The hooks of a state are called only when the proxy writes the state for the first time or changes it.
+type ProxyHooks interface {
+	AfterProxyClientState(ctx sdk.Context, upstreamClientID string, upstreamPrefix exported.Prefix, counterpartyClientID string, clientState exported.ClientState)         // Must be called when a client state is proxied
+	AfterProxyConnection(ctx sdk.Context, upstreamClientID string, upstreamPrefix exported.Prefix, connectionID string, connection connectiontypes.ConnectionEnd)          // Must be called when a connection is proxied
+	AfterProxyChannel(ctx sdk.Context, upstreamClientID string, upstreamPrefix exported.Prefix, portID, channelID string, channel channeltypes.Channel)                    // Must be called when a channel is proxied
+	AfterProxyPacketCommitment(ctx sdk.Context, upstreamClientID string, upstreamPrefix exported.Prefix, portID, channelID string, sequence uint64, commitment []byte)     // Must be called when a packet commitment is proxied
+	AfterProxyAcknowledgement(ctx sdk.Context, upstreamClientID string, upstreamPrefix exported.Prefix, portID, channelID string, sequence uint64, acknowledgement []byte) // Must be called when a packet acknowledgement is proxied
 }
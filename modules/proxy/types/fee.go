@@ -0,0 +1,96 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	commitmenttypes "github.com/cosmos/ibc-go/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/modules/core/24-host"
+)
+
+// NewPacketFee creates a new PacketFee instance.
+func NewPacketFee(fee sdk.Coins, refundAddress string) PacketFee {
+	return PacketFee{
+		Fee:           fee,
+		RefundAddress: refundAddress,
+	}
+}
+
+// Validate performs a stateless validation of the packet fee
+func (pf PacketFee) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(pf.RefundAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "failed to parse the refund address: %v", err)
+	}
+	if !pf.Fee.IsValid() || pf.Fee.IsZero() {
+		return sdkerrors.Wrapf(ErrInvalidPacketFee, "fee must be positive: %s", pf.Fee)
+	}
+	return nil
+}
+
+// Total returns the sum of the fees
+func (pfs PacketFees) Total() sdk.Coins {
+	total := sdk.NewCoins()
+	for _, pf := range pfs.PacketFees {
+		total = total.Add(pf.Fee...)
+	}
+	return total
+}
+
+// NewIncentivizedPacket creates a new IncentivizedPacket instance.
+func NewIncentivizedPacket(upstreamClientID string, upstreamPrefix commitmenttypes.MerklePrefix, direction PacketDirection, portID, channelID string, sequence uint64, packetFees []PacketFee) IncentivizedPacket {
+	return IncentivizedPacket{
+		UpstreamClientId: upstreamClientID,
+		UpstreamPrefix:   upstreamPrefix,
+		Direction:        direction,
+		PortId:           portID,
+		ChannelId:        channelID,
+		Sequence:         sequence,
+		PacketFees:       packetFees,
+	}
+}
+
+// Validate performs a stateless validation of the incentivized packet
+func (ip IncentivizedPacket) Validate() error {
+	if err := validatePacketID(ip.UpstreamClientId, ip.UpstreamPrefix, ip.Direction, ip.PortId, ip.ChannelId, ip.Sequence); err != nil {
+		return err
+	}
+	if len(ip.PacketFees) == 0 {
+		return sdkerrors.Wrap(ErrInvalidPacketFee, "packet fees cannot be empty")
+	}
+	for i, pf := range ip.PacketFees {
+		if err := pf.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "packet fee index %d", i)
+		}
+	}
+	return nil
+}
+
+// Validate returns an error if the packet direction is unknown
+func (d PacketDirection) Validate() error {
+	if _, ok := PacketDirection_name[int32(d)]; !ok {
+		return sdkerrors.Wrapf(ErrInvalidPacketFee, "unknown packet direction: %d", d)
+	}
+	return nil
+}
+
+// validatePacketID validates the identifiers of a packet sent through the proxy
+func validatePacketID(upstreamClientID string, upstreamPrefix commitmenttypes.MerklePrefix, direction PacketDirection, portID, channelID string, sequence uint64) error {
+	if err := host.ClientIdentifierValidator(upstreamClientID); err != nil {
+		return sdkerrors.Wrap(err, "invalid upstream client ID")
+	}
+	if upstreamPrefix.Empty() {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidPrefix, "upstream prefix cannot be empty")
+	}
+	if err := direction.Validate(); err != nil {
+		return err
+	}
+	if err := host.PortIdentifierValidator(portID); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if err := host.ChannelIdentifierValidator(channelID); err != nil {
+		return sdkerrors.Wrap(err, "invalid channel ID")
+	}
+	if sequence == 0 {
+		return sdkerrors.Wrap(ErrInvalidPacketFee, "packet sequence cannot be 0")
+	}
+	return nil
+}
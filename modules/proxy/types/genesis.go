@@ -1,12 +1,219 @@
 package types
 
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/modules/core/24-host"
+	"github.com/cosmos/ibc-go/modules/core/exported"
+)
+
+var (
+	_ codectypes.UnpackInterfacesMessage = GenesisState{}
+	_ codectypes.UnpackInterfacesMessage = UpstreamGenesisState{}
+)
+
+// NewGenesisState creates a GenesisState instance.
+func NewGenesisState(upstreams []UpstreamGenesisState, params Params) *GenesisState {
+	return &GenesisState{
+		Upstreams: upstreams,
+		Params:    params,
+	}
+}
+
 // DefaultGenesisState returns a GenesisState
 func DefaultGenesisState() *GenesisState {
-	return &GenesisState{}
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// NewUpstreamGenesisState creates an empty UpstreamGenesisState instance.
+func NewUpstreamGenesisState(upstreamClientID string, upstreamPrefix commitmenttypes.MerklePrefix) UpstreamGenesisState {
+	return UpstreamGenesisState{
+		UpstreamClientId: upstreamClientID,
+		UpstreamPrefix:   upstreamPrefix,
+	}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (gs GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, upstream := range gs.Upstreams {
+		if err := upstream.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	upstreams := make(map[string]bool)
+	for i, upstream := range gs.Upstreams {
+		if err := upstream.Validate(); err != nil {
+			return fmt.Errorf("invalid upstream index %d: %w", i, err)
+		}
+		key := string(ProxyKey(upstream.UpstreamPrefix, upstream.UpstreamClientId, nil))
+		if upstreams[key] {
+			return fmt.Errorf("duplicate upstream: client ID %s, prefix %X", upstream.UpstreamClientId, upstream.UpstreamPrefix.Bytes())
+		}
+		upstreams[key] = true
+	}
+	packets := make(map[string]bool)
+	for i, packet := range gs.IncentivizedPackets {
+		if err := packet.Validate(); err != nil {
+			return fmt.Errorf("invalid incentivized packet index %d: %w", i, err)
+		}
+		key := string(PacketFeesKey(&packet.UpstreamPrefix, packet.UpstreamClientId, packet.Direction, packet.PortId, packet.ChannelId, packet.Sequence))
+		if packets[key] {
+			return fmt.Errorf("duplicate incentivized packet: client ID %s, prefix %X, port ID %s, channel ID %s, sequence %d", packet.UpstreamClientId, packet.UpstreamPrefix.Bytes(), packet.PortId, packet.ChannelId, packet.Sequence)
+		}
+		packets[key] = true
+	}
+	paused := make(map[string]bool)
+	for i, pp := range gs.PausedProxies {
+		if err := pp.Validate(); err != nil {
+			return fmt.Errorf("invalid paused proxy index %d: %w", i, err)
+		}
+		key := string(PausedProxyKey(pp.UpstreamClientId, pp.PortId, pp.ChannelId))
+		if paused[key] {
+			return fmt.Errorf("duplicate paused proxy: client ID %s, port ID %s, channel ID %s", pp.UpstreamClientId, pp.PortId, pp.ChannelId)
+		}
+		paused[key] = true
+	}
 	return nil
 }
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (us UpstreamGenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, client := range us.Clients {
+		if err := client.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	for _, clientConsensus := range us.ClientsConsensus {
+		if err := clientConsensus.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate performs basic validation of the states stored for the upstream
+func (us UpstreamGenesisState) Validate() error {
+	if err := host.ClientIdentifierValidator(us.UpstreamClientId); err != nil {
+		return fmt.Errorf("invalid upstream client identifier %s: %w", us.UpstreamClientId, err)
+	}
+	if us.UpstreamPrefix.Empty() {
+		return fmt.Errorf("upstream prefix cannot be empty")
+	}
+
+	clients := make(map[string]bool)
+	for i, client := range us.Clients {
+		if err := host.ClientIdentifierValidator(client.ClientId); err != nil {
+			return fmt.Errorf("invalid client identifier %s index %d: %w", client.ClientId, i, err)
+		}
+		clientState, ok := client.ClientState.GetCachedValue().(exported.ClientState)
+		if !ok {
+			return fmt.Errorf("invalid client state with ID %s", client.ClientId)
+		}
+		if err := clientState.Validate(); err != nil {
+			return fmt.Errorf("invalid client %s index %d: %w", client.ClientId, i, err)
+		}
+		clients[client.ClientId] = true
+	}
+
+	for _, cc := range us.ClientsConsensus {
+		if !clients[cc.ClientId] {
+			return fmt.Errorf("consensus state in genesis has a client id %s that does not map to a genesis client", cc.ClientId)
+		}
+		for i, consensusState := range cc.ConsensusStates {
+			if consensusState.Height.IsZero() {
+				return fmt.Errorf("consensus state height cannot be zero")
+			}
+			cs, ok := consensusState.ConsensusState.GetCachedValue().(exported.ConsensusState)
+			if !ok {
+				return fmt.Errorf("invalid consensus state with client ID %s at height %s", cc.ClientId, consensusState.Height)
+			}
+			if err := cs.ValidateBasic(); err != nil {
+				return fmt.Errorf("invalid client consensus state clientID %s index %d: %w", cc.ClientId, i, err)
+			}
+		}
+	}
+
+	for i, conn := range us.Connections {
+		if err := conn.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid connection %s index %d: %w", conn.Id, i, err)
+		}
+	}
+
+	for i, channel := range us.Channels {
+		if err := validateChannel(channel); err != nil {
+			return fmt.Errorf("invalid channel %s index %d: %w", channel.ChannelId, i, err)
+		}
+	}
+
+	for i, commitment := range us.Commitments {
+		if err := commitment.Validate(); err != nil {
+			return fmt.Errorf("invalid packet commitment index %d: %w", i, err)
+		}
+		if len(commitment.Data) == 0 {
+			return fmt.Errorf("invalid packet commitment index %d: data bytes cannot be empty", i)
+		}
+	}
+
+	for i, ack := range us.Acknowledgements {
+		if err := ack.Validate(); err != nil {
+			return fmt.Errorf("invalid acknowledgement index %d: %w", i, err)
+		}
+		if len(ack.Data) == 0 {
+			return fmt.Errorf("invalid acknowledgement index %d: data bytes cannot be empty", i)
+		}
+	}
+
+	for i, receipt := range us.Receipts {
+		if err := receipt.Validate(); err != nil {
+			return fmt.Errorf("invalid packet receipt absence index %d: %w", i, err)
+		}
+	}
+
+	for i, rs := range us.RecvSequences {
+		if err := rs.Validate(); err != nil {
+			return fmt.Errorf("invalid receive sequence index %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// validateChannel is the same as IdentifiedChannel.ValidateBasic except that it allows multiple connection hops
+func validateChannel(channel channeltypes.IdentifiedChannel) error {
+	if err := host.ChannelIdentifierValidator(channel.ChannelId); err != nil {
+		return sdkerrors.Wrap(err, "invalid channel ID")
+	}
+	if err := host.PortIdentifierValidator(channel.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if channel.State == channeltypes.UNINITIALIZED {
+		return channeltypes.ErrInvalidChannelState
+	}
+	if !(channel.Ordering == channeltypes.ORDERED || channel.Ordering == channeltypes.UNORDERED) {
+		return sdkerrors.Wrap(channeltypes.ErrInvalidChannelOrdering, channel.Ordering.String())
+	}
+	if len(channel.ConnectionHops) == 0 {
+		return sdkerrors.Wrap(channeltypes.ErrInvalidChannel, "connection hops cannot be empty")
+	}
+	for _, hop := range channel.ConnectionHops {
+		if err := host.ConnectionIdentifierValidator(hop); err != nil {
+			return sdkerrors.Wrap(err, "invalid connection hop ID")
+		}
+	}
+	return channel.Counterparty.ValidateBasic()
+}
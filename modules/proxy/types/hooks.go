@@ -0,0 +1,47 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	connectiontypes "github.com/cosmos/ibc-go/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/modules/core/exported"
+)
+
+var _ ProxyHooks = MultiProxyHooks{}
+
+// MultiProxyHooks combines multiple proxy hooks, all hook functions are run in array sequence
+type MultiProxyHooks []ProxyHooks
+
+func NewMultiProxyHooks(hooks ...ProxyHooks) MultiProxyHooks {
+	return hooks
+}
+
+func (h MultiProxyHooks) AfterProxyClientState(ctx sdk.Context, upstreamClientID string, upstreamPrefix exported.Prefix, counterpartyClientID string, clientState exported.ClientState) {
+	for i := range h {
+		h[i].AfterProxyClientState(ctx, upstreamClientID, upstreamPrefix, counterpartyClientID, clientState)
+	}
+}
+
+func (h MultiProxyHooks) AfterProxyConnection(ctx sdk.Context, upstreamClientID string, upstreamPrefix exported.Prefix, connectionID string, connection connectiontypes.ConnectionEnd) {
+	for i := range h {
+		h[i].AfterProxyConnection(ctx, upstreamClientID, upstreamPrefix, connectionID, connection)
+	}
+}
+
+func (h MultiProxyHooks) AfterProxyChannel(ctx sdk.Context, upstreamClientID string, upstreamPrefix exported.Prefix, portID, channelID string, channel channeltypes.Channel) {
+	for i := range h {
+		h[i].AfterProxyChannel(ctx, upstreamClientID, upstreamPrefix, portID, channelID, channel)
+	}
+}
+
+func (h MultiProxyHooks) AfterProxyPacketCommitment(ctx sdk.Context, upstreamClientID string, upstreamPrefix exported.Prefix, portID, channelID string, sequence uint64, commitment []byte) {
+	for i := range h {
+		h[i].AfterProxyPacketCommitment(ctx, upstreamClientID, upstreamPrefix, portID, channelID, sequence, commitment)
+	}
+}
+
+func (h MultiProxyHooks) AfterProxyAcknowledgement(ctx sdk.Context, upstreamClientID string, upstreamPrefix exported.Prefix, portID, channelID string, sequence uint64, acknowledgement []byte) {
+	for i := range h {
+		h[i].AfterProxyAcknowledgement(ctx, upstreamClientID, upstreamPrefix, portID, channelID, sequence, acknowledgement)
+	}
+}
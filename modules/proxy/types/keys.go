@@ -1,8 +1,18 @@
 package types
 
 import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	commitmenttypes "github.com/cosmos/ibc-go/modules/core/23-commitment/types"
 	host "github.com/cosmos/ibc-go/modules/core/24-host"
 	"github.com/cosmos/ibc-go/modules/core/exported"
+
+	proxytypes "github.com/datachainlab/ibc-proxy/modules/light-clients/xx-proxy/types"
 )
 
 const (
@@ -21,9 +31,57 @@ const (
 	QuerierRoute = ModuleName
 )
 
-// ProxyKey returns the store key for a proxy state
+// The prefixes below never collide with the proxy states as the key of a proxy state begins with a decimal length.
+var (
+	// KeyPruningQueuePrefix is the prefix of the queue of the proxied packet acknowledgements,
+	// ordered by the time they were proxied.
+	KeyPruningQueuePrefix = []byte{0x00}
+	// KeyPacketFeesPrefix is the prefix of the fees escrowed for relaying packets through the proxy
+	KeyPacketFeesPrefix = []byte{0x01}
+	// KeyPausedProxyPrefix is the prefix of the upstream clients and channels for which proxying is paused
+	KeyPausedProxyPrefix = []byte{0x02}
+)
+
+// MaxPrunedProxyPacketsPerBlock is the maximum number of the packet acknowledgements pruned in a block.
+// The rest of them are pruned in the following blocks.
+const MaxPrunedProxyPacketsPerBlock = 100
+
+// ProxyStateIterator returns an iterator over the proxy states of all the upstreams,
+// whose keys begin with the decimal length of the upstream client ID
+func ProxyStateIterator(store sdk.KVStore) sdk.Iterator {
+	return store.Iterator([]byte{'0'}, []byte{'9' + 1})
+}
+
+// ProxyKey returns the store key for a proxy state, in the form of
+// "{len(upstream_client_id)}:{upstream_client_id}/{len(upstream_prefix)}:{upstream_prefix}/{key}"
 func ProxyKey(upstreamPrefix exported.Prefix, upstreamClientID string, key []byte) []byte {
-	return append(append([]byte(upstreamClientID+"/"), string(upstreamPrefix.Bytes())+"/"...), key...)
+	return append(append(proxytypes.UpstreamPathPrefix(upstreamClientID, upstreamPrefix.Bytes()), '/'), key...)
+}
+
+// ParseProxyKey splits a store key of a proxy state into the upstream client ID, the upstream prefix and the ICS-24 host path
+func ParseProxyKey(key []byte) (string, commitmenttypes.MerklePrefix, string, error) {
+	upstreamClientID, upstreamPrefix, rest, err := proxytypes.SplitUpstreamPathPrefix(key)
+	if err != nil {
+		return "", commitmenttypes.MerklePrefix{}, "", err
+	}
+	if len(rest) < 2 || rest[0] != '/' {
+		return "", commitmenttypes.MerklePrefix{}, "", fmt.Errorf("invalid proxy key: %X", key)
+	}
+	return upstreamClientID, commitmenttypes.NewMerklePrefix(upstreamPrefix), string(rest[1:]), nil
+}
+
+// ProxyCommitmentPath returns the path of a proxy state committed under the proxy prefix, in the form of
+// "/{proxy_prefix}/{proxy_key}", where the proxy key is the one returned by ProxyKey with '%' escaped in its upstream part.
+// This is the path that the proxy client verifies.
+func ProxyCommitmentPath(proxyPrefix, upstreamPrefix exported.Prefix, upstreamClientID string, path string) (string, error) {
+	merklePath, err := commitmenttypes.ApplyPrefix(
+		proxytypes.NewProxyCommitmentPrefix(proxyPrefix, upstreamPrefix, upstreamClientID),
+		commitmenttypes.NewMerklePath(path),
+	)
+	if err != nil {
+		return "", err
+	}
+	return "/" + strings.Join(merklePath.KeyPath, "/"), nil
 }
 
 // ProxyClientStateKey returns the store key for the proxy client state of a particular
@@ -59,3 +117,69 @@ func ProxyPacketCommitmentKey(upstreamPrefix exported.Prefix, upstreamClientID s
 func ProxyAcknowledgementKey(upstreamPrefix exported.Prefix, upstreamClientID string, portID string, channelID string, sequence uint64) []byte {
 	return ProxyKey(upstreamPrefix, upstreamClientID, host.PacketAcknowledgementKey(portID, channelID, sequence))
 }
+
+// ProxyPacketReceiptKey returns the store key of under which the marker of a proxy packet
+// receipt absence is stored
+func ProxyPacketReceiptKey(upstreamPrefix exported.Prefix, upstreamClientID string, portID string, channelID string, sequence uint64) []byte {
+	return ProxyKey(upstreamPrefix, upstreamClientID, []byte(proxytypes.PacketReceiptAbsencePath(portID, channelID, sequence)))
+}
+
+// ProxyNextSequenceRecvKey returns the store key for the proxy receive sequence of a particular
+// channel
+func ProxyNextSequenceRecvKey(upstreamPrefix exported.Prefix, upstreamClientID string, portID string, channelID string) []byte {
+	return ProxyKey(upstreamPrefix, upstreamClientID, host.NextSequenceRecvKey(portID, channelID))
+}
+
+// PruningQueueKey returns the key of the pruning queue entry for the proxy state proxied at the given time
+func PruningQueueKey(proxiedAt time.Time, proxyKey []byte) []byte {
+	key := append([]byte{}, KeyPruningQueuePrefix...)
+	key = append(key, sdk.Uint64ToBigEndian(uint64(proxiedAt.UnixNano()))...)
+	return append(key, proxyKey...)
+}
+
+// SplitPruningQueueKey returns the proxied time and the proxy key of a pruning queue entry
+func SplitPruningQueueKey(key []byte) (time.Time, []byte) {
+	offset := len(KeyPruningQueuePrefix)
+	return time.Unix(0, int64(sdk.BigEndianToUint64(key[offset:offset+8]))).UTC(), key[offset+8:]
+}
+
+// PacketFeesPrefixKey returns the prefix of the keys of the packet fees for the upstream client,
+// which is the beginning of the upstream path prefix of ProxyKey
+func PacketFeesPrefixKey(upstreamClientID string) []byte {
+	return append(append([]byte{}, KeyPacketFeesPrefix...), fmt.Sprintf("%d:%s/", len(upstreamClientID), upstreamClientID)...)
+}
+
+// PacketFeesKey returns the key of the fees escrowed for the packet with the given direction and the port, channel and sequence on the upstream side.
+// The upstream client and prefix are encoded in the same way as ProxyKey.
+func PacketFeesKey(upstreamPrefix exported.Prefix, upstreamClientID string, direction PacketDirection, portID, channelID string, sequence uint64) []byte {
+	key := append(append([]byte{}, KeyPacketFeesPrefix...), ProxyKey(upstreamPrefix, upstreamClientID, []byte(fmt.Sprintf("%d/%s/%s/", direction, portID, channelID)))...)
+	return append(key, sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// ParsePacketFeesKey returns the upstream client ID, the upstream prefix, the direction, the port ID, the channel ID and the sequence of the packet fees key
+func ParsePacketFeesKey(key []byte) (string, commitmenttypes.MerklePrefix, PacketDirection, string, string, uint64, error) {
+	invalid := func() (string, commitmenttypes.MerklePrefix, PacketDirection, string, string, uint64, error) {
+		return "", commitmenttypes.MerklePrefix{}, 0, "", "", 0, fmt.Errorf("invalid packet fees key: %X", key)
+	}
+	if !bytes.HasPrefix(key, KeyPacketFeesPrefix) || len(key) < len(KeyPacketFeesPrefix)+8 {
+		return invalid()
+	}
+	upstreamClientID, upstreamPrefix, path, err := ParseProxyKey(key[len(KeyPacketFeesPrefix) : len(key)-8])
+	if err != nil {
+		return invalid()
+	}
+	ids := strings.Split(path, "/")
+	if len(ids) != 4 || ids[3] != "" {
+		return invalid()
+	}
+	direction, err := strconv.ParseInt(ids[0], 10, 32)
+	if err != nil {
+		return invalid()
+	}
+	return upstreamClientID, upstreamPrefix, PacketDirection(direction), ids[1], ids[2], sdk.BigEndianToUint64(key[len(key)-8:]), nil
+}
+
+// PausedProxyKey returns the key of the pause of the upstream client, or of the port and channel of the upstream if they are given
+func PausedProxyKey(upstreamClientID, portID, channelID string) []byte {
+	return append(append([]byte{}, KeyPausedProxyPrefix...), upstreamClientID+"/"+portID+"/"+channelID...)
+}
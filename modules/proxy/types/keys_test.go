@@ -0,0 +1,89 @@
+//go:build go1.18
+// +build go1.18
+
+package types_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	commitmenttypes "github.com/cosmos/ibc-go/modules/core/23-commitment/types"
+	"github.com/stretchr/testify/require"
+
+	proxyclienttypes "github.com/datachainlab/ibc-proxy/modules/light-clients/xx-proxy/types"
+	"github.com/datachainlab/ibc-proxy/modules/proxy/types"
+)
+
+func FuzzProxyKey(f *testing.F) {
+	// the keys of the consensus version 1 collide for these pairs of tuples
+	f.Add("07-tendermint-0", []byte("store/ibc"), "connections/connection-0", "07-tendermint-0/store", []byte("ibc"), "connections/connection-0")
+	f.Add("07-tendermint-0", []byte("ibc"), "connections/connection-0", "07-tendermint-0", []byte("ibc/connections"), "connection-0")
+	// the merkle path of the proxy client is unescaped on verification
+	f.Add("07-tendermint-0", []byte("a%2Fb"), "connections/connection-0", "07-tendermint-0", []byte("a/b"), "connections/connection-0")
+	f.Add("1:a", []byte("2:b"), "c", "1:a/2:b", []byte(""), "c")
+
+	f.Fuzz(func(t *testing.T, clientID1 string, prefix1 []byte, path1 string, clientID2 string, prefix2 []byte, path2 string) {
+		key1 := requireProxyKey(t, clientID1, prefix1, path1)
+		key2 := requireProxyKey(t, clientID2, prefix2, path2)
+
+		sameUpstream := clientID1 == clientID2 && bytes.Equal(prefix1, prefix2)
+		require.Equal(t, sameUpstream && path1 == path2, bytes.Equal(key1, key2))
+
+		// the proxy store of an upstream never contains the states of another one
+		upstreamPrefix2 := commitmenttypes.NewMerklePrefix(prefix2)
+		require.Equal(t, sameUpstream, bytes.HasPrefix(key1, types.ProxyKey(&upstreamPrefix2, clientID2, nil)))
+	})
+}
+
+// requireProxyKey returns the proxy key of the tuple after checking that it is parsed back into the tuple
+// and that it is the key that the proxy client verifies
+func requireProxyKey(t *testing.T, upstreamClientID string, upstreamPrefix []byte, path string) []byte {
+	prefix := commitmenttypes.NewMerklePrefix(upstreamPrefix)
+	key := types.ProxyKey(&prefix, upstreamClientID, []byte(path))
+
+	if len(path) > 0 {
+		parsedClientID, parsedPrefix, parsedPath, err := types.ParseProxyKey(key)
+		require.NoError(t, err)
+		require.Equal(t, upstreamClientID, parsedClientID)
+		require.True(t, bytes.Equal(upstreamPrefix, parsedPrefix.Bytes()))
+		require.Equal(t, path, parsedPath)
+	}
+
+	// ICS-24 paths never contain '%'
+	if !strings.Contains(path, "%") {
+		proxyPrefix := commitmenttypes.NewMerklePrefix([]byte(types.StoreKey))
+		merklePath, err := commitmenttypes.ApplyPrefix(
+			proxyclienttypes.NewProxyCommitmentPrefix(&proxyPrefix, &prefix, upstreamClientID),
+			commitmenttypes.NewMerklePath(path),
+		)
+		require.NoError(t, err)
+		verifiedKey, err := merklePath.GetKey(1)
+		require.NoError(t, err)
+		require.Equal(t, key, verifiedKey)
+	}
+	return key
+}
+
+func TestPacketFeesKey(t *testing.T) {
+	prefix := commitmenttypes.NewMerklePrefix([]byte("ibc"))
+	key := types.PacketFeesKey(&prefix, "07-tendermint-0", types.PacketToUpstream, "transfer", "channel-0", 1)
+
+	upstreamClientID, upstreamPrefix, direction, portID, channelID, sequence, err := types.ParsePacketFeesKey(key)
+	require.NoError(t, err)
+	require.Equal(t, "07-tendermint-0", upstreamClientID)
+	require.Equal(t, prefix, upstreamPrefix)
+	require.Equal(t, types.PacketToUpstream, direction)
+	require.Equal(t, "transfer", portID)
+	require.Equal(t, "channel-0", channelID)
+	require.Equal(t, uint64(1), sequence)
+	require.True(t, bytes.HasPrefix(key, types.PacketFeesPrefixKey("07-tendermint-0")))
+
+	// the fees of the upstreams with different prefixes never share a key, nor do the clients whose IDs share a prefix
+	otherPrefix := commitmenttypes.NewMerklePrefix([]byte("other"))
+	require.NotEqual(t, key, types.PacketFeesKey(&otherPrefix, "07-tendermint-0", types.PacketToUpstream, "transfer", "channel-0", 1))
+	require.False(t, bytes.HasPrefix(types.PacketFeesKey(&prefix, "07-tendermint-01", types.PacketToUpstream, "transfer", "channel-0", 1), types.PacketFeesPrefixKey("07-tendermint-0")))
+
+	_, _, _, _, _, _, err = types.ParsePacketFeesKey(types.ProxyKey(&prefix, "07-tendermint-0", []byte("1/transfer/channel-0/")))
+	require.Error(t, err)
+}
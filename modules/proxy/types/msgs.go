@@ -3,10 +3,15 @@ package types
 import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
 	commitmenttypes "github.com/cosmos/ibc-go/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/modules/core/24-host"
 	"github.com/cosmos/ibc-go/modules/core/exported"
+
+	proxytypes "github.com/datachainlab/ibc-proxy/modules/light-clients/xx-proxy/types"
 )
 
 var (
@@ -14,7 +19,21 @@ var (
 	_, _, _, _ codectypes.UnpackInterfacesMessage = (*MsgProxyClientState)(nil), (*MsgProxyConnectionOpenTry)(nil), (*MsgProxyConnectionOpenAck)(nil), (*MsgProxyConnectionOpenConfirm)(nil)
 
 	_, _, _ sdk.Msg = (*MsgProxyChannelOpenTry)(nil), (*MsgProxyChannelOpenAck)(nil), (*MsgProxyChannelOpenConfirm)(nil)
-	_, _    sdk.Msg = (*MsgProxyRecvPacket)(nil), (*MsgProxyAcknowledgePacket)(nil)
+	_, _, _ sdk.Msg = (*MsgProxyRecvPacket)(nil), (*MsgProxyAcknowledgePacket)(nil), (*MsgProxyPacketBatch)(nil)
+	_, _    sdk.Msg = (*MsgRegisterUpstream)(nil), (*MsgDeregisterUpstream)(nil)
+	_, _    sdk.Msg = (*MsgProxyConnectionState)(nil), (*MsgProxyChannelState)(nil)
+
+	_ sdk.Msg                            = (*MsgProxyWithHeader)(nil)
+	_ codectypes.UnpackInterfacesMessage = (*MsgProxyWithHeader)(nil)
+
+	_ sdk.Msg = (*MsgSubmitProxyMisbehaviour)(nil)
+	_ sdk.Msg = (*MsgPruneProxyPacketCommitment)(nil)
+	_ sdk.Msg = (*MsgPayProxyPacketFee)(nil)
+
+	_, _ sdk.Msg = (*MsgPauseProxy)(nil), (*MsgUnpauseProxy)(nil)
+
+	_ sdk.Msg                            = (*MsgMigrateProxyClient)(nil)
+	_ codectypes.UnpackInterfacesMessage = (*MsgMigrateProxyClient)(nil)
 )
 
 func NewMsgProxyClientState(
@@ -296,6 +315,56 @@ func (msg MsgProxyConnectionOpenFinalize) UnpackInterfaces(unpacker codectypes.A
 	return nil
 }
 
+// NewMsgProxyConnectionState creates a new MsgProxyConnectionState instance
+func NewMsgProxyConnectionState(
+	connectionID string,
+	upstreamClientID string,
+	upstreamPrefix commitmenttypes.MerklePrefix,
+	connection connectiontypes.ConnectionEnd,
+	proof []byte,
+	proofHeight clienttypes.Height,
+	signer string,
+) *MsgProxyConnectionState {
+	return &MsgProxyConnectionState{
+		ConnectionId:     connectionID,
+		UpstreamClientId: upstreamClientID,
+		UpstreamPrefix:   upstreamPrefix,
+		Connection:       connection,
+		Proof:            proof,
+		ProofHeight:      proofHeight,
+		Signer:           signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgProxyConnectionState) ValidateBasic() error {
+	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
+		return sdkerrors.Wrap(err, "invalid connection ID")
+	}
+	if err := host.ClientIdentifierValidator(msg.UpstreamClientId); err != nil {
+		return sdkerrors.Wrap(err, "invalid upstream client ID")
+	}
+	if err := msg.Connection.ValidateBasic(); err != nil {
+		return err
+	}
+	if len(msg.Proof) == 0 {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty proof")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgProxyConnectionState) GetSigners() []sdk.AccAddress {
+	accAddr, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
+
 // ValidateBasic implements sdk.Msg
 func (msg MsgProxyChannelOpenTry) ValidateBasic() error {
 	return nil
@@ -352,6 +421,55 @@ func (msg MsgProxyChannelOpenFinalize) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{accAddr}
 }
 
+// ValidateBasic implements sdk.Msg
+func (msg MsgProxyChannelCloseConfirm) ValidateBasic() error {
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgProxyChannelCloseConfirm) GetSigners() []sdk.AccAddress {
+	accAddr, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgProxyChannelState) ValidateBasic() error {
+	if err := host.ClientIdentifierValidator(msg.UpstreamClientId); err != nil {
+		return sdkerrors.Wrap(err, "invalid upstream client ID")
+	}
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return sdkerrors.Wrap(err, "invalid channel ID")
+	}
+	if err := validateChannel(channeltypes.NewIdentifiedChannel(msg.PortId, msg.ChannelId, msg.Channel)); err != nil {
+		return err
+	}
+	if l := len(msg.UpstreamHops); l != len(msg.Channel.ConnectionHops)-1 {
+		return sdkerrors.Wrapf(channeltypes.ErrInvalidChannel, "upstream hops length must be %v, but got %v", len(msg.Channel.ConnectionHops)-1, l)
+	}
+	if len(msg.Proof) == 0 {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty proof")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgProxyChannelState) GetSigners() []sdk.AccAddress {
+	accAddr, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
+
 // ValidateBasic implements sdk.Msg
 func (msg MsgProxyRecvPacket) ValidateBasic() error {
 	return nil
@@ -379,6 +497,444 @@ func (msg MsgProxyAcknowledgePacket) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{accAddr}
 }
 
+// ValidateBasic implements sdk.Msg
+func (msg MsgProxyPacketBatch) ValidateBasic() error {
+	if len(msg.Items) == 0 {
+		return sdkerrors.Wrap(ErrInvalidPacketBatch, "items cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgProxyPacketBatch) GetSigners() []sdk.AccAddress {
+	accAddr, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgProxyTimeoutPacket) ValidateBasic() error {
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgProxyTimeoutPacket) GetSigners() []sdk.AccAddress {
+	accAddr, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgProxyTimeoutOnClose) ValidateBasic() error {
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgProxyTimeoutOnClose) GetSigners() []sdk.AccAddress {
+	accAddr, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
+
+// NewMsgPruneProxyPacketCommitment creates a new MsgPruneProxyPacketCommitment instance
+func NewMsgPruneProxyPacketCommitment(
+	upstreamClientID string,
+	upstreamPrefix commitmenttypes.MerklePrefix,
+	portID, channelID string,
+	sequence uint64,
+	proofAbsence []byte,
+	proofHeight clienttypes.Height,
+	signer string,
+) *MsgPruneProxyPacketCommitment {
+	return &MsgPruneProxyPacketCommitment{
+		UpstreamClientId: upstreamClientID,
+		UpstreamPrefix:   upstreamPrefix,
+		PortId:           portID,
+		ChannelId:        channelID,
+		Sequence:         sequence,
+		ProofAbsence:     proofAbsence,
+		ProofHeight:      proofHeight,
+		Signer:           signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgPruneProxyPacketCommitment) ValidateBasic() error {
+	if err := host.ClientIdentifierValidator(msg.UpstreamClientId); err != nil {
+		return sdkerrors.Wrap(err, "invalid upstream client ID")
+	}
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return sdkerrors.Wrap(err, "invalid channel ID")
+	}
+	if msg.Sequence == 0 {
+		return sdkerrors.Wrap(ErrInvalidProxyMsg, "packet sequence cannot be 0")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgPruneProxyPacketCommitment) GetSigners() []sdk.AccAddress {
+	accAddr, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
+
+// NewMsgPayProxyPacketFee creates a new MsgPayProxyPacketFee instance
+func NewMsgPayProxyPacketFee(upstreamClientID string, upstreamPrefix commitmenttypes.MerklePrefix, direction PacketDirection, portID, channelID string, sequence uint64, fee sdk.Coins, signer string) *MsgPayProxyPacketFee {
+	return &MsgPayProxyPacketFee{
+		UpstreamClientId: upstreamClientID,
+		UpstreamPrefix:   upstreamPrefix,
+		Direction:        direction,
+		PortId:           portID,
+		ChannelId:        channelID,
+		Sequence:         sequence,
+		Fee:              fee,
+		Signer:           signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgPayProxyPacketFee) ValidateBasic() error {
+	if err := validatePacketID(msg.UpstreamClientId, msg.UpstreamPrefix, msg.Direction, msg.PortId, msg.ChannelId, msg.Sequence); err != nil {
+		return err
+	}
+	return NewPacketFee(msg.Fee, msg.Signer).Validate()
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgPayProxyPacketFee) GetSigners() []sdk.AccAddress {
+	accAddr, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
+
+// NewMsgRegisterUpstream creates a new MsgRegisterUpstream instance
+func NewMsgRegisterUpstream(authority, upstreamClientID string) *MsgRegisterUpstream {
+	return &MsgRegisterUpstream{
+		Authority:        authority,
+		UpstreamClientId: upstreamClientID,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgRegisterUpstream) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return host.ClientIdentifierValidator(msg.UpstreamClientId)
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgRegisterUpstream) GetSigners() []sdk.AccAddress {
+	accAddr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
+
+// NewMsgDeregisterUpstream creates a new MsgDeregisterUpstream instance
+func NewMsgDeregisterUpstream(authority, upstreamClientID string) *MsgDeregisterUpstream {
+	return &MsgDeregisterUpstream{
+		Authority:        authority,
+		UpstreamClientId: upstreamClientID,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgDeregisterUpstream) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return host.ClientIdentifierValidator(msg.UpstreamClientId)
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgDeregisterUpstream) GetSigners() []sdk.AccAddress {
+	accAddr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
+
+// NewMsgPauseProxy creates a new MsgPauseProxy instance
+func NewMsgPauseProxy(signer, upstreamClientID, portID, channelID string) *MsgPauseProxy {
+	return &MsgPauseProxy{
+		Signer:           signer,
+		UpstreamClientId: upstreamClientID,
+		PortId:           portID,
+		ChannelId:        channelID,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgPauseProxy) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return NewPausedProxy(msg.UpstreamClientId, msg.PortId, msg.ChannelId).Validate()
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgPauseProxy) GetSigners() []sdk.AccAddress {
+	accAddr, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
+
+// NewMsgUnpauseProxy creates a new MsgUnpauseProxy instance
+func NewMsgUnpauseProxy(signer, upstreamClientID, portID, channelID string) *MsgUnpauseProxy {
+	return &MsgUnpauseProxy{
+		Signer:           signer,
+		UpstreamClientId: upstreamClientID,
+		PortId:           portID,
+		ChannelId:        channelID,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgUnpauseProxy) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return NewPausedProxy(msg.UpstreamClientId, msg.PortId, msg.ChannelId).Validate()
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgUnpauseProxy) GetSigners() []sdk.AccAddress {
+	accAddr, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
+
+// NewMsgMigrateProxyClient creates a new MsgMigrateProxyClient instance
+func NewMsgMigrateProxyClient(
+	authority string,
+	clientID string,
+	newClientID string,
+	upstreamClientState exported.ClientState,
+	newUpstreamClientState exported.ClientState,
+	upstreamConsensusState exported.ConsensusState,
+	upstreamConsensusHeight clienttypes.Height,
+	proofUpstreamClient []byte,
+	proofUpstreamConsensus []byte,
+	proofHeight clienttypes.Height,
+	proofNewUpstreamClient []byte,
+	proofNewUpstreamConsensus []byte,
+	newProofHeight clienttypes.Height,
+) (*MsgMigrateProxyClient, error) {
+	anyUpstreamClient, err := clienttypes.PackClientState(upstreamClientState)
+	if err != nil {
+		return nil, err
+	}
+	anyNewUpstreamClient, err := clienttypes.PackClientState(newUpstreamClientState)
+	if err != nil {
+		return nil, err
+	}
+	anyUpstreamConsensus, err := clienttypes.PackConsensusState(upstreamConsensusState)
+	if err != nil {
+		return nil, err
+	}
+	return &MsgMigrateProxyClient{
+		Authority:                 authority,
+		ClientId:                  clientID,
+		NewClientId:               newClientID,
+		UpstreamClientState:       anyUpstreamClient,
+		NewUpstreamClientState:    anyNewUpstreamClient,
+		UpstreamConsensusState:    anyUpstreamConsensus,
+		UpstreamConsensusHeight:   upstreamConsensusHeight,
+		ProofUpstreamClient:       proofUpstreamClient,
+		ProofUpstreamConsensus:    proofUpstreamConsensus,
+		ProofHeight:               proofHeight,
+		ProofNewUpstreamClient:    proofNewUpstreamClient,
+		ProofNewUpstreamConsensus: proofNewUpstreamConsensus,
+		NewProofHeight:            newProofHeight,
+	}, nil
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgMigrateProxyClient) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return validateClientMigration(
+		msg.ClientId, msg.NewClientId, msg.UpstreamConsensusHeight, msg.ProofHeight, msg.NewProofHeight,
+		msg.ProofUpstreamClient, msg.ProofUpstreamConsensus, msg.ProofNewUpstreamClient, msg.ProofNewUpstreamConsensus,
+	)
+}
+
+// validateClientMigration validates the client IDs, the heights and the proofs of a client migration
+func validateClientMigration(clientID, newClientID string, upstreamConsensusHeight, proofHeight, newProofHeight clienttypes.Height, proofs ...[]byte) error {
+	if err := host.ClientIdentifierValidator(clientID); err != nil {
+		return err
+	}
+	if err := host.ClientIdentifierValidator(newClientID); err != nil {
+		return err
+	}
+	if clientID == newClientID {
+		return sdkerrors.Wrap(ErrInvalidClientMigration, "client cannot be migrated to itself")
+	}
+	if upstreamConsensusHeight.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidClientMigration, "upstream consensus height cannot be zero")
+	}
+	for _, proof := range proofs {
+		if len(proof) == 0 {
+			return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty proof")
+		}
+	}
+	if proofHeight.IsZero() || newProofHeight.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidClientMigration, "proof heights cannot be zero")
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgMigrateProxyClient) GetSigners() []sdk.AccAddress {
+	accAddr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgMigrateProxyClient) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if err := unpacker.UnpackAny(msg.UpstreamClientState, new(exported.ClientState)); err != nil {
+		return err
+	}
+	if err := unpacker.UnpackAny(msg.NewUpstreamClientState, new(exported.ClientState)); err != nil {
+		return err
+	}
+	return unpacker.UnpackAny(msg.UpstreamConsensusState, new(exported.ConsensusState))
+}
+
+// NewMsgProxyWithHeader creates a new MsgProxyWithHeader instance
+func NewMsgProxyWithHeader(upstreamClientID string, header exported.Header, msg sdk.Msg, signer string) (*MsgProxyWithHeader, error) {
+	anyHeader, err := clienttypes.PackHeader(header)
+	if err != nil {
+		return nil, err
+	}
+	anyMsg, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+	return &MsgProxyWithHeader{
+		UpstreamClientId: upstreamClientID,
+		Header:           anyHeader,
+		Msg:              anyMsg,
+		Signer:           signer,
+	}, nil
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgProxyWithHeader) ValidateBasic() error {
+	if err := host.ClientIdentifierValidator(msg.UpstreamClientId); err != nil {
+		return err
+	}
+	if msg.Header == nil {
+		return sdkerrors.Wrap(ErrInvalidProxyMsg, "header cannot be nil")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	proxyMsg, err := msg.GetProxyMsg()
+	if err != nil {
+		return err
+	}
+	if _, ok := proxyMsg.(*MsgProxyWithHeader); ok {
+		return sdkerrors.Wrap(ErrInvalidProxyMsg, "proxy message cannot be nested")
+	}
+	if err := proxyMsg.ValidateBasic(); err != nil {
+		return err
+	}
+	// the signer of the proxy message isn't verified by the ante handler, so it must be the signer of this message
+	if signers := proxyMsg.GetSigners(); len(signers) != 1 || signers[0].String() != msg.Signer {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "signers of the proxy message must be the signer %s: %v", msg.Signer, signers)
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgProxyWithHeader) GetSigners() []sdk.AccAddress {
+	accAddr, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
+
+// GetProxyMsg returns the cached proxy message
+func (msg MsgProxyWithHeader) GetProxyMsg() (sdk.Msg, error) {
+	if msg.Msg == nil {
+		return nil, sdkerrors.Wrap(ErrInvalidProxyMsg, "proxy message cannot be nil")
+	}
+	proxyMsg, ok := msg.Msg.GetCachedValue().(sdk.Msg)
+	if !ok {
+		return nil, sdkerrors.Wrapf(ErrInvalidProxyMsg, "proxy message is not unpacked: %s", msg.Msg.TypeUrl)
+	}
+	return proxyMsg, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgProxyWithHeader) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var header exported.Header
+	if err := unpacker.UnpackAny(msg.Header, &header); err != nil {
+		return err
+	}
+
+	var proxyMsg sdk.Msg
+	return unpacker.UnpackAny(msg.Msg, &proxyMsg)
+}
+
+// NewMsgSubmitProxyMisbehaviour creates a new MsgSubmitProxyMisbehaviour instance
+func NewMsgSubmitProxyMisbehaviour(misbehaviour proxytypes.ProxyMisbehaviour, signer string) *MsgSubmitProxyMisbehaviour {
+	return &MsgSubmitProxyMisbehaviour{
+		Misbehaviour: misbehaviour,
+		Signer:       signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgSubmitProxyMisbehaviour) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return msg.Misbehaviour.ValidateBasic()
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgSubmitProxyMisbehaviour) GetSigners() []sdk.AccAddress {
+	accAddr, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
+
 func mustPackClientState(clientState exported.ClientState) *codectypes.Any {
 	anyClient, err := clienttypes.PackClientState(clientState)
 	if err != nil {
@@ -1,5 +1,155 @@
 package types
 
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	host "github.com/cosmos/ibc-go/modules/core/24-host"
+)
+
+var (
+	// KeyUpstreamMode is store's key for UpstreamMode Params
+	KeyUpstreamMode = []byte("UpstreamMode")
+	// KeyAllowedUpstreamClients is store's key for AllowedUpstreamClients Params
+	KeyAllowedUpstreamClients = []byte("AllowedUpstreamClients")
+	// KeyPacketRetentionPeriod is store's key for PacketRetentionPeriod Params
+	KeyPacketRetentionPeriod = []byte("PacketRetentionPeriod")
+	// KeyMaxExpectedTimePerBlock is store's key for MaxExpectedTimePerBlock Params
+	KeyMaxExpectedTimePerBlock = []byte("MaxExpectedTimePerBlock")
+	// KeyGuardian is store's key for Guardian Params
+	KeyGuardian = []byte("Guardian")
+)
+
+// DefaultMaxExpectedTimePerBlock is the default value for the maximum expected time per block
+const DefaultMaxExpectedTimePerBlock = 30 * time.Second
+
+// ParamKeyTable type declaration for parameters
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new parameter configuration for the proxy module
+func NewParams(upstreamMode UpstreamMode, allowedUpstreamClients []string, packetRetentionPeriod, maxExpectedTimePerBlock time.Duration, guardian string) Params {
+	return Params{
+		UpstreamMode:            upstreamMode,
+		AllowedUpstreamClients:  allowedUpstreamClients,
+		PacketRetentionPeriod:   packetRetentionPeriod,
+		MaxExpectedTimePerBlock: maxExpectedTimePerBlock,
+		Guardian:                guardian,
+	}
+}
+
+// DefaultParams is the default parameter configuration for the proxy module.
+// The proxy serves every upstream client unless the governance switches to the allowlist mode,
+// and keeps the proxied acknowledgements until a retention period is set. No guardian is set by default.
 func DefaultParams() Params {
-	return Params{}
+	return NewParams(UpstreamModeOpen, nil, 0, DefaultMaxExpectedTimePerBlock, "")
+}
+
+// Validate all proxy module parameters
+func (p Params) Validate() error {
+	if err := validateUpstreamMode(p.UpstreamMode); err != nil {
+		return err
+	}
+	if err := validateAllowedUpstreamClients(p.AllowedUpstreamClients); err != nil {
+		return err
+	}
+	if err := validatePacketRetentionPeriod(p.PacketRetentionPeriod); err != nil {
+		return err
+	}
+	if err := validateMaxExpectedTimePerBlock(p.MaxExpectedTimePerBlock); err != nil {
+		return err
+	}
+	return validateGuardian(p.Guardian)
+}
+
+// IsUpstreamAllowed returns true if the proxy may serve the given upstream client
+func (p Params) IsUpstreamAllowed(upstreamClientID string) bool {
+	if p.UpstreamMode == UpstreamModeOpen {
+		return true
+	}
+	for _, id := range p.AllowedUpstreamClients {
+		if id == upstreamClientID {
+			return true
+		}
+	}
+	return false
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyUpstreamMode, &p.UpstreamMode, validateUpstreamMode),
+		paramtypes.NewParamSetPair(KeyAllowedUpstreamClients, &p.AllowedUpstreamClients, validateAllowedUpstreamClients),
+		paramtypes.NewParamSetPair(KeyPacketRetentionPeriod, &p.PacketRetentionPeriod, validatePacketRetentionPeriod),
+		paramtypes.NewParamSetPair(KeyMaxExpectedTimePerBlock, &p.MaxExpectedTimePerBlock, validateMaxExpectedTimePerBlock),
+		paramtypes.NewParamSetPair(KeyGuardian, &p.Guardian, validateGuardian),
+	}
+}
+
+func validateUpstreamMode(i interface{}) error {
+	mode, ok := i.(UpstreamMode)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if _, ok := UpstreamMode_name[int32(mode)]; !ok {
+		return fmt.Errorf("invalid upstream mode: %d", mode)
+	}
+	return nil
+}
+
+func validateAllowedUpstreamClients(i interface{}) error {
+	clients, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool)
+	for i, id := range clients {
+		if err := host.ClientIdentifierValidator(id); err != nil {
+			return fmt.Errorf("invalid allowed upstream client %s index %d: %w", id, i, err)
+		}
+		if seen[id] {
+			return fmt.Errorf("duplicate allowed upstream client: %s", id)
+		}
+		seen[id] = true
+	}
+	return nil
+}
+
+func validatePacketRetentionPeriod(i interface{}) error {
+	period, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if period < 0 {
+		return fmt.Errorf("packet retention period cannot be negative: %s", period)
+	}
+	return nil
+}
+
+func validateMaxExpectedTimePerBlock(i interface{}) error {
+	timePerBlock, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if timePerBlock <= 0 {
+		return fmt.Errorf("max expected time per block must be positive: %s", timePerBlock)
+	}
+	return nil
+}
+
+func validateGuardian(i interface{}) error {
+	guardian, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if guardian == "" {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(guardian); err != nil {
+		return fmt.Errorf("invalid guardian address %s: %w", guardian, err)
+	}
+	return nil
 }
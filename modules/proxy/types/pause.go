@@ -0,0 +1,44 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/modules/core/24-host"
+)
+
+// NewPausedProxy creates a new PausedProxy instance.
+func NewPausedProxy(upstreamClientID, portID, channelID string) PausedProxy {
+	return PausedProxy{
+		UpstreamClientId: upstreamClientID,
+		PortId:           portID,
+		ChannelId:        channelID,
+	}
+}
+
+// IsUpstream returns true if proxying is paused for the whole upstream rather than for a channel
+func (pp PausedProxy) IsUpstream() bool {
+	return pp.PortId == "" && pp.ChannelId == ""
+}
+
+// Validate performs a stateless validation of the paused proxy.
+// The port and channel must be both empty or both valid identifiers. The signer is optional.
+func (pp PausedProxy) Validate() error {
+	if err := host.ClientIdentifierValidator(pp.UpstreamClientId); err != nil {
+		return sdkerrors.Wrap(err, "invalid upstream client ID")
+	}
+	if pp.Signer != "" {
+		if _, err := sdk.AccAddressFromBech32(pp.Signer); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+		}
+	}
+	if pp.IsUpstream() {
+		return nil
+	}
+	if err := host.PortIdentifierValidator(pp.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if err := host.ChannelIdentifierValidator(pp.ChannelId); err != nil {
+		return sdkerrors.Wrap(err, "invalid channel ID")
+	}
+	return nil
+}
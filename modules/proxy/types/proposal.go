@@ -0,0 +1,205 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/modules/core/24-host"
+	"github.com/cosmos/ibc-go/modules/core/exported"
+)
+
+const (
+	// ProposalTypeRegisterUpstream defines the type for a RegisterUpstreamProposal
+	ProposalTypeRegisterUpstream = "RegisterUpstream"
+	// ProposalTypeDeregisterUpstream defines the type for a DeregisterUpstreamProposal
+	ProposalTypeDeregisterUpstream = "DeregisterUpstream"
+	// ProposalTypeUnpauseProxy defines the type for a UnpauseProxyProposal
+	ProposalTypeUnpauseProxy = "UnpauseProxy"
+	// ProposalTypeMigrateProxyClient defines the type for a MigrateProxyClientProposal
+	ProposalTypeMigrateProxyClient = "MigrateProxyClient"
+)
+
+var (
+	_ govtypes.Content = &RegisterUpstreamProposal{}
+	_ govtypes.Content = &DeregisterUpstreamProposal{}
+	_ govtypes.Content = &UnpauseProxyProposal{}
+	_ govtypes.Content = &MigrateProxyClientProposal{}
+
+	_ codectypes.UnpackInterfacesMessage = &MigrateProxyClientProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeRegisterUpstream)
+	govtypes.RegisterProposalType(ProposalTypeDeregisterUpstream)
+	govtypes.RegisterProposalType(ProposalTypeUnpauseProxy)
+	govtypes.RegisterProposalType(ProposalTypeMigrateProxyClient)
+}
+
+// NewRegisterUpstreamProposal creates a new register upstream proposal.
+func NewRegisterUpstreamProposal(title, description, upstreamClientID string) govtypes.Content {
+	return &RegisterUpstreamProposal{
+		Title:            title,
+		Description:      description,
+		UpstreamClientId: upstreamClientID,
+	}
+}
+
+// GetTitle returns the title of a register upstream proposal.
+func (p *RegisterUpstreamProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a register upstream proposal.
+func (p *RegisterUpstreamProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a register upstream proposal.
+func (p *RegisterUpstreamProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a register upstream proposal.
+func (p *RegisterUpstreamProposal) ProposalType() string { return ProposalTypeRegisterUpstream }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *RegisterUpstreamProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	return host.ClientIdentifierValidator(p.UpstreamClientId)
+}
+
+// NewDeregisterUpstreamProposal creates a new deregister upstream proposal.
+func NewDeregisterUpstreamProposal(title, description, upstreamClientID string) govtypes.Content {
+	return &DeregisterUpstreamProposal{
+		Title:            title,
+		Description:      description,
+		UpstreamClientId: upstreamClientID,
+	}
+}
+
+// GetTitle returns the title of a deregister upstream proposal.
+func (p *DeregisterUpstreamProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a deregister upstream proposal.
+func (p *DeregisterUpstreamProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a deregister upstream proposal.
+func (p *DeregisterUpstreamProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a deregister upstream proposal.
+func (p *DeregisterUpstreamProposal) ProposalType() string { return ProposalTypeDeregisterUpstream }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *DeregisterUpstreamProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	return host.ClientIdentifierValidator(p.UpstreamClientId)
+}
+
+// NewUnpauseProxyProposal creates a new unpause proxy proposal.
+func NewUnpauseProxyProposal(title, description, upstreamClientID, portID, channelID string) govtypes.Content {
+	return &UnpauseProxyProposal{
+		Title:            title,
+		Description:      description,
+		UpstreamClientId: upstreamClientID,
+		PortId:           portID,
+		ChannelId:        channelID,
+	}
+}
+
+// GetTitle returns the title of an unpause proxy proposal.
+func (p *UnpauseProxyProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an unpause proxy proposal.
+func (p *UnpauseProxyProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an unpause proxy proposal.
+func (p *UnpauseProxyProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an unpause proxy proposal.
+func (p *UnpauseProxyProposal) ProposalType() string { return ProposalTypeUnpauseProxy }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *UnpauseProxyProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	return NewPausedProxy(p.UpstreamClientId, p.PortId, p.ChannelId).Validate()
+}
+
+// NewMigrateProxyClientProposal creates a new migrate proxy client proposal.
+func NewMigrateProxyClientProposal(
+	title, description string,
+	clientID string,
+	newClientID string,
+	upstreamClientState exported.ClientState,
+	newUpstreamClientState exported.ClientState,
+	upstreamConsensusState exported.ConsensusState,
+	upstreamConsensusHeight clienttypes.Height,
+	proofUpstreamClient []byte,
+	proofUpstreamConsensus []byte,
+	proofHeight clienttypes.Height,
+	proofNewUpstreamClient []byte,
+	proofNewUpstreamConsensus []byte,
+	newProofHeight clienttypes.Height,
+) (*MigrateProxyClientProposal, error) {
+	anyUpstreamClient, err := clienttypes.PackClientState(upstreamClientState)
+	if err != nil {
+		return nil, err
+	}
+	anyNewUpstreamClient, err := clienttypes.PackClientState(newUpstreamClientState)
+	if err != nil {
+		return nil, err
+	}
+	anyUpstreamConsensus, err := clienttypes.PackConsensusState(upstreamConsensusState)
+	if err != nil {
+		return nil, err
+	}
+	return &MigrateProxyClientProposal{
+		Title:                     title,
+		Description:               description,
+		ClientId:                  clientID,
+		NewClientId:               newClientID,
+		UpstreamClientState:       anyUpstreamClient,
+		NewUpstreamClientState:    anyNewUpstreamClient,
+		UpstreamConsensusState:    anyUpstreamConsensus,
+		UpstreamConsensusHeight:   upstreamConsensusHeight,
+		ProofUpstreamClient:       proofUpstreamClient,
+		ProofUpstreamConsensus:    proofUpstreamConsensus,
+		ProofHeight:               proofHeight,
+		ProofNewUpstreamClient:    proofNewUpstreamClient,
+		ProofNewUpstreamConsensus: proofNewUpstreamConsensus,
+		NewProofHeight:            newProofHeight,
+	}, nil
+}
+
+// GetTitle returns the title of a migrate proxy client proposal.
+func (p *MigrateProxyClientProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a migrate proxy client proposal.
+func (p *MigrateProxyClientProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a migrate proxy client proposal.
+func (p *MigrateProxyClientProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a migrate proxy client proposal.
+func (p *MigrateProxyClientProposal) ProposalType() string { return ProposalTypeMigrateProxyClient }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *MigrateProxyClientProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	return validateClientMigration(
+		p.ClientId, p.NewClientId, p.UpstreamConsensusHeight, p.ProofHeight, p.NewProofHeight,
+		p.ProofUpstreamClient, p.ProofUpstreamConsensus, p.ProofNewUpstreamClient, p.ProofNewUpstreamConsensus,
+	)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (p MigrateProxyClientProposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if err := unpacker.UnpackAny(p.UpstreamClientState, new(exported.ClientState)); err != nil {
+		return err
+	}
+	if err := unpacker.UnpackAny(p.NewUpstreamClientState, new(exported.ClientState)); err != nil {
+		return err
+	}
+	return unpacker.UnpackAny(p.UpstreamConsensusState, new(exported.ConsensusState))
+}
@@ -5,20 +5,28 @@ package types
 
 import (
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/codec/types"
-	_ "github.com/cosmos/ibc-go/modules/core/02-client/types"
-	_ "github.com/cosmos/ibc-go/modules/core/23-commitment/types"
+	types5 "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types4 "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	types2 "github.com/cosmos/ibc-go/modules/core/03-connection/types"
+	types3 "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	types "github.com/cosmos/ibc-go/modules/core/23-commitment/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -26,7 +34,76 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// UpstreamMode defines which upstream clients the proxy serves
+type UpstreamMode int32
+
+const (
+	// UPSTREAM_MODE_OPEN allows every upstream client
+	UpstreamModeOpen UpstreamMode = 0
+	// UPSTREAM_MODE_ALLOWLIST allows only the upstream clients in the allowlist
+	UpstreamModeAllowlist UpstreamMode = 1
+)
+
+var UpstreamMode_name = map[int32]string{
+	0: "UPSTREAM_MODE_OPEN",
+	1: "UPSTREAM_MODE_ALLOWLIST",
+}
+
+var UpstreamMode_value = map[string]int32{
+	"UPSTREAM_MODE_OPEN":      0,
+	"UPSTREAM_MODE_ALLOWLIST": 1,
+}
+
+func (x UpstreamMode) String() string {
+	return proto.EnumName(UpstreamMode_name, int32(x))
+}
+
+func (UpstreamMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_cd60f0f20217e257, []int{0}
+}
+
+// PacketDirection is the direction of a packet relative to the upstream
+type PacketDirection int32
+
+const (
+	// PACKET_DIRECTION_FROM_UPSTREAM is a packet sent by the upstream, whose commitment is proxied
+	PacketFromUpstream PacketDirection = 0
+	// PACKET_DIRECTION_TO_UPSTREAM is a packet received by the upstream, whose acknowledgement or receipt absence is proxied
+	PacketToUpstream PacketDirection = 1
+)
+
+var PacketDirection_name = map[int32]string{
+	0: "PACKET_DIRECTION_FROM_UPSTREAM",
+	1: "PACKET_DIRECTION_TO_UPSTREAM",
+}
+
+var PacketDirection_value = map[string]int32{
+	"PACKET_DIRECTION_FROM_UPSTREAM": 0,
+	"PACKET_DIRECTION_TO_UPSTREAM":   1,
+}
+
+func (x PacketDirection) String() string {
+	return proto.EnumName(PacketDirection_name, int32(x))
+}
+
+func (PacketDirection) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_cd60f0f20217e257, []int{1}
+}
+
+// Params defines the parameters for the proxy module.
 type Params struct {
+	UpstreamMode UpstreamMode `protobuf:"varint,1,opt,name=upstream_mode,json=upstreamMode,proto3,enum=ibc.proxy.v1.UpstreamMode" json:"upstream_mode,omitempty" yaml:"upstream_mode"`
+	// allowed_upstream_clients are the upstream client IDs registered by the governance
+	AllowedUpstreamClients []string `protobuf:"bytes,2,rep,name=allowed_upstream_clients,json=allowedUpstreamClients,proto3" json:"allowed_upstream_clients,omitempty" yaml:"allowed_upstream_clients"`
+	// packet_retention_period is how long the proxied packet acknowledgements are kept. Zero keeps them forever.
+	// The packet commitments are kept until they are proven to be deleted on the upstream.
+	PacketRetentionPeriod time.Duration `protobuf:"bytes,3,opt,name=packet_retention_period,json=packetRetentionPeriod,proto3,stdduration" json:"packet_retention_period" yaml:"packet_retention_period"`
+	// max_expected_time_per_block is the maximum expected time per block on the proxy,
+	// used to derive the block delay from the delay period of a connection.
+	MaxExpectedTimePerBlock time.Duration `protobuf:"bytes,4,opt,name=max_expected_time_per_block,json=maxExpectedTimePerBlock,proto3,stdduration" json:"max_expected_time_per_block" yaml:"max_expected_time_per_block"`
+	// guardian is an optional address that can pause proxying besides the authority.
+	// Only the authority can unpause it.
+	Guardian string `protobuf:"bytes,5,opt,name=guardian,proto3" json:"guardian,omitempty" yaml:"guardian"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -62,7 +139,47 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetUpstreamMode() UpstreamMode {
+	if m != nil {
+		return m.UpstreamMode
+	}
+	return UpstreamModeOpen
+}
+
+func (m *Params) GetAllowedUpstreamClients() []string {
+	if m != nil {
+		return m.AllowedUpstreamClients
+	}
+	return nil
+}
+
+func (m *Params) GetPacketRetentionPeriod() time.Duration {
+	if m != nil {
+		return m.PacketRetentionPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetMaxExpectedTimePerBlock() time.Duration {
+	if m != nil {
+		return m.MaxExpectedTimePerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
+	}
+	return ""
+}
+
+// GenesisState defines the proxy module's genesis state.
 type GenesisState struct {
+	Upstreams           []UpstreamGenesisState `protobuf:"bytes,1,rep,name=upstreams,proto3" json:"upstreams"`
+	Params              Params                 `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	IncentivizedPackets []IncentivizedPacket   `protobuf:"bytes,3,rep,name=incentivized_packets,json=incentivizedPackets,proto3" json:"incentivized_packets" yaml:"incentivized_packets"`
+	PausedProxies       []PausedProxy          `protobuf:"bytes,4,rep,name=paused_proxies,json=pausedProxies,proto3" json:"paused_proxies" yaml:"paused_proxies"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -98,192 +215,4522 @@ func (m *GenesisState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*Params)(nil), "ibc.proxy.v1.Params")
-	proto.RegisterType((*GenesisState)(nil), "ibc.proxy.v1.GenesisState")
+func (m *GenesisState) GetUpstreams() []UpstreamGenesisState {
+	if m != nil {
+		return m.Upstreams
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("ibc/modules/proxy/proxy.proto", fileDescriptor_cd60f0f20217e257) }
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
 
-var fileDescriptor_cd60f0f20217e257 = []byte{
-	// 227 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcd, 0x4c, 0x4a, 0xd6,
-	0xcf, 0xcd, 0x4f, 0x29, 0xcd, 0x49, 0x2d, 0xd6, 0x2f, 0x28, 0xca, 0xaf, 0xa8, 0x84, 0x90, 0x7a,
-	0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0x3c, 0x99, 0x49, 0xc9, 0x7a, 0x10, 0x81, 0x32, 0x43, 0x29,
-	0x91, 0xf4, 0xfc, 0xf4, 0x7c, 0xb0, 0x84, 0x3e, 0x88, 0x05, 0x51, 0x23, 0x25, 0x99, 0x9e, 0x9f,
-	0x9f, 0x9e, 0x93, 0xaa, 0x0f, 0xe6, 0x25, 0x95, 0xa6, 0xe9, 0x27, 0xe6, 0x41, 0xb5, 0x4b, 0xc9,
-	0x83, 0x4c, 0x4f, 0xce, 0x2f, 0x4a, 0xd5, 0x4f, 0xce, 0xc9, 0x4c, 0xcd, 0x2b, 0xd1, 0x2f, 0x33,
-	0x84, 0xb2, 0xa0, 0x0a, 0xd4, 0x11, 0x0a, 0xf2, 0x73, 0x73, 0x33, 0x4b, 0x72, 0x61, 0x8a, 0xe0,
-	0x3c, 0x88, 0x42, 0x25, 0x0e, 0x2e, 0xb6, 0x80, 0xc4, 0xa2, 0xc4, 0xdc, 0x62, 0x25, 0x3e, 0x2e,
-	0x1e, 0xf7, 0xd4, 0xbc, 0xd4, 0xe2, 0xcc, 0xe2, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x27, 0xff, 0x13,
-	0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86,
-	0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x4d, 0xcf, 0x2c, 0xc9, 0x28, 0x4d,
-	0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x4f, 0x49, 0x2c, 0x49, 0x4c, 0xce, 0x48, 0xcc, 0xcc, 0xcb, 0x49,
-	0x4c, 0xd2, 0xcf, 0x4c, 0x4a, 0xd6, 0x85, 0xf8, 0x15, 0xd5, 0xe7, 0x25, 0x95, 0x05, 0xa9, 0xc5,
-	0x49, 0x6c, 0x60, 0x1b, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xe2, 0xb6, 0x90, 0x15, 0x1b,
-	0x01, 0x00, 0x00,
+func (m *GenesisState) GetIncentivizedPackets() []IncentivizedPacket {
+	if m != nil {
+		return m.IncentivizedPackets
+	}
+	return nil
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GenesisState) GetPausedProxies() []PausedProxy {
+	if m != nil {
+		return m.PausedProxies
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// UpstreamGenesisState defines the states that the proxy stores for an upstream,
+// which is identified by the client ID on the proxy and the store prefix of the upstream.
+type UpstreamGenesisState struct {
+	UpstreamClientId string                         `protobuf:"bytes,1,opt,name=upstream_client_id,json=upstreamClientId,proto3" json:"upstream_client_id,omitempty"`
+	UpstreamPrefix   types.MerklePrefix             `protobuf:"bytes,2,opt,name=upstream_prefix,json=upstreamPrefix,proto3" json:"upstream_prefix"`
+	Clients          []types1.IdentifiedClientState `protobuf:"bytes,3,rep,name=clients,proto3" json:"clients"`
+	ClientsConsensus []types1.ClientConsensusStates `protobuf:"bytes,4,rep,name=clients_consensus,json=clientsConsensus,proto3" json:"clients_consensus"`
+	Connections      []types2.IdentifiedConnection  `protobuf:"bytes,5,rep,name=connections,proto3" json:"connections"`
+	Channels         []types3.IdentifiedChannel     `protobuf:"bytes,6,rep,name=channels,proto3" json:"channels"`
+	Commitments      []types3.PacketState           `protobuf:"bytes,7,rep,name=commitments,proto3" json:"commitments"`
+	// acknowledgements are the commitments of the acknowledgements
+	Acknowledgements []types3.PacketState `protobuf:"bytes,8,rep,name=acknowledgements,proto3" json:"acknowledgements"`
+	// receipts are the markers of the packet receipt absences
+	Receipts      []types3.PacketState    `protobuf:"bytes,9,rep,name=receipts,proto3" json:"receipts"`
+	RecvSequences []types3.PacketSequence `protobuf:"bytes,10,rep,name=recv_sequences,json=recvSequences,proto3" json:"recv_sequences"`
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *UpstreamGenesisState) Reset()         { *m = UpstreamGenesisState{} }
+func (m *UpstreamGenesisState) String() string { return proto.CompactTextString(m) }
+func (*UpstreamGenesisState) ProtoMessage()    {}
+func (*UpstreamGenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd60f0f20217e257, []int{2}
+}
+func (m *UpstreamGenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpstreamGenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpstreamGenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpstreamGenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpstreamGenesisState.Merge(m, src)
+}
+func (m *UpstreamGenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpstreamGenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpstreamGenesisState.DiscardUnknown(m)
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_UpstreamGenesisState proto.InternalMessageInfo
+
+func (m *UpstreamGenesisState) GetUpstreamClientId() string {
+	if m != nil {
+		return m.UpstreamClientId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *UpstreamGenesisState) GetUpstreamPrefix() types.MerklePrefix {
+	if m != nil {
+		return m.UpstreamPrefix
+	}
+	return types.MerklePrefix{}
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *UpstreamGenesisState) GetClients() []types1.IdentifiedClientState {
+	if m != nil {
+		return m.Clients
+	}
+	return nil
 }
 
-func encodeVarintProxy(dAtA []byte, offset int, v uint64) int {
-	offset -= sovProxy(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *UpstreamGenesisState) GetClientsConsensus() []types1.ClientConsensusStates {
+	if m != nil {
+		return m.ClientsConsensus
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *UpstreamGenesisState) GetConnections() []types2.IdentifiedConnection {
+	if m != nil {
+		return m.Connections
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UpstreamGenesisState) GetChannels() []types3.IdentifiedChannel {
+	if m != nil {
+		return m.Channels
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func sovProxy(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *UpstreamGenesisState) GetCommitments() []types3.PacketState {
+	if m != nil {
+		return m.Commitments
+	}
+	return nil
 }
-func sozProxy(x uint64) (n int) {
-	return sovProxy(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *UpstreamGenesisState) GetAcknowledgements() []types3.PacketState {
+	if m != nil {
+		return m.Acknowledgements
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowProxy
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipProxy(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthProxy
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
+
+func (m *UpstreamGenesisState) GetReceipts() []types3.PacketState {
+	if m != nil {
+		return m.Receipts
 	}
+	return nil
+}
 
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
+func (m *UpstreamGenesisState) GetRecvSequences() []types3.PacketSequence {
+	if m != nil {
+		return m.RecvSequences
 	}
 	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowProxy
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+
+// ProxyConnectionHop identifies the upstream under which the proxy stores the connection end of a connection hop.
+// The hops beyond the first one are on other chains than the upstream of the channel.
+type ProxyConnectionHop struct {
+	// client id corresponding to the chain of the hop on proxy
+	UpstreamClientId string `protobuf:"bytes,1,opt,name=upstream_client_id,json=upstreamClientId,proto3" json:"upstream_client_id,omitempty"`
+	// store prefix of the chain of the hop
+	UpstreamPrefix types.MerklePrefix `protobuf:"bytes,2,opt,name=upstream_prefix,json=upstreamPrefix,proto3" json:"upstream_prefix"`
+}
+
+func (m *ProxyConnectionHop) Reset()         { *m = ProxyConnectionHop{} }
+func (m *ProxyConnectionHop) String() string { return proto.CompactTextString(m) }
+func (*ProxyConnectionHop) ProtoMessage()    {}
+func (*ProxyConnectionHop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd60f0f20217e257, []int{3}
+}
+func (m *ProxyConnectionHop) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProxyConnectionHop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProxyConnectionHop.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		return b[:n], nil
+	}
+}
+func (m *ProxyConnectionHop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProxyConnectionHop.Merge(m, src)
+}
+func (m *ProxyConnectionHop) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProxyConnectionHop) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProxyConnectionHop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProxyConnectionHop proto.InternalMessageInfo
+
+func (m *ProxyConnectionHop) GetUpstreamClientId() string {
+	if m != nil {
+		return m.UpstreamClientId
+	}
+	return ""
+}
+
+func (m *ProxyConnectionHop) GetUpstreamPrefix() types.MerklePrefix {
+	if m != nil {
+		return m.UpstreamPrefix
+	}
+	return types.MerklePrefix{}
+}
+
+// PacketFee is a fee escrowed for relaying a packet through the proxy
+type PacketFee struct {
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+	// refund_address receives the fee back if the packet times out
+	RefundAddress string `protobuf:"bytes,2,opt,name=refund_address,json=refundAddress,proto3" json:"refund_address,omitempty" yaml:"refund_address"`
+}
+
+func (m *PacketFee) Reset()         { *m = PacketFee{} }
+func (m *PacketFee) String() string { return proto.CompactTextString(m) }
+func (*PacketFee) ProtoMessage()    {}
+func (*PacketFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd60f0f20217e257, []int{4}
+}
+func (m *PacketFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		switch fieldNum {
+		return b[:n], nil
+	}
+}
+func (m *PacketFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketFee.Merge(m, src)
+}
+func (m *PacketFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketFee proto.InternalMessageInfo
+
+func (m *PacketFee) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
+func (m *PacketFee) GetRefundAddress() string {
+	if m != nil {
+		return m.RefundAddress
+	}
+	return ""
+}
+
+// PacketFees is the list of the fees escrowed for a packet
+type PacketFees struct {
+	PacketFees []PacketFee `protobuf:"bytes,1,rep,name=packet_fees,json=packetFees,proto3" json:"packet_fees" yaml:"packet_fees"`
+}
+
+func (m *PacketFees) Reset()         { *m = PacketFees{} }
+func (m *PacketFees) String() string { return proto.CompactTextString(m) }
+func (*PacketFees) ProtoMessage()    {}
+func (*PacketFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd60f0f20217e257, []int{5}
+}
+func (m *PacketFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketFees.Merge(m, src)
+}
+func (m *PacketFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketFees proto.InternalMessageInfo
+
+func (m *PacketFees) GetPacketFees() []PacketFee {
+	if m != nil {
+		return m.PacketFees
+	}
+	return nil
+}
+
+// IncentivizedPacket is a packet that has fees escrowed for relaying it through the proxy.
+// The packet is identified by the upstream client and prefix on the proxy, the direction of the packet
+// and the port, channel and sequence of the packet on the upstream side.
+type IncentivizedPacket struct {
+	UpstreamClientId string `protobuf:"bytes,1,opt,name=upstream_client_id,json=upstreamClientId,proto3" json:"upstream_client_id,omitempty" yaml:"upstream_client_id"`
+	// port id of the upstream side: the source port if the packet is from the upstream, otherwise the destination port
+	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	// channel id of the upstream side: the source channel if the packet is from the upstream, otherwise the destination channel
+	ChannelId  string      `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	Sequence   uint64      `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	PacketFees []PacketFee `protobuf:"bytes,5,rep,name=packet_fees,json=packetFees,proto3" json:"packet_fees" yaml:"packet_fees"`
+	// direction of the packet relative to the upstream
+	Direction      PacketDirection    `protobuf:"varint,6,opt,name=direction,proto3,enum=ibc.proxy.v1.PacketDirection" json:"direction,omitempty"`
+	UpstreamPrefix types.MerklePrefix `protobuf:"bytes,7,opt,name=upstream_prefix,json=upstreamPrefix,proto3" json:"upstream_prefix" yaml:"upstream_prefix"`
+}
+
+func (m *IncentivizedPacket) Reset()         { *m = IncentivizedPacket{} }
+func (m *IncentivizedPacket) String() string { return proto.CompactTextString(m) }
+func (*IncentivizedPacket) ProtoMessage()    {}
+func (*IncentivizedPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd60f0f20217e257, []int{6}
+}
+func (m *IncentivizedPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IncentivizedPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IncentivizedPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IncentivizedPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncentivizedPacket.Merge(m, src)
+}
+func (m *IncentivizedPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *IncentivizedPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncentivizedPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IncentivizedPacket proto.InternalMessageInfo
+
+func (m *IncentivizedPacket) GetUpstreamClientId() string {
+	if m != nil {
+		return m.UpstreamClientId
+	}
+	return ""
+}
+
+func (m *IncentivizedPacket) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *IncentivizedPacket) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *IncentivizedPacket) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *IncentivizedPacket) GetPacketFees() []PacketFee {
+	if m != nil {
+		return m.PacketFees
+	}
+	return nil
+}
+
+func (m *IncentivizedPacket) GetDirection() PacketDirection {
+	if m != nil {
+		return m.Direction
+	}
+	return PacketFromUpstream
+}
+
+func (m *IncentivizedPacket) GetUpstreamPrefix() types.MerklePrefix {
+	if m != nil {
+		return m.UpstreamPrefix
+	}
+	return types.MerklePrefix{}
+}
+
+// PausedProxy identifies an upstream client, or a port and channel of the upstream, for which proxying is paused.
+// If the port and channel are empty, proxying is paused for the whole upstream.
+type PausedProxy struct {
+	UpstreamClientId string `protobuf:"bytes,1,opt,name=upstream_client_id,json=upstreamClientId,proto3" json:"upstream_client_id,omitempty" yaml:"upstream_client_id"`
+	PortId           string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	ChannelId        string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	// the address that paused proxying
+	Signer string `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *PausedProxy) Reset()         { *m = PausedProxy{} }
+func (m *PausedProxy) String() string { return proto.CompactTextString(m) }
+func (*PausedProxy) ProtoMessage()    {}
+func (*PausedProxy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd60f0f20217e257, []int{7}
+}
+func (m *PausedProxy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PausedProxy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PausedProxy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PausedProxy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PausedProxy.Merge(m, src)
+}
+func (m *PausedProxy) XXX_Size() int {
+	return m.Size()
+}
+func (m *PausedProxy) XXX_DiscardUnknown() {
+	xxx_messageInfo_PausedProxy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PausedProxy proto.InternalMessageInfo
+
+func (m *PausedProxy) GetUpstreamClientId() string {
+	if m != nil {
+		return m.UpstreamClientId
+	}
+	return ""
+}
+
+func (m *PausedProxy) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *PausedProxy) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *PausedProxy) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+// RegisterUpstreamProposal is a governance proposal. If it passes, the upstream client is added to the allowlist.
+type RegisterUpstreamProposal struct {
+	// the title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// the description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// the upstream client to be added to the allowlist
+	UpstreamClientId string `protobuf:"bytes,3,opt,name=upstream_client_id,json=upstreamClientId,proto3" json:"upstream_client_id,omitempty" yaml:"upstream_client_id"`
+}
+
+func (m *RegisterUpstreamProposal) Reset()         { *m = RegisterUpstreamProposal{} }
+func (m *RegisterUpstreamProposal) String() string { return proto.CompactTextString(m) }
+func (*RegisterUpstreamProposal) ProtoMessage()    {}
+func (*RegisterUpstreamProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd60f0f20217e257, []int{8}
+}
+func (m *RegisterUpstreamProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisterUpstreamProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisterUpstreamProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegisterUpstreamProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterUpstreamProposal.Merge(m, src)
+}
+func (m *RegisterUpstreamProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisterUpstreamProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterUpstreamProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterUpstreamProposal proto.InternalMessageInfo
+
+// DeregisterUpstreamProposal is a governance proposal. If it passes, the upstream client is removed from the allowlist.
+type DeregisterUpstreamProposal struct {
+	// the title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// the description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// the upstream client to be removed from the allowlist
+	UpstreamClientId string `protobuf:"bytes,3,opt,name=upstream_client_id,json=upstreamClientId,proto3" json:"upstream_client_id,omitempty" yaml:"upstream_client_id"`
+}
+
+func (m *DeregisterUpstreamProposal) Reset()         { *m = DeregisterUpstreamProposal{} }
+func (m *DeregisterUpstreamProposal) String() string { return proto.CompactTextString(m) }
+func (*DeregisterUpstreamProposal) ProtoMessage()    {}
+func (*DeregisterUpstreamProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd60f0f20217e257, []int{9}
+}
+func (m *DeregisterUpstreamProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeregisterUpstreamProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeregisterUpstreamProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeregisterUpstreamProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeregisterUpstreamProposal.Merge(m, src)
+}
+func (m *DeregisterUpstreamProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeregisterUpstreamProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeregisterUpstreamProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeregisterUpstreamProposal proto.InternalMessageInfo
+
+// UnpauseProxyProposal is a governance proposal. If it passes, proxying paused for the upstream client,
+// or for the port and channel of the upstream if they are given, is resumed.
+type UnpauseProxyProposal struct {
+	// the title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// the description of the proposal
+	Description      string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	UpstreamClientId string `protobuf:"bytes,3,opt,name=upstream_client_id,json=upstreamClientId,proto3" json:"upstream_client_id,omitempty" yaml:"upstream_client_id"`
+	PortId           string `protobuf:"bytes,4,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	ChannelId        string `protobuf:"bytes,5,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+}
+
+func (m *UnpauseProxyProposal) Reset()         { *m = UnpauseProxyProposal{} }
+func (m *UnpauseProxyProposal) String() string { return proto.CompactTextString(m) }
+func (*UnpauseProxyProposal) ProtoMessage()    {}
+func (*UnpauseProxyProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd60f0f20217e257, []int{10}
+}
+func (m *UnpauseProxyProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnpauseProxyProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnpauseProxyProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnpauseProxyProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnpauseProxyProposal.Merge(m, src)
+}
+func (m *UnpauseProxyProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnpauseProxyProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnpauseProxyProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnpauseProxyProposal proto.InternalMessageInfo
+
+// MigrateProxyClientProposal is a governance proposal. If it passes, the proxy client is migrated
+// to the proxy chain and the upstream client of the new proxy client as MsgMigrateProxyClient does.
+type MigrateProxyClientProposal struct {
+	// the title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// the description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// the proxy client to be migrated
+	ClientId string `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty" yaml:"client_id"`
+	// the proxy client whose proxy chain and upstream client the client migrates to
+	NewClientId string `protobuf:"bytes,4,opt,name=new_client_id,json=newClientId,proto3" json:"new_client_id,omitempty" yaml:"new_client_id"`
+	// the client states of the current and the new upstream clients on their proxy chains
+	UpstreamClientState    *types5.Any `protobuf:"bytes,5,opt,name=upstream_client_state,json=upstreamClientState,proto3" json:"upstream_client_state,omitempty" yaml:"upstream_client_state"`
+	NewUpstreamClientState *types5.Any `protobuf:"bytes,6,opt,name=new_upstream_client_state,json=newUpstreamClientState,proto3" json:"new_upstream_client_state,omitempty" yaml:"new_upstream_client_state"`
+	// the consensus state that both upstream clients have at the consensus height
+	UpstreamConsensusState  *types5.Any   `protobuf:"bytes,7,opt,name=upstream_consensus_state,json=upstreamConsensusState,proto3" json:"upstream_consensus_state,omitempty" yaml:"upstream_consensus_state"`
+	UpstreamConsensusHeight types1.Height `protobuf:"bytes,8,opt,name=upstream_consensus_height,json=upstreamConsensusHeight,proto3" json:"upstream_consensus_height" yaml:"upstream_consensus_height"`
+	// the proofs of the current upstream client at the proof height of the client
+	ProofUpstreamClient    []byte        `protobuf:"bytes,9,opt,name=proof_upstream_client,json=proofUpstreamClient,proto3" json:"proof_upstream_client,omitempty" yaml:"proof_upstream_client"`
+	ProofUpstreamConsensus []byte        `protobuf:"bytes,10,opt,name=proof_upstream_consensus,json=proofUpstreamConsensus,proto3" json:"proof_upstream_consensus,omitempty" yaml:"proof_upstream_consensus"`
+	ProofHeight            types1.Height `protobuf:"bytes,11,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height" yaml:"proof_height"`
+	// the proofs of the new upstream client at the proof height of the new client
+	ProofNewUpstreamClient    []byte        `protobuf:"bytes,12,opt,name=proof_new_upstream_client,json=proofNewUpstreamClient,proto3" json:"proof_new_upstream_client,omitempty" yaml:"proof_new_upstream_client"`
+	ProofNewUpstreamConsensus []byte        `protobuf:"bytes,13,opt,name=proof_new_upstream_consensus,json=proofNewUpstreamConsensus,proto3" json:"proof_new_upstream_consensus,omitempty" yaml:"proof_new_upstream_consensus"`
+	NewProofHeight            types1.Height `protobuf:"bytes,14,opt,name=new_proof_height,json=newProofHeight,proto3" json:"new_proof_height" yaml:"new_proof_height"`
+}
+
+func (m *MigrateProxyClientProposal) Reset()         { *m = MigrateProxyClientProposal{} }
+func (m *MigrateProxyClientProposal) String() string { return proto.CompactTextString(m) }
+func (*MigrateProxyClientProposal) ProtoMessage()    {}
+func (*MigrateProxyClientProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd60f0f20217e257, []int{11}
+}
+func (m *MigrateProxyClientProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MigrateProxyClientProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MigrateProxyClientProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MigrateProxyClientProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrateProxyClientProposal.Merge(m, src)
+}
+func (m *MigrateProxyClientProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MigrateProxyClientProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrateProxyClientProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigrateProxyClientProposal proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("ibc.proxy.v1.UpstreamMode", UpstreamMode_name, UpstreamMode_value)
+	proto.RegisterEnum("ibc.proxy.v1.PacketDirection", PacketDirection_name, PacketDirection_value)
+	proto.RegisterType((*Params)(nil), "ibc.proxy.v1.Params")
+	proto.RegisterType((*GenesisState)(nil), "ibc.proxy.v1.GenesisState")
+	proto.RegisterType((*UpstreamGenesisState)(nil), "ibc.proxy.v1.UpstreamGenesisState")
+	proto.RegisterType((*ProxyConnectionHop)(nil), "ibc.proxy.v1.ProxyConnectionHop")
+	proto.RegisterType((*PacketFee)(nil), "ibc.proxy.v1.PacketFee")
+	proto.RegisterType((*PacketFees)(nil), "ibc.proxy.v1.PacketFees")
+	proto.RegisterType((*IncentivizedPacket)(nil), "ibc.proxy.v1.IncentivizedPacket")
+	proto.RegisterType((*PausedProxy)(nil), "ibc.proxy.v1.PausedProxy")
+	proto.RegisterType((*RegisterUpstreamProposal)(nil), "ibc.proxy.v1.RegisterUpstreamProposal")
+	proto.RegisterType((*DeregisterUpstreamProposal)(nil), "ibc.proxy.v1.DeregisterUpstreamProposal")
+	proto.RegisterType((*UnpauseProxyProposal)(nil), "ibc.proxy.v1.UnpauseProxyProposal")
+	proto.RegisterType((*MigrateProxyClientProposal)(nil), "ibc.proxy.v1.MigrateProxyClientProposal")
+}
+
+func init() { proto.RegisterFile("ibc/modules/proxy/proxy.proto", fileDescriptor_cd60f0f20217e257) }
+
+var fileDescriptor_cd60f0f20217e257 = []byte{
+	// 1772 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcf, 0x6f, 0x23, 0x49,
+	0x15, 0x76, 0x8f, 0x1d, 0xc7, 0x2e, 0x3b, 0x19, 0x6f, 0xc5, 0x49, 0xda, 0x9e, 0x19, 0xb7, 0xe9,
+	0x59, 0xb1, 0x66, 0xd8, 0xb5, 0xc9, 0x00, 0x7b, 0x18, 0x38, 0x90, 0x4e, 0x32, 0x8c, 0xd9, 0xc9,
+	0xd8, 0xea, 0x38, 0x42, 0xac, 0x40, 0xad, 0x76, 0x77, 0xc5, 0x29, 0x62, 0x77, 0x37, 0x5d, 0xed,
+	0xfc, 0x58, 0x09, 0x89, 0x03, 0x12, 0xab, 0xe1, 0xc2, 0x0d, 0x0e, 0x8c, 0x84, 0x84, 0x10, 0x12,
+	0xe2, 0x0f, 0xd9, 0xe3, 0x5e, 0x90, 0x38, 0x79, 0x51, 0x46, 0x1c, 0xb8, 0xfa, 0xcc, 0x01, 0x75,
+	0x55, 0xf5, 0x0f, 0xb7, 0xed, 0x0c, 0x81, 0x3d, 0xec, 0x5e, 0x92, 0xae, 0x7a, 0xdf, 0xfb, 0xde,
+	0xab, 0xaf, 0x5e, 0xbf, 0xaa, 0x36, 0x78, 0x80, 0xfb, 0x46, 0x6b, 0x64, 0x9b, 0xe3, 0x21, 0x22,
+	0x2d, 0xc7, 0xb5, 0x2f, 0xaf, 0xd8, 0xdf, 0xa6, 0xe3, 0xda, 0x9e, 0x0d, 0x8b, 0xb8, 0x6f, 0x34,
+	0xd9, 0xc4, 0xf9, 0x4e, 0xb5, 0x3c, 0xb0, 0x07, 0x36, 0x35, 0xb4, 0xfc, 0x27, 0x86, 0xa9, 0x56,
+	0x06, 0xb6, 0x3d, 0x18, 0xa2, 0x16, 0x1d, 0xf5, 0xc7, 0x27, 0x2d, 0xdd, 0xe2, 0xee, 0xd5, 0x5a,
+	0xd2, 0x64, 0x8e, 0x5d, 0xdd, 0xc3, 0xb6, 0xc5, 0xed, 0x92, 0x1f, 0xdd, 0xb0, 0x5d, 0xd4, 0x32,
+	0x86, 0x18, 0x59, 0x5e, 0xeb, 0x7c, 0x87, 0x3f, 0x71, 0xc0, 0x3b, 0x11, 0xc0, 0xb6, 0x2c, 0x64,
+	0xf8, 0xbe, 0x14, 0x14, 0x8e, 0x38, 0xf0, 0x2b, 0x11, 0xf0, 0x54, 0xb7, 0x2c, 0x34, 0xa4, 0x28,
+	0xf6, 0x78, 0x13, 0x64, 0x80, 0x2c, 0x44, 0x30, 0x59, 0x10, 0x6e, 0x34, 0xc2, 0xde, 0x28, 0xc8,
+	0x29, 0x1c, 0x05, 0x0b, 0x33, 0x6c, 0x32, 0xb2, 0x49, 0xab, 0xaf, 0x13, 0xd4, 0x3a, 0xdf, 0xe9,
+	0x23, 0x4f, 0xf7, 0x51, 0x98, 0xa7, 0x23, 0xff, 0x3b, 0x0d, 0xb2, 0x5d, 0xdd, 0xd5, 0x47, 0x04,
+	0xfe, 0x08, 0xac, 0x8d, 0x1d, 0xe2, 0xb9, 0x48, 0x1f, 0x69, 0x23, 0xdb, 0x44, 0xa2, 0x50, 0x17,
+	0x1a, 0xeb, 0x8f, 0xab, 0xcd, 0xb8, 0xb4, 0xcd, 0x63, 0x0e, 0x39, 0xb4, 0x4d, 0xa4, 0x88, 0xd3,
+	0x89, 0x54, 0xbe, 0xd2, 0x47, 0xc3, 0x27, 0xf2, 0x8c, 0xab, 0xac, 0x16, 0xc7, 0x31, 0x1c, 0xfc,
+	0x09, 0x10, 0xf5, 0xe1, 0xd0, 0xbe, 0x40, 0xa6, 0x16, 0xe2, 0x98, 0x7c, 0x44, 0xbc, 0x53, 0x4f,
+	0x37, 0xf2, 0xca, 0xc3, 0xe9, 0x44, 0x92, 0x18, 0xd3, 0x32, 0xa4, 0xac, 0x6e, 0x71, 0x53, 0x90,
+	0xc3, 0x1e, 0x33, 0xc0, 0x9f, 0x83, 0x6d, 0x47, 0x37, 0xce, 0x90, 0xa7, 0xb9, 0xc8, 0x43, 0x96,
+	0xaf, 0xb6, 0xe6, 0x20, 0x17, 0xdb, 0xa6, 0x98, 0xae, 0x0b, 0x8d, 0xc2, 0xe3, 0x4a, 0x93, 0xed,
+	0x6f, 0x33, 0xd8, 0xdf, 0xe6, 0x3e, 0xdf, 0x5f, 0xe5, 0xd1, 0x27, 0x13, 0x29, 0x35, 0x9d, 0x48,
+	0x35, 0x16, 0x7c, 0x09, 0x8f, 0xfc, 0xbb, 0xcf, 0x24, 0x41, 0xdd, 0x64, 0x56, 0x35, 0x30, 0x76,
+	0xa9, 0x0d, 0xfe, 0x4a, 0x00, 0xf7, 0x46, 0xfa, 0xa5, 0x86, 0x2e, 0x1d, 0x64, 0x78, 0xc8, 0xd4,
+	0x3c, 0x3c, 0x42, 0xbe, 0xa3, 0xd6, 0x1f, 0xda, 0xc6, 0x99, 0x98, 0x79, 0x53, 0x0e, 0x4d, 0x9e,
+	0x83, 0xcc, 0x72, 0xb8, 0x81, 0x8b, 0xe5, 0xb1, 0x3d, 0xd2, 0x2f, 0x0f, 0x38, 0xa0, 0x87, 0x47,
+	0xa8, 0x8b, 0x5c, 0xc5, 0xb7, 0xc2, 0x16, 0xc8, 0x0d, 0xc6, 0xba, 0x6b, 0x62, 0xdd, 0x12, 0x57,
+	0xea, 0x42, 0x23, 0xaf, 0x6c, 0x4c, 0x27, 0xd2, 0x5d, 0x46, 0x1b, 0x58, 0x64, 0x35, 0x04, 0xc9,
+	0xff, 0xbc, 0x03, 0x8a, 0xdf, 0x67, 0x95, 0x75, 0xe4, 0xe9, 0x1e, 0x82, 0x4f, 0x41, 0x3e, 0xd0,
+	0x9d, 0x88, 0x42, 0x3d, 0xdd, 0x28, 0x3c, 0x96, 0x17, 0x17, 0x40, 0xdc, 0x4d, 0xc9, 0xf8, 0x2b,
+	0x50, 0x23, 0x57, 0xf8, 0x18, 0x64, 0x1d, 0x5a, 0x56, 0xe2, 0x1d, 0xba, 0xfa, 0xf2, 0x2c, 0x09,
+	0x2b, 0x39, 0xee, 0xc6, 0x91, 0xf0, 0x12, 0x94, 0xb1, 0x65, 0xf8, 0xd2, 0x9e, 0xe3, 0x8f, 0x90,
+	0xa9, 0x31, 0xb5, 0x89, 0x98, 0xa6, 0x69, 0xd4, 0x67, 0x19, 0xda, 0x31, 0x64, 0x97, 0x02, 0x95,
+	0x87, 0x5c, 0xc6, 0x7b, 0x6c, 0xbd, 0x8b, 0xb8, 0x64, 0x75, 0x03, 0xcf, 0x39, 0x12, 0xa8, 0x81,
+	0x75, 0x47, 0x1f, 0x13, 0x1f, 0xe7, 0xda, 0x97, 0x18, 0x11, 0x31, 0x43, 0x63, 0x56, 0x92, 0x59,
+	0xfb, 0x98, 0xae, 0x3f, 0x54, 0x1e, 0xf0, 0x60, 0x9b, 0x41, 0xdd, 0xc4, 0xdd, 0x65, 0x75, 0xcd,
+	0x09, 0xb1, 0xfe, 0xf8, 0xf7, 0x59, 0x50, 0x5e, 0x24, 0x1c, 0x7c, 0x17, 0xc0, 0x44, 0x9d, 0x6b,
+	0xd8, 0xa4, 0x6f, 0x5e, 0x5e, 0x2d, 0x8d, 0x67, 0xea, 0xbc, 0x6d, 0xc2, 0x23, 0x70, 0x37, 0x44,
+	0x3b, 0x2e, 0x3a, 0xc1, 0x97, 0x5c, 0xde, 0xb7, 0x69, 0xa2, 0x7e, 0x43, 0x68, 0xc6, 0x5a, 0xc0,
+	0xf9, 0x4e, 0xf3, 0x10, 0xb9, 0x67, 0x43, 0xd4, 0xa5, 0x58, 0x2e, 0xf7, 0x7a, 0x40, 0xc1, 0x66,
+	0x61, 0x1b, 0xac, 0x06, 0xef, 0x22, 0x53, 0xfa, 0x6b, 0x31, 0xb2, 0x21, 0xe6, 0x44, 0x6d, 0xd3,
+	0x57, 0xed, 0x04, 0x23, 0x93, 0x65, 0x13, 0xdf, 0xf7, 0xc0, 0x1f, 0xfe, 0x18, 0xbc, 0xc5, 0x1f,
+	0x35, 0xc3, 0xb6, 0x08, 0xb2, 0xc8, 0x38, 0x90, 0x72, 0x21, 0x29, 0xa3, 0xda, 0x0b, 0xa0, 0x94,
+	0x33, 0xa8, 0x8a, 0x12, 0x67, 0x0a, 0xad, 0xb0, 0x07, 0x0a, 0x51, 0x3b, 0x25, 0xe2, 0x0a, 0xe5,
+	0x7d, 0x37, 0xbe, 0xf2, 0xc0, 0x98, 0x48, 0x38, 0x9c, 0xe7, 0xd4, 0x71, 0x1a, 0xf8, 0x0c, 0xe4,
+	0x78, 0x9b, 0x25, 0x62, 0x96, 0x52, 0x7e, 0x35, 0x46, 0xc9, 0x2c, 0x09, 0x3e, 0x36, 0xc9, 0xc9,
+	0x42, 0x6f, 0xf8, 0x0c, 0x14, 0x22, 0xf1, 0x89, 0xb8, 0x1a, 0x2b, 0xdb, 0x24, 0x19, 0x2b, 0xbc,
+	0xb8, 0x86, 0x71, 0x57, 0xa8, 0x82, 0x92, 0x6e, 0x9c, 0x59, 0xf6, 0xc5, 0x10, 0x99, 0x03, 0xc4,
+	0xe8, 0x72, 0xb7, 0xa2, 0x9b, 0xf3, 0x87, 0x0a, 0xc8, 0xb9, 0xc8, 0x40, 0xd8, 0xf1, 0x88, 0x98,
+	0xbf, 0x15, 0x57, 0xe8, 0x07, 0xbb, 0x60, 0xdd, 0x45, 0xc6, 0xb9, 0x46, 0xd0, 0xcf, 0xc6, 0xc8,
+	0x32, 0x10, 0x11, 0x01, 0x65, 0x7a, 0x78, 0x13, 0x13, 0xc7, 0x72, 0xb2, 0x35, 0x9f, 0x20, 0x98,
+	0x23, 0x3f, 0xc8, 0xe4, 0x0a, 0xa5, 0xa2, 0xfc, 0x5b, 0x01, 0x40, 0xfa, 0x5a, 0x45, 0x5b, 0xf5,
+	0xcc, 0x76, 0xbe, 0x00, 0x2f, 0x87, 0xfc, 0x57, 0x01, 0xe4, 0xd9, 0x3a, 0x9e, 0x22, 0xff, 0x1c,
+	0x4b, 0x9f, 0x20, 0xc4, 0xfb, 0x62, 0xa5, 0xc9, 0xce, 0xd6, 0xa6, 0x7f, 0xb6, 0x36, 0xf9, 0xd9,
+	0xda, 0xdc, 0xb3, 0xb1, 0xa5, 0x7c, 0xc3, 0xe7, 0xfa, 0xcb, 0x67, 0x52, 0x63, 0x80, 0xbd, 0xd3,
+	0x71, 0xdf, 0x0f, 0xdb, 0xe2, 0x07, 0x31, 0xfb, 0xf7, 0x1e, 0x31, 0xcf, 0x5a, 0xde, 0x95, 0x83,
+	0x08, 0x75, 0x20, 0xaa, 0xcf, 0x0b, 0xbf, 0xe7, 0xcb, 0x7b, 0x32, 0xb6, 0x4c, 0x4d, 0x37, 0x4d,
+	0x17, 0x11, 0xd6, 0x3c, 0xf3, 0x4a, 0x25, 0xea, 0x33, 0xb3, 0x76, 0x59, 0x5d, 0x63, 0x13, 0xbb,
+	0x7c, 0xdc, 0x07, 0x20, 0xcc, 0x96, 0xbe, 0x30, 0xfc, 0x3c, 0x3b, 0x41, 0x28, 0x68, 0xe7, 0xdb,
+	0xc9, 0x9e, 0xc6, 0xe1, 0x4a, 0x95, 0x77, 0x34, 0x38, 0x73, 0x12, 0xfa, 0x9e, 0xb2, 0x0a, 0x9c,
+	0x90, 0x55, 0xbe, 0x4e, 0x03, 0x38, 0xdf, 0x7d, 0xe1, 0x07, 0xcb, 0x37, 0x4b, 0x79, 0x30, 0x9d,
+	0x48, 0x95, 0xc4, 0x3d, 0x21, 0xc4, 0xc8, 0x0b, 0xf6, 0xf2, 0xeb, 0x60, 0xd5, 0xb1, 0x5d, 0xca,
+	0xc0, 0x24, 0x80, 0xd3, 0x89, 0xb4, 0xce, 0x13, 0x63, 0x06, 0x59, 0xcd, 0xfa, 0x4f, 0x6d, 0x13,
+	0x7e, 0x0b, 0x00, 0x5e, 0x75, 0x1a, 0x66, 0x27, 0x7e, 0x5e, 0xd9, 0x9c, 0x4e, 0xa4, 0xb7, 0x18,
+	0x3e, 0xb2, 0xc9, 0x6a, 0x9e, 0x0f, 0xda, 0x26, 0xac, 0x82, 0x5c, 0x50, 0xc6, 0xf4, 0x84, 0xce,
+	0xa8, 0xe1, 0x38, 0x29, 0xdc, 0xca, 0xe7, 0x22, 0x1c, 0xfc, 0x0e, 0xc8, 0x9b, 0xd8, 0x65, 0xe5,
+	0x2d, 0x66, 0xe9, 0xe5, 0xea, 0xc1, 0x22, 0xce, 0xfd, 0x00, 0xa4, 0x46, 0x78, 0x38, 0x9a, 0xaf,
+	0xee, 0xd5, 0x5b, 0x54, 0x77, 0x8d, 0xe7, 0xb8, 0x95, 0xd8, 0x05, 0x46, 0x25, 0xcf, 0xd5, 0xfd,
+	0xdf, 0x04, 0x50, 0x88, 0x1d, 0x77, 0x5f, 0xba, 0xdd, 0xdd, 0x02, 0x59, 0x82, 0x07, 0x16, 0x72,
+	0xe9, 0xde, 0xe6, 0x55, 0x3e, 0x92, 0xff, 0x24, 0x00, 0x51, 0x45, 0x03, 0x4c, 0x3c, 0xe4, 0x1e,
+	0x87, 0x4b, 0xb6, 0x1d, 0x9b, 0xe8, 0x43, 0x58, 0x06, 0x2b, 0x1e, 0xf6, 0x86, 0x88, 0xb7, 0x18,
+	0x36, 0x80, 0x75, 0x50, 0x30, 0x11, 0x31, 0x5c, 0xec, 0xd0, 0x8d, 0xa3, 0x19, 0xab, 0xf1, 0xa9,
+	0x25, 0xe2, 0xa4, 0xff, 0x27, 0x71, 0x9e, 0x64, 0x3e, 0xfe, 0x83, 0x94, 0x92, 0xff, 0x2c, 0x80,
+	0xea, 0x3e, 0x72, 0xbf, 0x04, 0x99, 0xfe, 0xf2, 0x0e, 0x28, 0x1f, 0x5b, 0xf4, 0xba, 0x43, 0x4b,
+	0xe5, 0x0b, 0x95, 0x63, 0xbc, 0xd4, 0x32, 0xb7, 0x2c, 0xb5, 0x95, 0xff, 0xae, 0xd4, 0xb8, 0x0c,
+	0xff, 0xca, 0x83, 0xea, 0x21, 0x1e, 0xb8, 0xba, 0xc7, 0x64, 0x60, 0x19, 0xfc, 0xdf, 0x62, 0xec,
+	0x80, 0x7c, 0x52, 0x83, 0xf2, 0x74, 0x22, 0x95, 0x78, 0x46, 0xd1, 0xd2, 0x73, 0x46, 0xb0, 0xe4,
+	0xef, 0x82, 0x35, 0x0b, 0x5d, 0xc4, 0xa4, 0x63, 0x0b, 0x8f, 0x7d, 0xab, 0xcd, 0x98, 0x65, 0xb5,
+	0x60, 0xa1, 0x8b, 0x50, 0xb0, 0x9f, 0x82, 0xcd, 0xa4, 0xb2, 0xc4, 0xd3, 0x3d, 0x24, 0xae, 0xf0,
+	0x7b, 0x7c, 0xf2, 0x2b, 0x66, 0xd7, 0xba, 0x52, 0xea, 0xd3, 0x89, 0x74, 0x7f, 0xf1, 0xb6, 0x50,
+	0x67, 0x59, 0xdd, 0x98, 0xdd, 0x19, 0x76, 0xf9, 0x1d, 0x83, 0x8a, 0x9f, 0xca, 0xe2, 0x78, 0xd9,
+	0x1b, 0xe2, 0xbd, 0x3d, 0x9d, 0x48, 0xf5, 0x68, 0x2d, 0x4b, 0x62, 0x6e, 0x59, 0xe8, 0xe2, 0x78,
+	0x41, 0x58, 0x02, 0xc4, 0xc8, 0x23, 0xb8, 0x5d, 0xf2, 0xa8, 0xab, 0x37, 0x44, 0x8d, 0x7d, 0xa3,
+	0x2e, 0xf3, 0x97, 0xd5, 0xad, 0x70, 0xa1, 0x33, 0xb7, 0x5a, 0xf8, 0x0b, 0x01, 0x54, 0x16, 0x78,
+	0x9d, 0x22, 0x3c, 0x38, 0xf5, 0xc4, 0x1c, 0x0d, 0x5b, 0x5d, 0x74, 0x47, 0x7e, 0x46, 0x11, 0x4a,
+	0x83, 0x37, 0xf0, 0xfa, 0xd2, 0x04, 0x18, 0x95, 0xac, 0x6e, 0xcf, 0x65, 0xc0, 0x28, 0x60, 0x0f,
+	0x6c, 0x3a, 0xae, 0x6d, 0x9f, 0x24, 0xf5, 0x12, 0xf3, 0x75, 0xa1, 0x51, 0x8c, 0x6f, 0xe2, 0x42,
+	0x98, 0xac, 0x6e, 0xd0, 0xf9, 0x59, 0x49, 0xfd, 0x6f, 0xfb, 0x24, 0x3c, 0xbc, 0xfa, 0x03, 0x4a,
+	0x1c, 0xd3, 0x6d, 0x19, 0x52, 0x56, 0xb7, 0x66, 0xb9, 0x03, 0x03, 0xfc, 0x10, 0x14, 0x99, 0x13,
+	0x57, 0xaa, 0xf0, 0x46, 0xa5, 0xee, 0x71, 0xa5, 0x36, 0xe2, 0x21, 0x03, 0x71, 0x0a, 0x74, 0xc8,
+	0x05, 0xd1, 0x40, 0x85, 0x59, 0x17, 0x14, 0x91, 0x58, 0xa4, 0xb9, 0xc7, 0x2a, 0x6d, 0x29, 0x34,
+	0x48, 0xfe, 0x45, 0xb2, 0xdc, 0xe0, 0x29, 0xb8, 0xbf, 0xc8, 0x2b, 0xd4, 0x67, 0x8d, 0xc6, 0x78,
+	0x67, 0x3a, 0x91, 0x1e, 0x2e, 0x8f, 0x11, 0x69, 0x54, 0x99, 0x0b, 0x13, 0xca, 0x84, 0x40, 0xc9,
+	0xf7, 0x9a, 0x91, 0x6a, 0xfd, 0x8d, 0x52, 0x49, 0x5c, 0xaa, 0xed, 0xe8, 0x5d, 0x9a, 0x95, 0x6b,
+	0xdd, 0x42, 0x17, 0xdd, 0x48, 0x31, 0xd6, 0xeb, 0x1e, 0x7d, 0x04, 0x8a, 0xf1, 0x9f, 0x81, 0xfc,
+	0x7b, 0xfa, 0x71, 0xf7, 0xa8, 0xa7, 0x1e, 0xec, 0x1e, 0x6a, 0x87, 0x9d, 0xfd, 0x03, 0xad, 0xd3,
+	0x3d, 0x78, 0x51, 0x4a, 0x55, 0xcb, 0x2f, 0x5f, 0xd5, 0x4b, 0x71, 0x64, 0xc7, 0x41, 0x16, 0x7c,
+	0x1f, 0x6c, 0xcf, 0xa2, 0x77, 0x9f, 0x3f, 0xef, 0xfc, 0xf0, 0x79, 0xfb, 0xa8, 0x57, 0x12, 0xaa,
+	0x95, 0x97, 0xaf, 0xea, 0x9b, 0x71, 0x97, 0x5d, 0xff, 0x27, 0x9f, 0x21, 0x26, 0x5e, 0x35, 0xf3,
+	0xf1, 0x1f, 0x6b, 0xa9, 0x47, 0xbf, 0x16, 0xc0, 0xdd, 0xc4, 0x35, 0x09, 0x3e, 0x01, 0xb5, 0xee,
+	0xee, 0xde, 0x07, 0x07, 0x3d, 0x6d, 0xbf, 0xad, 0x1e, 0xec, 0xf5, 0xda, 0x9d, 0x17, 0xda, 0x53,
+	0xb5, 0x73, 0xa8, 0x05, 0x71, 0x4a, 0xa9, 0xea, 0xd6, 0xcb, 0x57, 0x75, 0xc8, 0xef, 0x6c, 0xae,
+	0x3d, 0x0a, 0x42, 0xc0, 0xf7, 0xc1, 0xfd, 0x39, 0xdf, 0x5e, 0x27, 0xf2, 0x14, 0xd8, 0x2a, 0x98,
+	0x67, 0xcf, 0x0e, 0xfc, 0x58, 0x36, 0x4a, 0xe7, 0x93, 0xeb, 0x9a, 0xf0, 0xe9, 0x75, 0x4d, 0xf8,
+	0xc7, 0x75, 0x4d, 0xf8, 0xcd, 0xeb, 0x5a, 0xea, 0xd3, 0xd7, 0xb5, 0xd4, 0xdf, 0x5f, 0xd7, 0x52,
+	0x1f, 0x7e, 0x3b, 0x76, 0xf5, 0x37, 0x75, 0x4f, 0x37, 0x4e, 0x75, 0x6c, 0x0d, 0xf5, 0x7e, 0x0b,
+	0xf7, 0x8d, 0xf7, 0xd8, 0xef, 0x97, 0xb3, 0xbf, 0x66, 0xd2, 0xaf, 0x81, 0x7e, 0x96, 0x76, 0x9c,
+	0x6f, 0xfe, 0x67, 0x00, 0x34, 0x87, 0x99, 0x4f, 0xef, 0x14, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxExpectedTimePerBlock, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxExpectedTimePerBlock):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintProxy(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.PacketRetentionPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.PacketRetentionPeriod):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintProxy(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.AllowedUpstreamClients) > 0 {
+		for iNdEx := len(m.AllowedUpstreamClients) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedUpstreamClients[iNdEx])
+			copy(dAtA[i:], m.AllowedUpstreamClients[iNdEx])
+			i = encodeVarintProxy(dAtA, i, uint64(len(m.AllowedUpstreamClients[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.UpstreamMode != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.UpstreamMode))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PausedProxies) > 0 {
+		for iNdEx := len(m.PausedProxies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PausedProxies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProxy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.IncentivizedPackets) > 0 {
+		for iNdEx := len(m.IncentivizedPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IncentivizedPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProxy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProxy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Upstreams) > 0 {
+		for iNdEx := len(m.Upstreams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Upstreams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProxy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpstreamGenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpstreamGenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpstreamGenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecvSequences) > 0 {
+		for iNdEx := len(m.RecvSequences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecvSequences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProxy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Receipts) > 0 {
+		for iNdEx := len(m.Receipts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Receipts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProxy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Acknowledgements) > 0 {
+		for iNdEx := len(m.Acknowledgements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Acknowledgements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProxy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Commitments) > 0 {
+		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProxy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Channels) > 0 {
+		for iNdEx := len(m.Channels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Channels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProxy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Connections) > 0 {
+		for iNdEx := len(m.Connections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Connections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProxy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ClientsConsensus) > 0 {
+		for iNdEx := len(m.ClientsConsensus) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClientsConsensus[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProxy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Clients) > 0 {
+		for iNdEx := len(m.Clients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Clients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProxy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.UpstreamPrefix.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProxy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.UpstreamClientId) > 0 {
+		i -= len(m.UpstreamClientId)
+		copy(dAtA[i:], m.UpstreamClientId)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.UpstreamClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProxyConnectionHop) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProxyConnectionHop) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProxyConnectionHop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.UpstreamPrefix.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProxy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.UpstreamClientId) > 0 {
+		i -= len(m.UpstreamClientId)
+		copy(dAtA[i:], m.UpstreamClientId)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.UpstreamClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PacketFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RefundAddress) > 0 {
+		i -= len(m.RefundAddress)
+		copy(dAtA[i:], m.RefundAddress)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.RefundAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProxy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PacketFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PacketFees) > 0 {
+		for iNdEx := len(m.PacketFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PacketFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProxy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IncentivizedPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IncentivizedPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IncentivizedPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.UpstreamPrefix.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProxy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.Direction != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.PacketFees) > 0 {
+		for iNdEx := len(m.PacketFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PacketFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProxy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UpstreamClientId) > 0 {
+		i -= len(m.UpstreamClientId)
+		copy(dAtA[i:], m.UpstreamClientId)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.UpstreamClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PausedProxy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PausedProxy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PausedProxy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UpstreamClientId) > 0 {
+		i -= len(m.UpstreamClientId)
+		copy(dAtA[i:], m.UpstreamClientId)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.UpstreamClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterUpstreamProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterUpstreamProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterUpstreamProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpstreamClientId) > 0 {
+		i -= len(m.UpstreamClientId)
+		copy(dAtA[i:], m.UpstreamClientId)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.UpstreamClientId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeregisterUpstreamProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeregisterUpstreamProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeregisterUpstreamProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpstreamClientId) > 0 {
+		i -= len(m.UpstreamClientId)
+		copy(dAtA[i:], m.UpstreamClientId)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.UpstreamClientId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnpauseProxyProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnpauseProxyProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnpauseProxyProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.UpstreamClientId) > 0 {
+		i -= len(m.UpstreamClientId)
+		copy(dAtA[i:], m.UpstreamClientId)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.UpstreamClientId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MigrateProxyClientProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MigrateProxyClientProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MigrateProxyClientProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.NewProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProxy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if len(m.ProofNewUpstreamConsensus) > 0 {
+		i -= len(m.ProofNewUpstreamConsensus)
+		copy(dAtA[i:], m.ProofNewUpstreamConsensus)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.ProofNewUpstreamConsensus)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.ProofNewUpstreamClient) > 0 {
+		i -= len(m.ProofNewUpstreamClient)
+		copy(dAtA[i:], m.ProofNewUpstreamClient)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.ProofNewUpstreamClient)))
+		i--
+		dAtA[i] = 0x62
+	}
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProxy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.ProofUpstreamConsensus) > 0 {
+		i -= len(m.ProofUpstreamConsensus)
+		copy(dAtA[i:], m.ProofUpstreamConsensus)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.ProofUpstreamConsensus)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.ProofUpstreamClient) > 0 {
+		i -= len(m.ProofUpstreamClient)
+		copy(dAtA[i:], m.ProofUpstreamClient)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.ProofUpstreamClient)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	{
+		size, err := m.UpstreamConsensusHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProxy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.UpstreamConsensusState != nil {
+		{
+			size, err := m.UpstreamConsensusState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProxy(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.NewUpstreamClientState != nil {
+		{
+			size, err := m.NewUpstreamClientState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProxy(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.UpstreamClientState != nil {
+		{
+			size, err := m.UpstreamClientState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProxy(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.NewClientId) > 0 {
+		i -= len(m.NewClientId)
+		copy(dAtA[i:], m.NewClientId)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.NewClientId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProxy(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProxy(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpstreamMode != 0 {
+		n += 1 + sovProxy(uint64(m.UpstreamMode))
+	}
+	if len(m.AllowedUpstreamClients) > 0 {
+		for _, s := range m.AllowedUpstreamClients {
+			l = len(s)
+			n += 1 + l + sovProxy(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.PacketRetentionPeriod)
+	n += 1 + l + sovProxy(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxExpectedTimePerBlock)
+	n += 1 + l + sovProxy(uint64(l))
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Upstreams) > 0 {
+		for _, e := range m.Upstreams {
+			l = e.Size()
+			n += 1 + l + sovProxy(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovProxy(uint64(l))
+	if len(m.IncentivizedPackets) > 0 {
+		for _, e := range m.IncentivizedPackets {
+			l = e.Size()
+			n += 1 + l + sovProxy(uint64(l))
+		}
+	}
+	if len(m.PausedProxies) > 0 {
+		for _, e := range m.PausedProxies {
+			l = e.Size()
+			n += 1 + l + sovProxy(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UpstreamGenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UpstreamClientId)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = m.UpstreamPrefix.Size()
+	n += 1 + l + sovProxy(uint64(l))
+	if len(m.Clients) > 0 {
+		for _, e := range m.Clients {
+			l = e.Size()
+			n += 1 + l + sovProxy(uint64(l))
+		}
+	}
+	if len(m.ClientsConsensus) > 0 {
+		for _, e := range m.ClientsConsensus {
+			l = e.Size()
+			n += 1 + l + sovProxy(uint64(l))
+		}
+	}
+	if len(m.Connections) > 0 {
+		for _, e := range m.Connections {
+			l = e.Size()
+			n += 1 + l + sovProxy(uint64(l))
+		}
+	}
+	if len(m.Channels) > 0 {
+		for _, e := range m.Channels {
+			l = e.Size()
+			n += 1 + l + sovProxy(uint64(l))
+		}
+	}
+	if len(m.Commitments) > 0 {
+		for _, e := range m.Commitments {
+			l = e.Size()
+			n += 1 + l + sovProxy(uint64(l))
+		}
+	}
+	if len(m.Acknowledgements) > 0 {
+		for _, e := range m.Acknowledgements {
+			l = e.Size()
+			n += 1 + l + sovProxy(uint64(l))
+		}
+	}
+	if len(m.Receipts) > 0 {
+		for _, e := range m.Receipts {
+			l = e.Size()
+			n += 1 + l + sovProxy(uint64(l))
+		}
+	}
+	if len(m.RecvSequences) > 0 {
+		for _, e := range m.RecvSequences {
+			l = e.Size()
+			n += 1 + l + sovProxy(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProxyConnectionHop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UpstreamClientId)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = m.UpstreamPrefix.Size()
+	n += 1 + l + sovProxy(uint64(l))
+	return n
+}
+
+func (m *PacketFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovProxy(uint64(l))
+		}
+	}
+	l = len(m.RefundAddress)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	return n
+}
+
+func (m *PacketFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PacketFees) > 0 {
+		for _, e := range m.PacketFees {
+			l = e.Size()
+			n += 1 + l + sovProxy(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IncentivizedPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UpstreamClientId)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovProxy(uint64(m.Sequence))
+	}
+	if len(m.PacketFees) > 0 {
+		for _, e := range m.PacketFees {
+			l = e.Size()
+			n += 1 + l + sovProxy(uint64(l))
+		}
+	}
+	if m.Direction != 0 {
+		n += 1 + sovProxy(uint64(m.Direction))
+	}
+	l = m.UpstreamPrefix.Size()
+	n += 1 + l + sovProxy(uint64(l))
+	return n
+}
+
+func (m *PausedProxy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UpstreamClientId)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	return n
+}
+
+func (m *RegisterUpstreamProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.UpstreamClientId)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	return n
+}
+
+func (m *DeregisterUpstreamProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.UpstreamClientId)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	return n
+}
+
+func (m *UnpauseProxyProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.UpstreamClientId)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	return n
+}
+
+func (m *MigrateProxyClientProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.NewClientId)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	if m.UpstreamClientState != nil {
+		l = m.UpstreamClientState.Size()
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	if m.NewUpstreamClientState != nil {
+		l = m.NewUpstreamClientState.Size()
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	if m.UpstreamConsensusState != nil {
+		l = m.UpstreamConsensusState.Size()
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = m.UpstreamConsensusHeight.Size()
+	n += 1 + l + sovProxy(uint64(l))
+	l = len(m.ProofUpstreamClient)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.ProofUpstreamConsensus)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovProxy(uint64(l))
+	l = len(m.ProofNewUpstreamClient)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.ProofNewUpstreamConsensus)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = m.NewProofHeight.Size()
+	n += 1 + l + sovProxy(uint64(l))
+	return n
+}
+
+func sovProxy(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProxy(x uint64) (n int) {
+	return sovProxy(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamMode", wireType)
+			}
+			m.UpstreamMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpstreamMode |= UpstreamMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedUpstreamClients", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedUpstreamClients = append(m.AllowedUpstreamClients, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketRetentionPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.PacketRetentionPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExpectedTimePerBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxExpectedTimePerBlock, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upstreams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Upstreams = append(m.Upstreams, UpstreamGenesisState{})
+			if err := m.Upstreams[len(m.Upstreams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentivizedPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncentivizedPackets = append(m.IncentivizedPackets, IncentivizedPacket{})
+			if err := m.IncentivizedPackets[len(m.IncentivizedPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedProxies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedProxies = append(m.PausedProxies, PausedProxy{})
+			if err := m.PausedProxies[len(m.PausedProxies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpstreamGenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpstreamGenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpstreamGenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpstreamClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamPrefix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpstreamPrefix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Clients = append(m.Clients, types1.IdentifiedClientState{})
+			if err := m.Clients[len(m.Clients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientsConsensus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientsConsensus = append(m.ClientsConsensus, types1.ClientConsensusStates{})
+			if err := m.ClientsConsensus[len(m.ClientsConsensus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Connections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Connections = append(m.Connections, types2.IdentifiedConnection{})
+			if err := m.Connections[len(m.Connections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channels = append(m.Channels, types3.IdentifiedChannel{})
+			if err := m.Channels[len(m.Channels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitments = append(m.Commitments, types3.PacketState{})
+			if err := m.Commitments[len(m.Commitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acknowledgements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Acknowledgements = append(m.Acknowledgements, types3.PacketState{})
+			if err := m.Acknowledgements[len(m.Acknowledgements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receipts = append(m.Receipts, types3.PacketState{})
+			if err := m.Receipts[len(m.Receipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvSequences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecvSequences = append(m.RecvSequences, types3.PacketSequence{})
+			if err := m.RecvSequences[len(m.RecvSequences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProxyConnectionHop) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProxyConnectionHop: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProxyConnectionHop: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpstreamClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamPrefix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpstreamPrefix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types4.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketFees = append(m.PacketFees, PacketFee{})
+			if err := m.PacketFees[len(m.PacketFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IncentivizedPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IncentivizedPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IncentivizedPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpstreamClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketFees = append(m.PacketFees, PacketFee{})
+			if err := m.PacketFees[len(m.PacketFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= PacketDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamPrefix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpstreamPrefix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PausedProxy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PausedProxy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PausedProxy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpstreamClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterUpstreamProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterUpstreamProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterUpstreamProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpstreamClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeregisterUpstreamProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeregisterUpstreamProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeregisterUpstreamProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpstreamClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnpauseProxyProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnpauseProxyProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnpauseProxyProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpstreamClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MigrateProxyClientProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MigrateProxyClientProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MigrateProxyClientProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpstreamClientState == nil {
+				m.UpstreamClientState = &types5.Any{}
+			}
+			if err := m.UpstreamClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewUpstreamClientState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewUpstreamClientState == nil {
+				m.NewUpstreamClientState = &types5.Any{}
+			}
+			if err := m.NewUpstreamClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamConsensusState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpstreamConsensusState == nil {
+				m.UpstreamConsensusState = &types5.Any{}
+			}
+			if err := m.UpstreamConsensusState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamConsensusHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpstreamConsensusHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofUpstreamClient", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofUpstreamClient = append(m.ProofUpstreamClient[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofUpstreamClient == nil {
+				m.ProofUpstreamClient = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofUpstreamConsensus", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofUpstreamConsensus = append(m.ProofUpstreamConsensus[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofUpstreamConsensus == nil {
+				m.ProofUpstreamConsensus = []byte{}
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofNewUpstreamClient", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofNewUpstreamClient = append(m.ProofNewUpstreamClient[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofNewUpstreamClient == nil {
+				m.ProofNewUpstreamClient = []byte{}
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofNewUpstreamConsensus", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofNewUpstreamConsensus = append(m.ProofNewUpstreamConsensus[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofNewUpstreamConsensus == nil {
+				m.ProofNewUpstreamConsensus = []byte{}
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NewProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProxy(dAtA[iNdEx:])
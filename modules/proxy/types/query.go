@@ -0,0 +1,112 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/modules/core/exported"
+)
+
+var (
+	_ codectypes.UnpackInterfacesMessage = QueryProxyClientStateResponse{}
+	_ codectypes.UnpackInterfacesMessage = QueryProxyConsensusStateResponse{}
+)
+
+// NewQueryProxyClientStateResponse creates a new QueryProxyClientStateResponse instance.
+func NewQueryProxyClientStateResponse(
+	clientStateAny *codectypes.Any, proof []byte, height clienttypes.Height,
+) *QueryProxyClientStateResponse {
+	return &QueryProxyClientStateResponse{
+		ClientState: clientStateAny,
+		Proof:       proof,
+		ProofHeight: height,
+	}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (qcsr QueryProxyClientStateResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpacker.UnpackAny(qcsr.ClientState, new(exported.ClientState))
+}
+
+// NewQueryProxyConsensusStateResponse creates a new QueryProxyConsensusStateResponse instance.
+func NewQueryProxyConsensusStateResponse(
+	consensusStateAny *codectypes.Any, proof []byte, height clienttypes.Height,
+) *QueryProxyConsensusStateResponse {
+	return &QueryProxyConsensusStateResponse{
+		ConsensusState: consensusStateAny,
+		Proof:          proof,
+		ProofHeight:    height,
+	}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (qcsr QueryProxyConsensusStateResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpacker.UnpackAny(qcsr.ConsensusState, new(exported.ConsensusState))
+}
+
+// NewQueryProxyConnectionResponse creates a new QueryProxyConnectionResponse instance.
+func NewQueryProxyConnectionResponse(
+	connection connectiontypes.ConnectionEnd, proof []byte, height clienttypes.Height,
+) *QueryProxyConnectionResponse {
+	return &QueryProxyConnectionResponse{
+		Connection:  &connection,
+		Proof:       proof,
+		ProofHeight: height,
+	}
+}
+
+// NewQueryProxyChannelResponse creates a new QueryProxyChannelResponse instance.
+func NewQueryProxyChannelResponse(
+	channel channeltypes.Channel, proof []byte, height clienttypes.Height,
+) *QueryProxyChannelResponse {
+	return &QueryProxyChannelResponse{
+		Channel:     &channel,
+		Proof:       proof,
+		ProofHeight: height,
+	}
+}
+
+// NewQueryProxyPacketCommitmentResponse creates a new QueryProxyPacketCommitmentResponse instance.
+func NewQueryProxyPacketCommitmentResponse(
+	commitment []byte, proof []byte, height clienttypes.Height,
+) *QueryProxyPacketCommitmentResponse {
+	return &QueryProxyPacketCommitmentResponse{
+		Commitment:  commitment,
+		Proof:       proof,
+		ProofHeight: height,
+	}
+}
+
+// NewQueryProxyPacketAcknowledgementResponse creates a new QueryProxyPacketAcknowledgementResponse instance.
+func NewQueryProxyPacketAcknowledgementResponse(
+	acknowledgement []byte, proof []byte, height clienttypes.Height,
+) *QueryProxyPacketAcknowledgementResponse {
+	return &QueryProxyPacketAcknowledgementResponse{
+		Acknowledgement: acknowledgement,
+		Proof:           proof,
+		ProofHeight:     height,
+	}
+}
+
+// NewQueryProxyPacketReceiptAbsenceResponse creates a new QueryProxyPacketReceiptAbsenceResponse instance.
+func NewQueryProxyPacketReceiptAbsenceResponse(
+	absence bool, proof []byte, height clienttypes.Height,
+) *QueryProxyPacketReceiptAbsenceResponse {
+	return &QueryProxyPacketReceiptAbsenceResponse{
+		Absence:     absence,
+		Proof:       proof,
+		ProofHeight: height,
+	}
+}
+
+// NewQueryProxyNextSequenceRecvResponse creates a new QueryProxyNextSequenceRecvResponse instance.
+func NewQueryProxyNextSequenceRecvResponse(
+	sequence uint64, proof []byte, height clienttypes.Height,
+) *QueryProxyNextSequenceRecvResponse {
+	return &QueryProxyNextSequenceRecvResponse{
+		NextSequenceRecv: sequence,
+		Proof:            proof,
+		ProofHeight:      height,
+	}
+}
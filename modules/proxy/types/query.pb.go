@@ -0,0 +1,8081 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/modules/proxy/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	types2 "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	types3 "github.com/cosmos/ibc-go/modules/core/03-connection/types"
+	types4 "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	types "github.com/cosmos/ibc-go/modules/core/23-commitment/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryProxyClientStateRequest is the request type for the Query/ProxyClientState RPC method
+type QueryProxyClientStateRequest struct {
+	// client id corresponding to upstream on proxy
+	UpstreamClientId string `protobuf:"bytes,1,opt,name=upstream_client_id,json=upstreamClientId,proto3" json:"upstream_client_id,omitempty"`
+	// store prefix of the upstream
+	UpstreamPrefix types.MerklePrefix `protobuf:"bytes,2,opt,name=upstream_prefix,json=upstreamPrefix,proto3" json:"upstream_prefix"`
+	// client id corresponding to downstream on upstream
+	ClientId string `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+}
+
+func (m *QueryProxyClientStateRequest) Reset()         { *m = QueryProxyClientStateRequest{} }
+func (m *QueryProxyClientStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProxyClientStateRequest) ProtoMessage()    {}
+func (*QueryProxyClientStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ba836a4b4707e3d, []int{0}
+}
+func (m *QueryProxyClientStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProxyClientStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProxyClientStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProxyClientStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProxyClientStateRequest.Merge(m, src)
+}
+func (m *QueryProxyClientStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProxyClientStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProxyClientStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProxyClientStateRequest proto.InternalMessageInfo
+
+func (m *QueryProxyClientStateRequest) GetUpstreamClientId() string {
+	if m != nil {
+		return m.UpstreamClientId
+	}
+	return ""
+}
+
+func (m *QueryProxyClientStateRequest) GetUpstreamPrefix() types.MerklePrefix {
+	if m != nil {
+		return m.UpstreamPrefix
+	}
+	return types.MerklePrefix{}
+}
+
+func (m *QueryProxyClientStateRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+// QueryProxyClientStateResponse is the response type for the Query/ProxyClientState RPC method.
+type QueryProxyClientStateResponse struct {
+	// client state associated with the request identifiers
+	ClientState *types1.Any `protobuf:"bytes,1,opt,name=client_state,json=clientState,proto3" json:"client_state,omitempty"`
+	// merkle proof of existence
+	Proof []byte `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+	// height at which the proof was retrieved
+	ProofHeight types2.Height `protobuf:"bytes,3,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+}
+
+func (m *QueryProxyClientStateResponse) Reset()         { *m = QueryProxyClientStateResponse{} }
+func (m *QueryProxyClientStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProxyClientStateResponse) ProtoMessage()    {}
+func (*QueryProxyClientStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ba836a4b4707e3d, []int{1}
+}
+func (m *QueryProxyClientStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProxyClientStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProxyClientStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProxyClientStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProxyClientStateResponse.Merge(m, src)
+}
+func (m *QueryProxyClientStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProxyClientStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProxyClientStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProxyClientStateResponse proto.InternalMessageInfo
+
+func (m *QueryProxyClientStateResponse) GetClientState() *types1.Any {
+	if m != nil {
+		return m.ClientState
+	}
+	return nil
+}
+
+func (m *QueryProxyClientStateResponse) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *QueryProxyClientStateResponse) GetProofHeight() types2.Height {
+	if m != nil {
+		return m.ProofHeight
+	}
+	return types2.Height{}
+}
+
+// QueryProxyConsensusStateRequest is the request type for the Query/ProxyConsensusState RPC method
+type QueryProxyConsensusStateRequest struct {
+	// client id corresponding to upstream on proxy
+	UpstreamClientId string `protobuf:"bytes,1,opt,name=upstream_client_id,json=upstreamClientId,proto3" json:"upstream_client_id,omitempty"`
+	// store prefix of the upstream
+	UpstreamPrefix types.MerklePrefix `protobuf:"bytes,2,opt,name=upstream_prefix,json=upstreamPrefix,proto3" json:"upstream_prefix"`
+	// client id corresponding to downstream on upstream
+	ClientId string `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// consensus state revision number
+	RevisionNumber uint64 `protobuf:"varint,4,opt,name=revision_number,json=revisionNumber,proto3" json:"revision_number,omitempty"`
+	// consensus state revision height
+	RevisionHeight uint64 `protobuf:"varint,5,opt,name=revision_height,json=revisionHeight,proto3" json:"revision_height,omitempty"`
+}
+
+func (m *QueryProxyConsensusStateRequest) Reset()         { *m = QueryProxyConsensusStateRequest{} }
+func (m *QueryProxyConsensusStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProxyConsensusStateRequest) ProtoMessage()    {}
+func (*QueryProxyConsensusStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ba836a4b4707e3d, []int{2}
+}
+func (m *QueryProxyConsensusStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProxyConsensusStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProxyConsensusStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProxyConsensusStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProxyConsensusStateRequest.Merge(m, src)
+}
+func (m *QueryProxyConsensusStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProxyConsensusStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProxyConsensusStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProxyConsensusStateRequest proto.InternalMessageInfo
+
+func (m *QueryProxyConsensusStateRequest) GetUpstreamClientId() string {
+	if m != nil {
+		return m.UpstreamClientId
+	}
+	return ""
+}
+
+func (m *QueryProxyConsensusStateRequest) GetUpstreamPrefix() types.MerklePrefix {
+	if m != nil {
+		return m.UpstreamPrefix
+	}
+	return types.MerklePrefix{}
+}
+
+func (m *QueryProxyConsensusStateRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *QueryProxyConsensusStateRequest) GetRevisionNumber() uint64 {
+	if m != nil {
+		return m.RevisionNumber
+	}
+	return 0
+}
+
+func (m *QueryProxyConsensusStateRequest) GetRevisionHeight() uint64 {
+	if m != nil {
+		return m.RevisionHeight
+	}
+	return 0
+}
+
+// QueryProxyConsensusStateResponse is the response type for the Query/ProxyConsensusState RPC method.
+type QueryProxyConsensusStateResponse struct {
+	// consensus state associated with the request identifiers
+	ConsensusState *types1.Any `protobuf:"bytes,1,opt,name=consensus_state,json=consensusState,proto3" json:"consensus_state,omitempty"`
+	// merkle proof of existence
+	Proof []byte `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+	// height at which the proof was retrieved
+	ProofHeight types2.Height `protobuf:"bytes,3,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+}
+
+func (m *QueryProxyConsensusStateResponse) Reset()         { *m = QueryProxyConsensusStateResponse{} }
+func (m *QueryProxyConsensusStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProxyConsensusStateResponse) ProtoMessage()    {}
+func (*QueryProxyConsensusStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ba836a4b4707e3d, []int{3}
+}
+func (m *QueryProxyConsensusStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProxyConsensusStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProxyConsensusStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProxyConsensusStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProxyConsensusStateResponse.Merge(m, src)
+}
+func (m *QueryProxyConsensusStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProxyConsensusStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProxyConsensusStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProxyConsensusStateResponse proto.InternalMessageInfo
+
+func (m *QueryProxyConsensusStateResponse) GetConsensusState() *types1.Any {
+	if m != nil {
+		return m.ConsensusState
+	}
+	return nil
+}
+
+func (m *QueryProxyConsensusStateResponse) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *QueryProxyConsensusStateResponse) GetProofHeight() types2.Height {
+	if m != nil {
+		return m.ProofHeight
+	}
+	return types2.Height{}
+}
+
+// QueryProxyConnectionRequest is the request type for the Query/ProxyConnection RPC method
+type QueryProxyConnectionRequest struct {
+	// client id corresponding to upstream on proxy
+	UpstreamClientId string `protobuf:"bytes,1,opt,name=upstream_client_id,json=upstreamClientId,proto3" json:"upstream_client_id,omitempty"`
+	// store prefix of the upstream
+	UpstreamPrefix types.MerklePrefix `protobuf:"bytes,2,opt,name=upstream_prefix,json=upstreamPrefix,proto3" json:"upstream_prefix"`
+	// connection id on upstream
+	ConnectionId string `protobuf:"bytes,3,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+}
+
+func (m *QueryProxyConnectionRequest) Reset()         { *m = QueryProxyConnectionRequest{} }
+func (m *QueryProxyConnectionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProxyConnectionRequest) ProtoMessage()    {}
+func (*QueryProxyConnectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ba836a4b4707e3d, []int{4}
+}
+func (m *QueryProxyConnectionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProxyConnectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProxyConnectionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProxyConnectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProxyConnectionRequest.Merge(m, src)
+}
+func (m *QueryProxyConnectionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProxyConnectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProxyConnectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProxyConnectionRequest proto.InternalMessageInfo
+
+func (m *QueryProxyConnectionRequest) GetUpstreamClientId() string {
+	if m != nil {
+		return m.UpstreamClientId
+	}
+	return ""
+}
+
+func (m *QueryProxyConnectionRequest) GetUpstreamPrefix() types.MerklePrefix {
+	if m != nil {
+		return m.UpstreamPrefix
+	}
+	return types.MerklePrefix{}
+}
+
+func (m *QueryProxyConnectionRequest) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+// QueryProxyConnectionResponse is the response type for the Query/ProxyConnection RPC method.
+type QueryProxyConnectionResponse struct {
+	// connection associated with the request identifiers
+	Connection *types3.ConnectionEnd `protobuf:"bytes,1,opt,name=connection,proto3" json:"connection,omitempty"`
+	// merkle proof of existence
+	Proof []byte `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+	// height at which the proof was retrieved
+	ProofHeight types2.Height `protobuf:"bytes,3,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+}
+
+func (m *QueryProxyConnectionResponse) Reset()         { *m = QueryProxyConnectionResponse{} }
+func (m *QueryProxyConnectionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProxyConnectionResponse) ProtoMessage()    {}
+func (*QueryProxyConnectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ba836a4b4707e3d, []int{5}
+}
+func (m *QueryProxyConnectionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProxyConnectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProxyConnectionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProxyConnectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProxyConnectionResponse.Merge(m, src)
+}
+func (m *QueryProxyConnectionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProxyConnectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProxyConnectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProxyConnectionResponse proto.InternalMessageInfo
+
+func (m *QueryProxyConnectionResponse) GetConnection() *types3.ConnectionEnd {
+	if m != nil {
+		return m.Connection
+	}
+	return nil
+}
+
+func (m *QueryProxyConnectionResponse) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *QueryProxyConnectionResponse) GetProofHeight() types2.Height {
+	if m != nil {
+		return m.ProofHeight
+	}
+	return types2.Height{}
+}
+
+// QueryProxyChannelRequest is the request type for the Query/ProxyChannel RPC method
+type QueryProxyChannelRequest struct {
+	// client id corresponding to upstream on proxy
+	UpstreamClientId string `protobuf:"bytes,1,opt,name=upstream_client_id,json=upstreamClientId,proto3" json:"upstream_client_id,omitempty"`
+	// store prefix of the upstream
+	UpstreamPrefix types.MerklePrefix `protobuf:"bytes,2,opt,name=upstream_prefix,json=upstreamPrefix,proto3" json:"upstream_prefix"`
+	// port id on upstream
+	PortId string `protobuf:"bytes,3,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel id on upstream
+	ChannelId string `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *QueryProxyChannelRequest) Reset()         { *m = QueryProxyChannelRequest{} }
+func (m *QueryProxyChannelRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProxyChannelRequest) ProtoMessage()    {}
+func (*QueryProxyChannelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ba836a4b4707e3d, []int{6}
+}
+func (m *QueryProxyChannelRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProxyChannelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProxyChannelRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProxyChannelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProxyChannelRequest.Merge(m, src)
+}
+func (m *QueryProxyChannelRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProxyChannelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProxyChannelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProxyChannelRequest proto.InternalMessageInfo
+
+func (m *QueryProxyChannelRequest) GetUpstreamClientId() string {
+	if m != nil {
+		return m.UpstreamClientId
+	}
+	return ""
+}
+
+func (m *QueryProxyChannelRequest) GetUpstreamPrefix() types.MerklePrefix {
+	if m != nil {
+		return m.UpstreamPrefix
+	}
+	return types.MerklePrefix{}
+}
+
+func (m *QueryProxyChannelRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *QueryProxyChannelRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+// QueryProxyChannelResponse is the response type for the Query/ProxyChannel RPC method.
+type QueryProxyChannelResponse struct {
+	// channel associated with the request identifiers
+	Channel *types4.Channel `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// merkle proof of existence
+	Proof []byte `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+	// height at which the proof was retrieved
+	ProofHeight types2.Height `protobuf:"bytes,3,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+}
+
+func (m *QueryProxyChannelResponse) Reset()         { *m = QueryProxyChannelResponse{} }
+func (m *QueryProxyChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProxyChannelResponse) ProtoMessage()    {}
+func (*QueryProxyChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ba836a4b4707e3d, []int{7}
+}
+func (m *QueryProxyChannelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProxyChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProxyChannelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProxyChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProxyChannelResponse.Merge(m, src)
+}
+func (m *QueryProxyChannelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProxyChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProxyChannelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProxyChannelResponse proto.InternalMessageInfo
+
+func (m *QueryProxyChannelResponse) GetChannel() *types4.Channel {
+	if m != nil {
+		return m.Channel
+	}
+	return nil
+}
+
+func (m *QueryProxyChannelResponse) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *QueryProxyChannelResponse) GetProofHeight() types2.Height {
+	if m != nil {
+		return m.ProofHeight
+	}
+	return types2.Height{}
+}
+
+// QueryProxyPacketCommitmentRequest is the request type for the Query/ProxyPacketCommitment RPC method
+type QueryProxyPacketCommitmentRequest struct {
+	// client id corresponding to upstream on proxy
+	UpstreamClientId string `protobuf:"bytes,1,opt,name=upstream_client_id,json=upstreamClientId,proto3" json:"upstream_client_id,omitempty"`
+	// store prefix of the upstream
+	UpstreamPrefix types.MerklePrefix `protobuf:"bytes,2,opt,name=upstream_prefix,json=upstreamPrefix,proto3" json:"upstream_prefix"`
+	// port id on upstream
+	PortId string `protobuf:"bytes,3,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel id on upstream
+	ChannelId string `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// packet sequence
+	Sequence uint64 `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *QueryProxyPacketCommitmentRequest) Reset()         { *m = QueryProxyPacketCommitmentRequest{} }
+func (m *QueryProxyPacketCommitmentRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProxyPacketCommitmentRequest) ProtoMessage()    {}
+func (*QueryProxyPacketCommitmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ba836a4b4707e3d, []int{8}
+}
+func (m *QueryProxyPacketCommitmentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProxyPacketCommitmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProxyPacketCommitmentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProxyPacketCommitmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProxyPacketCommitmentRequest.Merge(m, src)
+}
+func (m *QueryProxyPacketCommitmentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProxyPacketCommitmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProxyPacketCommitmentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProxyPacketCommitmentRequest proto.InternalMessageInfo
+
+func (m *QueryProxyPacketCommitmentRequest) GetUpstreamClientId() string {
+	if m != nil {
+		return m.UpstreamClientId
+	}
+	return ""
+}
+
+func (m *QueryProxyPacketCommitmentRequest) GetUpstreamPrefix() types.MerklePrefix {
+	if m != nil {
+		return m.UpstreamPrefix
+	}
+	return types.MerklePrefix{}
+}
+
+func (m *QueryProxyPacketCommitmentRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *QueryProxyPacketCommitmentRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryProxyPacketCommitmentRequest) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+// QueryProxyPacketCommitmentResponse is the response type for the Query/ProxyPacketCommitment RPC method.
+type QueryProxyPacketCommitmentResponse struct {
+	// packet commitment hash associated with the request identifiers
+	Commitment []byte `protobuf:"bytes,1,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// merkle proof of existence
+	Proof []byte `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+	// height at which the proof was retrieved
+	ProofHeight types2.Height `protobuf:"bytes,3,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+}
+
+func (m *QueryProxyPacketCommitmentResponse) Reset()         { *m = QueryProxyPacketCommitmentResponse{} }
+func (m *QueryProxyPacketCommitmentResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProxyPacketCommitmentResponse) ProtoMessage()    {}
+func (*QueryProxyPacketCommitmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ba836a4b4707e3d, []int{9}
+}
+func (m *QueryProxyPacketCommitmentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProxyPacketCommitmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProxyPacketCommitmentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProxyPacketCommitmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProxyPacketCommitmentResponse.Merge(m, src)
+}
+func (m *QueryProxyPacketCommitmentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProxyPacketCommitmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProxyPacketCommitmentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProxyPacketCommitmentResponse proto.InternalMessageInfo
+
+func (m *QueryProxyPacketCommitmentResponse) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
+}
+
+func (m *QueryProxyPacketCommitmentResponse) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *QueryProxyPacketCommitmentResponse) GetProofHeight() types2.Height {
+	if m != nil {
+		return m.ProofHeight
+	}
+	return types2.Height{}
+}
+
+// QueryProxyPacketAcknowledgementRequest is the request type for the Query/ProxyPacketAcknowledgement RPC method
+type QueryProxyPacketAcknowledgementRequest struct {
+	// client id corresponding to upstream on proxy
+	UpstreamClientId string `protobuf:"bytes,1,opt,name=upstream_client_id,json=upstreamClientId,proto3" json:"upstream_client_id,omitempty"`
+	// store prefix of the upstream
+	UpstreamPrefix types.MerklePrefix `protobuf:"bytes,2,opt,name=upstream_prefix,json=upstreamPrefix,proto3" json:"upstream_prefix"`
+	// port id on upstream
+	PortId string `protobuf:"bytes,3,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel id on upstream
+	ChannelId string `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// packet sequence
+	Sequence uint64 `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *QueryProxyPacketAcknowledgementRequest) Reset() {
+	*m = QueryProxyPacketAcknowledgementRequest{}
+}
+func (m *QueryProxyPacketAcknowledgementRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProxyPacketAcknowledgementRequest) ProtoMessage()    {}
+func (*QueryProxyPacketAcknowledgementRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ba836a4b4707e3d, []int{10}
+}
+func (m *QueryProxyPacketAcknowledgementRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProxyPacketAcknowledgementRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProxyPacketAcknowledgementRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProxyPacketAcknowledgementRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProxyPacketAcknowledgementRequest.Merge(m, src)
+}
+func (m *QueryProxyPacketAcknowledgementRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProxyPacketAcknowledgementRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProxyPacketAcknowledgementRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProxyPacketAcknowledgementRequest proto.InternalMessageInfo
+
+func (m *QueryProxyPacketAcknowledgementRequest) GetUpstreamClientId() string {
+	if m != nil {
+		return m.UpstreamClientId
+	}
+	return ""
+}
+
+func (m *QueryProxyPacketAcknowledgementRequest) GetUpstreamPrefix() types.MerklePrefix {
+	if m != nil {
+		return m.UpstreamPrefix
+	}
+	return types.MerklePrefix{}
+}
+
+func (m *QueryProxyPacketAcknowledgementRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *QueryProxyPacketAcknowledgementRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryProxyPacketAcknowledgementRequest) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+// QueryProxyPacketAcknowledgementResponse is the response type for the Query/ProxyPacketAcknowledgement RPC method.
+type QueryProxyPacketAcknowledgementResponse struct {
+	// packet acknowledgement hash associated with the request identifiers
+	Acknowledgement []byte `protobuf:"bytes,1,opt,name=acknowledgement,proto3" json:"acknowledgement,omitempty"`
+	// merkle proof of existence
+	Proof []byte `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+	// height at which the proof was retrieved
+	ProofHeight types2.Height `protobuf:"bytes,3,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+}
+
+func (m *QueryProxyPacketAcknowledgementResponse) Reset() {
+	*m = QueryProxyPacketAcknowledgementResponse{}
+}
+func (m *QueryProxyPacketAcknowledgementResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProxyPacketAcknowledgementResponse) ProtoMessage()    {}
+func (*QueryProxyPacketAcknowledgementResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ba836a4b4707e3d, []int{11}
+}
+func (m *QueryProxyPacketAcknowledgementResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProxyPacketAcknowledgementResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProxyPacketAcknowledgementResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProxyPacketAcknowledgementResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProxyPacketAcknowledgementResponse.Merge(m, src)
+}
+func (m *QueryProxyPacketAcknowledgementResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProxyPacketAcknowledgementResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProxyPacketAcknowledgementResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProxyPacketAcknowledgementResponse proto.InternalMessageInfo
+
+func (m *QueryProxyPacketAcknowledgementResponse) GetAcknowledgement() []byte {
+	if m != nil {
+		return m.Acknowledgement
+	}
+	return nil
+}
+
+func (m *QueryProxyPacketAcknowledgementResponse) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *QueryProxyPacketAcknowledgementResponse) GetProofHeight() types2.Height {
+	if m != nil {
+		return m.ProofHeight
+	}
+	return types2.Height{}
+}
+
+// QueryProxyPacketReceiptAbsenceRequest is the request type for the Query/ProxyPacketReceiptAbsence RPC method
+type QueryProxyPacketReceiptAbsenceRequest struct {
+	// client id corresponding to upstream on proxy
+	UpstreamClientId string `protobuf:"bytes,1,opt,name=upstream_client_id,json=upstreamClientId,proto3" json:"upstream_client_id,omitempty"`
+	// store prefix of the upstream
+	UpstreamPrefix types.MerklePrefix `protobuf:"bytes,2,opt,name=upstream_prefix,json=upstreamPrefix,proto3" json:"upstream_prefix"`
+	// port id on upstream
+	PortId string `protobuf:"bytes,3,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel id on upstream
+	ChannelId string `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// packet sequence
+	Sequence uint64 `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *QueryProxyPacketReceiptAbsenceRequest) Reset()         { *m = QueryProxyPacketReceiptAbsenceRequest{} }
+func (m *QueryProxyPacketReceiptAbsenceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProxyPacketReceiptAbsenceRequest) ProtoMessage()    {}
+func (*QueryProxyPacketReceiptAbsenceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ba836a4b4707e3d, []int{12}
+}
+func (m *QueryProxyPacketReceiptAbsenceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProxyPacketReceiptAbsenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProxyPacketReceiptAbsenceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProxyPacketReceiptAbsenceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProxyPacketReceiptAbsenceRequest.Merge(m, src)
+}
+func (m *QueryProxyPacketReceiptAbsenceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProxyPacketReceiptAbsenceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProxyPacketReceiptAbsenceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProxyPacketReceiptAbsenceRequest proto.InternalMessageInfo
+
+func (m *QueryProxyPacketReceiptAbsenceRequest) GetUpstreamClientId() string {
+	if m != nil {
+		return m.UpstreamClientId
+	}
+	return ""
+}
+
+func (m *QueryProxyPacketReceiptAbsenceRequest) GetUpstreamPrefix() types.MerklePrefix {
+	if m != nil {
+		return m.UpstreamPrefix
+	}
+	return types.MerklePrefix{}
+}
+
+func (m *QueryProxyPacketReceiptAbsenceRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *QueryProxyPacketReceiptAbsenceRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryProxyPacketReceiptAbsenceRequest) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+// QueryProxyPacketReceiptAbsenceResponse is the response type for the Query/ProxyPacketReceiptAbsence RPC method.
+type QueryProxyPacketReceiptAbsenceResponse struct {
+	// true if the proxy has verified that the upstream has no receipt for the packet
+	Absence bool `protobuf:"varint,1,opt,name=absence,proto3" json:"absence,omitempty"`
+	// merkle proof of existence
+	Proof []byte `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+	// height at which the proof was retrieved
+	ProofHeight types2.Height `protobuf:"bytes,3,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+}
+
+func (m *QueryProxyPacketReceiptAbsenceResponse) Reset() {
+	*m = QueryProxyPacketReceiptAbsenceResponse{}
+}
+func (m *QueryProxyPacketReceiptAbsenceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProxyPacketReceiptAbsenceResponse) ProtoMessage()    {}
+func (*QueryProxyPacketReceiptAbsenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ba836a4b4707e3d, []int{13}
+}
+func (m *QueryProxyPacketReceiptAbsenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProxyPacketReceiptAbsenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProxyPacketReceiptAbsenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProxyPacketReceiptAbsenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProxyPacketReceiptAbsenceResponse.Merge(m, src)
+}
+func (m *QueryProxyPacketReceiptAbsenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProxyPacketReceiptAbsenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProxyPacketReceiptAbsenceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProxyPacketReceiptAbsenceResponse proto.InternalMessageInfo
+
+func (m *QueryProxyPacketReceiptAbsenceResponse) GetAbsence() bool {
+	if m != nil {
+		return m.Absence
+	}
+	return false
+}
+
+func (m *QueryProxyPacketReceiptAbsenceResponse) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *QueryProxyPacketReceiptAbsenceResponse) GetProofHeight() types2.Height {
+	if m != nil {
+		return m.ProofHeight
+	}
+	return types2.Height{}
+}
+
+// QueryProxyNextSequenceRecvRequest is the request type for the Query/ProxyNextSequenceRecv RPC method
+type QueryProxyNextSequenceRecvRequest struct {
+	// client id corresponding to upstream on proxy
+	UpstreamClientId string `protobuf:"bytes,1,opt,name=upstream_client_id,json=upstreamClientId,proto3" json:"upstream_client_id,omitempty"`
+	// store prefix of the upstream
+	UpstreamPrefix types.MerklePrefix `protobuf:"bytes,2,opt,name=upstream_prefix,json=upstreamPrefix,proto3" json:"upstream_prefix"`
+	// port id on upstream
+	PortId string `protobuf:"bytes,3,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel id on upstream
+	ChannelId string `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *QueryProxyNextSequenceRecvRequest) Reset()         { *m = QueryProxyNextSequenceRecvRequest{} }
+func (m *QueryProxyNextSequenceRecvRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProxyNextSequenceRecvRequest) ProtoMessage()    {}
+func (*QueryProxyNextSequenceRecvRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ba836a4b4707e3d, []int{14}
+}
+func (m *QueryProxyNextSequenceRecvRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProxyNextSequenceRecvRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProxyNextSequenceRecvRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProxyNextSequenceRecvRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProxyNextSequenceRecvRequest.Merge(m, src)
+}
+func (m *QueryProxyNextSequenceRecvRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProxyNextSequenceRecvRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProxyNextSequenceRecvRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProxyNextSequenceRecvRequest proto.InternalMessageInfo
+
+func (m *QueryProxyNextSequenceRecvRequest) GetUpstreamClientId() string {
+	if m != nil {
+		return m.UpstreamClientId
+	}
+	return ""
+}
+
+func (m *QueryProxyNextSequenceRecvRequest) GetUpstreamPrefix() types.MerklePrefix {
+	if m != nil {
+		return m.UpstreamPrefix
+	}
+	return types.MerklePrefix{}
+}
+
+func (m *QueryProxyNextSequenceRecvRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *QueryProxyNextSequenceRecvRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+// QueryProxyNextSequenceRecvResponse is the response type for the Query/ProxyNextSequenceRecv RPC method.
+type QueryProxyNextSequenceRecvResponse struct {
+	// next sequence receive number
+	NextSequenceRecv uint64 `protobuf:"varint,1,opt,name=next_sequence_recv,json=nextSequenceRecv,proto3" json:"next_sequence_recv,omitempty"`
+	// merkle proof of existence
+	Proof []byte `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+	// height at which the proof was retrieved
+	ProofHeight types2.Height `protobuf:"bytes,3,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+}
+
+func (m *QueryProxyNextSequenceRecvResponse) Reset()         { *m = QueryProxyNextSequenceRecvResponse{} }
+func (m *QueryProxyNextSequenceRecvResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProxyNextSequenceRecvResponse) ProtoMessage()    {}
+func (*QueryProxyNextSequenceRecvResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ba836a4b4707e3d, []int{15}
+}
+func (m *QueryProxyNextSequenceRecvResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProxyNextSequenceRecvResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProxyNextSequenceRecvResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProxyNextSequenceRecvResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProxyNextSequenceRecvResponse.Merge(m, src)
+}
+func (m *QueryProxyNextSequenceRecvResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProxyNextSequenceRecvResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProxyNextSequenceRecvResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProxyNextSequenceRecvResponse proto.InternalMessageInfo
+
+func (m *QueryProxyNextSequenceRecvResponse) GetNextSequenceRecv() uint64 {
+	if m != nil {
+		return m.NextSequenceRecv
+	}
+	return 0
+}
+
+func (m *QueryProxyNextSequenceRecvResponse) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *QueryProxyNextSequenceRecvResponse) GetProofHeight() types2.Height {
+	if m != nil {
+		return m.ProofHeight
+	}
+	return types2.Height{}
+}
+
+// QueryProxyPrunablePacketsRequest is the request type for the Query/ProxyPrunablePackets RPC method
+type QueryProxyPrunablePacketsRequest struct {
+	// client id corresponding to upstream on proxy
+	UpstreamClientId string `protobuf:"bytes,1,opt,name=upstream_client_id,json=upstreamClientId,proto3" json:"upstream_client_id,omitempty"`
+	// store prefix of the upstream
+	UpstreamPrefix types.MerklePrefix `protobuf:"bytes,2,opt,name=upstream_prefix,json=upstreamPrefix,proto3" json:"upstream_prefix"`
+}
+
+func (m *QueryProxyPrunablePacketsRequest) Reset()         { *m = QueryProxyPrunablePacketsRequest{} }
+func (m *QueryProxyPrunablePacketsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProxyPrunablePacketsRequest) ProtoMessage()    {}
+func (*QueryProxyPrunablePacketsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ba836a4b4707e3d, []int{16}
+}
+func (m *QueryProxyPrunablePacketsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProxyPrunablePacketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProxyPrunablePacketsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProxyPrunablePacketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProxyPrunablePacketsRequest.Merge(m, src)
+}
+func (m *QueryProxyPrunablePacketsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProxyPrunablePacketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProxyPrunablePacketsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProxyPrunablePacketsRequest proto.InternalMessageInfo
+
+func (m *QueryProxyPrunablePacketsRequest) GetUpstreamClientId() string {
+	if m != nil {
+		return m.UpstreamClientId
+	}
+	return ""
+}
+
+func (m *QueryProxyPrunablePacketsRequest) GetUpstreamPrefix() types.MerklePrefix {
+	if m != nil {
+		return m.UpstreamPrefix
+	}
+	return types.MerklePrefix{}
+}
+
+// QueryProxyPrunablePacketsResponse is the response type for the Query/ProxyPrunablePackets RPC method.
+// The packet commitments are not counted as they are pruned by MsgPruneProxyPacketCommitment.
+type QueryProxyPrunablePacketsResponse struct {
+	// number of prunable packet acknowledgements
+	Acknowledgements uint64 `protobuf:"varint,2,opt,name=acknowledgements,proto3" json:"acknowledgements,omitempty"`
+}
+
+func (m *QueryProxyPrunablePacketsResponse) Reset()         { *m = QueryProxyPrunablePacketsResponse{} }
+func (m *QueryProxyPrunablePacketsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProxyPrunablePacketsResponse) ProtoMessage()    {}
+func (*QueryProxyPrunablePacketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ba836a4b4707e3d, []int{17}
+}
+func (m *QueryProxyPrunablePacketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProxyPrunablePacketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProxyPrunablePacketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProxyPrunablePacketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProxyPrunablePacketsResponse.Merge(m, src)
+}
+func (m *QueryProxyPrunablePacketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProxyPrunablePacketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProxyPrunablePacketsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProxyPrunablePacketsResponse proto.InternalMessageInfo
+
+func (m *QueryProxyPrunablePacketsResponse) GetAcknowledgements() uint64 {
+	if m != nil {
+		return m.Acknowledgements
+	}
+	return 0
+}
+
+// QueryIncentivizedPacketsRequest is the request type for the Query/IncentivizedPackets RPC method
+type QueryIncentivizedPacketsRequest struct {
+	// client id corresponding to upstream on proxy. If empty, the packets of all the upstreams are returned.
+	UpstreamClientId string `protobuf:"bytes,1,opt,name=upstream_client_id,json=upstreamClientId,proto3" json:"upstream_client_id,omitempty"`
+	// pagination request
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryIncentivizedPacketsRequest) Reset()         { *m = QueryIncentivizedPacketsRequest{} }
+func (m *QueryIncentivizedPacketsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIncentivizedPacketsRequest) ProtoMessage()    {}
+func (*QueryIncentivizedPacketsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ba836a4b4707e3d, []int{18}
+}
+func (m *QueryIncentivizedPacketsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIncentivizedPacketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIncentivizedPacketsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIncentivizedPacketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIncentivizedPacketsRequest.Merge(m, src)
+}
+func (m *QueryIncentivizedPacketsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIncentivizedPacketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIncentivizedPacketsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIncentivizedPacketsRequest proto.InternalMessageInfo
+
+func (m *QueryIncentivizedPacketsRequest) GetUpstreamClientId() string {
+	if m != nil {
+		return m.UpstreamClientId
+	}
+	return ""
+}
+
+func (m *QueryIncentivizedPacketsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryIncentivizedPacketsResponse is the response type for the Query/IncentivizedPackets RPC method.
+type QueryIncentivizedPacketsResponse struct {
+	// packets that have fees escrowed
+	IncentivizedPackets []IncentivizedPacket `protobuf:"bytes,1,rep,name=incentivized_packets,json=incentivizedPackets,proto3" json:"incentivized_packets"`
+	// pagination response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryIncentivizedPacketsResponse) Reset()         { *m = QueryIncentivizedPacketsResponse{} }
+func (m *QueryIncentivizedPacketsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIncentivizedPacketsResponse) ProtoMessage()    {}
+func (*QueryIncentivizedPacketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ba836a4b4707e3d, []int{19}
+}
+func (m *QueryIncentivizedPacketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIncentivizedPacketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIncentivizedPacketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIncentivizedPacketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIncentivizedPacketsResponse.Merge(m, src)
+}
+func (m *QueryIncentivizedPacketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIncentivizedPacketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIncentivizedPacketsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIncentivizedPacketsResponse proto.InternalMessageInfo
+
+func (m *QueryIncentivizedPacketsResponse) GetIncentivizedPackets() []IncentivizedPacket {
+	if m != nil {
+		return m.IncentivizedPackets
+	}
+	return nil
+}
+
+func (m *QueryIncentivizedPacketsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryIncentivizedPacketRequest is the request type for the Query/IncentivizedPacket RPC method
+type QueryIncentivizedPacketRequest struct {
+	// client id corresponding to upstream on proxy
+	UpstreamClientId string `protobuf:"bytes,1,opt,name=upstream_client_id,json=upstreamClientId,proto3" json:"upstream_client_id,omitempty"`
+	// port id of the packet on the upstream side
+	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel id of the packet on the upstream side
+	ChannelId string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// packet sequence
+	Sequence uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// direction of the packet relative to the upstream
+	Direction PacketDirection `protobuf:"varint,5,opt,name=direction,proto3,enum=ibc.proxy.v1.PacketDirection" json:"direction,omitempty"`
+	// store prefix of the upstream
+	UpstreamPrefix types.MerklePrefix `protobuf:"bytes,6,opt,name=upstream_prefix,json=upstreamPrefix,proto3" json:"upstream_prefix"`
+}
+
+func (m *QueryIncentivizedPacketRequest) Reset()         { *m = QueryIncentivizedPacketRequest{} }
+func (m *QueryIncentivizedPacketRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIncentivizedPacketRequest) ProtoMessage()    {}
+func (*QueryIncentivizedPacketRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ba836a4b4707e3d, []int{20}
+}
+func (m *QueryIncentivizedPacketRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIncentivizedPacketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIncentivizedPacketRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIncentivizedPacketRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIncentivizedPacketRequest.Merge(m, src)
+}
+func (m *QueryIncentivizedPacketRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIncentivizedPacketRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIncentivizedPacketRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIncentivizedPacketRequest proto.InternalMessageInfo
+
+func (m *QueryIncentivizedPacketRequest) GetUpstreamClientId() string {
+	if m != nil {
+		return m.UpstreamClientId
+	}
+	return ""
+}
+
+func (m *QueryIncentivizedPacketRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *QueryIncentivizedPacketRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryIncentivizedPacketRequest) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *QueryIncentivizedPacketRequest) GetDirection() PacketDirection {
+	if m != nil {
+		return m.Direction
+	}
+	return PacketFromUpstream
+}
+
+func (m *QueryIncentivizedPacketRequest) GetUpstreamPrefix() types.MerklePrefix {
+	if m != nil {
+		return m.UpstreamPrefix
+	}
+	return types.MerklePrefix{}
+}
+
+// QueryIncentivizedPacketResponse is the response type for the Query/IncentivizedPacket RPC method.
+type QueryIncentivizedPacketResponse struct {
+	// packet with the fees escrowed for it
+	IncentivizedPacket IncentivizedPacket `protobuf:"bytes,1,opt,name=incentivized_packet,json=incentivizedPacket,proto3" json:"incentivized_packet"`
+}
+
+func (m *QueryIncentivizedPacketResponse) Reset()         { *m = QueryIncentivizedPacketResponse{} }
+func (m *QueryIncentivizedPacketResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIncentivizedPacketResponse) ProtoMessage()    {}
+func (*QueryIncentivizedPacketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ba836a4b4707e3d, []int{21}
+}
+func (m *QueryIncentivizedPacketResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIncentivizedPacketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIncentivizedPacketResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIncentivizedPacketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIncentivizedPacketResponse.Merge(m, src)
+}
+func (m *QueryIncentivizedPacketResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIncentivizedPacketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIncentivizedPacketResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIncentivizedPacketResponse proto.InternalMessageInfo
+
+func (m *QueryIncentivizedPacketResponse) GetIncentivizedPacket() IncentivizedPacket {
+	if m != nil {
+		return m.IncentivizedPacket
+	}
+	return IncentivizedPacket{}
+}
+
+// QueryPausedProxiesRequest is the request type for the Query/PausedProxies RPC method
+type QueryPausedProxiesRequest struct {
+	// pagination request
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPausedProxiesRequest) Reset()         { *m = QueryPausedProxiesRequest{} }
+func (m *QueryPausedProxiesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPausedProxiesRequest) ProtoMessage()    {}
+func (*QueryPausedProxiesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ba836a4b4707e3d, []int{22}
+}
+func (m *QueryPausedProxiesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPausedProxiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPausedProxiesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPausedProxiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPausedProxiesRequest.Merge(m, src)
+}
+func (m *QueryPausedProxiesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPausedProxiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPausedProxiesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPausedProxiesRequest proto.InternalMessageInfo
+
+func (m *QueryPausedProxiesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPausedProxiesResponse is the response type for the Query/PausedProxies RPC method.
+type QueryPausedProxiesResponse struct {
+	// upstream clients and channels for which proxying is paused
+	PausedProxies []PausedProxy `protobuf:"bytes,1,rep,name=paused_proxies,json=pausedProxies,proto3" json:"paused_proxies"`
+	// pagination response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPausedProxiesResponse) Reset()         { *m = QueryPausedProxiesResponse{} }
+func (m *QueryPausedProxiesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPausedProxiesResponse) ProtoMessage()    {}
+func (*QueryPausedProxiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ba836a4b4707e3d, []int{23}
+}
+func (m *QueryPausedProxiesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPausedProxiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPausedProxiesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPausedProxiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPausedProxiesResponse.Merge(m, src)
+}
+func (m *QueryPausedProxiesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPausedProxiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPausedProxiesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPausedProxiesResponse proto.InternalMessageInfo
+
+func (m *QueryPausedProxiesResponse) GetPausedProxies() []PausedProxy {
+	if m != nil {
+		return m.PausedProxies
+	}
+	return nil
+}
+
+func (m *QueryPausedProxiesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryUpstreamChainRequest is the request type for the Query/UpstreamChain RPC method
+type QueryUpstreamChainRequest struct {
+	// client id of the proxy client on the downstream
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+}
+
+func (m *QueryUpstreamChainRequest) Reset()         { *m = QueryUpstreamChainRequest{} }
+func (m *QueryUpstreamChainRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUpstreamChainRequest) ProtoMessage()    {}
+func (*QueryUpstreamChainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ba836a4b4707e3d, []int{24}
+}
+func (m *QueryUpstreamChainRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpstreamChainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpstreamChainRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpstreamChainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpstreamChainRequest.Merge(m, src)
+}
+func (m *QueryUpstreamChainRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpstreamChainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpstreamChainRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpstreamChainRequest proto.InternalMessageInfo
+
+func (m *QueryUpstreamChainRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+// QueryUpstreamChainResponse is the response type for the Query/UpstreamChain RPC method.
+type QueryUpstreamChainResponse struct {
+	// chain id of the proxy. It is empty if the type of the proxy client has no chain id.
+	ProxyChainId string `protobuf:"bytes,1,opt,name=proxy_chain_id,json=proxyChainId,proto3" json:"proxy_chain_id,omitempty"`
+	// client id corresponding to upstream on proxy
+	UpstreamClientId string `protobuf:"bytes,2,opt,name=upstream_client_id,json=upstreamClientId,proto3" json:"upstream_client_id,omitempty"`
+	// chain id of the upstream chain that the upstream client tracks.
+	// It is empty if the proxy client was created without the binding to the upstream chain.
+	UpstreamChainId string `protobuf:"bytes,3,opt,name=upstream_chain_id,json=upstreamChainId,proto3" json:"upstream_chain_id,omitempty"`
+	// client type of the upstream client. Any client type is accepted if it is empty.
+	UpstreamClientType string `protobuf:"bytes,4,opt,name=upstream_client_type,json=upstreamClientType,proto3" json:"upstream_client_type,omitempty"`
+}
+
+func (m *QueryUpstreamChainResponse) Reset()         { *m = QueryUpstreamChainResponse{} }
+func (m *QueryUpstreamChainResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUpstreamChainResponse) ProtoMessage()    {}
+func (*QueryUpstreamChainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ba836a4b4707e3d, []int{25}
+}
+func (m *QueryUpstreamChainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpstreamChainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpstreamChainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpstreamChainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpstreamChainResponse.Merge(m, src)
+}
+func (m *QueryUpstreamChainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpstreamChainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpstreamChainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpstreamChainResponse proto.InternalMessageInfo
+
+func (m *QueryUpstreamChainResponse) GetProxyChainId() string {
+	if m != nil {
+		return m.ProxyChainId
+	}
+	return ""
+}
+
+func (m *QueryUpstreamChainResponse) GetUpstreamClientId() string {
+	if m != nil {
+		return m.UpstreamClientId
+	}
+	return ""
+}
+
+func (m *QueryUpstreamChainResponse) GetUpstreamChainId() string {
+	if m != nil {
+		return m.UpstreamChainId
+	}
+	return ""
+}
+
+func (m *QueryUpstreamChainResponse) GetUpstreamClientType() string {
+	if m != nil {
+		return m.UpstreamClientType
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*QueryProxyClientStateRequest)(nil), "ibc.proxy.v1.QueryProxyClientStateRequest")
+	proto.RegisterType((*QueryProxyClientStateResponse)(nil), "ibc.proxy.v1.QueryProxyClientStateResponse")
+	proto.RegisterType((*QueryProxyConsensusStateRequest)(nil), "ibc.proxy.v1.QueryProxyConsensusStateRequest")
+	proto.RegisterType((*QueryProxyConsensusStateResponse)(nil), "ibc.proxy.v1.QueryProxyConsensusStateResponse")
+	proto.RegisterType((*QueryProxyConnectionRequest)(nil), "ibc.proxy.v1.QueryProxyConnectionRequest")
+	proto.RegisterType((*QueryProxyConnectionResponse)(nil), "ibc.proxy.v1.QueryProxyConnectionResponse")
+	proto.RegisterType((*QueryProxyChannelRequest)(nil), "ibc.proxy.v1.QueryProxyChannelRequest")
+	proto.RegisterType((*QueryProxyChannelResponse)(nil), "ibc.proxy.v1.QueryProxyChannelResponse")
+	proto.RegisterType((*QueryProxyPacketCommitmentRequest)(nil), "ibc.proxy.v1.QueryProxyPacketCommitmentRequest")
+	proto.RegisterType((*QueryProxyPacketCommitmentResponse)(nil), "ibc.proxy.v1.QueryProxyPacketCommitmentResponse")
+	proto.RegisterType((*QueryProxyPacketAcknowledgementRequest)(nil), "ibc.proxy.v1.QueryProxyPacketAcknowledgementRequest")
+	proto.RegisterType((*QueryProxyPacketAcknowledgementResponse)(nil), "ibc.proxy.v1.QueryProxyPacketAcknowledgementResponse")
+	proto.RegisterType((*QueryProxyPacketReceiptAbsenceRequest)(nil), "ibc.proxy.v1.QueryProxyPacketReceiptAbsenceRequest")
+	proto.RegisterType((*QueryProxyPacketReceiptAbsenceResponse)(nil), "ibc.proxy.v1.QueryProxyPacketReceiptAbsenceResponse")
+	proto.RegisterType((*QueryProxyNextSequenceRecvRequest)(nil), "ibc.proxy.v1.QueryProxyNextSequenceRecvRequest")
+	proto.RegisterType((*QueryProxyNextSequenceRecvResponse)(nil), "ibc.proxy.v1.QueryProxyNextSequenceRecvResponse")
+	proto.RegisterType((*QueryProxyPrunablePacketsRequest)(nil), "ibc.proxy.v1.QueryProxyPrunablePacketsRequest")
+	proto.RegisterType((*QueryProxyPrunablePacketsResponse)(nil), "ibc.proxy.v1.QueryProxyPrunablePacketsResponse")
+	proto.RegisterType((*QueryIncentivizedPacketsRequest)(nil), "ibc.proxy.v1.QueryIncentivizedPacketsRequest")
+	proto.RegisterType((*QueryIncentivizedPacketsResponse)(nil), "ibc.proxy.v1.QueryIncentivizedPacketsResponse")
+	proto.RegisterType((*QueryIncentivizedPacketRequest)(nil), "ibc.proxy.v1.QueryIncentivizedPacketRequest")
+	proto.RegisterType((*QueryIncentivizedPacketResponse)(nil), "ibc.proxy.v1.QueryIncentivizedPacketResponse")
+	proto.RegisterType((*QueryPausedProxiesRequest)(nil), "ibc.proxy.v1.QueryPausedProxiesRequest")
+	proto.RegisterType((*QueryPausedProxiesResponse)(nil), "ibc.proxy.v1.QueryPausedProxiesResponse")
+	proto.RegisterType((*QueryUpstreamChainRequest)(nil), "ibc.proxy.v1.QueryUpstreamChainRequest")
+	proto.RegisterType((*QueryUpstreamChainResponse)(nil), "ibc.proxy.v1.QueryUpstreamChainResponse")
+}
+
+func init() { proto.RegisterFile("ibc/modules/proxy/query.proto", fileDescriptor_9ba836a4b4707e3d) }
+
+var fileDescriptor_9ba836a4b4707e3d = []byte{
+	// 1716 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0xcf, 0x6f, 0x13, 0x47,
+	0x1b, 0xce, 0x18, 0x03, 0xc9, 0xc4, 0xf9, 0xf1, 0x4d, 0x82, 0xbe, 0x64, 0x49, 0x4c, 0xf0, 0x17,
+	0x48, 0xbe, 0x7c, 0xb0, 0x9b, 0x04, 0xf8, 0x8a, 0x54, 0xa1, 0x0a, 0x28, 0x94, 0x20, 0x01, 0xe9,
+	0x02, 0xaa, 0xca, 0xc5, 0x5d, 0xaf, 0x07, 0x67, 0x15, 0x67, 0x77, 0xd9, 0x5d, 0x9b, 0x84, 0xc8,
+	0x97, 0xb6, 0xa8, 0x3d, 0x55, 0x95, 0x38, 0xf4, 0xd2, 0xaa, 0xbd, 0xf4, 0x08, 0x97, 0xf6, 0xd4,
+	0x22, 0x54, 0x55, 0x6a, 0xc5, 0xa9, 0x42, 0xaa, 0x2a, 0xf5, 0x84, 0x2a, 0xe0, 0x4f, 0xa8, 0x54,
+	0xa9, 0xaa, 0xda, 0x6a, 0x67, 0xde, 0xf5, 0xee, 0xd8, 0xbb, 0x8e, 0x03, 0x58, 0x02, 0x7a, 0x89,
+	0xbc, 0x33, 0xef, 0xbc, 0xf3, 0x3c, 0xef, 0x33, 0xbf, 0xde, 0x37, 0x78, 0xdc, 0x28, 0xe8, 0xca,
+	0x8a, 0x55, 0xac, 0x94, 0xa9, 0xab, 0xd8, 0x8e, 0xb5, 0xba, 0xa6, 0x5c, 0xa9, 0x50, 0x67, 0x4d,
+	0xb6, 0x1d, 0xcb, 0xb3, 0x48, 0xc6, 0x28, 0xe8, 0x32, 0x6b, 0x96, 0xab, 0x73, 0xd2, 0x70, 0xc9,
+	0x2a, 0x59, 0xac, 0x43, 0xf1, 0x7f, 0x71, 0x1b, 0x69, 0xac, 0x64, 0x59, 0xa5, 0x32, 0x55, 0x34,
+	0xdb, 0x50, 0x34, 0xd3, 0xb4, 0x3c, 0xcd, 0x33, 0x2c, 0xd3, 0x85, 0xde, 0x51, 0xe8, 0x65, 0x5f,
+	0x85, 0xca, 0x65, 0x45, 0x33, 0xc1, 0xb9, 0xb4, 0xcb, 0x9f, 0x5b, 0xb7, 0x1c, 0xaa, 0xe8, 0x65,
+	0x83, 0x9a, 0x9e, 0x52, 0x9d, 0x83, 0x5f, 0x60, 0x30, 0x15, 0x1a, 0x58, 0xa6, 0x49, 0x75, 0xdf,
+	0x2f, 0x33, 0xaa, 0x7f, 0x81, 0xe1, 0xee, 0xd0, 0x70, 0x49, 0x33, 0x4d, 0x5a, 0x66, 0x56, 0xfc,
+	0x67, 0x8c, 0xaf, 0x95, 0x15, 0xc3, 0x5b, 0x09, 0x26, 0xac, 0x7f, 0x81, 0xe1, 0x8c, 0x6e, 0xb9,
+	0x2b, 0x96, 0xab, 0x14, 0x34, 0x97, 0xf2, 0x58, 0x28, 0xd5, 0xb9, 0x02, 0xf5, 0xb4, 0x39, 0xc5,
+	0xd6, 0x4a, 0x86, 0xa9, 0x45, 0xe6, 0x8d, 0x89, 0x1e, 0xfb, 0xcb, 0xbb, 0x73, 0x77, 0x10, 0x1e,
+	0x7b, 0xdd, 0xf7, 0xb0, 0xe8, 0x37, 0x1e, 0x67, 0xd4, 0xce, 0x7b, 0x9a, 0x47, 0x55, 0x7a, 0xa5,
+	0x42, 0x5d, 0x8f, 0xec, 0xc3, 0xa4, 0x62, 0xbb, 0x9e, 0x43, 0xb5, 0x95, 0x3c, 0x67, 0x9e, 0x37,
+	0x8a, 0x23, 0x68, 0x02, 0x4d, 0xf7, 0xa8, 0x83, 0x41, 0x0f, 0x1f, 0xb7, 0x50, 0x24, 0xe7, 0xf1,
+	0x40, 0xdd, 0xda, 0x76, 0xe8, 0x65, 0x63, 0x75, 0x24, 0x35, 0x81, 0xa6, 0x7b, 0xe7, 0x27, 0x65,
+	0x5f, 0x26, 0x9f, 0x9c, 0x1c, 0xa1, 0x53, 0x9d, 0x93, 0xcf, 0x50, 0x67, 0xb9, 0x4c, 0x17, 0x99,
+	0xed, 0xb1, 0xf4, 0xdd, 0xfb, 0xbb, 0xba, 0xd4, 0xfe, 0xc0, 0x05, 0x6f, 0x25, 0x3b, 0x71, 0x4f,
+	0x38, 0xf3, 0x16, 0x36, 0x73, 0xb7, 0x0e, 0x33, 0xe6, 0xbe, 0x40, 0x78, 0x3c, 0x81, 0x80, 0x6b,
+	0x5b, 0xa6, 0x4b, 0xc9, 0x4b, 0x38, 0x03, 0xc3, 0x5d, 0xbf, 0x9d, 0x61, 0xef, 0x9d, 0x1f, 0x96,
+	0xb9, 0xea, 0x72, 0xa0, 0xba, 0x7c, 0xd4, 0x5c, 0x53, 0x7b, 0xf5, 0xd0, 0x01, 0x19, 0xc6, 0x5b,
+	0x6d, 0xc7, 0xb2, 0x2e, 0x33, 0x0a, 0x19, 0x95, 0x7f, 0x90, 0xe3, 0x38, 0xc3, 0x7e, 0xe4, 0x97,
+	0xa8, 0x51, 0x5a, 0xf2, 0x18, 0xa0, 0xde, 0x79, 0x29, 0xc2, 0x8f, 0xaf, 0x8f, 0xea, 0x9c, 0x7c,
+	0x8a, 0x59, 0x00, 0xab, 0x5e, 0x36, 0x8a, 0x37, 0xe5, 0x6e, 0xa4, 0xf0, 0xae, 0x08, 0x6a, 0x1f,
+	0xa7, 0xe9, 0x56, 0xdc, 0xe7, 0x29, 0xf2, 0x64, 0x0a, 0x0f, 0x38, 0xb4, 0x6a, 0xb8, 0x86, 0x65,
+	0xe6, 0xcd, 0xca, 0x4a, 0x81, 0x3a, 0x23, 0xe9, 0x09, 0x34, 0x9d, 0x56, 0xfb, 0x83, 0xe6, 0xb3,
+	0xac, 0x55, 0x30, 0x84, 0xa0, 0x6d, 0x15, 0x0d, 0x21, 0x2a, 0xb7, 0x11, 0x9e, 0x48, 0x8e, 0x0a,
+	0xc8, 0x79, 0x04, 0x0f, 0xe8, 0x41, 0x4f, 0x1b, 0x8a, 0xf6, 0xeb, 0x82, 0x9b, 0x4e, 0x8a, 0xfa,
+	0x1d, 0xc2, 0x3b, 0x05, 0xf8, 0x70, 0x02, 0x3c, 0x43, 0x82, 0xfe, 0x07, 0xf7, 0x85, 0x27, 0x53,
+	0x28, 0x6a, 0x26, 0x6c, 0x5c, 0x28, 0xe6, 0xbe, 0x11, 0xcf, 0x84, 0x08, 0x0f, 0x90, 0xe0, 0x04,
+	0xc6, 0xe1, 0x00, 0x88, 0xfe, 0x9e, 0x28, 0xaa, 0xa0, 0xcf, 0x47, 0x15, 0x8e, 0x3f, 0x61, 0x16,
+	0xd5, 0xc8, 0xc0, 0x4e, 0x4a, 0xf1, 0x13, 0xc2, 0x23, 0x11, 0x0a, 0xfc, 0x98, 0x7d, 0x86, 0x74,
+	0xf8, 0x37, 0xde, 0x6e, 0x5b, 0x4e, 0x64, 0x5b, 0x6d, 0xf3, 0x3f, 0x17, 0x8a, 0x64, 0x1c, 0x63,
+	0xb8, 0x14, 0xfc, 0xbe, 0x34, 0xeb, 0xeb, 0x81, 0x96, 0x85, 0x62, 0xee, 0x16, 0xc2, 0xa3, 0x31,
+	0xbc, 0x40, 0x97, 0xff, 0xe3, 0xed, 0x60, 0x0a, 0xa2, 0x8c, 0x45, 0x20, 0xf2, 0x0e, 0xa6, 0x08,
+	0x0c, 0x0b, 0x8c, 0x3b, 0x29, 0xc4, 0xef, 0x08, 0xef, 0x0e, 0x01, 0x2f, 0x6a, 0xfa, 0x32, 0xf5,
+	0x8e, 0xd7, 0xa3, 0xf5, 0xfc, 0x2b, 0x42, 0x24, 0xdc, 0xed, 0xfa, 0x2c, 0x4c, 0x9d, 0xc2, 0xa9,
+	0x56, 0xff, 0xce, 0x7d, 0x8a, 0x70, 0xae, 0x15, 0x79, 0x90, 0x2d, 0xeb, 0x6f, 0xa7, 0xa0, 0x95,
+	0xb1, 0xce, 0xa8, 0x91, 0x96, 0x4e, 0xca, 0xf3, 0x17, 0xc2, 0x7b, 0x1b, 0x11, 0x1e, 0xd5, 0x97,
+	0x4d, 0xeb, 0x6a, 0x99, 0x16, 0x4b, 0xf4, 0x1f, 0xa0, 0xd1, 0x2d, 0x84, 0xa7, 0x36, 0x8c, 0x00,
+	0x08, 0x35, 0x8d, 0x07, 0x34, 0xb1, 0x0b, 0xd4, 0x6a, 0x6c, 0xee, 0xa4, 0x64, 0x7f, 0x22, 0xbc,
+	0xa7, 0x11, 0xb0, 0x4a, 0x75, 0x6a, 0xd8, 0xde, 0xd1, 0x82, 0xeb, 0x73, 0x7a, 0xc1, 0x15, 0xfb,
+	0x24, 0x66, 0xcd, 0x36, 0x06, 0x00, 0x04, 0x1b, 0xc1, 0xdb, 0x35, 0xde, 0xc4, 0x68, 0x77, 0xab,
+	0xc1, 0x67, 0x27, 0x05, 0xba, 0x2f, 0x1c, 0x79, 0x67, 0xe9, 0xaa, 0x77, 0x1e, 0xa0, 0xab, 0x54,
+	0xaf, 0xbe, 0x00, 0x97, 0xd0, 0x4d, 0xe1, 0x58, 0x6b, 0x26, 0x08, 0xc1, 0xdf, 0x87, 0x89, 0x49,
+	0x57, 0xbd, 0x7c, 0x20, 0x5c, 0xde, 0xa1, 0x7a, 0x95, 0x31, 0x4c, 0xab, 0x83, 0x66, 0xc3, 0xa8,
+	0x4e, 0x0a, 0xf2, 0xb9, 0xf0, 0xac, 0x5c, 0x74, 0x2a, 0xa6, 0x56, 0x28, 0x53, 0xbe, 0x70, 0xdc,
+	0x67, 0x47, 0x8f, 0xdc, 0x45, 0xbc, 0xbb, 0x05, 0x4c, 0x88, 0xea, 0x0c, 0x1e, 0x6c, 0x38, 0x6c,
+	0x5c, 0x36, 0x75, 0x5a, 0x6d, 0x6a, 0x3f, 0x9d, 0xee, 0x46, 0x83, 0xa9, 0xdc, 0x47, 0x08, 0x72,
+	0x8d, 0x05, 0x53, 0xa7, 0xa6, 0x67, 0x54, 0x8d, 0x6b, 0xb4, 0xf8, 0x44, 0xec, 0x4f, 0x62, 0x1c,
+	0xe6, 0x99, 0x40, 0x7c, 0xaf, 0xcc, 0x93, 0x52, 0xd9, 0x4f, 0x4a, 0x65, 0x9e, 0xa0, 0x43, 0x52,
+	0x2a, 0x2f, 0x6a, 0xa5, 0xe0, 0x50, 0x52, 0x23, 0x23, 0x73, 0xdf, 0x07, 0xc2, 0xc4, 0x22, 0x03,
+	0xc2, 0x6f, 0xe2, 0x61, 0x23, 0xd2, 0x9d, 0xb7, 0x79, 0xff, 0x08, 0x9a, 0xd8, 0x32, 0xdd, 0x3b,
+	0x3f, 0x21, 0x47, 0xd3, 0x7f, 0xb9, 0xd9, 0x11, 0xc4, 0x7a, 0xc8, 0x68, 0x9e, 0x82, 0xbc, 0x16,
+	0xc3, 0x63, 0x6a, 0x43, 0x1e, 0x1c, 0x97, 0x40, 0xe4, 0xcb, 0x14, 0xce, 0x26, 0x10, 0x79, 0xbc,
+	0x08, 0x47, 0xb6, 0x66, 0xaa, 0xc5, 0xd6, 0xdc, 0xd2, 0xea, 0xdc, 0x4c, 0x8b, 0xe7, 0x26, 0x79,
+	0x19, 0xf7, 0x14, 0x0d, 0x07, 0x1e, 0xed, 0xfe, 0xa1, 0xda, 0x3f, 0x3f, 0x2e, 0x46, 0x8f, 0x23,
+	0x7e, 0x35, 0x30, 0x52, 0x43, 0xfb, 0xb8, 0x05, 0xbf, 0xed, 0x89, 0x17, 0xfc, 0xb5, 0xc4, 0x85,
+	0x59, 0x57, 0xff, 0x0d, 0x3c, 0x14, 0xa3, 0x3e, 0x3c, 0x6f, 0xdb, 0x15, 0x9f, 0x34, 0x8b, 0x9f,
+	0xd3, 0x83, 0x87, 0xb4, 0x56, 0x71, 0x69, 0xd1, 0xdf, 0x72, 0x06, 0xad, 0x6f, 0x07, 0x71, 0x81,
+	0xa3, 0xc7, 0x5e, 0xe0, 0x37, 0x11, 0x96, 0xe2, 0x66, 0x01, 0x72, 0x27, 0x71, 0xbf, 0xcd, 0x3a,
+	0xf2, 0x36, 0xef, 0x81, 0x45, 0x3d, 0xda, 0x28, 0x4b, 0x30, 0x78, 0x0d, 0x08, 0xf5, 0xd9, 0x51,
+	0x7f, 0x4f, 0x6f, 0x1d, 0x1f, 0x86, 0xa0, 0x5c, 0x0c, 0xd6, 0xe3, 0x92, 0x66, 0xd4, 0xd3, 0x57,
+	0xa1, 0x18, 0x80, 0x1a, 0xca, 0x30, 0x3f, 0x04, 0x4c, 0x1b, 0x86, 0x02, 0xd3, 0x49, 0xdc, 0xcf,
+	0xe8, 0xe4, 0x75, 0xbf, 0x39, 0x74, 0x90, 0xb1, 0x21, 0x8f, 0x31, 0xcc, 0x85, 0x62, 0xc2, 0x1e,
+	0x49, 0x25, 0xec, 0x91, 0x19, 0xfc, 0xaf, 0xd0, 0x3a, 0x70, 0xcb, 0x77, 0x44, 0x7d, 0xad, 0x06,
+	0x9e, 0x67, 0xf1, 0x70, 0xa3, 0x67, 0x6f, 0xcd, 0xa6, 0x70, 0xb7, 0x11, 0xd1, 0xf7, 0x85, 0x35,
+	0x9b, 0xce, 0xdf, 0xde, 0x81, 0xb7, 0x32, 0x42, 0xe4, 0x5b, 0x84, 0x07, 0x1b, 0x8b, 0x4b, 0x64,
+	0x46, 0x94, 0xa8, 0x55, 0x09, 0x4d, 0xfa, 0x5f, 0x5b, 0xb6, 0x3c, 0x52, 0xb9, 0x8b, 0x6f, 0xff,
+	0xf8, 0xe8, 0x46, 0xea, 0x1c, 0x39, 0xa3, 0xf8, 0x85, 0x3b, 0x36, 0xc8, 0xaf, 0x01, 0x06, 0x08,
+	0x5d, 0x65, 0xbd, 0x39, 0x44, 0x35, 0xa8, 0x49, 0xba, 0xca, 0x7a, 0x53, 0x1b, 0x2f, 0x91, 0x90,
+	0xeb, 0x29, 0x3c, 0x14, 0x53, 0x55, 0x21, 0xfb, 0x13, 0xb1, 0xc5, 0xd5, 0xa4, 0x24, 0xb9, 0x5d,
+	0x73, 0x60, 0xf3, 0x01, 0x62, 0x74, 0xde, 0x43, 0xe4, 0x5d, 0xf4, 0xe4, 0x84, 0xc4, 0xb2, 0x8f,
+	0x12, 0x54, 0x8f, 0x94, 0xf5, 0x86, 0x3a, 0x54, 0x4d, 0xe1, 0xcf, 0x85, 0x48, 0x07, 0x6f, 0xa8,
+	0x91, 0xaf, 0x10, 0x1e, 0x68, 0x28, 0x6b, 0x90, 0xff, 0xb6, 0x20, 0x25, 0x96, 0x70, 0xa4, 0x99,
+	0x76, 0x4c, 0x81, 0xfb, 0x22, 0xa3, 0x7e, 0x9a, 0x9c, 0xda, 0x1c, 0xf1, 0xba, 0x23, 0x9f, 0x7c,
+	0xb4, 0x56, 0x53, 0x23, 0x5f, 0x23, 0x9c, 0x89, 0x26, 0xfe, 0x64, 0x6f, 0x22, 0x1c, 0xa1, 0xe2,
+	0x21, 0x4d, 0x6d, 0x68, 0x07, 0x98, 0x2f, 0x31, 0xcc, 0x17, 0x88, 0xba, 0x39, 0xcc, 0xdc, 0x8b,
+	0x0f, 0xb8, 0x7e, 0x37, 0xd5, 0x14, 0xff, 0xc6, 0x72, 0x95, 0x75, 0xb8, 0xc7, 0x6a, 0xe4, 0x57,
+	0x84, 0x77, 0xc4, 0x26, 0xc2, 0x44, 0x49, 0x82, 0x97, 0x50, 0x2f, 0x90, 0x66, 0xdb, 0x1f, 0x00,
+	0xc4, 0x56, 0x19, 0x31, 0x87, 0xd8, 0x4f, 0x9f, 0x98, 0xc2, 0xef, 0xa4, 0x7c, 0x78, 0xfd, 0xb9,
+	0xca, 0x7a, 0x70, 0xeb, 0xd6, 0xc8, 0x1f, 0x08, 0x4b, 0xc9, 0xb9, 0x25, 0x39, 0xd8, 0x9a, 0x4a,
+	0x7c, 0x32, 0x2e, 0x1d, 0xda, 0xe4, 0x28, 0x88, 0xc2, 0x15, 0x16, 0x85, 0x65, 0x62, 0x74, 0x2e,
+	0x0a, 0x9a, 0xbe, 0x2c, 0xd0, 0xbf, 0x9e, 0xc2, 0xa3, 0x89, 0x89, 0x1a, 0x39, 0xd0, 0x9a, 0x47,
+	0x6c, 0x5e, 0x2b, 0x1d, 0xdc, 0xdc, 0x20, 0xe0, 0x5e, 0x63, 0xdc, 0xaf, 0x92, 0x4a, 0xe7, 0xb8,
+	0x3b, 0x7c, 0xe6, 0x3c, 0x24, 0x99, 0x42, 0x1c, 0x1e, 0x05, 0xab, 0xbf, 0x31, 0x5f, 0x4a, 0x5e,
+	0xfd, 0x09, 0xa9, 0xa3, 0x34, 0xdb, 0xfe, 0x00, 0xe0, 0xbe, 0xc4, 0xb8, 0x17, 0xc8, 0x5b, 0x1d,
+	0xe0, 0x2e, 0xe4, 0x78, 0xe4, 0x0e, 0xc2, 0xc3, 0x71, 0xf9, 0x0b, 0x49, 0xbc, 0x39, 0xe2, 0xf3,
+	0x31, 0x49, 0x69, 0xdb, 0x1e, 0x38, 0x9e, 0x60, 0x1c, 0x5f, 0x21, 0x47, 0x36, 0xc5, 0xd1, 0x06,
+	0x6f, 0x41, 0x5a, 0x41, 0x3e, 0x43, 0x78, 0x28, 0x26, 0x1d, 0x89, 0xbd, 0x28, 0x93, 0x13, 0x2a,
+	0x49, 0x6e, 0xd7, 0x1c, 0xd0, 0xcf, 0x30, 0xf4, 0x93, 0x24, 0x27, 0xa2, 0x8f, 0xcb, 0x7c, 0xc8,
+	0x6f, 0x08, 0x93, 0x66, 0x5f, 0x64, 0x5f, 0x5b, 0x53, 0x06, 0x00, 0xf7, 0xb7, 0x69, 0x0d, 0xf8,
+	0xde, 0xe1, 0x17, 0x79, 0x8d, 0xac, 0x77, 0x60, 0x09, 0x05, 0xab, 0x27, 0xba, 0x61, 0xe2, 0xa8,
+	0x93, 0xf7, 0x11, 0xee, 0x13, 0x9e, 0xd2, 0x24, 0xf6, 0x66, 0x8b, 0x79, 0xd2, 0x4b, 0xd3, 0x1b,
+	0x1b, 0x02, 0xd5, 0x49, 0xc6, 0x34, 0x4b, 0xc6, 0x44, 0xa6, 0xe2, 0x4b, 0x9d, 0x7c, 0x8c, 0x70,
+	0x9f, 0xf0, 0xd6, 0x8d, 0x85, 0x12, 0xf7, 0x90, 0x96, 0xa6, 0x37, 0x36, 0x04, 0x28, 0x87, 0x19,
+	0x94, 0x79, 0x32, 0x2b, 0x42, 0x89, 0x7b, 0x1c, 0x89, 0x4f, 0xe1, 0x63, 0xe7, 0xee, 0x3e, 0xc8,
+	0xa2, 0x7b, 0x0f, 0xb2, 0xe8, 0x97, 0x07, 0x59, 0xf4, 0xe1, 0xc3, 0x6c, 0xd7, 0xbd, 0x87, 0xd9,
+	0xae, 0x9f, 0x1f, 0x66, 0xbb, 0x2e, 0x1d, 0x2a, 0x19, 0xde, 0x52, 0xa5, 0xe0, 0x67, 0x6b, 0x4a,
+	0x51, 0xf3, 0x34, 0x66, 0x5f, 0xd6, 0x0a, 0xfe, 0x14, 0xfb, 0xf9, 0x14, 0xe2, 0xbf, 0x8b, 0xfd,
+	0x87, 0xb2, 0x5b, 0xd8, 0xc6, 0xfe, 0xab, 0x76, 0xe0, 0xef, 0x01, 0x00, 0x2f, 0xb2, 0x89, 0x9b,
+	0x8e, 0x1f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// ProxyClientState queries the client state of the downstream that the upstream has.
+	ProxyClientState(ctx context.Context, in *QueryProxyClientStateRequest, opts ...grpc.CallOption) (*QueryProxyClientStateResponse, error)
+	// ProxyConsensusState queries the consensus state of the downstream that the upstream has.
+	ProxyConsensusState(ctx context.Context, in *QueryProxyConsensusStateRequest, opts ...grpc.CallOption) (*QueryProxyConsensusStateResponse, error)
+	// ProxyConnection queries the connection end that the upstream has.
+	ProxyConnection(ctx context.Context, in *QueryProxyConnectionRequest, opts ...grpc.CallOption) (*QueryProxyConnectionResponse, error)
+	// ProxyChannel queries the channel end that the upstream has.
+	ProxyChannel(ctx context.Context, in *QueryProxyChannelRequest, opts ...grpc.CallOption) (*QueryProxyChannelResponse, error)
+	// ProxyPacketCommitment queries the packet commitment hash that the upstream has.
+	ProxyPacketCommitment(ctx context.Context, in *QueryProxyPacketCommitmentRequest, opts ...grpc.CallOption) (*QueryProxyPacketCommitmentResponse, error)
+	// ProxyPacketAcknowledgement queries the packet acknowledgement hash that the upstream has.
+	ProxyPacketAcknowledgement(ctx context.Context, in *QueryProxyPacketAcknowledgementRequest, opts ...grpc.CallOption) (*QueryProxyPacketAcknowledgementResponse, error)
+	// ProxyPacketReceiptAbsence queries if the proxy has verified the absence of a packet receipt on the upstream.
+	ProxyPacketReceiptAbsence(ctx context.Context, in *QueryProxyPacketReceiptAbsenceRequest, opts ...grpc.CallOption) (*QueryProxyPacketReceiptAbsenceResponse, error)
+	// ProxyNextSequenceRecv queries the next receive sequence that the upstream has.
+	ProxyNextSequenceRecv(ctx context.Context, in *QueryProxyNextSequenceRecvRequest, opts ...grpc.CallOption) (*QueryProxyNextSequenceRecvResponse, error)
+	// ProxyPrunablePackets queries how many packet acknowledgements of the upstream
+	// have outlived the retention period and will be pruned.
+	ProxyPrunablePackets(ctx context.Context, in *QueryProxyPrunablePacketsRequest, opts ...grpc.CallOption) (*QueryProxyPrunablePacketsResponse, error)
+	// IncentivizedPackets queries the packets that have fees escrowed for relaying them through the proxy.
+	IncentivizedPackets(ctx context.Context, in *QueryIncentivizedPacketsRequest, opts ...grpc.CallOption) (*QueryIncentivizedPacketsResponse, error)
+	// IncentivizedPacket queries the fees escrowed for a packet.
+	IncentivizedPacket(ctx context.Context, in *QueryIncentivizedPacketRequest, opts ...grpc.CallOption) (*QueryIncentivizedPacketResponse, error)
+	// PausedProxies queries the upstream clients and channels for which proxying is paused.
+	PausedProxies(ctx context.Context, in *QueryPausedProxiesRequest, opts ...grpc.CallOption) (*QueryPausedProxiesResponse, error)
+	// UpstreamChain queries the upstream chain which a proxy client of the downstream is bound to.
+	UpstreamChain(ctx context.Context, in *QueryUpstreamChainRequest, opts ...grpc.CallOption) (*QueryUpstreamChainResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) ProxyClientState(ctx context.Context, in *QueryProxyClientStateRequest, opts ...grpc.CallOption) (*QueryProxyClientStateResponse, error) {
+	out := new(QueryProxyClientStateResponse)
+	err := c.cc.Invoke(ctx, "/ibc.proxy.v1.Query/ProxyClientState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProxyConsensusState(ctx context.Context, in *QueryProxyConsensusStateRequest, opts ...grpc.CallOption) (*QueryProxyConsensusStateResponse, error) {
+	out := new(QueryProxyConsensusStateResponse)
+	err := c.cc.Invoke(ctx, "/ibc.proxy.v1.Query/ProxyConsensusState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProxyConnection(ctx context.Context, in *QueryProxyConnectionRequest, opts ...grpc.CallOption) (*QueryProxyConnectionResponse, error) {
+	out := new(QueryProxyConnectionResponse)
+	err := c.cc.Invoke(ctx, "/ibc.proxy.v1.Query/ProxyConnection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProxyChannel(ctx context.Context, in *QueryProxyChannelRequest, opts ...grpc.CallOption) (*QueryProxyChannelResponse, error) {
+	out := new(QueryProxyChannelResponse)
+	err := c.cc.Invoke(ctx, "/ibc.proxy.v1.Query/ProxyChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProxyPacketCommitment(ctx context.Context, in *QueryProxyPacketCommitmentRequest, opts ...grpc.CallOption) (*QueryProxyPacketCommitmentResponse, error) {
+	out := new(QueryProxyPacketCommitmentResponse)
+	err := c.cc.Invoke(ctx, "/ibc.proxy.v1.Query/ProxyPacketCommitment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProxyPacketAcknowledgement(ctx context.Context, in *QueryProxyPacketAcknowledgementRequest, opts ...grpc.CallOption) (*QueryProxyPacketAcknowledgementResponse, error) {
+	out := new(QueryProxyPacketAcknowledgementResponse)
+	err := c.cc.Invoke(ctx, "/ibc.proxy.v1.Query/ProxyPacketAcknowledgement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProxyPacketReceiptAbsence(ctx context.Context, in *QueryProxyPacketReceiptAbsenceRequest, opts ...grpc.CallOption) (*QueryProxyPacketReceiptAbsenceResponse, error) {
+	out := new(QueryProxyPacketReceiptAbsenceResponse)
+	err := c.cc.Invoke(ctx, "/ibc.proxy.v1.Query/ProxyPacketReceiptAbsence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProxyNextSequenceRecv(ctx context.Context, in *QueryProxyNextSequenceRecvRequest, opts ...grpc.CallOption) (*QueryProxyNextSequenceRecvResponse, error) {
+	out := new(QueryProxyNextSequenceRecvResponse)
+	err := c.cc.Invoke(ctx, "/ibc.proxy.v1.Query/ProxyNextSequenceRecv", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProxyPrunablePackets(ctx context.Context, in *QueryProxyPrunablePacketsRequest, opts ...grpc.CallOption) (*QueryProxyPrunablePacketsResponse, error) {
+	out := new(QueryProxyPrunablePacketsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.proxy.v1.Query/ProxyPrunablePackets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IncentivizedPackets(ctx context.Context, in *QueryIncentivizedPacketsRequest, opts ...grpc.CallOption) (*QueryIncentivizedPacketsResponse, error) {
+	out := new(QueryIncentivizedPacketsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.proxy.v1.Query/IncentivizedPackets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IncentivizedPacket(ctx context.Context, in *QueryIncentivizedPacketRequest, opts ...grpc.CallOption) (*QueryIncentivizedPacketResponse, error) {
+	out := new(QueryIncentivizedPacketResponse)
+	err := c.cc.Invoke(ctx, "/ibc.proxy.v1.Query/IncentivizedPacket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PausedProxies(ctx context.Context, in *QueryPausedProxiesRequest, opts ...grpc.CallOption) (*QueryPausedProxiesResponse, error) {
+	out := new(QueryPausedProxiesResponse)
+	err := c.cc.Invoke(ctx, "/ibc.proxy.v1.Query/PausedProxies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UpstreamChain(ctx context.Context, in *QueryUpstreamChainRequest, opts ...grpc.CallOption) (*QueryUpstreamChainResponse, error) {
+	out := new(QueryUpstreamChainResponse)
+	err := c.cc.Invoke(ctx, "/ibc.proxy.v1.Query/UpstreamChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// ProxyClientState queries the client state of the downstream that the upstream has.
+	ProxyClientState(context.Context, *QueryProxyClientStateRequest) (*QueryProxyClientStateResponse, error)
+	// ProxyConsensusState queries the consensus state of the downstream that the upstream has.
+	ProxyConsensusState(context.Context, *QueryProxyConsensusStateRequest) (*QueryProxyConsensusStateResponse, error)
+	// ProxyConnection queries the connection end that the upstream has.
+	ProxyConnection(context.Context, *QueryProxyConnectionRequest) (*QueryProxyConnectionResponse, error)
+	// ProxyChannel queries the channel end that the upstream has.
+	ProxyChannel(context.Context, *QueryProxyChannelRequest) (*QueryProxyChannelResponse, error)
+	// ProxyPacketCommitment queries the packet commitment hash that the upstream has.
+	ProxyPacketCommitment(context.Context, *QueryProxyPacketCommitmentRequest) (*QueryProxyPacketCommitmentResponse, error)
+	// ProxyPacketAcknowledgement queries the packet acknowledgement hash that the upstream has.
+	ProxyPacketAcknowledgement(context.Context, *QueryProxyPacketAcknowledgementRequest) (*QueryProxyPacketAcknowledgementResponse, error)
+	// ProxyPacketReceiptAbsence queries if the proxy has verified the absence of a packet receipt on the upstream.
+	ProxyPacketReceiptAbsence(context.Context, *QueryProxyPacketReceiptAbsenceRequest) (*QueryProxyPacketReceiptAbsenceResponse, error)
+	// ProxyNextSequenceRecv queries the next receive sequence that the upstream has.
+	ProxyNextSequenceRecv(context.Context, *QueryProxyNextSequenceRecvRequest) (*QueryProxyNextSequenceRecvResponse, error)
+	// ProxyPrunablePackets queries how many packet acknowledgements of the upstream
+	// have outlived the retention period and will be pruned.
+	ProxyPrunablePackets(context.Context, *QueryProxyPrunablePacketsRequest) (*QueryProxyPrunablePacketsResponse, error)
+	// IncentivizedPackets queries the packets that have fees escrowed for relaying them through the proxy.
+	IncentivizedPackets(context.Context, *QueryIncentivizedPacketsRequest) (*QueryIncentivizedPacketsResponse, error)
+	// IncentivizedPacket queries the fees escrowed for a packet.
+	IncentivizedPacket(context.Context, *QueryIncentivizedPacketRequest) (*QueryIncentivizedPacketResponse, error)
+	// PausedProxies queries the upstream clients and channels for which proxying is paused.
+	PausedProxies(context.Context, *QueryPausedProxiesRequest) (*QueryPausedProxiesResponse, error)
+	// UpstreamChain queries the upstream chain which a proxy client of the downstream is bound to.
+	UpstreamChain(context.Context, *QueryUpstreamChainRequest) (*QueryUpstreamChainResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) ProxyClientState(ctx context.Context, req *QueryProxyClientStateRequest) (*QueryProxyClientStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProxyClientState not implemented")
+}
+func (*UnimplementedQueryServer) ProxyConsensusState(ctx context.Context, req *QueryProxyConsensusStateRequest) (*QueryProxyConsensusStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProxyConsensusState not implemented")
+}
+func (*UnimplementedQueryServer) ProxyConnection(ctx context.Context, req *QueryProxyConnectionRequest) (*QueryProxyConnectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProxyConnection not implemented")
+}
+func (*UnimplementedQueryServer) ProxyChannel(ctx context.Context, req *QueryProxyChannelRequest) (*QueryProxyChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProxyChannel not implemented")
+}
+func (*UnimplementedQueryServer) ProxyPacketCommitment(ctx context.Context, req *QueryProxyPacketCommitmentRequest) (*QueryProxyPacketCommitmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProxyPacketCommitment not implemented")
+}
+func (*UnimplementedQueryServer) ProxyPacketAcknowledgement(ctx context.Context, req *QueryProxyPacketAcknowledgementRequest) (*QueryProxyPacketAcknowledgementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProxyPacketAcknowledgement not implemented")
+}
+func (*UnimplementedQueryServer) ProxyPacketReceiptAbsence(ctx context.Context, req *QueryProxyPacketReceiptAbsenceRequest) (*QueryProxyPacketReceiptAbsenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProxyPacketReceiptAbsence not implemented")
+}
+func (*UnimplementedQueryServer) ProxyNextSequenceRecv(ctx context.Context, req *QueryProxyNextSequenceRecvRequest) (*QueryProxyNextSequenceRecvResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProxyNextSequenceRecv not implemented")
+}
+func (*UnimplementedQueryServer) ProxyPrunablePackets(ctx context.Context, req *QueryProxyPrunablePacketsRequest) (*QueryProxyPrunablePacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProxyPrunablePackets not implemented")
+}
+func (*UnimplementedQueryServer) IncentivizedPackets(ctx context.Context, req *QueryIncentivizedPacketsRequest) (*QueryIncentivizedPacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncentivizedPackets not implemented")
+}
+func (*UnimplementedQueryServer) IncentivizedPacket(ctx context.Context, req *QueryIncentivizedPacketRequest) (*QueryIncentivizedPacketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncentivizedPacket not implemented")
+}
+func (*UnimplementedQueryServer) PausedProxies(ctx context.Context, req *QueryPausedProxiesRequest) (*QueryPausedProxiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PausedProxies not implemented")
+}
+func (*UnimplementedQueryServer) UpstreamChain(ctx context.Context, req *QueryUpstreamChainRequest) (*QueryUpstreamChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpstreamChain not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_ProxyClientState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProxyClientStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProxyClientState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.proxy.v1.Query/ProxyClientState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProxyClientState(ctx, req.(*QueryProxyClientStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProxyConsensusState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProxyConsensusStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProxyConsensusState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.proxy.v1.Query/ProxyConsensusState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProxyConsensusState(ctx, req.(*QueryProxyConsensusStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProxyConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProxyConnectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProxyConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.proxy.v1.Query/ProxyConnection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProxyConnection(ctx, req.(*QueryProxyConnectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProxyChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProxyChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProxyChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.proxy.v1.Query/ProxyChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProxyChannel(ctx, req.(*QueryProxyChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProxyPacketCommitment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProxyPacketCommitmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProxyPacketCommitment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.proxy.v1.Query/ProxyPacketCommitment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProxyPacketCommitment(ctx, req.(*QueryProxyPacketCommitmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProxyPacketAcknowledgement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProxyPacketAcknowledgementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProxyPacketAcknowledgement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.proxy.v1.Query/ProxyPacketAcknowledgement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProxyPacketAcknowledgement(ctx, req.(*QueryProxyPacketAcknowledgementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProxyPacketReceiptAbsence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProxyPacketReceiptAbsenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProxyPacketReceiptAbsence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.proxy.v1.Query/ProxyPacketReceiptAbsence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProxyPacketReceiptAbsence(ctx, req.(*QueryProxyPacketReceiptAbsenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProxyNextSequenceRecv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProxyNextSequenceRecvRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProxyNextSequenceRecv(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.proxy.v1.Query/ProxyNextSequenceRecv",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProxyNextSequenceRecv(ctx, req.(*QueryProxyNextSequenceRecvRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProxyPrunablePackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProxyPrunablePacketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProxyPrunablePackets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.proxy.v1.Query/ProxyPrunablePackets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProxyPrunablePackets(ctx, req.(*QueryProxyPrunablePacketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IncentivizedPackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIncentivizedPacketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IncentivizedPackets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.proxy.v1.Query/IncentivizedPackets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IncentivizedPackets(ctx, req.(*QueryIncentivizedPacketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IncentivizedPacket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIncentivizedPacketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IncentivizedPacket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.proxy.v1.Query/IncentivizedPacket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IncentivizedPacket(ctx, req.(*QueryIncentivizedPacketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PausedProxies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPausedProxiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PausedProxies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.proxy.v1.Query/PausedProxies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PausedProxies(ctx, req.(*QueryPausedProxiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UpstreamChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUpstreamChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UpstreamChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.proxy.v1.Query/UpstreamChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UpstreamChain(ctx, req.(*QueryUpstreamChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ibc.proxy.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ProxyClientState",
+			Handler:    _Query_ProxyClientState_Handler,
+		},
+		{
+			MethodName: "ProxyConsensusState",
+			Handler:    _Query_ProxyConsensusState_Handler,
+		},
+		{
+			MethodName: "ProxyConnection",
+			Handler:    _Query_ProxyConnection_Handler,
+		},
+		{
+			MethodName: "ProxyChannel",
+			Handler:    _Query_ProxyChannel_Handler,
+		},
+		{
+			MethodName: "ProxyPacketCommitment",
+			Handler:    _Query_ProxyPacketCommitment_Handler,
+		},
+		{
+			MethodName: "ProxyPacketAcknowledgement",
+			Handler:    _Query_ProxyPacketAcknowledgement_Handler,
+		},
+		{
+			MethodName: "ProxyPacketReceiptAbsence",
+			Handler:    _Query_ProxyPacketReceiptAbsence_Handler,
+		},
+		{
+			MethodName: "ProxyNextSequenceRecv",
+			Handler:    _Query_ProxyNextSequenceRecv_Handler,
+		},
+		{
+			MethodName: "ProxyPrunablePackets",
+			Handler:    _Query_ProxyPrunablePackets_Handler,
+		},
+		{
+			MethodName: "IncentivizedPackets",
+			Handler:    _Query_IncentivizedPackets_Handler,
+		},
+		{
+			MethodName: "IncentivizedPacket",
+			Handler:    _Query_IncentivizedPacket_Handler,
+		},
+		{
+			MethodName: "PausedProxies",
+			Handler:    _Query_PausedProxies_Handler,
+		},
+		{
+			MethodName: "UpstreamChain",
+			Handler:    _Query_UpstreamChain_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ibc/modules/proxy/query.proto",
+}
+
+func (m *QueryProxyClientStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProxyClientStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProxyClientStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.UpstreamPrefix.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.UpstreamClientId) > 0 {
+		i -= len(m.UpstreamClientId)
+		copy(dAtA[i:], m.UpstreamClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UpstreamClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProxyClientStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProxyClientStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProxyClientStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ClientState != nil {
+		{
+			size, err := m.ClientState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProxyConsensusStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProxyConsensusStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProxyConsensusStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RevisionHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RevisionHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.RevisionNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RevisionNumber))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.UpstreamPrefix.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.UpstreamClientId) > 0 {
+		i -= len(m.UpstreamClientId)
+		copy(dAtA[i:], m.UpstreamClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UpstreamClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProxyConsensusStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProxyConsensusStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProxyConsensusStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ConsensusState != nil {
+		{
+			size, err := m.ConsensusState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProxyConnectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProxyConnectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProxyConnectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.UpstreamPrefix.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.UpstreamClientId) > 0 {
+		i -= len(m.UpstreamClientId)
+		copy(dAtA[i:], m.UpstreamClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UpstreamClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProxyConnectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProxyConnectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProxyConnectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Connection != nil {
+		{
+			size, err := m.Connection.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProxyChannelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProxyChannelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProxyChannelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.UpstreamPrefix.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.UpstreamClientId) > 0 {
+		i -= len(m.UpstreamClientId)
+		copy(dAtA[i:], m.UpstreamClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UpstreamClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProxyChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProxyChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProxyChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Channel != nil {
+		{
+			size, err := m.Channel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProxyPacketCommitmentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProxyPacketCommitmentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProxyPacketCommitmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.UpstreamPrefix.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.UpstreamClientId) > 0 {
+		i -= len(m.UpstreamClientId)
+		copy(dAtA[i:], m.UpstreamClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UpstreamClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProxyPacketCommitmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProxyPacketCommitmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProxyPacketCommitmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProxyPacketAcknowledgementRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProxyPacketAcknowledgementRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProxyPacketAcknowledgementRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.UpstreamPrefix.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.UpstreamClientId) > 0 {
+		i -= len(m.UpstreamClientId)
+		copy(dAtA[i:], m.UpstreamClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UpstreamClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProxyPacketAcknowledgementResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProxyPacketAcknowledgementResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProxyPacketAcknowledgementResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Acknowledgement) > 0 {
+		i -= len(m.Acknowledgement)
+		copy(dAtA[i:], m.Acknowledgement)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Acknowledgement)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProxyPacketReceiptAbsenceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProxyPacketReceiptAbsenceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProxyPacketReceiptAbsenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.UpstreamPrefix.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.UpstreamClientId) > 0 {
+		i -= len(m.UpstreamClientId)
+		copy(dAtA[i:], m.UpstreamClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UpstreamClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProxyPacketReceiptAbsenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProxyPacketReceiptAbsenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProxyPacketReceiptAbsenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Absence {
+		i--
+		if m.Absence {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProxyNextSequenceRecvRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProxyNextSequenceRecvRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProxyNextSequenceRecvRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.UpstreamPrefix.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.UpstreamClientId) > 0 {
+		i -= len(m.UpstreamClientId)
+		copy(dAtA[i:], m.UpstreamClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UpstreamClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProxyNextSequenceRecvResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProxyNextSequenceRecvResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProxyNextSequenceRecvResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.NextSequenceRecv != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextSequenceRecv))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProxyPrunablePacketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProxyPrunablePacketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProxyPrunablePacketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.UpstreamPrefix.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.UpstreamClientId) > 0 {
+		i -= len(m.UpstreamClientId)
+		copy(dAtA[i:], m.UpstreamClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UpstreamClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProxyPrunablePacketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProxyPrunablePacketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProxyPrunablePacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Acknowledgements != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Acknowledgements))
+		i--
+		dAtA[i] = 0x10
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIncentivizedPacketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIncentivizedPacketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIncentivizedPacketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UpstreamClientId) > 0 {
+		i -= len(m.UpstreamClientId)
+		copy(dAtA[i:], m.UpstreamClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UpstreamClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIncentivizedPacketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIncentivizedPacketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIncentivizedPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.IncentivizedPackets) > 0 {
+		for iNdEx := len(m.IncentivizedPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IncentivizedPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIncentivizedPacketRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIncentivizedPacketRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIncentivizedPacketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.UpstreamPrefix.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Direction != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UpstreamClientId) > 0 {
+		i -= len(m.UpstreamClientId)
+		copy(dAtA[i:], m.UpstreamClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UpstreamClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIncentivizedPacketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIncentivizedPacketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIncentivizedPacketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.IncentivizedPacket.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPausedProxiesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPausedProxiesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPausedProxiesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPausedProxiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPausedProxiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPausedProxiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PausedProxies) > 0 {
+		for iNdEx := len(m.PausedProxies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PausedProxies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUpstreamChainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpstreamChainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpstreamChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUpstreamChainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpstreamChainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpstreamChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpstreamClientType) > 0 {
+		i -= len(m.UpstreamClientType)
+		copy(dAtA[i:], m.UpstreamClientType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UpstreamClientType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.UpstreamChainId) > 0 {
+		i -= len(m.UpstreamChainId)
+		copy(dAtA[i:], m.UpstreamChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UpstreamChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.UpstreamClientId) > 0 {
+		i -= len(m.UpstreamClientId)
+		copy(dAtA[i:], m.UpstreamClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UpstreamClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProxyChainId) > 0 {
+		i -= len(m.ProxyChainId)
+		copy(dAtA[i:], m.ProxyChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProxyChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryProxyClientStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UpstreamClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.UpstreamPrefix.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProxyClientStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClientState != nil {
+		l = m.ClientState.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProxyConsensusStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UpstreamClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.UpstreamPrefix.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RevisionNumber != 0 {
+		n += 1 + sovQuery(uint64(m.RevisionNumber))
+	}
+	if m.RevisionHeight != 0 {
+		n += 1 + sovQuery(uint64(m.RevisionHeight))
+	}
+	return n
+}
+
+func (m *QueryProxyConsensusStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConsensusState != nil {
+		l = m.ConsensusState.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProxyConnectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UpstreamClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.UpstreamPrefix.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProxyConnectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Connection != nil {
+		l = m.Connection.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProxyChannelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UpstreamClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.UpstreamPrefix.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProxyChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Channel != nil {
+		l = m.Channel.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProxyPacketCommitmentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UpstreamClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.UpstreamPrefix.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QueryProxyPacketCommitmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProxyPacketAcknowledgementRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UpstreamClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.UpstreamPrefix.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QueryProxyPacketAcknowledgementResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Acknowledgement)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProxyPacketReceiptAbsenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UpstreamClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.UpstreamPrefix.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QueryProxyPacketReceiptAbsenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Absence {
+		n += 2
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProxyNextSequenceRecvRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UpstreamClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.UpstreamPrefix.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProxyNextSequenceRecvResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NextSequenceRecv != 0 {
+		n += 1 + sovQuery(uint64(m.NextSequenceRecv))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProxyPrunablePacketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UpstreamClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.UpstreamPrefix.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProxyPrunablePacketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Acknowledgements != 0 {
+		n += 1 + sovQuery(uint64(m.Acknowledgements))
+	}
+	return n
+}
+
+func (m *QueryIncentivizedPacketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UpstreamClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIncentivizedPacketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.IncentivizedPackets) > 0 {
+		for _, e := range m.IncentivizedPackets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIncentivizedPacketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UpstreamClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	if m.Direction != 0 {
+		n += 1 + sovQuery(uint64(m.Direction))
+	}
+	l = m.UpstreamPrefix.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryIncentivizedPacketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.IncentivizedPacket.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPausedProxiesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPausedProxiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PausedProxies) > 0 {
+		for _, e := range m.PausedProxies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUpstreamChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUpstreamChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProxyChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.UpstreamClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.UpstreamChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.UpstreamClientType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryProxyClientStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProxyClientStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProxyClientStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpstreamClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamPrefix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpstreamPrefix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProxyClientStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProxyClientStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProxyClientStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClientState == nil {
+				m.ClientState = &types1.Any{}
+			}
+			if err := m.ClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProxyConsensusStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProxyConsensusStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProxyConsensusStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpstreamClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamPrefix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpstreamPrefix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevisionNumber", wireType)
+			}
+			m.RevisionNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevisionNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevisionHeight", wireType)
+			}
+			m.RevisionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevisionHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProxyConsensusStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProxyConsensusStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProxyConsensusStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsensusState == nil {
+				m.ConsensusState = &types1.Any{}
+			}
+			if err := m.ConsensusState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProxyConnectionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProxyConnectionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProxyConnectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpstreamClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamPrefix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpstreamPrefix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProxyConnectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProxyConnectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProxyConnectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Connection", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Connection == nil {
+				m.Connection = &types3.ConnectionEnd{}
+			}
+			if err := m.Connection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProxyChannelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProxyChannelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProxyChannelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpstreamClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamPrefix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpstreamPrefix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProxyChannelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProxyChannelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProxyChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Channel == nil {
+				m.Channel = &types4.Channel{}
+			}
+			if err := m.Channel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProxyPacketCommitmentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProxyPacketCommitmentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProxyPacketCommitmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpstreamClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamPrefix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpstreamPrefix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProxyPacketCommitmentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProxyPacketCommitmentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProxyPacketCommitmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProxyPacketAcknowledgementRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProxyPacketAcknowledgementRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProxyPacketAcknowledgementRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpstreamClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamPrefix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpstreamPrefix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProxyPacketAcknowledgementResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProxyPacketAcknowledgementResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProxyPacketAcknowledgementResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acknowledgement", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Acknowledgement = append(m.Acknowledgement[:0], dAtA[iNdEx:postIndex]...)
+			if m.Acknowledgement == nil {
+				m.Acknowledgement = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProxyPacketReceiptAbsenceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProxyPacketReceiptAbsenceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProxyPacketReceiptAbsenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpstreamClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamPrefix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpstreamPrefix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProxyPacketReceiptAbsenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProxyPacketReceiptAbsenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProxyPacketReceiptAbsenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Absence", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Absence = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProxyNextSequenceRecvRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProxyNextSequenceRecvRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProxyNextSequenceRecvRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpstreamClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamPrefix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpstreamPrefix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProxyNextSequenceRecvResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProxyNextSequenceRecvResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProxyNextSequenceRecvResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSequenceRecv", wireType)
+			}
+			m.NextSequenceRecv = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSequenceRecv |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProxyPrunablePacketsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProxyPrunablePacketsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProxyPrunablePacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpstreamClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamPrefix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpstreamPrefix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProxyPrunablePacketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProxyPrunablePacketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProxyPrunablePacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acknowledgements", wireType)
+			}
+			m.Acknowledgements = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Acknowledgements |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIncentivizedPacketsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncentivizedPacketsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncentivizedPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpstreamClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIncentivizedPacketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncentivizedPacketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncentivizedPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentivizedPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncentivizedPackets = append(m.IncentivizedPackets, IncentivizedPacket{})
+			if err := m.IncentivizedPackets[len(m.IncentivizedPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIncentivizedPacketRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncentivizedPacketRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncentivizedPacketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpstreamClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= PacketDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamPrefix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpstreamPrefix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIncentivizedPacketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncentivizedPacketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncentivizedPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentivizedPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IncentivizedPacket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPausedProxiesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPausedProxiesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPausedProxiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPausedProxiesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPausedProxiesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPausedProxiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedProxies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedProxies = append(m.PausedProxies, PausedProxy{})
+			if err := m.PausedProxies[len(m.PausedProxies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUpstreamChainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpstreamChainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpstreamChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUpstreamChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpstreamChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpstreamChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProxyChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpstreamClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpstreamChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpstreamClientType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -7,10 +7,13 @@ import (
 	context "context"
 	fmt "fmt"
 	types1 "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types6 "github.com/cosmos/cosmos-sdk/types"
 	types2 "github.com/cosmos/ibc-go/modules/core/02-client/types"
 	types3 "github.com/cosmos/ibc-go/modules/core/03-connection/types"
 	types4 "github.com/cosmos/ibc-go/modules/core/04-channel/types"
 	types "github.com/cosmos/ibc-go/modules/core/23-commitment/types"
+	types5 "github.com/datachainlab/ibc-proxy/modules/light-clients/xx-proxy/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -450,6 +453,87 @@ func (m *MsgProxyConnectionOpenFinalizeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgProxyConnectionOpenFinalizeResponse proto.InternalMessageInfo
 
+// MsgProxyConnectionState proxies a connection end that the upstream has already opened
+// for an upstream client that doesn't have it yet, e.g. a new upstream client that a proxy client migrates to.
+type MsgProxyConnectionState struct {
+	ConnectionId     string               `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	UpstreamClientId string               `protobuf:"bytes,2,opt,name=upstream_client_id,json=upstreamClientId,proto3" json:"upstream_client_id,omitempty"`
+	UpstreamPrefix   types.MerklePrefix   `protobuf:"bytes,3,opt,name=upstream_prefix,json=upstreamPrefix,proto3" json:"upstream_prefix"`
+	Connection       types3.ConnectionEnd `protobuf:"bytes,4,opt,name=connection,proto3" json:"connection"`
+	Proof            []byte               `protobuf:"bytes,5,opt,name=proof,proto3" json:"proof,omitempty"`
+	ProofHeight      types2.Height        `protobuf:"bytes,6,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+	Signer           string               `protobuf:"bytes,7,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgProxyConnectionState) Reset()         { *m = MsgProxyConnectionState{} }
+func (m *MsgProxyConnectionState) String() string { return proto.CompactTextString(m) }
+func (*MsgProxyConnectionState) ProtoMessage()    {}
+func (*MsgProxyConnectionState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68797dc99f8f4cd2, []int{10}
+}
+func (m *MsgProxyConnectionState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProxyConnectionState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProxyConnectionState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgProxyConnectionState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProxyConnectionState.Merge(m, src)
+}
+func (m *MsgProxyConnectionState) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProxyConnectionState) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProxyConnectionState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProxyConnectionState proto.InternalMessageInfo
+
+type MsgProxyConnectionStateResponse struct {
+}
+
+func (m *MsgProxyConnectionStateResponse) Reset()         { *m = MsgProxyConnectionStateResponse{} }
+func (m *MsgProxyConnectionStateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProxyConnectionStateResponse) ProtoMessage()    {}
+func (*MsgProxyConnectionStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68797dc99f8f4cd2, []int{11}
+}
+func (m *MsgProxyConnectionStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProxyConnectionStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProxyConnectionStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgProxyConnectionStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProxyConnectionStateResponse.Merge(m, src)
+}
+func (m *MsgProxyConnectionStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProxyConnectionStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProxyConnectionStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProxyConnectionStateResponse proto.InternalMessageInfo
+
 type MsgProxyChannelOpenTry struct {
 	UpstreamClientId string             `protobuf:"bytes,1,opt,name=upstream_client_id,json=upstreamClientId,proto3" json:"upstream_client_id,omitempty"`
 	UpstreamPrefix   types.MerklePrefix `protobuf:"bytes,2,opt,name=upstream_prefix,json=upstreamPrefix,proto3" json:"upstream_prefix"`
@@ -462,13 +546,15 @@ type MsgProxyChannelOpenTry struct {
 	ProofInit        []byte             `protobuf:"bytes,9,opt,name=proof_init,json=proofInit,proto3" json:"proof_init,omitempty"`
 	ProofHeight      types2.Height      `protobuf:"bytes,10,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
 	Signer           string             `protobuf:"bytes,11,opt,name=signer,proto3" json:"signer,omitempty"`
+	// upstream_hops locates the connection ends of connection_hops[1:] in the proxy store
+	UpstreamHops []ProxyConnectionHop `protobuf:"bytes,12,rep,name=upstream_hops,json=upstreamHops,proto3" json:"upstream_hops"`
 }
 
 func (m *MsgProxyChannelOpenTry) Reset()         { *m = MsgProxyChannelOpenTry{} }
 func (m *MsgProxyChannelOpenTry) String() string { return proto.CompactTextString(m) }
 func (*MsgProxyChannelOpenTry) ProtoMessage()    {}
 func (*MsgProxyChannelOpenTry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68797dc99f8f4cd2, []int{10}
+	return fileDescriptor_68797dc99f8f4cd2, []int{12}
 }
 func (m *MsgProxyChannelOpenTry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -504,7 +590,7 @@ func (m *MsgProxyChannelOpenTryResponse) Reset()         { *m = MsgProxyChannelO
 func (m *MsgProxyChannelOpenTryResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgProxyChannelOpenTryResponse) ProtoMessage()    {}
 func (*MsgProxyChannelOpenTryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68797dc99f8f4cd2, []int{11}
+	return fileDescriptor_68797dc99f8f4cd2, []int{13}
 }
 func (m *MsgProxyChannelOpenTryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -546,13 +632,15 @@ type MsgProxyChannelOpenAck struct {
 	ProofTry            []byte             `protobuf:"bytes,10,opt,name=proof_try,json=proofTry,proto3" json:"proof_try,omitempty"`
 	ProofHeight         types2.Height      `protobuf:"bytes,11,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
 	Signer              string             `protobuf:"bytes,12,opt,name=signer,proto3" json:"signer,omitempty"`
+	// upstream_hops locates the connection ends of connection_hops[1:] in the proxy store
+	UpstreamHops []ProxyConnectionHop `protobuf:"bytes,13,rep,name=upstream_hops,json=upstreamHops,proto3" json:"upstream_hops"`
 }
 
 func (m *MsgProxyChannelOpenAck) Reset()         { *m = MsgProxyChannelOpenAck{} }
 func (m *MsgProxyChannelOpenAck) String() string { return proto.CompactTextString(m) }
 func (*MsgProxyChannelOpenAck) ProtoMessage()    {}
 func (*MsgProxyChannelOpenAck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68797dc99f8f4cd2, []int{12}
+	return fileDescriptor_68797dc99f8f4cd2, []int{14}
 }
 func (m *MsgProxyChannelOpenAck) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -588,7 +676,7 @@ func (m *MsgProxyChannelOpenAckResponse) Reset()         { *m = MsgProxyChannelO
 func (m *MsgProxyChannelOpenAckResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgProxyChannelOpenAckResponse) ProtoMessage()    {}
 func (*MsgProxyChannelOpenAckResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68797dc99f8f4cd2, []int{13}
+	return fileDescriptor_68797dc99f8f4cd2, []int{15}
 }
 func (m *MsgProxyChannelOpenAckResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -632,7 +720,7 @@ func (m *MsgProxyChannelOpenConfirm) Reset()         { *m = MsgProxyChannelOpenC
 func (m *MsgProxyChannelOpenConfirm) String() string { return proto.CompactTextString(m) }
 func (*MsgProxyChannelOpenConfirm) ProtoMessage()    {}
 func (*MsgProxyChannelOpenConfirm) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68797dc99f8f4cd2, []int{14}
+	return fileDescriptor_68797dc99f8f4cd2, []int{16}
 }
 func (m *MsgProxyChannelOpenConfirm) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -724,7 +812,7 @@ func (m *MsgProxyChannelOpenConfirmResponse) Reset()         { *m = MsgProxyChan
 func (m *MsgProxyChannelOpenConfirmResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgProxyChannelOpenConfirmResponse) ProtoMessage()    {}
 func (*MsgProxyChannelOpenConfirmResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68797dc99f8f4cd2, []int{15}
+	return fileDescriptor_68797dc99f8f4cd2, []int{17}
 }
 func (m *MsgProxyChannelOpenConfirmResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -767,7 +855,7 @@ func (m *MsgProxyChannelOpenFinalize) Reset()         { *m = MsgProxyChannelOpen
 func (m *MsgProxyChannelOpenFinalize) String() string { return proto.CompactTextString(m) }
 func (*MsgProxyChannelOpenFinalize) ProtoMessage()    {}
 func (*MsgProxyChannelOpenFinalize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68797dc99f8f4cd2, []int{16}
+	return fileDescriptor_68797dc99f8f4cd2, []int{18}
 }
 func (m *MsgProxyChannelOpenFinalize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -852,7 +940,7 @@ func (m *MsgProxyChannelOpenFinalizeResponse) Reset()         { *m = MsgProxyCha
 func (m *MsgProxyChannelOpenFinalizeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgProxyChannelOpenFinalizeResponse) ProtoMessage()    {}
 func (*MsgProxyChannelOpenFinalizeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68797dc99f8f4cd2, []int{17}
+	return fileDescriptor_68797dc99f8f4cd2, []int{19}
 }
 func (m *MsgProxyChannelOpenFinalizeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -881,6 +969,281 @@ func (m *MsgProxyChannelOpenFinalizeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgProxyChannelOpenFinalizeResponse proto.InternalMessageInfo
 
+type MsgProxyChannelCloseConfirm struct {
+	UpstreamClientId string             `protobuf:"bytes,1,opt,name=upstream_client_id,json=upstreamClientId,proto3" json:"upstream_client_id,omitempty"`
+	UpstreamPrefix   types.MerklePrefix `protobuf:"bytes,2,opt,name=upstream_prefix,json=upstreamPrefix,proto3" json:"upstream_prefix"`
+	PortId           string             `protobuf:"bytes,3,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId        string             `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	ProofInit        []byte             `protobuf:"bytes,5,opt,name=proof_init,json=proofInit,proto3" json:"proof_init,omitempty"`
+	ProofHeight      types2.Height      `protobuf:"bytes,6,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+	Signer           string             `protobuf:"bytes,7,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgProxyChannelCloseConfirm) Reset()         { *m = MsgProxyChannelCloseConfirm{} }
+func (m *MsgProxyChannelCloseConfirm) String() string { return proto.CompactTextString(m) }
+func (*MsgProxyChannelCloseConfirm) ProtoMessage()    {}
+func (*MsgProxyChannelCloseConfirm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68797dc99f8f4cd2, []int{20}
+}
+func (m *MsgProxyChannelCloseConfirm) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProxyChannelCloseConfirm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProxyChannelCloseConfirm.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgProxyChannelCloseConfirm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProxyChannelCloseConfirm.Merge(m, src)
+}
+func (m *MsgProxyChannelCloseConfirm) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProxyChannelCloseConfirm) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProxyChannelCloseConfirm.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProxyChannelCloseConfirm proto.InternalMessageInfo
+
+func (m *MsgProxyChannelCloseConfirm) GetUpstreamClientId() string {
+	if m != nil {
+		return m.UpstreamClientId
+	}
+	return ""
+}
+
+func (m *MsgProxyChannelCloseConfirm) GetUpstreamPrefix() types.MerklePrefix {
+	if m != nil {
+		return m.UpstreamPrefix
+	}
+	return types.MerklePrefix{}
+}
+
+func (m *MsgProxyChannelCloseConfirm) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *MsgProxyChannelCloseConfirm) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *MsgProxyChannelCloseConfirm) GetProofInit() []byte {
+	if m != nil {
+		return m.ProofInit
+	}
+	return nil
+}
+
+func (m *MsgProxyChannelCloseConfirm) GetProofHeight() types2.Height {
+	if m != nil {
+		return m.ProofHeight
+	}
+	return types2.Height{}
+}
+
+func (m *MsgProxyChannelCloseConfirm) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+type MsgProxyChannelCloseConfirmResponse struct {
+}
+
+func (m *MsgProxyChannelCloseConfirmResponse) Reset()         { *m = MsgProxyChannelCloseConfirmResponse{} }
+func (m *MsgProxyChannelCloseConfirmResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProxyChannelCloseConfirmResponse) ProtoMessage()    {}
+func (*MsgProxyChannelCloseConfirmResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68797dc99f8f4cd2, []int{21}
+}
+func (m *MsgProxyChannelCloseConfirmResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProxyChannelCloseConfirmResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProxyChannelCloseConfirmResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgProxyChannelCloseConfirmResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProxyChannelCloseConfirmResponse.Merge(m, src)
+}
+func (m *MsgProxyChannelCloseConfirmResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProxyChannelCloseConfirmResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProxyChannelCloseConfirmResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProxyChannelCloseConfirmResponse proto.InternalMessageInfo
+
+// MsgProxyChannelState proxies a channel that the upstream has already opened
+// for an upstream client that doesn't have it yet, e.g. a new upstream client that a proxy client migrates to.
+type MsgProxyChannelState struct {
+	UpstreamClientId string             `protobuf:"bytes,1,opt,name=upstream_client_id,json=upstreamClientId,proto3" json:"upstream_client_id,omitempty"`
+	UpstreamPrefix   types.MerklePrefix `protobuf:"bytes,2,opt,name=upstream_prefix,json=upstreamPrefix,proto3" json:"upstream_prefix"`
+	PortId           string             `protobuf:"bytes,3,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId        string             `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Channel          types4.Channel     `protobuf:"bytes,5,opt,name=channel,proto3" json:"channel"`
+	Proof            []byte             `protobuf:"bytes,6,opt,name=proof,proto3" json:"proof,omitempty"`
+	ProofHeight      types2.Height      `protobuf:"bytes,7,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+	Signer           string             `protobuf:"bytes,8,opt,name=signer,proto3" json:"signer,omitempty"`
+	// upstream_hops locates the connection ends of channel.connection_hops[1:] in the proxy store
+	UpstreamHops []ProxyConnectionHop `protobuf:"bytes,9,rep,name=upstream_hops,json=upstreamHops,proto3" json:"upstream_hops"`
+}
+
+func (m *MsgProxyChannelState) Reset()         { *m = MsgProxyChannelState{} }
+func (m *MsgProxyChannelState) String() string { return proto.CompactTextString(m) }
+func (*MsgProxyChannelState) ProtoMessage()    {}
+func (*MsgProxyChannelState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68797dc99f8f4cd2, []int{22}
+}
+func (m *MsgProxyChannelState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProxyChannelState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProxyChannelState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgProxyChannelState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProxyChannelState.Merge(m, src)
+}
+func (m *MsgProxyChannelState) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProxyChannelState) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProxyChannelState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProxyChannelState proto.InternalMessageInfo
+
+func (m *MsgProxyChannelState) GetUpstreamClientId() string {
+	if m != nil {
+		return m.UpstreamClientId
+	}
+	return ""
+}
+
+func (m *MsgProxyChannelState) GetUpstreamPrefix() types.MerklePrefix {
+	if m != nil {
+		return m.UpstreamPrefix
+	}
+	return types.MerklePrefix{}
+}
+
+func (m *MsgProxyChannelState) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *MsgProxyChannelState) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *MsgProxyChannelState) GetChannel() types4.Channel {
+	if m != nil {
+		return m.Channel
+	}
+	return types4.Channel{}
+}
+
+func (m *MsgProxyChannelState) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *MsgProxyChannelState) GetProofHeight() types2.Height {
+	if m != nil {
+		return m.ProofHeight
+	}
+	return types2.Height{}
+}
+
+func (m *MsgProxyChannelState) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgProxyChannelState) GetUpstreamHops() []ProxyConnectionHop {
+	if m != nil {
+		return m.UpstreamHops
+	}
+	return nil
+}
+
+type MsgProxyChannelStateResponse struct {
+}
+
+func (m *MsgProxyChannelStateResponse) Reset()         { *m = MsgProxyChannelStateResponse{} }
+func (m *MsgProxyChannelStateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProxyChannelStateResponse) ProtoMessage()    {}
+func (*MsgProxyChannelStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68797dc99f8f4cd2, []int{23}
+}
+func (m *MsgProxyChannelStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProxyChannelStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProxyChannelStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgProxyChannelStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProxyChannelStateResponse.Merge(m, src)
+}
+func (m *MsgProxyChannelStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProxyChannelStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProxyChannelStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProxyChannelStateResponse proto.InternalMessageInfo
+
 type MsgProxyRecvPacket struct {
 	UpstreamClientId string             `protobuf:"bytes,1,opt,name=upstream_client_id,json=upstreamClientId,proto3" json:"upstream_client_id,omitempty"`
 	UpstreamPrefix   types.MerklePrefix `protobuf:"bytes,2,opt,name=upstream_prefix,json=upstreamPrefix,proto3" json:"upstream_prefix"`
@@ -894,7 +1257,7 @@ func (m *MsgProxyRecvPacket) Reset()         { *m = MsgProxyRecvPacket{} }
 func (m *MsgProxyRecvPacket) String() string { return proto.CompactTextString(m) }
 func (*MsgProxyRecvPacket) ProtoMessage()    {}
 func (*MsgProxyRecvPacket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68797dc99f8f4cd2, []int{18}
+	return fileDescriptor_68797dc99f8f4cd2, []int{24}
 }
 func (m *MsgProxyRecvPacket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -930,7 +1293,7 @@ func (m *MsgProxyRecvPacketResponse) Reset()         { *m = MsgProxyRecvPacketRe
 func (m *MsgProxyRecvPacketResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgProxyRecvPacketResponse) ProtoMessage()    {}
 func (*MsgProxyRecvPacketResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68797dc99f8f4cd2, []int{19}
+	return fileDescriptor_68797dc99f8f4cd2, []int{25}
 }
 func (m *MsgProxyRecvPacketResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -973,7 +1336,7 @@ func (m *MsgProxyAcknowledgePacket) Reset()         { *m = MsgProxyAcknowledgePa
 func (m *MsgProxyAcknowledgePacket) String() string { return proto.CompactTextString(m) }
 func (*MsgProxyAcknowledgePacket) ProtoMessage()    {}
 func (*MsgProxyAcknowledgePacket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68797dc99f8f4cd2, []int{20}
+	return fileDescriptor_68797dc99f8f4cd2, []int{26}
 }
 func (m *MsgProxyAcknowledgePacket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1009,7 +1372,7 @@ func (m *MsgProxyAcknowledgePacketResponse) Reset()         { *m = MsgProxyAckno
 func (m *MsgProxyAcknowledgePacketResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgProxyAcknowledgePacketResponse) ProtoMessage()    {}
 func (*MsgProxyAcknowledgePacketResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68797dc99f8f4cd2, []int{21}
+	return fileDescriptor_68797dc99f8f4cd2, []int{27}
 }
 func (m *MsgProxyAcknowledgePacketResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1038,1856 +1401,2313 @@ func (m *MsgProxyAcknowledgePacketResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgProxyAcknowledgePacketResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgProxyClientState)(nil), "ibc.proxy.v1.MsgProxyClientState")
-	proto.RegisterType((*MsgProxyClientStateResponse)(nil), "ibc.proxy.v1.MsgProxyClientStateResponse")
-	proto.RegisterType((*MsgProxyConnectionOpenTry)(nil), "ibc.proxy.v1.MsgProxyConnectionOpenTry")
-	proto.RegisterType((*MsgProxyConnectionOpenTryResponse)(nil), "ibc.proxy.v1.MsgProxyConnectionOpenTryResponse")
-	proto.RegisterType((*MsgProxyConnectionOpenAck)(nil), "ibc.proxy.v1.MsgProxyConnectionOpenAck")
-	proto.RegisterType((*MsgProxyConnectionOpenAckResponse)(nil), "ibc.proxy.v1.MsgProxyConnectionOpenAckResponse")
-	proto.RegisterType((*MsgProxyConnectionOpenConfirm)(nil), "ibc.proxy.v1.MsgProxyConnectionOpenConfirm")
-	proto.RegisterType((*MsgProxyConnectionOpenConfirmResponse)(nil), "ibc.proxy.v1.MsgProxyConnectionOpenConfirmResponse")
-	proto.RegisterType((*MsgProxyConnectionOpenFinalize)(nil), "ibc.proxy.v1.MsgProxyConnectionOpenFinalize")
-	proto.RegisterType((*MsgProxyConnectionOpenFinalizeResponse)(nil), "ibc.proxy.v1.MsgProxyConnectionOpenFinalizeResponse")
-	proto.RegisterType((*MsgProxyChannelOpenTry)(nil), "ibc.proxy.v1.MsgProxyChannelOpenTry")
-	proto.RegisterType((*MsgProxyChannelOpenTryResponse)(nil), "ibc.proxy.v1.MsgProxyChannelOpenTryResponse")
-	proto.RegisterType((*MsgProxyChannelOpenAck)(nil), "ibc.proxy.v1.MsgProxyChannelOpenAck")
-	proto.RegisterType((*MsgProxyChannelOpenAckResponse)(nil), "ibc.proxy.v1.MsgProxyChannelOpenAckResponse")
-	proto.RegisterType((*MsgProxyChannelOpenConfirm)(nil), "ibc.proxy.v1.MsgProxyChannelOpenConfirm")
-	proto.RegisterType((*MsgProxyChannelOpenConfirmResponse)(nil), "ibc.proxy.v1.MsgProxyChannelOpenConfirmResponse")
-	proto.RegisterType((*MsgProxyChannelOpenFinalize)(nil), "ibc.proxy.v1.MsgProxyChannelOpenFinalize")
-	proto.RegisterType((*MsgProxyChannelOpenFinalizeResponse)(nil), "ibc.proxy.v1.MsgProxyChannelOpenFinalizeResponse")
-	proto.RegisterType((*MsgProxyRecvPacket)(nil), "ibc.proxy.v1.MsgProxyRecvPacket")
-	proto.RegisterType((*MsgProxyRecvPacketResponse)(nil), "ibc.proxy.v1.MsgProxyRecvPacketResponse")
-	proto.RegisterType((*MsgProxyAcknowledgePacket)(nil), "ibc.proxy.v1.MsgProxyAcknowledgePacket")
-	proto.RegisterType((*MsgProxyAcknowledgePacketResponse)(nil), "ibc.proxy.v1.MsgProxyAcknowledgePacketResponse")
+// MsgProxyPacketBatch proxies the commitments and the acknowledgements of many packets
+// that the upstream has at the same proof height.
+type MsgProxyPacketBatch struct {
+	UpstreamClientId string                 `protobuf:"bytes,1,opt,name=upstream_client_id,json=upstreamClientId,proto3" json:"upstream_client_id,omitempty"`
+	UpstreamPrefix   types.MerklePrefix     `protobuf:"bytes,2,opt,name=upstream_prefix,json=upstreamPrefix,proto3" json:"upstream_prefix"`
+	Items            []ProxyPacketBatchItem `protobuf:"bytes,3,rep,name=items,proto3" json:"items"`
+	// merkle proof whose lowest proof is an ICS-23 batch proof of the items that have no proof
+	BatchProof  []byte        `protobuf:"bytes,4,opt,name=batch_proof,json=batchProof,proto3" json:"batch_proof,omitempty"`
+	ProofHeight types2.Height `protobuf:"bytes,5,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+	// if true, a failed item reverts the whole batch
+	Atomic bool   `protobuf:"varint,6,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	Signer string `protobuf:"bytes,7,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func init() { proto.RegisterFile("ibc/modules/proxy/tx.proto", fileDescriptor_68797dc99f8f4cd2) }
-
-var fileDescriptor_68797dc99f8f4cd2 = []byte{
-	// 1454 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0x8f, 0xe3, 0x1f, 0xb1, 0x9f, 0xdd, 0xd8, 0xda, 0xa4, 0xe9, 0x76, 0xf3, 0xad, 0xe3, 0x38,
-	0xed, 0x37, 0xae, 0x08, 0xeb, 0x3a, 0x2d, 0x42, 0x20, 0x38, 0xa4, 0x15, 0xa8, 0x55, 0x29, 0x8d,
-	0x5c, 0xc4, 0x01, 0x09, 0x85, 0xf5, 0x7a, 0x62, 0xaf, 0x6c, 0xef, 0xae, 0x76, 0xd7, 0x6e, 0xcc,
-	0x01, 0xc1, 0x01, 0x09, 0x6e, 0xfc, 0x09, 0xfd, 0x0b, 0xf8, 0x33, 0x50, 0x6f, 0xf4, 0xc0, 0x01,
-	0x71, 0x40, 0xa8, 0xbd, 0x70, 0x41, 0x88, 0x3b, 0x07, 0xb4, 0x33, 0xb3, 0xb3, 0xb3, 0x6b, 0xaf,
-	0xbd, 0x69, 0x5a, 0xd4, 0xa0, 0xdc, 0x3c, 0x33, 0x9f, 0x79, 0xef, 0xcd, 0xfb, 0xbc, 0xf7, 0xe6,
-	0xcd, 0x1a, 0x24, 0xad, 0xa5, 0xd6, 0x07, 0x46, 0x7b, 0xd8, 0x47, 0x76, 0xdd, 0xb4, 0x8c, 0xa3,
-	0x71, 0xdd, 0x39, 0x92, 0x4d, 0xcb, 0x70, 0x0c, 0xa1, 0xa0, 0xb5, 0x54, 0x19, 0xcf, 0xc9, 0xa3,
-	0x86, 0xb4, 0xda, 0x31, 0x3a, 0x06, 0x5e, 0xa8, 0xbb, 0xbf, 0x08, 0x46, 0xda, 0x70, 0xf7, 0xab,
-	0x86, 0x85, 0xea, 0x6a, 0x5f, 0x43, 0xba, 0x53, 0x1f, 0x35, 0xe8, 0x2f, 0x0a, 0xd8, 0xf6, 0x01,
-	0x86, 0xae, 0x23, 0xd5, 0xd1, 0x0c, 0x1d, 0x83, 0xd8, 0x88, 0x02, 0x37, 0x7d, 0x60, 0x57, 0xd1,
-	0x75, 0xd4, 0xc7, 0x28, 0xf2, 0x73, 0x8a, 0xac, 0xc1, 0x40, 0x73, 0x06, 0x9e, 0x42, 0x36, 0xa2,
-	0xc0, 0x8b, 0x1d, 0xc3, 0xe8, 0xf4, 0x51, 0x1d, 0x8f, 0x5a, 0xc3, 0xc3, 0xba, 0xa2, 0x8f, 0xc9,
-	0x52, 0xf5, 0x87, 0x14, 0xac, 0xdc, 0xb3, 0x3b, 0xfb, 0xee, 0xb1, 0x6e, 0x61, 0x43, 0x1f, 0x38,
-	0x8a, 0x83, 0x84, 0x1d, 0x10, 0x86, 0xa6, 0xed, 0x58, 0x48, 0x19, 0x1c, 0x90, 0x03, 0x1c, 0x68,
-	0x6d, 0x31, 0x51, 0x49, 0xd4, 0x72, 0xcd, 0x92, 0xb7, 0x42, 0x36, 0xdc, 0x69, 0x0b, 0x0f, 0xa0,
-	0xc8, 0xd0, 0xa6, 0x85, 0x0e, 0xb5, 0x23, 0x71, 0xb1, 0x92, 0xa8, 0xe5, 0x77, 0x2f, 0xcb, 0xae,
-	0xd3, 0x5c, 0x1b, 0x65, 0xce, 0xaa, 0x51, 0x43, 0xbe, 0x87, 0xac, 0x5e, 0x1f, 0xed, 0x63, 0xec,
-	0xcd, 0xd4, 0xe3, 0x5f, 0x37, 0x16, 0x9a, 0xcb, 0x9e, 0x08, 0x32, 0x2b, 0xdc, 0x80, 0x35, 0xd5,
-	0x18, 0xea, 0x0e, 0xb2, 0x4c, 0xc5, 0x72, 0xc6, 0x9c, 0x19, 0x49, 0x6c, 0xc6, 0x2a, 0xbf, 0xca,
-	0x4c, 0x79, 0x13, 0x0a, 0x14, 0x68, 0xbb, 0x07, 0x11, 0x53, 0xd8, 0x8e, 0x55, 0x99, 0xb8, 0x40,
-	0xf6, 0x5c, 0x20, 0xef, 0xe9, 0xe3, 0x66, 0x5e, 0xe5, 0x4e, 0xfc, 0x2e, 0x14, 0x55, 0x43, 0xb7,
-	0x91, 0x6e, 0x0f, 0x6d, 0xba, 0x37, 0x3d, 0x63, 0xef, 0x32, 0x03, 0x93, 0xed, 0x9b, 0x50, 0x30,
-	0x2d, 0xc3, 0x38, 0xa4, 0x66, 0x8a, 0x99, 0x4a, 0xa2, 0x56, 0x68, 0xe6, 0xf1, 0x1c, 0x31, 0x4e,
-	0xd8, 0x86, 0x22, 0x85, 0x78, 0x5b, 0xc5, 0x25, 0x8c, 0x5a, 0x26, 0x28, 0x6f, 0x56, 0xb8, 0xe5,
-	0xc9, 0xea, 0x22, 0xad, 0xd3, 0x75, 0xc4, 0x2c, 0xb6, 0x43, 0xe2, 0x7c, 0x49, 0x42, 0x6a, 0xd4,
-	0x90, 0x6f, 0x63, 0x04, 0xf5, 0x20, 0xd1, 0x46, 0xa6, 0x84, 0xbb, 0x50, 0xf2, 0xcf, 0x43, 0x05,
-	0xe5, 0x62, 0x0a, 0xf2, 0x3d, 0x41, 0x85, 0xad, 0x41, 0xc6, 0xd6, 0x3a, 0x3a, 0xb2, 0x44, 0xc0,
-	0xbe, 0xa7, 0xa3, 0xb7, 0xb3, 0xdf, 0x3c, 0xda, 0x58, 0xf8, 0xfd, 0xd1, 0xc6, 0x42, 0xf5, 0x12,
-	0xac, 0x4f, 0x89, 0xa3, 0x26, 0xb2, 0x4d, 0x57, 0x54, 0xf5, 0xaf, 0x25, 0xb8, 0xc8, 0xd6, 0x59,
-	0xac, 0xdf, 0x37, 0x91, 0xfe, 0x91, 0x35, 0x16, 0xb6, 0xe0, 0x9c, 0x9f, 0x00, 0x7e, 0xa0, 0x15,
-	0xfc, 0xc9, 0x97, 0x15, 0x64, 0x77, 0x01, 0x7c, 0x25, 0x38, 0xb0, 0xf2, 0xbb, 0x57, 0x78, 0x79,
-	0x2c, 0x2d, 0x47, 0x0d, 0xd9, 0x37, 0xfc, 0x3d, 0xbd, 0x4d, 0x05, 0x72, 0xdb, 0x85, 0x0f, 0xe0,
-	0x42, 0xdb, 0x78, 0xa8, 0x07, 0xd3, 0x66, 0x7e, 0x18, 0x9e, 0xf7, 0x37, 0xf1, 0x29, 0xd8, 0x04,
-	0x89, 0x97, 0x76, 0x8c, 0xd8, 0x14, 0x39, 0x81, 0xc1, 0x28, 0xbd, 0x09, 0x02, 0xae, 0x60, 0x41,
-	0xe3, 0x32, 0x33, 0x64, 0x95, 0xcc, 0x70, 0x69, 0xb8, 0x04, 0x40, 0xa2, 0x53, 0xd3, 0x35, 0x87,
-	0x46, 0x70, 0x0e, 0xcf, 0xdc, 0xd1, 0x35, 0x67, 0x22, 0x11, 0xb2, 0xb1, 0x12, 0x21, 0x17, 0x2b,
-	0x11, 0xe0, 0x45, 0x25, 0x42, 0xfe, 0x79, 0x13, 0x61, 0x07, 0x3b, 0xd0, 0x38, 0x3c, 0xe0, 0xdd,
-	0x28, 0x16, 0xb0, 0xf5, 0x25, 0xbc, 0xc2, 0xa5, 0x80, 0xb0, 0x0b, 0xe7, 0x03, 0x68, 0x76, 0xdc,
-	0x73, 0x78, 0xc3, 0x0a, 0xb7, 0x81, 0x9d, 0xf9, 0xc3, 0xa0, 0x06, 0x6a, 0xf0, 0x72, 0x4c, 0x83,
-	0x39, 0x1b, 0xa8, 0xc5, 0x1f, 0xc3, 0x5a, 0x48, 0xbb, 0x27, 0xb3, 0x18, 0x53, 0xe6, 0xaa, 0x19,
-	0xb0, 0x70, 0xa2, 0x24, 0x94, 0x22, 0x4a, 0xc2, 0x16, 0x6c, 0x46, 0xa6, 0x3c, 0x2b, 0x0c, 0x7f,
-	0x46, 0x16, 0x86, 0x3d, 0xb5, 0x77, 0x56, 0x18, 0x4e, 0x53, 0x61, 0x58, 0x07, 0x52, 0x06, 0x0e,
-	0x1c, 0x6b, 0x4c, 0xeb, 0x42, 0x16, 0x4f, 0xb8, 0x25, 0xfe, 0xac, 0x2c, 0x9c, 0x95, 0x85, 0x39,
-	0x65, 0x61, 0x4f, 0xed, 0xb1, 0xb2, 0xf0, 0x6d, 0x12, 0x2e, 0x4d, 0x47, 0xdd, 0x32, 0xf4, 0x43,
-	0xcd, 0x1a, 0xc4, 0x2b, 0x0d, 0xd3, 0xdb, 0xd8, 0xc5, 0xf8, 0x6d, 0x6c, 0xf2, 0xc4, 0x85, 0xe4,
-	0x1d, 0x90, 0x82, 0x6d, 0x6c, 0xc0, 0xe8, 0x14, 0x36, 0x45, 0x0c, 0xb4, 0xb2, 0xfc, 0x01, 0x58,
-	0x4e, 0x29, 0x6a, 0x0f, 0xa7, 0xb6, 0x97, 0x53, 0x6e, 0x75, 0x0c, 0xe7, 0x41, 0xe6, 0x79, 0xf2,
-	0xc0, 0x27, 0x6c, 0x29, 0x82, 0xb0, 0x6d, 0xb8, 0x32, 0x93, 0x0a, 0x46, 0xda, 0x4f, 0x8b, 0x50,
-	0x9e, 0x8e, 0x7c, 0x5f, 0xd3, 0x95, 0xbe, 0xf6, 0x39, 0x3a, 0x35, 0xac, 0x6d, 0xc1, 0x39, 0x56,
-	0x8b, 0xdc, 0x33, 0x62, 0xa2, 0x0a, 0xcd, 0x82, 0x57, 0x89, 0x70, 0x08, 0x86, 0xfd, 0x9f, 0x3e,
-	0x99, 0xff, 0x33, 0x11, 0xfe, 0xaf, 0xc1, 0xff, 0x67, 0x7b, 0x95, 0x11, 0xf0, 0x77, 0x12, 0xd6,
-	0x18, 0x94, 0xbc, 0x15, 0xbd, 0x16, 0xfb, 0x15, 0x78, 0xd0, 0x5d, 0x83, 0xb4, 0x61, 0xb5, 0x91,
-	0x85, 0xe9, 0x59, 0x0e, 0xf8, 0x89, 0xbe, 0x6b, 0x47, 0x0d, 0xf9, 0xbe, 0x8b, 0x68, 0x12, 0xa0,
-	0x7b, 0x23, 0x70, 0xd1, 0xd2, 0x35, 0x4c, 0x5b, 0x4c, 0x55, 0x92, 0xb5, 0x1c, 0x7e, 0x7d, 0xd1,
-	0xe9, 0xdb, 0x86, 0x69, 0x0b, 0x17, 0x60, 0xc9, 0x34, 0x2c, 0x7c, 0xa4, 0x34, 0xf1, 0xa2, 0x3b,
-	0xbc, 0xd3, 0x76, 0x9b, 0x55, 0x2a, 0xdc, 0x5d, 0x23, 0x1e, 0xce, 0xd1, 0x19, 0x12, 0x69, 0xdc,
-	0x55, 0xea, 0x89, 0x20, 0x89, 0x50, 0xf2, 0x57, 0xf6, 0x89, 0x30, 0x11, 0x96, 0x46, 0xc8, 0xb2,
-	0xdd, 0x86, 0x20, 0x8b, 0x21, 0xde, 0x30, 0xd4, 0x13, 0xe7, 0xc2, 0x3d, 0xf1, 0x0b, 0xb9, 0xb0,
-	0xfc, 0x40, 0xc9, 0x47, 0x04, 0x4a, 0x85, 0x4b, 0xbf, 0x00, 0xfb, 0x2c, 0x40, 0xbe, 0x4f, 0x4d,
-	0x0d, 0x10, 0xb7, 0x98, 0x9c, 0x05, 0xc8, 0xc9, 0x03, 0x64, 0x17, 0xce, 0xf3, 0x9d, 0x99, 0x2f,
-	0x97, 0x84, 0xcb, 0x0a, 0xd7, 0x7e, 0x31, 0x0d, 0x5c, 0x50, 0xe5, 0x82, 0x41, 0x15, 0xe8, 0xa7,
-	0x20, 0xd4, 0x4f, 0x85, 0x43, 0x2a, 0x7f, 0xb2, 0x90, 0x2a, 0x1c, 0x2b, 0xa4, 0xf8, 0x9b, 0xfa,
-	0xeb, 0x24, 0x48, 0x53, 0x20, 0x5e, 0x8d, 0x7c, 0x05, 0xc2, 0x8a, 0xe3, 0x3e, 0x39, 0x83, 0xfb,
-	0x54, 0x98, 0xfb, 0x48, 0x36, 0xd3, 0xd1, 0x6c, 0x06, 0xee, 0xeb, 0xcc, 0x9c, 0xfb, 0x7a, 0xe9,
-	0x64, 0x9c, 0x65, 0x79, 0xce, 0xaa, 0x97, 0xa1, 0x1a, 0x4d, 0x03, 0x63, 0xeb, 0x97, 0x45, 0xee,
-	0x3b, 0x8d, 0x0f, 0x63, 0xf7, 0xf3, 0x29, 0xa6, 0x6b, 0xe2, 0xca, 0x4e, 0xc7, 0xb8, 0xb2, 0x5f,
-	0x64, 0xcb, 0x54, 0xbd, 0x02, 0x5b, 0x33, 0x7c, 0xcb, 0x38, 0xf8, 0x71, 0x11, 0x04, 0x0f, 0xd7,
-	0x44, 0xea, 0x68, 0x5f, 0x51, 0x7b, 0xc8, 0x79, 0x15, 0x5c, 0xff, 0x16, 0x64, 0x4c, 0x6c, 0x0c,
-	0xed, 0xa0, 0xd6, 0xa7, 0x56, 0x60, 0x62, 0x2f, 0x15, 0x41, 0x37, 0x08, 0xab, 0x90, 0xc6, 0x2e,
-	0xa2, 0x8d, 0x12, 0x19, 0xfc, 0x5b, 0x1d, 0xd2, 0xff, 0xfc, 0x12, 0xe4, 0x3b, 0x94, 0xf9, 0xfb,
-	0xcb, 0xa4, 0xff, 0x89, 0x61, 0x4f, 0xed, 0xe9, 0xc6, 0xc3, 0x3e, 0x6a, 0x77, 0xd0, 0x7f, 0xc2,
-	0xed, 0x35, 0x28, 0x2a, 0xfe, 0x91, 0x5c, 0x9d, 0x94, 0x80, 0xf0, 0xb4, 0x4f, 0x50, 0x7a, 0x16,
-	0x41, 0x2f, 0xe9, 0x09, 0xc1, 0xbd, 0xf9, 0x26, 0x18, 0xf0, 0x78, 0xda, 0xfd, 0x23, 0x07, 0xc9,
-	0x7b, 0x76, 0x47, 0xf8, 0x0c, 0x4a, 0x13, 0xff, 0x47, 0x6c, 0xca, 0xfc, 0xbf, 0x2f, 0xf2, 0x94,
-	0x4f, 0xcd, 0xd2, 0xd5, 0xb9, 0x10, 0x4f, 0x93, 0x60, 0xc1, 0x5a, 0xc4, 0x97, 0xe8, 0xed, 0x08,
-	0x21, 0x61, 0xa0, 0x54, 0x8f, 0x09, 0x9c, 0xa3, 0xd3, 0xbd, 0x16, 0x62, 0xe9, 0xdc, 0x53, 0x7b,
-	0xf1, 0x74, 0x72, 0x77, 0xb3, 0xf0, 0x05, 0x48, 0x33, 0x5e, 0xd0, 0xaf, 0xc5, 0x11, 0x47, 0xc1,
-	0xd2, 0xf5, 0x63, 0x80, 0x99, 0xfe, 0xaf, 0x12, 0xb0, 0x3e, 0xeb, 0x35, 0xb8, 0x13, 0x47, 0xa8,
-	0x87, 0x96, 0x6e, 0x1c, 0x07, 0xcd, 0x6c, 0xd0, 0x60, 0x65, 0xda, 0x7b, 0xe8, 0x72, 0x84, 0xb0,
-	0x00, 0x4a, 0xda, 0x89, 0x83, 0x9a, 0xa5, 0xca, 0xe5, 0x77, 0xbe, 0x2a, 0x97, 0xdc, 0x9d, 0x38,
-	0x28, 0xa6, 0x6a, 0x08, 0x17, 0xa2, 0x3a, 0xae, 0xda, 0x5c, 0x41, 0x1e, 0xa7, 0xd7, 0xe2, 0x22,
-	0x99, 0xda, 0x23, 0x10, 0x23, 0x5b, 0x87, 0xab, 0x73, 0xa5, 0x31, 0x26, 0x1b, 0xb1, 0xa1, 0x4c,
-	0xf3, 0xa7, 0x50, 0x0c, 0x5f, 0x98, 0x95, 0xe9, 0x52, 0x7c, 0x84, 0x54, 0x9b, 0x87, 0x98, 0xc8,
-	0xce, 0xc9, 0xfb, 0x21, 0x22, 0x3b, 0x27, 0x80, 0x51, 0xd9, 0x19, 0x59, 0xef, 0x6e, 0xde, 0x7f,
-	0xfc, 0xb4, 0x9c, 0x78, 0xf2, 0xb4, 0x9c, 0xf8, 0xed, 0x69, 0x39, 0xf1, 0xdd, 0xb3, 0xf2, 0xc2,
-	0x93, 0x67, 0xe5, 0x85, 0x9f, 0x9f, 0x95, 0x17, 0x3e, 0x79, 0xa3, 0xa3, 0x39, 0xdd, 0x61, 0xcb,
-	0xbd, 0x49, 0xea, 0x6d, 0xc5, 0x51, 0xd4, 0xae, 0xa2, 0xe9, 0x7d, 0xa5, 0x55, 0xd7, 0x5a, 0xea,
-	0xeb, 0xe4, 0x6f, 0xe9, 0xd0, 0x9f, 0xd4, 0x63, 0x13, 0xd9, 0xad, 0x0c, 0xfe, 0x40, 0x7b, 0xfd,
-	0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x89, 0x9d, 0x10, 0x23, 0xc6, 0x1e, 0x00, 0x00,
+func (m *MsgProxyPacketBatch) Reset()         { *m = MsgProxyPacketBatch{} }
+func (m *MsgProxyPacketBatch) String() string { return proto.CompactTextString(m) }
+func (*MsgProxyPacketBatch) ProtoMessage()    {}
+func (*MsgProxyPacketBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68797dc99f8f4cd2, []int{28}
+}
+func (m *MsgProxyPacketBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProxyPacketBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProxyPacketBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgProxyPacketBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProxyPacketBatch.Merge(m, src)
+}
+func (m *MsgProxyPacketBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProxyPacketBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProxyPacketBatch.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgProxyPacketBatch proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	ProxyClientState(ctx context.Context, in *MsgProxyClientState, opts ...grpc.CallOption) (*MsgProxyClientStateResponse, error)
-	ProxyConnectionOpenTry(ctx context.Context, in *MsgProxyConnectionOpenTry, opts ...grpc.CallOption) (*MsgProxyConnectionOpenTryResponse, error)
-	ProxyConnectionOpenAck(ctx context.Context, in *MsgProxyConnectionOpenAck, opts ...grpc.CallOption) (*MsgProxyConnectionOpenAckResponse, error)
-	ProxyConnectionOpenConfirm(ctx context.Context, in *MsgProxyConnectionOpenConfirm, opts ...grpc.CallOption) (*MsgProxyConnectionOpenConfirmResponse, error)
-	ProxyConnectionOpenFinalize(ctx context.Context, in *MsgProxyConnectionOpenFinalize, opts ...grpc.CallOption) (*MsgProxyConnectionOpenFinalizeResponse, error)
-	ProxyChannelOpenTry(ctx context.Context, in *MsgProxyChannelOpenTry, opts ...grpc.CallOption) (*MsgProxyChannelOpenTryResponse, error)
-	ProxyChannelOpenAck(ctx context.Context, in *MsgProxyChannelOpenAck, opts ...grpc.CallOption) (*MsgProxyChannelOpenAckResponse, error)
-	ProxyChannelOpenConfirm(ctx context.Context, in *MsgProxyChannelOpenConfirm, opts ...grpc.CallOption) (*MsgProxyChannelOpenConfirmResponse, error)
-	ProxyChannelOpenFinalize(ctx context.Context, in *MsgProxyChannelOpenFinalize, opts ...grpc.CallOption) (*MsgProxyChannelOpenFinalizeResponse, error)
-	ProxyRecvPacket(ctx context.Context, in *MsgProxyRecvPacket, opts ...grpc.CallOption) (*MsgProxyRecvPacketResponse, error)
-	ProxyAcknowledgePacket(ctx context.Context, in *MsgProxyAcknowledgePacket, opts ...grpc.CallOption) (*MsgProxyAcknowledgePacketResponse, error)
+// ProxyPacketBatchItem is a packet commitment or a packet acknowledgement in a batch
+type ProxyPacketBatchItem struct {
+	Packet types4.Packet `protobuf:"bytes,1,opt,name=packet,proto3" json:"packet"`
+	// acknowledgement of the packet. If empty, the item proxies the packet commitment.
+	Acknowledgement []byte `protobuf:"bytes,2,opt,name=acknowledgement,proto3" json:"acknowledgement,omitempty"`
+	// proof of the item. If empty, it is taken from the batch proof.
+	Proof []byte `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *ProxyPacketBatchItem) Reset()         { *m = ProxyPacketBatchItem{} }
+func (m *ProxyPacketBatchItem) String() string { return proto.CompactTextString(m) }
+func (*ProxyPacketBatchItem) ProtoMessage()    {}
+func (*ProxyPacketBatchItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68797dc99f8f4cd2, []int{29}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *ProxyPacketBatchItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) ProxyClientState(ctx context.Context, in *MsgProxyClientState, opts ...grpc.CallOption) (*MsgProxyClientStateResponse, error) {
-	out := new(MsgProxyClientStateResponse)
-	err := c.cc.Invoke(ctx, "/ibc.proxy.v1.Msg/ProxyClientState", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ProxyPacketBatchItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProxyPacketBatchItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *msgClient) ProxyConnectionOpenTry(ctx context.Context, in *MsgProxyConnectionOpenTry, opts ...grpc.CallOption) (*MsgProxyConnectionOpenTryResponse, error) {
-	out := new(MsgProxyConnectionOpenTryResponse)
-	err := c.cc.Invoke(ctx, "/ibc.proxy.v1.Msg/ProxyConnectionOpenTry", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ProxyPacketBatchItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProxyPacketBatchItem.Merge(m, src)
 }
-
-func (c *msgClient) ProxyConnectionOpenAck(ctx context.Context, in *MsgProxyConnectionOpenAck, opts ...grpc.CallOption) (*MsgProxyConnectionOpenAckResponse, error) {
-	out := new(MsgProxyConnectionOpenAckResponse)
-	err := c.cc.Invoke(ctx, "/ibc.proxy.v1.Msg/ProxyConnectionOpenAck", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ProxyPacketBatchItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProxyPacketBatchItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProxyPacketBatchItem.DiscardUnknown(m)
 }
 
-func (c *msgClient) ProxyConnectionOpenConfirm(ctx context.Context, in *MsgProxyConnectionOpenConfirm, opts ...grpc.CallOption) (*MsgProxyConnectionOpenConfirmResponse, error) {
-	out := new(MsgProxyConnectionOpenConfirmResponse)
-	err := c.cc.Invoke(ctx, "/ibc.proxy.v1.Msg/ProxyConnectionOpenConfirm", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_ProxyPacketBatchItem proto.InternalMessageInfo
+
+type MsgProxyPacketBatchResponse struct {
+	// results in the same order as the items
+	Results []ProxyPacketBatchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
 }
 
-func (c *msgClient) ProxyConnectionOpenFinalize(ctx context.Context, in *MsgProxyConnectionOpenFinalize, opts ...grpc.CallOption) (*MsgProxyConnectionOpenFinalizeResponse, error) {
-	out := new(MsgProxyConnectionOpenFinalizeResponse)
-	err := c.cc.Invoke(ctx, "/ibc.proxy.v1.Msg/ProxyConnectionOpenFinalize", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgProxyPacketBatchResponse) Reset()         { *m = MsgProxyPacketBatchResponse{} }
+func (m *MsgProxyPacketBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProxyPacketBatchResponse) ProtoMessage()    {}
+func (*MsgProxyPacketBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68797dc99f8f4cd2, []int{30}
+}
+func (m *MsgProxyPacketBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProxyPacketBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProxyPacketBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgProxyPacketBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProxyPacketBatchResponse.Merge(m, src)
+}
+func (m *MsgProxyPacketBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProxyPacketBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProxyPacketBatchResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) ProxyChannelOpenTry(ctx context.Context, in *MsgProxyChannelOpenTry, opts ...grpc.CallOption) (*MsgProxyChannelOpenTryResponse, error) {
-	out := new(MsgProxyChannelOpenTryResponse)
-	err := c.cc.Invoke(ctx, "/ibc.proxy.v1.Msg/ProxyChannelOpenTry", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgProxyPacketBatchResponse proto.InternalMessageInfo
+
+func (m *MsgProxyPacketBatchResponse) GetResults() []ProxyPacketBatchResult {
+	if m != nil {
+		return m.Results
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) ProxyChannelOpenAck(ctx context.Context, in *MsgProxyChannelOpenAck, opts ...grpc.CallOption) (*MsgProxyChannelOpenAckResponse, error) {
-	out := new(MsgProxyChannelOpenAckResponse)
-	err := c.cc.Invoke(ctx, "/ibc.proxy.v1.Msg/ProxyChannelOpenAck", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// ProxyPacketBatchResult is the result of an item in a batch. The code is 0 if the item succeeded.
+type ProxyPacketBatchResult struct {
+	Codespace string `protobuf:"bytes,1,opt,name=codespace,proto3" json:"codespace,omitempty"`
+	Code      uint32 `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Log       string `protobuf:"bytes,3,opt,name=log,proto3" json:"log,omitempty"`
 }
 
-func (c *msgClient) ProxyChannelOpenConfirm(ctx context.Context, in *MsgProxyChannelOpenConfirm, opts ...grpc.CallOption) (*MsgProxyChannelOpenConfirmResponse, error) {
-	out := new(MsgProxyChannelOpenConfirmResponse)
-	err := c.cc.Invoke(ctx, "/ibc.proxy.v1.Msg/ProxyChannelOpenConfirm", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ProxyPacketBatchResult) Reset()         { *m = ProxyPacketBatchResult{} }
+func (m *ProxyPacketBatchResult) String() string { return proto.CompactTextString(m) }
+func (*ProxyPacketBatchResult) ProtoMessage()    {}
+func (*ProxyPacketBatchResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68797dc99f8f4cd2, []int{31}
+}
+func (m *ProxyPacketBatchResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProxyPacketBatchResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProxyPacketBatchResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ProxyPacketBatchResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProxyPacketBatchResult.Merge(m, src)
+}
+func (m *ProxyPacketBatchResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProxyPacketBatchResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProxyPacketBatchResult.DiscardUnknown(m)
 }
 
-func (c *msgClient) ProxyChannelOpenFinalize(ctx context.Context, in *MsgProxyChannelOpenFinalize, opts ...grpc.CallOption) (*MsgProxyChannelOpenFinalizeResponse, error) {
-	out := new(MsgProxyChannelOpenFinalizeResponse)
-	err := c.cc.Invoke(ctx, "/ibc.proxy.v1.Msg/ProxyChannelOpenFinalize", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ProxyPacketBatchResult proto.InternalMessageInfo
+
+func (m *ProxyPacketBatchResult) GetCodespace() string {
+	if m != nil {
+		return m.Codespace
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) ProxyRecvPacket(ctx context.Context, in *MsgProxyRecvPacket, opts ...grpc.CallOption) (*MsgProxyRecvPacketResponse, error) {
-	out := new(MsgProxyRecvPacketResponse)
-	err := c.cc.Invoke(ctx, "/ibc.proxy.v1.Msg/ProxyRecvPacket", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ProxyPacketBatchResult) GetCode() uint32 {
+	if m != nil {
+		return m.Code
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) ProxyAcknowledgePacket(ctx context.Context, in *MsgProxyAcknowledgePacket, opts ...grpc.CallOption) (*MsgProxyAcknowledgePacketResponse, error) {
-	out := new(MsgProxyAcknowledgePacketResponse)
-	err := c.cc.Invoke(ctx, "/ibc.proxy.v1.Msg/ProxyAcknowledgePacket", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ProxyPacketBatchResult) GetLog() string {
+	if m != nil {
+		return m.Log
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	ProxyClientState(context.Context, *MsgProxyClientState) (*MsgProxyClientStateResponse, error)
-	ProxyConnectionOpenTry(context.Context, *MsgProxyConnectionOpenTry) (*MsgProxyConnectionOpenTryResponse, error)
-	ProxyConnectionOpenAck(context.Context, *MsgProxyConnectionOpenAck) (*MsgProxyConnectionOpenAckResponse, error)
-	ProxyConnectionOpenConfirm(context.Context, *MsgProxyConnectionOpenConfirm) (*MsgProxyConnectionOpenConfirmResponse, error)
-	ProxyConnectionOpenFinalize(context.Context, *MsgProxyConnectionOpenFinalize) (*MsgProxyConnectionOpenFinalizeResponse, error)
-	ProxyChannelOpenTry(context.Context, *MsgProxyChannelOpenTry) (*MsgProxyChannelOpenTryResponse, error)
-	ProxyChannelOpenAck(context.Context, *MsgProxyChannelOpenAck) (*MsgProxyChannelOpenAckResponse, error)
-	ProxyChannelOpenConfirm(context.Context, *MsgProxyChannelOpenConfirm) (*MsgProxyChannelOpenConfirmResponse, error)
-	ProxyChannelOpenFinalize(context.Context, *MsgProxyChannelOpenFinalize) (*MsgProxyChannelOpenFinalizeResponse, error)
-	ProxyRecvPacket(context.Context, *MsgProxyRecvPacket) (*MsgProxyRecvPacketResponse, error)
-	ProxyAcknowledgePacket(context.Context, *MsgProxyAcknowledgePacket) (*MsgProxyAcknowledgePacketResponse, error)
+type MsgProxyTimeoutPacket struct {
+	UpstreamClientId string             `protobuf:"bytes,1,opt,name=upstream_client_id,json=upstreamClientId,proto3" json:"upstream_client_id,omitempty"`
+	UpstreamPrefix   types.MerklePrefix `protobuf:"bytes,2,opt,name=upstream_prefix,json=upstreamPrefix,proto3" json:"upstream_prefix"`
+	Packet           types4.Packet      `protobuf:"bytes,3,opt,name=packet,proto3" json:"packet"`
+	ProofUnreceived  []byte             `protobuf:"bytes,4,opt,name=proof_unreceived,json=proofUnreceived,proto3" json:"proof_unreceived,omitempty"`
+	ProofHeight      types2.Height      `protobuf:"bytes,5,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+	NextSequenceRecv uint64             `protobuf:"varint,6,opt,name=next_sequence_recv,json=nextSequenceRecv,proto3" json:"next_sequence_recv,omitempty"`
+	Signer           string             `protobuf:"bytes,7,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgProxyTimeoutPacket) Reset()         { *m = MsgProxyTimeoutPacket{} }
+func (m *MsgProxyTimeoutPacket) String() string { return proto.CompactTextString(m) }
+func (*MsgProxyTimeoutPacket) ProtoMessage()    {}
+func (*MsgProxyTimeoutPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68797dc99f8f4cd2, []int{32}
 }
-
-func (*UnimplementedMsgServer) ProxyClientState(ctx context.Context, req *MsgProxyClientState) (*MsgProxyClientStateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProxyClientState not implemented")
+func (m *MsgProxyTimeoutPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) ProxyConnectionOpenTry(ctx context.Context, req *MsgProxyConnectionOpenTry) (*MsgProxyConnectionOpenTryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProxyConnectionOpenTry not implemented")
+func (m *MsgProxyTimeoutPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProxyTimeoutPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) ProxyConnectionOpenAck(ctx context.Context, req *MsgProxyConnectionOpenAck) (*MsgProxyConnectionOpenAckResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProxyConnectionOpenAck not implemented")
+func (m *MsgProxyTimeoutPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProxyTimeoutPacket.Merge(m, src)
 }
-func (*UnimplementedMsgServer) ProxyConnectionOpenConfirm(ctx context.Context, req *MsgProxyConnectionOpenConfirm) (*MsgProxyConnectionOpenConfirmResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProxyConnectionOpenConfirm not implemented")
+func (m *MsgProxyTimeoutPacket) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) ProxyConnectionOpenFinalize(ctx context.Context, req *MsgProxyConnectionOpenFinalize) (*MsgProxyConnectionOpenFinalizeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProxyConnectionOpenFinalize not implemented")
+func (m *MsgProxyTimeoutPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProxyTimeoutPacket.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) ProxyChannelOpenTry(ctx context.Context, req *MsgProxyChannelOpenTry) (*MsgProxyChannelOpenTryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProxyChannelOpenTry not implemented")
+
+var xxx_messageInfo_MsgProxyTimeoutPacket proto.InternalMessageInfo
+
+type MsgProxyTimeoutPacketResponse struct {
 }
-func (*UnimplementedMsgServer) ProxyChannelOpenAck(ctx context.Context, req *MsgProxyChannelOpenAck) (*MsgProxyChannelOpenAckResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProxyChannelOpenAck not implemented")
+
+func (m *MsgProxyTimeoutPacketResponse) Reset()         { *m = MsgProxyTimeoutPacketResponse{} }
+func (m *MsgProxyTimeoutPacketResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProxyTimeoutPacketResponse) ProtoMessage()    {}
+func (*MsgProxyTimeoutPacketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68797dc99f8f4cd2, []int{33}
 }
-func (*UnimplementedMsgServer) ProxyChannelOpenConfirm(ctx context.Context, req *MsgProxyChannelOpenConfirm) (*MsgProxyChannelOpenConfirmResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProxyChannelOpenConfirm not implemented")
+func (m *MsgProxyTimeoutPacketResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) ProxyChannelOpenFinalize(ctx context.Context, req *MsgProxyChannelOpenFinalize) (*MsgProxyChannelOpenFinalizeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProxyChannelOpenFinalize not implemented")
+func (m *MsgProxyTimeoutPacketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProxyTimeoutPacketResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) ProxyRecvPacket(ctx context.Context, req *MsgProxyRecvPacket) (*MsgProxyRecvPacketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProxyRecvPacket not implemented")
+func (m *MsgProxyTimeoutPacketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProxyTimeoutPacketResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) ProxyAcknowledgePacket(ctx context.Context, req *MsgProxyAcknowledgePacket) (*MsgProxyAcknowledgePacketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProxyAcknowledgePacket not implemented")
+func (m *MsgProxyTimeoutPacketResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProxyTimeoutPacketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProxyTimeoutPacketResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgProxyTimeoutPacketResponse proto.InternalMessageInfo
+
+type MsgProxyTimeoutOnClose struct {
+	UpstreamClientId string             `protobuf:"bytes,1,opt,name=upstream_client_id,json=upstreamClientId,proto3" json:"upstream_client_id,omitempty"`
+	UpstreamPrefix   types.MerklePrefix `protobuf:"bytes,2,opt,name=upstream_prefix,json=upstreamPrefix,proto3" json:"upstream_prefix"`
+	Packet           types4.Packet      `protobuf:"bytes,3,opt,name=packet,proto3" json:"packet"`
+	ProofUnreceived  []byte             `protobuf:"bytes,4,opt,name=proof_unreceived,json=proofUnreceived,proto3" json:"proof_unreceived,omitempty"`
+	ProofClose       []byte             `protobuf:"bytes,5,opt,name=proof_close,json=proofClose,proto3" json:"proof_close,omitempty"`
+	ProofHeight      types2.Height      `protobuf:"bytes,6,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+	NextSequenceRecv uint64             `protobuf:"varint,7,opt,name=next_sequence_recv,json=nextSequenceRecv,proto3" json:"next_sequence_recv,omitempty"`
+	Signer           string             `protobuf:"bytes,8,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func _Msg_ProxyClientState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgProxyClientState)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ProxyClientState(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.proxy.v1.Msg/ProxyClientState",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ProxyClientState(ctx, req.(*MsgProxyClientState))
+func (m *MsgProxyTimeoutOnClose) Reset()         { *m = MsgProxyTimeoutOnClose{} }
+func (m *MsgProxyTimeoutOnClose) String() string { return proto.CompactTextString(m) }
+func (*MsgProxyTimeoutOnClose) ProtoMessage()    {}
+func (*MsgProxyTimeoutOnClose) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68797dc99f8f4cd2, []int{34}
+}
+func (m *MsgProxyTimeoutOnClose) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProxyTimeoutOnClose) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProxyTimeoutOnClose.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgProxyTimeoutOnClose) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProxyTimeoutOnClose.Merge(m, src)
+}
+func (m *MsgProxyTimeoutOnClose) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProxyTimeoutOnClose) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProxyTimeoutOnClose.DiscardUnknown(m)
 }
 
-func _Msg_ProxyConnectionOpenTry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgProxyConnectionOpenTry)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ProxyConnectionOpenTry(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.proxy.v1.Msg/ProxyConnectionOpenTry",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ProxyConnectionOpenTry(ctx, req.(*MsgProxyConnectionOpenTry))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgProxyTimeoutOnClose proto.InternalMessageInfo
+
+type MsgProxyTimeoutOnCloseResponse struct {
 }
 
-func _Msg_ProxyConnectionOpenAck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgProxyConnectionOpenAck)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ProxyConnectionOpenAck(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.proxy.v1.Msg/ProxyConnectionOpenAck",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ProxyConnectionOpenAck(ctx, req.(*MsgProxyConnectionOpenAck))
+func (m *MsgProxyTimeoutOnCloseResponse) Reset()         { *m = MsgProxyTimeoutOnCloseResponse{} }
+func (m *MsgProxyTimeoutOnCloseResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProxyTimeoutOnCloseResponse) ProtoMessage()    {}
+func (*MsgProxyTimeoutOnCloseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68797dc99f8f4cd2, []int{35}
+}
+func (m *MsgProxyTimeoutOnCloseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProxyTimeoutOnCloseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProxyTimeoutOnCloseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgProxyTimeoutOnCloseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProxyTimeoutOnCloseResponse.Merge(m, src)
+}
+func (m *MsgProxyTimeoutOnCloseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProxyTimeoutOnCloseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProxyTimeoutOnCloseResponse.DiscardUnknown(m)
 }
 
-func _Msg_ProxyConnectionOpenConfirm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgProxyConnectionOpenConfirm)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ProxyConnectionOpenConfirm(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.proxy.v1.Msg/ProxyConnectionOpenConfirm",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ProxyConnectionOpenConfirm(ctx, req.(*MsgProxyConnectionOpenConfirm))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgProxyTimeoutOnCloseResponse proto.InternalMessageInfo
+
+// MsgPruneProxyPacketCommitment deletes a proxied packet commitment with a proof that the upstream has deleted it,
+// and refunds the fees escrowed for the packet. The fees of a packet that nobody proxied are refunded with the same proof,
+// which also refunds a fee escrowed for a packet that the upstream has not sent yet.
+// The proof must be at the latest height of the upstream client. Anyone can submit it.
+type MsgPruneProxyPacketCommitment struct {
+	UpstreamClientId string             `protobuf:"bytes,1,opt,name=upstream_client_id,json=upstreamClientId,proto3" json:"upstream_client_id,omitempty"`
+	UpstreamPrefix   types.MerklePrefix `protobuf:"bytes,2,opt,name=upstream_prefix,json=upstreamPrefix,proto3" json:"upstream_prefix"`
+	// port id of the packet on the upstream side
+	PortId string `protobuf:"bytes,3,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel id of the packet on the upstream side
+	ChannelId string `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Sequence  uint64 `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// proof of the absence of the packet commitment on the upstream
+	ProofAbsence []byte        `protobuf:"bytes,6,opt,name=proof_absence,json=proofAbsence,proto3" json:"proof_absence,omitempty"`
+	ProofHeight  types2.Height `protobuf:"bytes,7,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+	Signer       string        `protobuf:"bytes,8,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func _Msg_ProxyConnectionOpenFinalize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgProxyConnectionOpenFinalize)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ProxyConnectionOpenFinalize(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.proxy.v1.Msg/ProxyConnectionOpenFinalize",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ProxyConnectionOpenFinalize(ctx, req.(*MsgProxyConnectionOpenFinalize))
+func (m *MsgPruneProxyPacketCommitment) Reset()         { *m = MsgPruneProxyPacketCommitment{} }
+func (m *MsgPruneProxyPacketCommitment) String() string { return proto.CompactTextString(m) }
+func (*MsgPruneProxyPacketCommitment) ProtoMessage()    {}
+func (*MsgPruneProxyPacketCommitment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68797dc99f8f4cd2, []int{36}
+}
+func (m *MsgPruneProxyPacketCommitment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPruneProxyPacketCommitment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPruneProxyPacketCommitment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgPruneProxyPacketCommitment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPruneProxyPacketCommitment.Merge(m, src)
+}
+func (m *MsgPruneProxyPacketCommitment) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPruneProxyPacketCommitment) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPruneProxyPacketCommitment.DiscardUnknown(m)
 }
 
-func _Msg_ProxyChannelOpenTry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgProxyChannelOpenTry)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ProxyChannelOpenTry(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.proxy.v1.Msg/ProxyChannelOpenTry",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ProxyChannelOpenTry(ctx, req.(*MsgProxyChannelOpenTry))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgPruneProxyPacketCommitment proto.InternalMessageInfo
+
+type MsgPruneProxyPacketCommitmentResponse struct {
 }
 
-func _Msg_ProxyChannelOpenAck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgProxyChannelOpenAck)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ProxyChannelOpenAck(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.proxy.v1.Msg/ProxyChannelOpenAck",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ProxyChannelOpenAck(ctx, req.(*MsgProxyChannelOpenAck))
+func (m *MsgPruneProxyPacketCommitmentResponse) Reset()         { *m = MsgPruneProxyPacketCommitmentResponse{} }
+func (m *MsgPruneProxyPacketCommitmentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPruneProxyPacketCommitmentResponse) ProtoMessage()    {}
+func (*MsgPruneProxyPacketCommitmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68797dc99f8f4cd2, []int{37}
+}
+func (m *MsgPruneProxyPacketCommitmentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPruneProxyPacketCommitmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPruneProxyPacketCommitmentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgPruneProxyPacketCommitmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPruneProxyPacketCommitmentResponse.Merge(m, src)
+}
+func (m *MsgPruneProxyPacketCommitmentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPruneProxyPacketCommitmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPruneProxyPacketCommitmentResponse.DiscardUnknown(m)
 }
 
-func _Msg_ProxyChannelOpenConfirm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgProxyChannelOpenConfirm)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ProxyChannelOpenConfirm(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.proxy.v1.Msg/ProxyChannelOpenConfirm",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ProxyChannelOpenConfirm(ctx, req.(*MsgProxyChannelOpenConfirm))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgPruneProxyPacketCommitmentResponse proto.InternalMessageInfo
+
+// MsgProxyWithHeader updates the upstream client with the header and executes the proxy message
+// with the proofs at the height of the header in the same transaction.
+type MsgProxyWithHeader struct {
+	UpstreamClientId string      `protobuf:"bytes,1,opt,name=upstream_client_id,json=upstreamClientId,proto3" json:"upstream_client_id,omitempty"`
+	Header           *types1.Any `protobuf:"bytes,2,opt,name=header,proto3" json:"header,omitempty"`
+	// proxy message whose proof height is the height of the header
+	Msg    *types1.Any `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Signer string      `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func _Msg_ProxyChannelOpenFinalize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgProxyChannelOpenFinalize)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ProxyChannelOpenFinalize(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.proxy.v1.Msg/ProxyChannelOpenFinalize",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ProxyChannelOpenFinalize(ctx, req.(*MsgProxyChannelOpenFinalize))
+func (m *MsgProxyWithHeader) Reset()         { *m = MsgProxyWithHeader{} }
+func (m *MsgProxyWithHeader) String() string { return proto.CompactTextString(m) }
+func (*MsgProxyWithHeader) ProtoMessage()    {}
+func (*MsgProxyWithHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68797dc99f8f4cd2, []int{38}
+}
+func (m *MsgProxyWithHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProxyWithHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProxyWithHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgProxyWithHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProxyWithHeader.Merge(m, src)
+}
+func (m *MsgProxyWithHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProxyWithHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProxyWithHeader.DiscardUnknown(m)
 }
 
-func _Msg_ProxyRecvPacket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgProxyRecvPacket)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ProxyRecvPacket(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.proxy.v1.Msg/ProxyRecvPacket",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ProxyRecvPacket(ctx, req.(*MsgProxyRecvPacket))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgProxyWithHeader proto.InternalMessageInfo
+
+type MsgProxyWithHeaderResponse struct {
+	// response of the proxy message
+	MsgResponse *types1.Any `protobuf:"bytes,1,opt,name=msg_response,json=msgResponse,proto3" json:"msg_response,omitempty"`
 }
 
-func _Msg_ProxyAcknowledgePacket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgProxyAcknowledgePacket)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ProxyAcknowledgePacket(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.proxy.v1.Msg/ProxyAcknowledgePacket",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ProxyAcknowledgePacket(ctx, req.(*MsgProxyAcknowledgePacket))
+func (m *MsgProxyWithHeaderResponse) Reset()         { *m = MsgProxyWithHeaderResponse{} }
+func (m *MsgProxyWithHeaderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProxyWithHeaderResponse) ProtoMessage()    {}
+func (*MsgProxyWithHeaderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68797dc99f8f4cd2, []int{39}
+}
+func (m *MsgProxyWithHeaderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProxyWithHeaderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProxyWithHeaderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ibc.proxy.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ProxyClientState",
-			Handler:    _Msg_ProxyClientState_Handler,
-		},
-		{
-			MethodName: "ProxyConnectionOpenTry",
-			Handler:    _Msg_ProxyConnectionOpenTry_Handler,
-		},
-		{
-			MethodName: "ProxyConnectionOpenAck",
-			Handler:    _Msg_ProxyConnectionOpenAck_Handler,
-		},
-		{
-			MethodName: "ProxyConnectionOpenConfirm",
-			Handler:    _Msg_ProxyConnectionOpenConfirm_Handler,
-		},
-		{
-			MethodName: "ProxyConnectionOpenFinalize",
-			Handler:    _Msg_ProxyConnectionOpenFinalize_Handler,
-		},
-		{
-			MethodName: "ProxyChannelOpenTry",
-			Handler:    _Msg_ProxyChannelOpenTry_Handler,
-		},
-		{
-			MethodName: "ProxyChannelOpenAck",
-			Handler:    _Msg_ProxyChannelOpenAck_Handler,
-		},
-		{
-			MethodName: "ProxyChannelOpenConfirm",
-			Handler:    _Msg_ProxyChannelOpenConfirm_Handler,
-		},
-		{
-			MethodName: "ProxyChannelOpenFinalize",
-			Handler:    _Msg_ProxyChannelOpenFinalize_Handler,
-		},
-		{
-			MethodName: "ProxyRecvPacket",
-			Handler:    _Msg_ProxyRecvPacket_Handler,
-		},
-		{
-			MethodName: "ProxyAcknowledgePacket",
-			Handler:    _Msg_ProxyAcknowledgePacket_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "ibc/modules/proxy/tx.proto",
+func (m *MsgProxyWithHeaderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProxyWithHeaderResponse.Merge(m, src)
+}
+func (m *MsgProxyWithHeaderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProxyWithHeaderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProxyWithHeaderResponse.DiscardUnknown(m)
 }
 
-func (m *MsgProxyClientState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgProxyWithHeaderResponse proto.InternalMessageInfo
+
+func (m *MsgProxyWithHeaderResponse) GetMsgResponse() *types1.Any {
+	if m != nil {
+		return m.MsgResponse
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgProxyClientState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSubmitProxyMisbehaviour freezes the proxy client on the downstream with a proof
+// that the proxy committed a state which the upstream doesn't have.
+type MsgSubmitProxyMisbehaviour struct {
+	Misbehaviour types5.ProxyMisbehaviour `protobuf:"bytes,1,opt,name=misbehaviour,proto3" json:"misbehaviour"`
+	Signer       string                   `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func (m *MsgProxyClientState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x52
-	}
-	{
-		size, err := m.ConsensusHeight.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4a
-	{
-		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgSubmitProxyMisbehaviour) Reset()         { *m = MsgSubmitProxyMisbehaviour{} }
+func (m *MsgSubmitProxyMisbehaviour) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitProxyMisbehaviour) ProtoMessage()    {}
+func (*MsgSubmitProxyMisbehaviour) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68797dc99f8f4cd2, []int{40}
+}
+func (m *MsgSubmitProxyMisbehaviour) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitProxyMisbehaviour) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitProxyMisbehaviour.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x42
-	if len(m.ProofConsensus) > 0 {
-		i -= len(m.ProofConsensus)
-		copy(dAtA[i:], m.ProofConsensus)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofConsensus)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.ProofClient) > 0 {
-		i -= len(m.ProofClient)
-		copy(dAtA[i:], m.ProofClient)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofClient)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.ConsensusState != nil {
-		{
-			size, err := m.ConsensusState.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.ClientState != nil {
-		{
-			size, err := m.ClientState.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.CounterpartyClientId) > 0 {
-		i -= len(m.CounterpartyClientId)
-		copy(dAtA[i:], m.CounterpartyClientId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CounterpartyClientId)))
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	{
-		size, err := m.UpstreamPrefix.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *MsgSubmitProxyMisbehaviour) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitProxyMisbehaviour.Merge(m, src)
+}
+func (m *MsgSubmitProxyMisbehaviour) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitProxyMisbehaviour) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitProxyMisbehaviour.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitProxyMisbehaviour proto.InternalMessageInfo
+
+type MsgSubmitProxyMisbehaviourResponse struct {
+}
+
+func (m *MsgSubmitProxyMisbehaviourResponse) Reset()         { *m = MsgSubmitProxyMisbehaviourResponse{} }
+func (m *MsgSubmitProxyMisbehaviourResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitProxyMisbehaviourResponse) ProtoMessage()    {}
+func (*MsgSubmitProxyMisbehaviourResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68797dc99f8f4cd2, []int{41}
+}
+func (m *MsgSubmitProxyMisbehaviourResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitProxyMisbehaviourResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitProxyMisbehaviourResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.UpstreamClientId) > 0 {
-		i -= len(m.UpstreamClientId)
-		copy(dAtA[i:], m.UpstreamClientId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.UpstreamClientId)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgProxyClientStateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSubmitProxyMisbehaviourResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitProxyMisbehaviourResponse.Merge(m, src)
 }
-
-func (m *MsgProxyClientStateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSubmitProxyMisbehaviourResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitProxyMisbehaviourResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitProxyMisbehaviourResponse.DiscardUnknown(m)
 }
 
-func (m *MsgProxyClientStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgSubmitProxyMisbehaviourResponse proto.InternalMessageInfo
+
+// MsgPayProxyPacketFee escrows a fee for relaying a packet through the proxy. The fee is paid to the relayer
+// that proxies the packet commitment or the acknowledgement of the packet, or refunded to the signer if the packet times out
+// or if the upstream deletes the commitment of the packet that nobody proxied. A packet that has been proxied cannot be paid for.
+type MsgPayProxyPacketFee struct {
+	UpstreamClientId string `protobuf:"bytes,1,opt,name=upstream_client_id,json=upstreamClientId,proto3" json:"upstream_client_id,omitempty"`
+	// port id of the packet on the upstream side
+	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel id of the packet on the upstream side
+	ChannelId string                                   `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Sequence  uint64                                   `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Fee       github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+	Signer    string                                   `protobuf:"bytes,6,opt,name=signer,proto3" json:"signer,omitempty"`
+	// direction of the packet relative to the upstream
+	Direction      PacketDirection    `protobuf:"varint,7,opt,name=direction,proto3,enum=ibc.proxy.v1.PacketDirection" json:"direction,omitempty"`
+	UpstreamPrefix types.MerklePrefix `protobuf:"bytes,8,opt,name=upstream_prefix,json=upstreamPrefix,proto3" json:"upstream_prefix"`
 }
 
-func (m *MsgProxyConnectionOpenTry) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgPayProxyPacketFee) Reset()         { *m = MsgPayProxyPacketFee{} }
+func (m *MsgPayProxyPacketFee) String() string { return proto.CompactTextString(m) }
+func (*MsgPayProxyPacketFee) ProtoMessage()    {}
+func (*MsgPayProxyPacketFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68797dc99f8f4cd2, []int{42}
+}
+func (m *MsgPayProxyPacketFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPayProxyPacketFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPayProxyPacketFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgPayProxyPacketFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPayProxyPacketFee.Merge(m, src)
+}
+func (m *MsgPayProxyPacketFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPayProxyPacketFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPayProxyPacketFee.DiscardUnknown(m)
 }
 
-func (m *MsgProxyConnectionOpenTry) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgPayProxyPacketFee proto.InternalMessageInfo
+
+type MsgPayProxyPacketFeeResponse struct {
 }
 
-func (m *MsgProxyConnectionOpenTry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
-	}
-	{
-		size, err := m.ProxyConsensusHeight.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgPayProxyPacketFeeResponse) Reset()         { *m = MsgPayProxyPacketFeeResponse{} }
+func (m *MsgPayProxyPacketFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPayProxyPacketFeeResponse) ProtoMessage()    {}
+func (*MsgPayProxyPacketFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68797dc99f8f4cd2, []int{43}
+}
+func (m *MsgPayProxyPacketFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPayProxyPacketFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPayProxyPacketFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x7a
-	{
-		size, err := m.ProofProxyHeight.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *MsgPayProxyPacketFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPayProxyPacketFeeResponse.Merge(m, src)
+}
+func (m *MsgPayProxyPacketFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPayProxyPacketFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPayProxyPacketFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPayProxyPacketFeeResponse proto.InternalMessageInfo
+
+// MsgRegisterUpstream adds an upstream client to the allowlist. It must be signed by the authority.
+// Governance uses RegisterUpstreamProposal instead.
+type MsgRegisterUpstream struct {
+	Authority        string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	UpstreamClientId string `protobuf:"bytes,2,opt,name=upstream_client_id,json=upstreamClientId,proto3" json:"upstream_client_id,omitempty"`
+}
+
+func (m *MsgRegisterUpstream) Reset()         { *m = MsgRegisterUpstream{} }
+func (m *MsgRegisterUpstream) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterUpstream) ProtoMessage()    {}
+func (*MsgRegisterUpstream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68797dc99f8f4cd2, []int{44}
+}
+func (m *MsgRegisterUpstream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterUpstream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterUpstream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x72
-	if len(m.ProofProxyConsensus) > 0 {
-		i -= len(m.ProofProxyConsensus)
-		copy(dAtA[i:], m.ProofProxyConsensus)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofProxyConsensus)))
-		i--
-		dAtA[i] = 0x6a
-	}
-	if len(m.ProofProxyClient) > 0 {
-		i -= len(m.ProofProxyClient)
-		copy(dAtA[i:], m.ProofProxyClient)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofProxyClient)))
-		i--
-		dAtA[i] = 0x62
+		return b[:n], nil
 	}
-	{
-		size, err := m.ConsensusHeight.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *MsgRegisterUpstream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterUpstream.Merge(m, src)
+}
+func (m *MsgRegisterUpstream) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterUpstream) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterUpstream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterUpstream proto.InternalMessageInfo
+
+type MsgRegisterUpstreamResponse struct {
+}
+
+func (m *MsgRegisterUpstreamResponse) Reset()         { *m = MsgRegisterUpstreamResponse{} }
+func (m *MsgRegisterUpstreamResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterUpstreamResponse) ProtoMessage()    {}
+func (*MsgRegisterUpstreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68797dc99f8f4cd2, []int{45}
+}
+func (m *MsgRegisterUpstreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterUpstreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterUpstreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x5a
-	{
-		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *MsgRegisterUpstreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterUpstreamResponse.Merge(m, src)
+}
+func (m *MsgRegisterUpstreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterUpstreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterUpstreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterUpstreamResponse proto.InternalMessageInfo
+
+// MsgDeregisterUpstream removes an upstream client from the allowlist. It must be signed by the authority.
+// Governance uses DeregisterUpstreamProposal instead.
+type MsgDeregisterUpstream struct {
+	Authority        string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	UpstreamClientId string `protobuf:"bytes,2,opt,name=upstream_client_id,json=upstreamClientId,proto3" json:"upstream_client_id,omitempty"`
+}
+
+func (m *MsgDeregisterUpstream) Reset()         { *m = MsgDeregisterUpstream{} }
+func (m *MsgDeregisterUpstream) String() string { return proto.CompactTextString(m) }
+func (*MsgDeregisterUpstream) ProtoMessage()    {}
+func (*MsgDeregisterUpstream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68797dc99f8f4cd2, []int{46}
+}
+func (m *MsgDeregisterUpstream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeregisterUpstream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeregisterUpstream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x52
-	if len(m.ProofConsensus) > 0 {
-		i -= len(m.ProofConsensus)
-		copy(dAtA[i:], m.ProofConsensus)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofConsensus)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.ProofClient) > 0 {
-		i -= len(m.ProofClient)
-		copy(dAtA[i:], m.ProofClient)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofClient)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.ProofInit) > 0 {
-		i -= len(m.ProofInit)
-		copy(dAtA[i:], m.ProofInit)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofInit)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.ProxyClientState != nil {
-		{
-			size, err := m.ProxyClientState.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.DownstreamConsensusState != nil {
-		{
-			size, err := m.DownstreamConsensusState.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.DownstreamClientState != nil {
-		{
-			size, err := m.DownstreamClientState.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
+		return b[:n], nil
 	}
-	{
-		size, err := m.Connection.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *MsgDeregisterUpstream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeregisterUpstream.Merge(m, src)
+}
+func (m *MsgDeregisterUpstream) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeregisterUpstream) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeregisterUpstream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeregisterUpstream proto.InternalMessageInfo
+
+type MsgDeregisterUpstreamResponse struct {
+}
+
+func (m *MsgDeregisterUpstreamResponse) Reset()         { *m = MsgDeregisterUpstreamResponse{} }
+func (m *MsgDeregisterUpstreamResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeregisterUpstreamResponse) ProtoMessage()    {}
+func (*MsgDeregisterUpstreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68797dc99f8f4cd2, []int{47}
+}
+func (m *MsgDeregisterUpstreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeregisterUpstreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeregisterUpstreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.UpstreamPrefix.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *MsgDeregisterUpstreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeregisterUpstreamResponse.Merge(m, src)
+}
+func (m *MsgDeregisterUpstreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeregisterUpstreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeregisterUpstreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeregisterUpstreamResponse proto.InternalMessageInfo
+
+// MsgPauseProxy pauses proxying for an upstream client, or for a port and channel of the upstream if they are given.
+// It must be signed by the authority or the guardian.
+type MsgPauseProxy struct {
+	Signer           string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	UpstreamClientId string `protobuf:"bytes,2,opt,name=upstream_client_id,json=upstreamClientId,proto3" json:"upstream_client_id,omitempty"`
+	PortId           string `protobuf:"bytes,3,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId        string `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *MsgPauseProxy) Reset()         { *m = MsgPauseProxy{} }
+func (m *MsgPauseProxy) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseProxy) ProtoMessage()    {}
+func (*MsgPauseProxy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68797dc99f8f4cd2, []int{48}
+}
+func (m *MsgPauseProxy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseProxy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseProxy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.ConnectionId) > 0 {
-		i -= len(m.ConnectionId)
-		copy(dAtA[i:], m.ConnectionId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgProxyConnectionOpenTryResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgPauseProxy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseProxy.Merge(m, src)
 }
-
-func (m *MsgProxyConnectionOpenTryResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgPauseProxy) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgProxyConnectionOpenTryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgPauseProxy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseProxy.DiscardUnknown(m)
 }
 
-func (m *MsgProxyConnectionOpenAck) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_MsgPauseProxy proto.InternalMessageInfo
+
+type MsgPauseProxyResponse struct {
 }
 
-func (m *MsgProxyConnectionOpenAck) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgPauseProxyResponse) Reset()         { *m = MsgPauseProxyResponse{} }
+func (m *MsgPauseProxyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseProxyResponse) ProtoMessage()    {}
+func (*MsgPauseProxyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68797dc99f8f4cd2, []int{49}
 }
-
-func (m *MsgProxyConnectionOpenAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
-	}
-	{
-		size, err := m.ProxyConsensusHeight.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x7a
-	{
-		size, err := m.ProofProxyHeight.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x72
-	if len(m.ProofProxyConsensus) > 0 {
-		i -= len(m.ProofProxyConsensus)
-		copy(dAtA[i:], m.ProofProxyConsensus)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofProxyConsensus)))
-		i--
-		dAtA[i] = 0x6a
-	}
-	if len(m.ProofProxyClient) > 0 {
-		i -= len(m.ProofProxyClient)
-		copy(dAtA[i:], m.ProofProxyClient)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofProxyClient)))
-		i--
-		dAtA[i] = 0x62
-	}
-	{
-		size, err := m.ConsensusHeight.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgPauseProxyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseProxyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseProxyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x5a
-	{
-		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *MsgPauseProxyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseProxyResponse.Merge(m, src)
+}
+func (m *MsgPauseProxyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseProxyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseProxyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPauseProxyResponse proto.InternalMessageInfo
+
+// MsgUnpauseProxy resumes proxying paused by MsgPauseProxy. It must be signed by the authority,
+// or by the guardian if the guardian paused it. Governance uses UnpauseProxyProposal instead.
+type MsgUnpauseProxy struct {
+	Signer           string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	UpstreamClientId string `protobuf:"bytes,2,opt,name=upstream_client_id,json=upstreamClientId,proto3" json:"upstream_client_id,omitempty"`
+	PortId           string `protobuf:"bytes,3,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId        string `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *MsgUnpauseProxy) Reset()         { *m = MsgUnpauseProxy{} }
+func (m *MsgUnpauseProxy) String() string { return proto.CompactTextString(m) }
+func (*MsgUnpauseProxy) ProtoMessage()    {}
+func (*MsgUnpauseProxy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68797dc99f8f4cd2, []int{50}
+}
+func (m *MsgUnpauseProxy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnpauseProxy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnpauseProxy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x52
-	if len(m.ProofConsensus) > 0 {
-		i -= len(m.ProofConsensus)
-		copy(dAtA[i:], m.ProofConsensus)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofConsensus)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.ProofClient) > 0 {
-		i -= len(m.ProofClient)
-		copy(dAtA[i:], m.ProofClient)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofClient)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.ProofTry) > 0 {
-		i -= len(m.ProofTry)
-		copy(dAtA[i:], m.ProofTry)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofTry)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.ProxyClientState != nil {
-		{
-			size, err := m.ProxyClientState.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.DownstreamConsensusState != nil {
-		{
-			size, err := m.DownstreamConsensusState.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.DownstreamClientState != nil {
-		{
-			size, err := m.DownstreamClientState.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
+		return b[:n], nil
 	}
-	{
-		size, err := m.Connection.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *MsgUnpauseProxy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnpauseProxy.Merge(m, src)
+}
+func (m *MsgUnpauseProxy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnpauseProxy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnpauseProxy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnpauseProxy proto.InternalMessageInfo
+
+type MsgUnpauseProxyResponse struct {
+}
+
+func (m *MsgUnpauseProxyResponse) Reset()         { *m = MsgUnpauseProxyResponse{} }
+func (m *MsgUnpauseProxyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnpauseProxyResponse) ProtoMessage()    {}
+func (*MsgUnpauseProxyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68797dc99f8f4cd2, []int{51}
+}
+func (m *MsgUnpauseProxyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnpauseProxyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnpauseProxyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.UpstreamPrefix.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *MsgUnpauseProxyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnpauseProxyResponse.Merge(m, src)
+}
+func (m *MsgUnpauseProxyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnpauseProxyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnpauseProxyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnpauseProxyResponse proto.InternalMessageInfo
+
+// MsgMigrateProxyClient migrates a proxy client to the proxy chain and the upstream client of another proxy client.
+// The proofs must show that both upstream clients track the same upstream chain. It must be signed by the authority.
+// Governance uses MigrateProxyClientProposal instead.
+type MsgMigrateProxyClient struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// the proxy client to be migrated
+	ClientId string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// the proxy client whose proxy chain and upstream client the client migrates to
+	NewClientId string `protobuf:"bytes,3,opt,name=new_client_id,json=newClientId,proto3" json:"new_client_id,omitempty"`
+	// the client states of the current and the new upstream clients on their proxy chains
+	UpstreamClientState    *types1.Any `protobuf:"bytes,4,opt,name=upstream_client_state,json=upstreamClientState,proto3" json:"upstream_client_state,omitempty"`
+	NewUpstreamClientState *types1.Any `protobuf:"bytes,5,opt,name=new_upstream_client_state,json=newUpstreamClientState,proto3" json:"new_upstream_client_state,omitempty"`
+	// the consensus state that both upstream clients have at the consensus height
+	UpstreamConsensusState  *types1.Any   `protobuf:"bytes,6,opt,name=upstream_consensus_state,json=upstreamConsensusState,proto3" json:"upstream_consensus_state,omitempty"`
+	UpstreamConsensusHeight types2.Height `protobuf:"bytes,7,opt,name=upstream_consensus_height,json=upstreamConsensusHeight,proto3" json:"upstream_consensus_height"`
+	// the proofs of the current upstream client at the proof height of the client
+	ProofUpstreamClient    []byte        `protobuf:"bytes,8,opt,name=proof_upstream_client,json=proofUpstreamClient,proto3" json:"proof_upstream_client,omitempty"`
+	ProofUpstreamConsensus []byte        `protobuf:"bytes,9,opt,name=proof_upstream_consensus,json=proofUpstreamConsensus,proto3" json:"proof_upstream_consensus,omitempty"`
+	ProofHeight            types2.Height `protobuf:"bytes,10,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+	// the proofs of the new upstream client at the proof height of the new client
+	ProofNewUpstreamClient    []byte        `protobuf:"bytes,11,opt,name=proof_new_upstream_client,json=proofNewUpstreamClient,proto3" json:"proof_new_upstream_client,omitempty"`
+	ProofNewUpstreamConsensus []byte        `protobuf:"bytes,12,opt,name=proof_new_upstream_consensus,json=proofNewUpstreamConsensus,proto3" json:"proof_new_upstream_consensus,omitempty"`
+	NewProofHeight            types2.Height `protobuf:"bytes,13,opt,name=new_proof_height,json=newProofHeight,proto3" json:"new_proof_height"`
+}
+
+func (m *MsgMigrateProxyClient) Reset()         { *m = MsgMigrateProxyClient{} }
+func (m *MsgMigrateProxyClient) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateProxyClient) ProtoMessage()    {}
+func (*MsgMigrateProxyClient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68797dc99f8f4cd2, []int{52}
+}
+func (m *MsgMigrateProxyClient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateProxyClient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateProxyClient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.ConnectionId) > 0 {
-		i -= len(m.ConnectionId)
-		copy(dAtA[i:], m.ConnectionId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgProxyConnectionOpenAckResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgMigrateProxyClient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateProxyClient.Merge(m, src)
 }
-
-func (m *MsgProxyConnectionOpenAckResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgMigrateProxyClient) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateProxyClient) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateProxyClient.DiscardUnknown(m)
 }
 
-func (m *MsgProxyConnectionOpenAckResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgMigrateProxyClient proto.InternalMessageInfo
+
+type MsgMigrateProxyClientResponse struct {
 }
 
-func (m *MsgProxyConnectionOpenConfirm) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgMigrateProxyClientResponse) Reset()         { *m = MsgMigrateProxyClientResponse{} }
+func (m *MsgMigrateProxyClientResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateProxyClientResponse) ProtoMessage()    {}
+func (*MsgMigrateProxyClientResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68797dc99f8f4cd2, []int{53}
+}
+func (m *MsgMigrateProxyClientResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateProxyClientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateProxyClientResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgProxyConnectionOpenConfirm) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgMigrateProxyClientResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateProxyClientResponse.Merge(m, src)
+}
+func (m *MsgMigrateProxyClientResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateProxyClientResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateProxyClientResponse.DiscardUnknown(m)
 }
 
-func (m *MsgProxyConnectionOpenConfirm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	{
-		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	if len(m.ProofAck) > 0 {
-		i -= len(m.ProofAck)
-		copy(dAtA[i:], m.ProofAck)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofAck)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.CounterpartyConnectionId) > 0 {
-		i -= len(m.CounterpartyConnectionId)
-		copy(dAtA[i:], m.CounterpartyConnectionId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CounterpartyConnectionId)))
-		i--
-		dAtA[i] = 0x22
-	}
-	{
-		size, err := m.UpstreamPrefix.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.UpstreamClientId) > 0 {
-		i -= len(m.UpstreamClientId)
-		copy(dAtA[i:], m.UpstreamClientId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.UpstreamClientId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ConnectionId) > 0 {
-		i -= len(m.ConnectionId)
-		copy(dAtA[i:], m.ConnectionId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgMigrateProxyClientResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgProxyClientState)(nil), "ibc.proxy.v1.MsgProxyClientState")
+	proto.RegisterType((*MsgProxyClientStateResponse)(nil), "ibc.proxy.v1.MsgProxyClientStateResponse")
+	proto.RegisterType((*MsgProxyConnectionOpenTry)(nil), "ibc.proxy.v1.MsgProxyConnectionOpenTry")
+	proto.RegisterType((*MsgProxyConnectionOpenTryResponse)(nil), "ibc.proxy.v1.MsgProxyConnectionOpenTryResponse")
+	proto.RegisterType((*MsgProxyConnectionOpenAck)(nil), "ibc.proxy.v1.MsgProxyConnectionOpenAck")
+	proto.RegisterType((*MsgProxyConnectionOpenAckResponse)(nil), "ibc.proxy.v1.MsgProxyConnectionOpenAckResponse")
+	proto.RegisterType((*MsgProxyConnectionOpenConfirm)(nil), "ibc.proxy.v1.MsgProxyConnectionOpenConfirm")
+	proto.RegisterType((*MsgProxyConnectionOpenConfirmResponse)(nil), "ibc.proxy.v1.MsgProxyConnectionOpenConfirmResponse")
+	proto.RegisterType((*MsgProxyConnectionOpenFinalize)(nil), "ibc.proxy.v1.MsgProxyConnectionOpenFinalize")
+	proto.RegisterType((*MsgProxyConnectionOpenFinalizeResponse)(nil), "ibc.proxy.v1.MsgProxyConnectionOpenFinalizeResponse")
+	proto.RegisterType((*MsgProxyConnectionState)(nil), "ibc.proxy.v1.MsgProxyConnectionState")
+	proto.RegisterType((*MsgProxyConnectionStateResponse)(nil), "ibc.proxy.v1.MsgProxyConnectionStateResponse")
+	proto.RegisterType((*MsgProxyChannelOpenTry)(nil), "ibc.proxy.v1.MsgProxyChannelOpenTry")
+	proto.RegisterType((*MsgProxyChannelOpenTryResponse)(nil), "ibc.proxy.v1.MsgProxyChannelOpenTryResponse")
+	proto.RegisterType((*MsgProxyChannelOpenAck)(nil), "ibc.proxy.v1.MsgProxyChannelOpenAck")
+	proto.RegisterType((*MsgProxyChannelOpenAckResponse)(nil), "ibc.proxy.v1.MsgProxyChannelOpenAckResponse")
+	proto.RegisterType((*MsgProxyChannelOpenConfirm)(nil), "ibc.proxy.v1.MsgProxyChannelOpenConfirm")
+	proto.RegisterType((*MsgProxyChannelOpenConfirmResponse)(nil), "ibc.proxy.v1.MsgProxyChannelOpenConfirmResponse")
+	proto.RegisterType((*MsgProxyChannelOpenFinalize)(nil), "ibc.proxy.v1.MsgProxyChannelOpenFinalize")
+	proto.RegisterType((*MsgProxyChannelOpenFinalizeResponse)(nil), "ibc.proxy.v1.MsgProxyChannelOpenFinalizeResponse")
+	proto.RegisterType((*MsgProxyChannelCloseConfirm)(nil), "ibc.proxy.v1.MsgProxyChannelCloseConfirm")
+	proto.RegisterType((*MsgProxyChannelCloseConfirmResponse)(nil), "ibc.proxy.v1.MsgProxyChannelCloseConfirmResponse")
+	proto.RegisterType((*MsgProxyChannelState)(nil), "ibc.proxy.v1.MsgProxyChannelState")
+	proto.RegisterType((*MsgProxyChannelStateResponse)(nil), "ibc.proxy.v1.MsgProxyChannelStateResponse")
+	proto.RegisterType((*MsgProxyRecvPacket)(nil), "ibc.proxy.v1.MsgProxyRecvPacket")
+	proto.RegisterType((*MsgProxyRecvPacketResponse)(nil), "ibc.proxy.v1.MsgProxyRecvPacketResponse")
+	proto.RegisterType((*MsgProxyAcknowledgePacket)(nil), "ibc.proxy.v1.MsgProxyAcknowledgePacket")
+	proto.RegisterType((*MsgProxyAcknowledgePacketResponse)(nil), "ibc.proxy.v1.MsgProxyAcknowledgePacketResponse")
+	proto.RegisterType((*MsgProxyPacketBatch)(nil), "ibc.proxy.v1.MsgProxyPacketBatch")
+	proto.RegisterType((*ProxyPacketBatchItem)(nil), "ibc.proxy.v1.ProxyPacketBatchItem")
+	proto.RegisterType((*MsgProxyPacketBatchResponse)(nil), "ibc.proxy.v1.MsgProxyPacketBatchResponse")
+	proto.RegisterType((*ProxyPacketBatchResult)(nil), "ibc.proxy.v1.ProxyPacketBatchResult")
+	proto.RegisterType((*MsgProxyTimeoutPacket)(nil), "ibc.proxy.v1.MsgProxyTimeoutPacket")
+	proto.RegisterType((*MsgProxyTimeoutPacketResponse)(nil), "ibc.proxy.v1.MsgProxyTimeoutPacketResponse")
+	proto.RegisterType((*MsgProxyTimeoutOnClose)(nil), "ibc.proxy.v1.MsgProxyTimeoutOnClose")
+	proto.RegisterType((*MsgProxyTimeoutOnCloseResponse)(nil), "ibc.proxy.v1.MsgProxyTimeoutOnCloseResponse")
+	proto.RegisterType((*MsgPruneProxyPacketCommitment)(nil), "ibc.proxy.v1.MsgPruneProxyPacketCommitment")
+	proto.RegisterType((*MsgPruneProxyPacketCommitmentResponse)(nil), "ibc.proxy.v1.MsgPruneProxyPacketCommitmentResponse")
+	proto.RegisterType((*MsgProxyWithHeader)(nil), "ibc.proxy.v1.MsgProxyWithHeader")
+	proto.RegisterType((*MsgProxyWithHeaderResponse)(nil), "ibc.proxy.v1.MsgProxyWithHeaderResponse")
+	proto.RegisterType((*MsgSubmitProxyMisbehaviour)(nil), "ibc.proxy.v1.MsgSubmitProxyMisbehaviour")
+	proto.RegisterType((*MsgSubmitProxyMisbehaviourResponse)(nil), "ibc.proxy.v1.MsgSubmitProxyMisbehaviourResponse")
+	proto.RegisterType((*MsgPayProxyPacketFee)(nil), "ibc.proxy.v1.MsgPayProxyPacketFee")
+	proto.RegisterType((*MsgPayProxyPacketFeeResponse)(nil), "ibc.proxy.v1.MsgPayProxyPacketFeeResponse")
+	proto.RegisterType((*MsgRegisterUpstream)(nil), "ibc.proxy.v1.MsgRegisterUpstream")
+	proto.RegisterType((*MsgRegisterUpstreamResponse)(nil), "ibc.proxy.v1.MsgRegisterUpstreamResponse")
+	proto.RegisterType((*MsgDeregisterUpstream)(nil), "ibc.proxy.v1.MsgDeregisterUpstream")
+	proto.RegisterType((*MsgDeregisterUpstreamResponse)(nil), "ibc.proxy.v1.MsgDeregisterUpstreamResponse")
+	proto.RegisterType((*MsgPauseProxy)(nil), "ibc.proxy.v1.MsgPauseProxy")
+	proto.RegisterType((*MsgPauseProxyResponse)(nil), "ibc.proxy.v1.MsgPauseProxyResponse")
+	proto.RegisterType((*MsgUnpauseProxy)(nil), "ibc.proxy.v1.MsgUnpauseProxy")
+	proto.RegisterType((*MsgUnpauseProxyResponse)(nil), "ibc.proxy.v1.MsgUnpauseProxyResponse")
+	proto.RegisterType((*MsgMigrateProxyClient)(nil), "ibc.proxy.v1.MsgMigrateProxyClient")
+	proto.RegisterType((*MsgMigrateProxyClientResponse)(nil), "ibc.proxy.v1.MsgMigrateProxyClientResponse")
 }
 
-func (m *MsgProxyConnectionOpenConfirmResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() { proto.RegisterFile("ibc/modules/proxy/tx.proto", fileDescriptor_68797dc99f8f4cd2) }
+
+var fileDescriptor_68797dc99f8f4cd2 = []byte{
+	// 2707 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0xcb, 0x6f, 0x1c, 0x59,
+	0xd5, 0x77, 0xbb, 0xdb, 0xed, 0xee, 0xd3, 0xed, 0xc7, 0x57, 0x71, 0xec, 0x72, 0x39, 0x7e, 0xa4,
+	0x1d, 0x4f, 0x9c, 0x89, 0xa7, 0x3b, 0xf6, 0xcc, 0xa7, 0x61, 0x60, 0x00, 0x39, 0x0e, 0xa3, 0x84,
+	0xe0, 0xc4, 0xea, 0x24, 0x83, 0x84, 0x66, 0x64, 0xaa, 0xab, 0xaf, 0xbb, 0x4b, 0xee, 0xae, 0x6a,
+	0xaa, 0xaa, 0xdb, 0x31, 0x0b, 0x04, 0x23, 0x21, 0x81, 0xd8, 0xb0, 0x01, 0x84, 0xd8, 0xcc, 0x1a,
+	0xfe, 0x00, 0x24, 0xd6, 0x08, 0x0d, 0x2b, 0x66, 0xc1, 0x02, 0xb1, 0x00, 0x94, 0x6c, 0xd8, 0x21,
+	0x76, 0x48, 0x6c, 0xd0, 0x7d, 0xd4, 0xad, 0x5b, 0xcf, 0x2e, 0xc7, 0xce, 0xe0, 0x84, 0x6c, 0x92,
+	0xae, 0x7b, 0x7f, 0xf7, 0x9c, 0x73, 0xcf, 0xeb, 0x9e, 0xfb, 0x30, 0x28, 0x7a, 0x43, 0xab, 0x75,
+	0xcd, 0x66, 0xbf, 0x83, 0xec, 0x5a, 0xcf, 0x32, 0x1f, 0x1f, 0xd7, 0x9c, 0xc7, 0xd5, 0x9e, 0x65,
+	0x3a, 0xa6, 0x54, 0xd6, 0x1b, 0x5a, 0x95, 0xb4, 0x55, 0x07, 0x9b, 0xca, 0x4c, 0xcb, 0x6c, 0x99,
+	0xa4, 0xa3, 0x86, 0x7f, 0x51, 0x8c, 0xb2, 0x8c, 0xc7, 0x6b, 0xa6, 0x85, 0x6a, 0x5a, 0x47, 0x47,
+	0x86, 0x53, 0x1b, 0x6c, 0xb2, 0x5f, 0x0c, 0x70, 0xd5, 0x03, 0x98, 0x86, 0x81, 0x34, 0x47, 0x37,
+	0x0d, 0x02, 0xe2, 0x5f, 0x0c, 0x78, 0xd9, 0x03, 0xb6, 0x55, 0xc3, 0x40, 0x1d, 0x82, 0xa2, 0x3f,
+	0x23, 0x68, 0x75, 0xbb, 0xba, 0xd3, 0x75, 0x19, 0xf2, 0x2f, 0x06, 0x9c, 0x6f, 0x99, 0x66, 0xab,
+	0x83, 0x6a, 0xe4, 0xab, 0xd1, 0x3f, 0xa8, 0xa9, 0xc6, 0x31, 0xeb, 0x5a, 0x0c, 0x4f, 0x98, 0xfc,
+	0xcb, 0xba, 0xd7, 0x70, 0x77, 0x47, 0x6f, 0xb5, 0x1d, 0x3a, 0x0b, 0x17, 0x33, 0xd8, 0xf4, 0xc1,
+	0x96, 0x34, 0xd3, 0xee, 0x9a, 0x76, 0xad, 0xa1, 0xda, 0xa8, 0x36, 0xd8, 0x6c, 0x20, 0x47, 0xc5,
+	0x62, 0xe8, 0x6c, 0x32, 0x95, 0xdf, 0xe5, 0xe0, 0xc2, 0xae, 0xdd, 0xda, 0xc3, 0x43, 0x76, 0x08,
+	0xa1, 0x07, 0x8e, 0xea, 0x20, 0x69, 0x03, 0xa4, 0x7e, 0xcf, 0x76, 0x2c, 0xa4, 0x76, 0xf7, 0x29,
+	0x83, 0x7d, 0xbd, 0x29, 0x67, 0x56, 0x32, 0xeb, 0xc5, 0xfa, 0xb4, 0xdb, 0x43, 0x07, 0xdc, 0x69,
+	0x4a, 0x0f, 0x60, 0x8a, 0xa3, 0x7b, 0x16, 0x3a, 0xd0, 0x1f, 0xcb, 0xa3, 0x2b, 0x99, 0xf5, 0xd2,
+	0xd6, 0x95, 0x2a, 0x36, 0x0d, 0xd6, 0x44, 0x55, 0x98, 0xfb, 0x60, 0xb3, 0xba, 0x8b, 0xac, 0xc3,
+	0x0e, 0xda, 0x23, 0xd8, 0x9b, 0xb9, 0x4f, 0xfe, 0xb2, 0x3c, 0x52, 0x9f, 0x74, 0x49, 0xd0, 0x56,
+	0xe9, 0x2d, 0x98, 0xd5, 0xcc, 0xbe, 0xe1, 0x20, 0xab, 0xa7, 0x5a, 0xce, 0xb1, 0x20, 0x46, 0x96,
+	0x88, 0x31, 0x23, 0xf6, 0x72, 0x51, 0xde, 0x86, 0x32, 0x03, 0xda, 0x78, 0x22, 0x72, 0x8e, 0xc8,
+	0x31, 0x53, 0xa5, 0x8a, 0xae, 0xba, 0x8a, 0xae, 0x6e, 0x1b, 0xc7, 0xf5, 0x92, 0x26, 0xcc, 0xf8,
+	0x8b, 0x30, 0xa5, 0x99, 0x86, 0x8d, 0x0c, 0xbb, 0x6f, 0xb3, 0xb1, 0x63, 0x09, 0x63, 0x27, 0x39,
+	0x98, 0x0e, 0xbf, 0x0c, 0xe5, 0x9e, 0x65, 0x9a, 0x07, 0x4c, 0x4c, 0x39, 0xbf, 0x92, 0x59, 0x2f,
+	0xd7, 0x4b, 0xa4, 0x8d, 0x0a, 0x27, 0x5d, 0x85, 0x29, 0x06, 0x71, 0x87, 0xca, 0xe3, 0x04, 0x35,
+	0x49, 0x51, 0x6e, 0xab, 0xb4, 0xe3, 0xd2, 0x6a, 0x23, 0x6c, 0x60, 0xb9, 0x40, 0xe4, 0x50, 0x04,
+	0x5d, 0x52, 0xc7, 0x1d, 0x6c, 0x56, 0x6f, 0x13, 0x04, 0xd3, 0x20, 0xe5, 0x46, 0x9b, 0xa4, 0xbb,
+	0x30, 0xed, 0xcd, 0x87, 0x11, 0x2a, 0xa6, 0x24, 0xe4, 0x69, 0x82, 0x11, 0x9b, 0x85, 0xbc, 0xad,
+	0xb7, 0x0c, 0x64, 0xc9, 0x40, 0x74, 0xcf, 0xbe, 0x3e, 0x5f, 0xf8, 0xc1, 0xc7, 0xcb, 0x23, 0x7f,
+	0xff, 0x78, 0x79, 0xa4, 0xb2, 0x08, 0x0b, 0x11, 0x7e, 0x54, 0x47, 0x76, 0x0f, 0x93, 0xaa, 0xfc,
+	0x73, 0x1c, 0xe6, 0x79, 0x3f, 0x8f, 0xa8, 0xfb, 0x3d, 0x64, 0x3c, 0xb4, 0x8e, 0xa5, 0x55, 0x98,
+	0xf0, 0xc2, 0xcc, 0x73, 0xb4, 0xb2, 0xd7, 0xf8, 0xbc, 0x9c, 0xec, 0x2e, 0x80, 0xc7, 0x84, 0x38,
+	0x56, 0x69, 0x6b, 0x4d, 0xa4, 0xe7, 0xf6, 0x61, 0x7a, 0x9e, 0xe0, 0x5f, 0x31, 0x9a, 0x8c, 0xa0,
+	0x30, 0x5c, 0xfa, 0x1a, 0xcc, 0x35, 0xcd, 0x23, 0xc3, 0x1f, 0x36, 0xc3, 0xdd, 0xf0, 0xa2, 0x37,
+	0x48, 0x0c, 0xc1, 0x3a, 0x28, 0x22, 0xb5, 0x13, 0xf8, 0xa6, 0x2c, 0x10, 0xf4, 0x7b, 0xe9, 0x4d,
+	0x90, 0x48, 0x76, 0xf0, 0x0b, 0x97, 0x4f, 0xa0, 0x35, 0xdd, 0x0b, 0xa6, 0x86, 0x45, 0x00, 0xea,
+	0x9d, 0xba, 0xa1, 0x3b, 0xcc, 0x83, 0x8b, 0xa4, 0xe5, 0x8e, 0xa1, 0x3b, 0xa1, 0x40, 0x28, 0xa4,
+	0x0a, 0x84, 0x62, 0xaa, 0x40, 0x80, 0xb3, 0x0a, 0x84, 0xd2, 0xb3, 0x06, 0xc2, 0x06, 0x51, 0xa0,
+	0x79, 0xb0, 0x2f, 0xaa, 0x51, 0x2e, 0x13, 0xe9, 0xa7, 0x49, 0x8f, 0x10, 0x02, 0xd2, 0x16, 0x5c,
+	0xf4, 0xa1, 0xf9, 0x74, 0x27, 0xc8, 0x80, 0x0b, 0xc2, 0x00, 0x3e, 0xe7, 0x7b, 0x7e, 0x0e, 0x4c,
+	0xe0, 0xc9, 0x94, 0x02, 0x0b, 0x32, 0x30, 0x89, 0xdf, 0x87, 0xd9, 0x00, 0x77, 0x97, 0xe6, 0x54,
+	0x4a, 0x9a, 0x33, 0x3d, 0x9f, 0x84, 0xa1, 0x94, 0x30, 0x1d, 0x93, 0x12, 0x56, 0xe1, 0x72, 0x6c,
+	0xc8, 0xf3, 0xc4, 0xf0, 0x8f, 0xd8, 0xc4, 0xb0, 0xad, 0x1d, 0xbe, 0x4a, 0x0c, 0x2f, 0x52, 0x62,
+	0x58, 0x00, 0x9a, 0x06, 0xf6, 0x1d, 0xeb, 0x98, 0xe5, 0x85, 0x02, 0x69, 0xc0, 0x29, 0xfe, 0x55,
+	0x5a, 0x78, 0x95, 0x16, 0x86, 0xa4, 0x85, 0x6d, 0xed, 0x90, 0xa7, 0x85, 0x1f, 0x66, 0x61, 0x31,
+	0x1a, 0xb5, 0x63, 0x1a, 0x07, 0xba, 0xd5, 0x4d, 0x97, 0x1a, 0xa2, 0xcb, 0xd8, 0xd1, 0xf4, 0x65,
+	0x6c, 0xf6, 0xd4, 0x89, 0xe4, 0x5d, 0x50, 0xfc, 0x65, 0xac, 0x4f, 0xe8, 0x1c, 0x11, 0x45, 0xf6,
+	0x95, 0xb2, 0xe2, 0x04, 0x78, 0x4c, 0xa9, 0xda, 0xa1, 0x3c, 0x26, 0xc4, 0x14, 0xce, 0x8e, 0xc1,
+	0x38, 0xc8, 0x3f, 0x4b, 0x1c, 0x78, 0x06, 0x1b, 0x8f, 0x31, 0xd8, 0x55, 0x58, 0x4b, 0x34, 0x05,
+	0x37, 0xda, 0x1f, 0x47, 0x61, 0x29, 0x1a, 0xf9, 0x9e, 0x6e, 0xa8, 0x1d, 0xfd, 0xdb, 0xe8, 0x85,
+	0xb1, 0xda, 0x2a, 0x4c, 0xf0, 0x5c, 0x84, 0xe7, 0x48, 0x0c, 0x55, 0xae, 0x97, 0xdd, 0x4c, 0x44,
+	0x5c, 0x30, 0xa8, 0xff, 0xb1, 0xd3, 0xe9, 0x3f, 0x1f, 0xa3, 0xff, 0x75, 0x78, 0x2d, 0x59, 0xab,
+	0xdc, 0x00, 0x3f, 0xca, 0xc2, 0x5c, 0x18, 0x4a, 0xb3, 0xf3, 0x8b, 0xa2, 0x79, 0xff, 0xc2, 0x9b,
+	0x3b, 0xdd, 0xc2, 0x3b, 0x03, 0x63, 0x44, 0xd7, 0x2c, 0x74, 0xe8, 0xc7, 0x67, 0x15, 0x37, 0x97,
+	0x61, 0x39, 0xc6, 0x18, 0xdc, 0x60, 0xbf, 0xcf, 0xc1, 0x2c, 0xc7, 0xd0, 0x23, 0x04, 0x77, 0x4f,
+	0x74, 0x0e, 0x76, 0xe0, 0x37, 0x60, 0xcc, 0xb4, 0x9a, 0xc8, 0x22, 0x56, 0x9d, 0xf4, 0x29, 0x88,
+	0xca, 0x8a, 0xe9, 0xdc, 0xc7, 0x88, 0x3a, 0x05, 0xe2, 0x25, 0x5c, 0x70, 0xb2, 0xb6, 0xd9, 0xb3,
+	0xe5, 0xdc, 0x4a, 0x76, 0xbd, 0x58, 0x9f, 0xf4, 0x9a, 0x6f, 0x9b, 0x3d, 0x5b, 0x9a, 0x83, 0xf1,
+	0x9e, 0x69, 0x91, 0x29, 0x8d, 0x51, 0xf5, 0xe1, 0xcf, 0x3b, 0x4d, 0xbc, 0xbb, 0x60, 0xc4, 0x71,
+	0x1f, 0x0d, 0x89, 0x22, 0x6b, 0xa1, 0x0e, 0x2a, 0xd4, 0x3e, 0x2e, 0x09, 0x6a, 0x81, 0x69, 0xaf,
+	0x67, 0x8f, 0x12, 0x93, 0x61, 0x7c, 0x80, 0x2c, 0x1b, 0x3b, 0x52, 0x81, 0x40, 0xdc, 0xcf, 0xc0,
+	0x26, 0xa6, 0x18, 0xdc, 0xc4, 0x9c, 0x49, 0x85, 0xe1, 0x79, 0x48, 0x49, 0xf4, 0x10, 0xe9, 0x2e,
+	0x4c, 0x70, 0x5b, 0x11, 0x15, 0x95, 0x57, 0xb2, 0xeb, 0xa5, 0xad, 0x95, 0xaa, 0x78, 0x8c, 0x55,
+	0x0d, 0xf8, 0xcd, 0x6d, 0xb3, 0xc7, 0x78, 0x94, 0xdd, 0xc1, 0x58, 0x91, 0x82, 0xbb, 0xad, 0x08,
+	0xc9, 0xd7, 0xe7, 0x4a, 0xdc, 0xdb, 0xfe, 0x1d, 0xed, 0x6d, 0x78, 0x29, 0x79, 0xe5, 0x6d, 0xa7,
+	0xf7, 0xb6, 0x2d, 0x10, 0x0a, 0xf6, 0x7d, 0x81, 0x2e, 0xf5, 0xbd, 0x0b, 0x5e, 0xe7, 0x0e, 0xe7,
+	0x20, 0x78, 0x68, 0xd1, 0xef, 0xa1, 0xbe, 0x6a, 0x1a, 0x02, 0xd5, 0x74, 0xd0, 0x3f, 0x4b, 0xa7,
+	0xf3, 0xcf, 0x72, 0xb2, 0x7f, 0x4e, 0x3c, 0x47, 0xff, 0x14, 0x8b, 0xbe, 0xef, 0x67, 0x41, 0x89,
+	0x80, 0xb8, 0xcb, 0xed, 0x39, 0xf0, 0x51, 0xc1, 0x91, 0xb2, 0x09, 0x8e, 0x94, 0x0b, 0x3a, 0x52,
+	0xac, 0x6b, 0x8c, 0xc5, 0xbb, 0x86, 0xaf, 0xf4, 0xcb, 0x0f, 0x29, 0xfd, 0xc6, 0x4f, 0xe7, 0x00,
+	0x05, 0xd1, 0x01, 0x2a, 0x57, 0xa0, 0x12, 0x6f, 0x06, 0x6e, 0xad, 0x3f, 0x8f, 0xc2, 0x42, 0x04,
+	0x8c, 0x97, 0x7a, 0x2f, 0xb0, 0xb9, 0x42, 0xd5, 0xdf, 0x58, 0x8a, 0xea, 0xef, 0x2c, 0xab, 0x88,
+	0xca, 0x1a, 0xac, 0x26, 0xe8, 0x56, 0xac, 0xb8, 0x83, 0x36, 0xd8, 0xe9, 0x98, 0x36, 0x7a, 0x09,
+	0x42, 0xc6, 0xbf, 0x42, 0x8f, 0x0d, 0x5b, 0xa1, 0x9f, 0xb3, 0xf6, 0x45, 0xad, 0x72, 0xed, 0xff,
+	0x21, 0x0b, 0x33, 0x01, 0xdc, 0xb9, 0xb9, 0x3d, 0x79, 0x56, 0xb5, 0xbf, 0x0b, 0xe3, 0xec, 0x83,
+	0x6d, 0x67, 0x2e, 0x45, 0xae, 0xc3, 0x6c, 0xba, 0x8c, 0xb9, 0x3b, 0xc4, 0xab, 0xb7, 0xf3, 0x49,
+	0xf5, 0xf6, 0x59, 0x26, 0xab, 0xf0, 0x6a, 0x55, 0x7c, 0xf6, 0xd5, 0xaa, 0xb2, 0x04, 0x97, 0xa2,
+	0x0c, 0xea, 0x59, 0x7c, 0x14, 0x24, 0x17, 0x50, 0x47, 0xda, 0x60, 0x4f, 0xd5, 0x0e, 0x91, 0x73,
+	0x1e, 0xec, 0xfd, 0x0e, 0xe4, 0x7b, 0x44, 0x18, 0xb6, 0x05, 0x5b, 0x88, 0x34, 0x1b, 0x95, 0x97,
+	0x91, 0x60, 0x03, 0x3c, 0xa3, 0xe5, 0x92, 0x8c, 0xf6, 0x9c, 0x36, 0xb7, 0x97, 0x40, 0x09, 0x2b,
+	0x94, 0xeb, 0xfb, 0xbb, 0x59, 0xef, 0x74, 0x78, 0x5b, 0x3b, 0x34, 0xcc, 0xa3, 0x0e, 0x6a, 0xb6,
+	0xd0, 0x4b, 0xa1, 0xf6, 0x75, 0x98, 0x52, 0xbd, 0x29, 0x61, 0x9e, 0xcc, 0x00, 0xc1, 0xe6, 0xff,
+	0xee, 0x2e, 0x56, 0x38, 0xae, 0x0b, 0x59, 0x80, 0xdb, 0xe9, 0x5f, 0xa3, 0xde, 0x35, 0x32, 0x9b,
+	0xac, 0xea, 0x68, 0xed, 0xf3, 0x60, 0xa1, 0x2f, 0xc1, 0x98, 0xee, 0xa0, 0xae, 0x2d, 0x67, 0x49,
+	0x5e, 0xa8, 0x44, 0xe4, 0x05, 0x41, 0xe2, 0x3b, 0x0e, 0xea, 0x32, 0x42, 0x74, 0x98, 0xb4, 0x0c,
+	0xa5, 0x06, 0xee, 0xd9, 0x17, 0x63, 0x04, 0x48, 0xd3, 0xde, 0x99, 0x06, 0x8a, 0xea, 0x98, 0x5d,
+	0x5d, 0x23, 0x66, 0x2c, 0xd4, 0xd9, 0x57, 0x0a, 0xfb, 0xfc, 0x22, 0x03, 0x33, 0x51, 0xb3, 0x10,
+	0x5c, 0x33, 0x73, 0x06, 0xae, 0x39, 0x3a, 0xc4, 0x35, 0xb3, 0x82, 0x6b, 0x0a, 0xd2, 0x69, 0x5e,
+	0x7d, 0x22, 0xc8, 0xe7, 0xfa, 0x8d, 0x74, 0x0b, 0xc6, 0x2d, 0x64, 0xf7, 0x3b, 0x8e, 0x2d, 0x67,
+	0x56, 0xb2, 0xdc, 0xd2, 0xb1, 0xe6, 0xa9, 0x13, 0xb0, 0xbb, 0xea, 0xb0, 0xa1, 0x95, 0x0f, 0x60,
+	0x36, 0x1a, 0x28, 0x5d, 0x82, 0xa2, 0x66, 0x36, 0x91, 0xdd, 0x53, 0x35, 0xc4, 0xdc, 0xce, 0x6b,
+	0x90, 0x24, 0xc8, 0xe1, 0x0f, 0x32, 0xb7, 0x89, 0x3a, 0xf9, 0x2d, 0x4d, 0x43, 0xb6, 0x63, 0xb6,
+	0xd8, 0x9a, 0x89, 0x7f, 0x56, 0x7e, 0x92, 0x85, 0x8b, 0xee, 0x1c, 0x1e, 0xea, 0x5d, 0x64, 0xf6,
+	0x9d, 0x97, 0x22, 0xff, 0x5c, 0x03, 0x7a, 0x2b, 0xb0, 0xdf, 0x37, 0x2c, 0xa4, 0x21, 0x7d, 0x80,
+	0x9a, 0x6e, 0x02, 0x22, 0xed, 0x8f, 0x78, 0xf3, 0xd9, 0xb8, 0xf8, 0x06, 0x48, 0x06, 0x7a, 0xec,
+	0xec, 0xdb, 0xe8, 0x5b, 0x7d, 0x64, 0x68, 0x68, 0xdf, 0x42, 0xda, 0x80, 0xb8, 0x7b, 0xae, 0x3e,
+	0x8d, 0x7b, 0x1e, 0xb0, 0x0e, 0xbc, 0x0e, 0xa4, 0x70, 0xfc, 0x65, 0x58, 0x8c, 0x34, 0x0b, 0x4f,
+	0x4a, 0xbf, 0xce, 0xc2, 0x6c, 0x00, 0x71, 0xdf, 0x20, 0x85, 0xdc, 0xff, 0x8e, 0xe5, 0x96, 0xa1,
+	0xe4, 0x5e, 0xbb, 0x99, 0x36, 0x62, 0x0b, 0x08, 0xb0, 0x5b, 0x37, 0xac, 0x89, 0x33, 0x59, 0x45,
+	0xa2, 0x4d, 0x3b, 0x3e, 0xd4, 0xb4, 0x85, 0x18, 0xd3, 0x0a, 0x47, 0x05, 0x7e, 0xc3, 0x71, 0xdb,
+	0x7e, 0xe4, 0xde, 0x0f, 0xf5, 0x0d, 0x24, 0xc4, 0xfe, 0x0e, 0xb7, 0xc2, 0x8b, 0x5c, 0x83, 0x2b,
+	0x50, 0x70, 0x35, 0x49, 0x2c, 0x96, 0xab, 0xf3, 0x6f, 0x6f, 0x6b, 0xaa, 0x36, 0x6c, 0x02, 0xc8,
+	0x0b, 0x5b, 0xd3, 0x6d, 0xda, 0xf6, 0x5c, 0x0b, 0xee, 0x88, 0x8b, 0xa1, 0x38, 0x1b, 0x70, 0x6b,
+	0xfd, 0x26, 0xe3, 0x95, 0xcd, 0x5f, 0xd7, 0x9d, 0xf6, 0x6d, 0xa4, 0xe2, 0xf3, 0xbb, 0x93, 0x99,
+	0x68, 0x03, 0xf2, 0x6d, 0x32, 0x4e, 0x1e, 0x4d, 0xb8, 0x96, 0x66, 0x18, 0xe9, 0x35, 0xc8, 0x76,
+	0xed, 0x96, 0x9c, 0x4d, 0x80, 0x62, 0x80, 0x30, 0xcb, 0x5c, 0xcc, 0x2c, 0x1f, 0x81, 0x12, 0x96,
+	0x9d, 0xaf, 0x60, 0x6f, 0x43, 0xb9, 0x6b, 0xb7, 0xf6, 0x2d, 0xf6, 0x2d, 0x67, 0x12, 0x18, 0x96,
+	0xba, 0x76, 0x8b, 0xeb, 0xe4, 0xa7, 0x19, 0x42, 0xf7, 0x41, 0xbf, 0xd1, 0xd5, 0x1d, 0x42, 0x7d,
+	0x57, 0xb7, 0x1b, 0xa8, 0xad, 0x0e, 0x74, 0xb3, 0x6f, 0x49, 0xef, 0x43, 0xb9, 0x2b, 0x7c, 0x33,
+	0xba, 0x1b, 0xc4, 0x84, 0xe2, 0xb3, 0xbf, 0xc0, 0x5a, 0x29, 0xd2, 0x70, 0x77, 0x38, 0x22, 0x1d,
+	0x61, 0xbe, 0xa3, 0x31, 0xf3, 0xa5, 0xa7, 0x3f, 0x31, 0x72, 0x71, 0xf1, 0x7f, 0xc5, 0xf6, 0xbe,
+	0xea, 0xb1, 0x60, 0xfa, 0xf7, 0xd0, 0x49, 0x53, 0xab, 0x10, 0x22, 0xa3, 0x09, 0x21, 0x92, 0x4d,
+	0x0a, 0x91, 0x5c, 0x20, 0x44, 0x3e, 0x84, 0xec, 0x01, 0xc2, 0x91, 0x83, 0x0b, 0x8a, 0xf9, 0x2a,
+	0x7d, 0x01, 0x59, 0xc5, 0x2f, 0x20, 0xab, 0xec, 0x05, 0x64, 0x75, 0xc7, 0xd4, 0x8d, 0x9b, 0x37,
+	0xb0, 0x7a, 0x7e, 0xf9, 0xd7, 0xe5, 0xf5, 0x96, 0xee, 0xb4, 0xfb, 0x0d, 0x1c, 0xdf, 0x35, 0xf6,
+	0x5c, 0x92, 0xfe, 0xf7, 0x86, 0xdd, 0x3c, 0xac, 0x39, 0xc7, 0x3d, 0x64, 0x93, 0x01, 0x76, 0x1d,
+	0xd3, 0x8d, 0xdb, 0xd3, 0x48, 0x5f, 0x80, 0x62, 0x53, 0xb7, 0xd8, 0xbd, 0xd5, 0x38, 0x39, 0xc3,
+	0x5e, 0x0c, 0x54, 0x33, 0x44, 0x49, 0xb7, 0x5c, 0x50, 0xdd, 0xc3, 0x47, 0xe5, 0x9f, 0xc2, 0x69,
+	0xf3, 0x8f, 0x60, 0x53, 0xb6, 0xaf, 0x0d, 0x1a, 0x8b, 0x5b, 0xf3, 0x90, 0x94, 0xef, 0x75, 0xd4,
+	0xd2, 0x6d, 0x07, 0x59, 0x8f, 0x18, 0x19, 0x5c, 0x3e, 0xa9, 0x7d, 0xa7, 0x6d, 0x5a, 0xba, 0x73,
+	0xec, 0x96, 0x4f, 0xbc, 0xe1, 0x64, 0x97, 0x85, 0xa1, 0xa7, 0x82, 0x41, 0x66, 0x5c, 0x96, 0x2e,
+	0x29, 0xb7, 0x6e, 0x21, 0xeb, 0xb3, 0x91, 0x86, 0x96, 0x11, 0x61, 0x76, 0x5c, 0x9e, 0x9f, 0x65,
+	0x60, 0x82, 0x28, 0xaf, 0x6f, 0xd3, 0x34, 0x27, 0x78, 0x40, 0xc6, 0xe7, 0x01, 0x27, 0xbb, 0x3d,
+	0x7d, 0xc6, 0xd5, 0x41, 0x10, 0x7d, 0x0e, 0x2e, 0xfa, 0x04, 0xe3, 0x22, 0xff, 0x3c, 0x03, 0x53,
+	0xbb, 0x76, 0xeb, 0x91, 0xd1, 0x3b, 0x7f, 0x42, 0xcf, 0xc3, 0x5c, 0x40, 0x34, 0x2e, 0xf6, 0x6f,
+	0xf3, 0x64, 0x42, 0xbb, 0x7a, 0xcb, 0x52, 0x1d, 0x24, 0xbe, 0x98, 0x49, 0x36, 0xfd, 0x02, 0x14,
+	0x83, 0x92, 0x17, 0x34, 0x57, 0xe2, 0x0a, 0x4c, 0x18, 0xe8, 0x28, 0xf4, 0x7a, 0xb8, 0x64, 0xa0,
+	0x23, 0x3e, 0xab, 0xdb, 0x70, 0x31, 0xa8, 0x83, 0xe1, 0xaf, 0xb3, 0x2e, 0xf8, 0x95, 0x43, 0x4f,
+	0xfe, 0xee, 0xc3, 0x3c, 0xe6, 0x16, 0x4d, 0x2d, 0xe9, 0x69, 0xd6, 0xac, 0x81, 0x8e, 0x1e, 0x45,
+	0x10, 0xbc, 0x07, 0xb2, 0x47, 0x2c, 0xf0, 0xd4, 0x2b, 0xe9, 0x79, 0xd6, 0x2c, 0x97, 0xce, 0xff,
+	0xd0, 0xeb, 0x03, 0x98, 0x8f, 0xa0, 0x77, 0xc2, 0x3a, 0x61, 0x2e, 0x44, 0x9c, 0x76, 0x7b, 0x2f,
+	0x9b, 0x02, 0x0a, 0x90, 0x0b, 0xc2, 0xcb, 0x26, 0xff, 0x34, 0xa5, 0xcf, 0x81, 0x1c, 0x1c, 0x13,
+	0x78, 0xff, 0x35, 0xeb, 0x1f, 0x76, 0xb6, 0xef, 0xc0, 0xde, 0x81, 0x79, 0x4a, 0x24, 0xc2, 0x6e,
+	0x72, 0x49, 0xe0, 0x7f, 0x2f, 0x68, 0x20, 0xe9, 0xcb, 0x70, 0x29, 0x6a, 0x28, 0x97, 0x9e, 0xbe,
+	0xff, 0x9a, 0x0f, 0x8d, 0xe6, 0x13, 0xf8, 0x2a, 0x4c, 0xe3, 0xa1, 0xbe, 0x49, 0x4c, 0xa4, 0x9c,
+	0xc4, 0xa4, 0x81, 0x8e, 0xf6, 0xbc, 0x79, 0x84, 0x32, 0x5a, 0x38, 0x8a, 0xdc, 0x38, 0xdb, 0xfa,
+	0x68, 0x16, 0xb2, 0xbb, 0x76, 0x4b, 0xfa, 0x26, 0x4c, 0x87, 0x1e, 0xfe, 0x5f, 0xf6, 0x2f, 0x59,
+	0x11, 0x6f, 0xba, 0x95, 0x6b, 0x43, 0x21, 0xbc, 0x3a, 0xb2, 0x60, 0x36, 0x70, 0xf2, 0xea, 0x3e,
+	0x6f, 0xb8, 0x1a, 0x43, 0x24, 0x08, 0x54, 0x6a, 0x29, 0x81, 0x43, 0x78, 0xe2, 0x4b, 0xb3, 0x54,
+	0x3c, 0xb7, 0xb5, 0xc3, 0x74, 0x3c, 0x85, 0x9b, 0x4b, 0xe9, 0x3b, 0xa0, 0x24, 0x3c, 0x55, 0xbb,
+	0x9e, 0x86, 0x1c, 0x03, 0x2b, 0x6f, 0x9e, 0x00, 0xcc, 0xf9, 0x7f, 0x2f, 0x03, 0x0b, 0x49, 0xcf,
+	0xae, 0x36, 0xd2, 0x10, 0x75, 0xd1, 0xca, 0x5b, 0x27, 0x41, 0x73, 0x19, 0x3a, 0x30, 0x13, 0x80,
+	0x51, 0x8f, 0x5a, 0x1b, 0x46, 0x8d, 0x7a, 0xd5, 0x1b, 0xa9, 0x60, 0x9c, 0x9b, 0x0e, 0x17, 0xa2,
+	0x5e, 0xcd, 0x5c, 0x89, 0xa1, 0xe2, 0x43, 0x29, 0x1b, 0x69, 0x50, 0x49, 0xac, 0xb0, 0x37, 0x0d,
+	0x67, 0x85, 0x5d, 0x69, 0x23, 0x0d, 0x8a, 0xb3, 0xea, 0xc3, 0x5c, 0xdc, 0xed, 0xf7, 0xfa, 0x50,
+	0x42, 0xae, 0x07, 0xdd, 0x48, 0x8b, 0xe4, 0x6c, 0x1f, 0x83, 0x1c, 0x7b, 0x8d, 0x7b, 0x6d, 0x28,
+	0x35, 0xee, 0x37, 0x9b, 0xa9, 0xa1, 0x71, 0x9c, 0x7d, 0x97, 0x97, 0xc9, 0x9c, 0x45, 0xa8, 0xb2,
+	0x99, 0x1a, 0xca, 0x39, 0x6b, 0xf0, 0x7f, 0xe1, 0x8b, 0xbb, 0x4a, 0x22, 0x1d, 0xea, 0xa8, 0xaf,
+	0x0f, 0xc7, 0x70, 0x26, 0x1f, 0xc2, 0x54, 0xf0, 0xae, 0x68, 0x25, 0x7a, 0xb8, 0x87, 0x50, 0xd6,
+	0x87, 0x21, 0x42, 0xa9, 0x2e, 0x7c, 0x35, 0x12, 0x93, 0xea, 0x42, 0x40, 0xa5, 0x96, 0x12, 0xc8,
+	0x79, 0xba, 0x8b, 0x86, 0x78, 0xcc, 0x1f, 0xb3, 0x68, 0x08, 0x10, 0xe5, 0xda, 0x50, 0x08, 0xe7,
+	0x70, 0x00, 0x52, 0xc4, 0x61, 0xeb, 0x6a, 0x34, 0x01, 0x1f, 0x48, 0xb9, 0x9e, 0x02, 0x14, 0x8a,
+	0xeb, 0xc0, 0xd9, 0xe0, 0x95, 0x44, 0x1a, 0x0c, 0xa5, 0x6c, 0xa4, 0x41, 0xf9, 0xd7, 0x87, 0xd8,
+	0xa3, 0xaa, 0x28, 0xa9, 0xe3, 0xc0, 0xca, 0x9b, 0x27, 0x00, 0x87, 0xfc, 0x50, 0x38, 0x7c, 0x89,
+	0xf1, 0x43, 0x0f, 0xa1, 0xac, 0x0f, 0x43, 0x88, 0x69, 0x2b, 0xee, 0x1c, 0x23, 0x4c, 0x24, 0x06,
+	0xa9, 0xdc, 0x48, 0x8b, 0xf4, 0x85, 0x70, 0xe8, 0xfc, 0x21, 0x22, 0x84, 0x83, 0x18, 0xe5, 0xf5,
+	0xe1, 0x18, 0xd1, 0xdf, 0x43, 0xfb, 0xe2, 0xb0, 0xbf, 0x07, 0x21, 0xca, 0xb5, 0xa1, 0x10, 0xd1,
+	0xdf, 0x23, 0x76, 0xbb, 0x61, 0x7f, 0x0f, 0x83, 0x94, 0xeb, 0x29, 0x40, 0x9c, 0xcf, 0x3d, 0x00,
+	0x61, 0x13, 0xbb, 0x10, 0xa1, 0x03, 0xb7, 0x53, 0x59, 0x4d, 0xe8, 0xe4, 0xf4, 0x1e, 0x42, 0xd9,
+	0xb7, 0xc3, 0x5c, 0x0c, 0x0d, 0x12, 0xbb, 0x95, 0xb5, 0xc4, 0x6e, 0x51, 0x1b, 0x11, 0x1b, 0xc0,
+	0xb0, 0x40, 0x61, 0x90, 0x72, 0x3d, 0x05, 0xc8, 0xe5, 0x73, 0xf3, 0xfe, 0x27, 0x4f, 0x96, 0x32,
+	0x9f, 0x3e, 0x59, 0xca, 0xfc, 0xed, 0xc9, 0x52, 0xe6, 0xc7, 0x4f, 0x97, 0x46, 0x3e, 0x7d, 0xba,
+	0x34, 0xf2, 0xa7, 0xa7, 0x4b, 0x23, 0xdf, 0xf8, 0x7f, 0xe1, 0x3c, 0xa8, 0xa9, 0x3a, 0xaa, 0xd6,
+	0x56, 0x75, 0xa3, 0xa3, 0x36, 0x6a, 0x7a, 0x43, 0x7b, 0x83, 0xfe, 0x95, 0x6d, 0xe0, 0x0f, 0x91,
+	0xf1, 0x11, 0x51, 0x23, 0x4f, 0xf6, 0x5f, 0x6f, 0xfe, 0x67, 0x00, 0x99, 0x2f, 0xef, 0xb0, 0xaa,
+	0x3c, 0x00, 0x00,
 }
 
-func (m *MsgProxyConnectionOpenConfirmResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	ProxyClientState(ctx context.Context, in *MsgProxyClientState, opts ...grpc.CallOption) (*MsgProxyClientStateResponse, error)
+	ProxyConnectionOpenTry(ctx context.Context, in *MsgProxyConnectionOpenTry, opts ...grpc.CallOption) (*MsgProxyConnectionOpenTryResponse, error)
+	ProxyConnectionOpenAck(ctx context.Context, in *MsgProxyConnectionOpenAck, opts ...grpc.CallOption) (*MsgProxyConnectionOpenAckResponse, error)
+	ProxyConnectionOpenConfirm(ctx context.Context, in *MsgProxyConnectionOpenConfirm, opts ...grpc.CallOption) (*MsgProxyConnectionOpenConfirmResponse, error)
+	ProxyConnectionOpenFinalize(ctx context.Context, in *MsgProxyConnectionOpenFinalize, opts ...grpc.CallOption) (*MsgProxyConnectionOpenFinalizeResponse, error)
+	ProxyConnectionState(ctx context.Context, in *MsgProxyConnectionState, opts ...grpc.CallOption) (*MsgProxyConnectionStateResponse, error)
+	ProxyChannelOpenTry(ctx context.Context, in *MsgProxyChannelOpenTry, opts ...grpc.CallOption) (*MsgProxyChannelOpenTryResponse, error)
+	ProxyChannelOpenAck(ctx context.Context, in *MsgProxyChannelOpenAck, opts ...grpc.CallOption) (*MsgProxyChannelOpenAckResponse, error)
+	ProxyChannelOpenConfirm(ctx context.Context, in *MsgProxyChannelOpenConfirm, opts ...grpc.CallOption) (*MsgProxyChannelOpenConfirmResponse, error)
+	ProxyChannelOpenFinalize(ctx context.Context, in *MsgProxyChannelOpenFinalize, opts ...grpc.CallOption) (*MsgProxyChannelOpenFinalizeResponse, error)
+	ProxyChannelCloseConfirm(ctx context.Context, in *MsgProxyChannelCloseConfirm, opts ...grpc.CallOption) (*MsgProxyChannelCloseConfirmResponse, error)
+	ProxyChannelState(ctx context.Context, in *MsgProxyChannelState, opts ...grpc.CallOption) (*MsgProxyChannelStateResponse, error)
+	ProxyRecvPacket(ctx context.Context, in *MsgProxyRecvPacket, opts ...grpc.CallOption) (*MsgProxyRecvPacketResponse, error)
+	ProxyAcknowledgePacket(ctx context.Context, in *MsgProxyAcknowledgePacket, opts ...grpc.CallOption) (*MsgProxyAcknowledgePacketResponse, error)
+	ProxyPacketBatch(ctx context.Context, in *MsgProxyPacketBatch, opts ...grpc.CallOption) (*MsgProxyPacketBatchResponse, error)
+	ProxyTimeoutPacket(ctx context.Context, in *MsgProxyTimeoutPacket, opts ...grpc.CallOption) (*MsgProxyTimeoutPacketResponse, error)
+	ProxyTimeoutOnClose(ctx context.Context, in *MsgProxyTimeoutOnClose, opts ...grpc.CallOption) (*MsgProxyTimeoutOnCloseResponse, error)
+	PruneProxyPacketCommitment(ctx context.Context, in *MsgPruneProxyPacketCommitment, opts ...grpc.CallOption) (*MsgPruneProxyPacketCommitmentResponse, error)
+	ProxyWithHeader(ctx context.Context, in *MsgProxyWithHeader, opts ...grpc.CallOption) (*MsgProxyWithHeaderResponse, error)
+	SubmitProxyMisbehaviour(ctx context.Context, in *MsgSubmitProxyMisbehaviour, opts ...grpc.CallOption) (*MsgSubmitProxyMisbehaviourResponse, error)
+	PayProxyPacketFee(ctx context.Context, in *MsgPayProxyPacketFee, opts ...grpc.CallOption) (*MsgPayProxyPacketFeeResponse, error)
+	RegisterUpstream(ctx context.Context, in *MsgRegisterUpstream, opts ...grpc.CallOption) (*MsgRegisterUpstreamResponse, error)
+	DeregisterUpstream(ctx context.Context, in *MsgDeregisterUpstream, opts ...grpc.CallOption) (*MsgDeregisterUpstreamResponse, error)
+	PauseProxy(ctx context.Context, in *MsgPauseProxy, opts ...grpc.CallOption) (*MsgPauseProxyResponse, error)
+	UnpauseProxy(ctx context.Context, in *MsgUnpauseProxy, opts ...grpc.CallOption) (*MsgUnpauseProxyResponse, error)
+	MigrateProxyClient(ctx context.Context, in *MsgMigrateProxyClient, opts ...grpc.CallOption) (*MsgMigrateProxyClientResponse, error)
 }
 
-func (m *MsgProxyConnectionOpenConfirmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type msgClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *MsgProxyConnectionOpenFinalize) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) ProxyClientState(ctx context.Context, in *MsgProxyClientState, opts ...grpc.CallOption) (*MsgProxyClientStateResponse, error) {
+	out := new(MsgProxyClientStateResponse)
+	err := c.cc.Invoke(ctx, "/ibc.proxy.v1.Msg/ProxyClientState", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgProxyConnectionOpenFinalize) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ProxyConnectionOpenTry(ctx context.Context, in *MsgProxyConnectionOpenTry, opts ...grpc.CallOption) (*MsgProxyConnectionOpenTryResponse, error) {
+	out := new(MsgProxyConnectionOpenTryResponse)
+	err := c.cc.Invoke(ctx, "/ibc.proxy.v1.Msg/ProxyConnectionOpenTry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgProxyConnectionOpenFinalize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x32
-	}
-	{
-		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.ProofConfirm) > 0 {
-		i -= len(m.ProofConfirm)
-		copy(dAtA[i:], m.ProofConfirm)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofConfirm)))
-		i--
-		dAtA[i] = 0x22
-	}
-	{
-		size, err := m.UpstreamPrefix.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.UpstreamClientId) > 0 {
-		i -= len(m.UpstreamClientId)
-		copy(dAtA[i:], m.UpstreamClientId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.UpstreamClientId)))
-		i--
-		dAtA[i] = 0x12
+func (c *msgClient) ProxyConnectionOpenAck(ctx context.Context, in *MsgProxyConnectionOpenAck, opts ...grpc.CallOption) (*MsgProxyConnectionOpenAckResponse, error) {
+	out := new(MsgProxyConnectionOpenAckResponse)
+	err := c.cc.Invoke(ctx, "/ibc.proxy.v1.Msg/ProxyConnectionOpenAck", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.ConnectionId) > 0 {
-		i -= len(m.ConnectionId)
-		copy(dAtA[i:], m.ConnectionId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) ProxyConnectionOpenConfirm(ctx context.Context, in *MsgProxyConnectionOpenConfirm, opts ...grpc.CallOption) (*MsgProxyConnectionOpenConfirmResponse, error) {
+	out := new(MsgProxyConnectionOpenConfirmResponse)
+	err := c.cc.Invoke(ctx, "/ibc.proxy.v1.Msg/ProxyConnectionOpenConfirm", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgProxyConnectionOpenFinalizeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ProxyConnectionOpenFinalize(ctx context.Context, in *MsgProxyConnectionOpenFinalize, opts ...grpc.CallOption) (*MsgProxyConnectionOpenFinalizeResponse, error) {
+	out := new(MsgProxyConnectionOpenFinalizeResponse)
+	err := c.cc.Invoke(ctx, "/ibc.proxy.v1.Msg/ProxyConnectionOpenFinalize", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgProxyConnectionOpenFinalizeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ProxyConnectionState(ctx context.Context, in *MsgProxyConnectionState, opts ...grpc.CallOption) (*MsgProxyConnectionStateResponse, error) {
+	out := new(MsgProxyConnectionStateResponse)
+	err := c.cc.Invoke(ctx, "/ibc.proxy.v1.Msg/ProxyConnectionState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgProxyConnectionOpenFinalizeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) ProxyChannelOpenTry(ctx context.Context, in *MsgProxyChannelOpenTry, opts ...grpc.CallOption) (*MsgProxyChannelOpenTryResponse, error) {
+	out := new(MsgProxyChannelOpenTryResponse)
+	err := c.cc.Invoke(ctx, "/ibc.proxy.v1.Msg/ProxyChannelOpenTry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgProxyChannelOpenTry) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ProxyChannelOpenAck(ctx context.Context, in *MsgProxyChannelOpenAck, opts ...grpc.CallOption) (*MsgProxyChannelOpenAckResponse, error) {
+	out := new(MsgProxyChannelOpenAckResponse)
+	err := c.cc.Invoke(ctx, "/ibc.proxy.v1.Msg/ProxyChannelOpenAck", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgProxyChannelOpenTry) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ProxyChannelOpenConfirm(ctx context.Context, in *MsgProxyChannelOpenConfirm, opts ...grpc.CallOption) (*MsgProxyChannelOpenConfirmResponse, error) {
+	out := new(MsgProxyChannelOpenConfirmResponse)
+	err := c.cc.Invoke(ctx, "/ibc.proxy.v1.Msg/ProxyChannelOpenConfirm", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgProxyChannelOpenTry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	{
-		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x52
-	if len(m.ProofInit) > 0 {
-		i -= len(m.ProofInit)
-		copy(dAtA[i:], m.ProofInit)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofInit)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.DownstreamPortId) > 0 {
-		i -= len(m.DownstreamPortId)
-		copy(dAtA[i:], m.DownstreamPortId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DownstreamPortId)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.PortId) > 0 {
-		i -= len(m.PortId)
-		copy(dAtA[i:], m.PortId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.ConnectionHops) > 0 {
-		for iNdEx := len(m.ConnectionHops) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ConnectionHops[iNdEx])
-			copy(dAtA[i:], m.ConnectionHops[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionHops[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
+func (c *msgClient) ProxyChannelOpenFinalize(ctx context.Context, in *MsgProxyChannelOpenFinalize, opts ...grpc.CallOption) (*MsgProxyChannelOpenFinalizeResponse, error) {
+	out := new(MsgProxyChannelOpenFinalizeResponse)
+	err := c.cc.Invoke(ctx, "/ibc.proxy.v1.Msg/ProxyChannelOpenFinalize", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Order != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Order))
-		i--
-		dAtA[i] = 0x18
+	return out, nil
+}
+
+func (c *msgClient) ProxyChannelCloseConfirm(ctx context.Context, in *MsgProxyChannelCloseConfirm, opts ...grpc.CallOption) (*MsgProxyChannelCloseConfirmResponse, error) {
+	out := new(MsgProxyChannelCloseConfirmResponse)
+	err := c.cc.Invoke(ctx, "/ibc.proxy.v1.Msg/ProxyChannelCloseConfirm", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	{
-		size, err := m.UpstreamPrefix.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	return out, nil
+}
+
+func (c *msgClient) ProxyChannelState(ctx context.Context, in *MsgProxyChannelState, opts ...grpc.CallOption) (*MsgProxyChannelStateResponse, error) {
+	out := new(MsgProxyChannelStateResponse)
+	err := c.cc.Invoke(ctx, "/ibc.proxy.v1.Msg/ProxyChannelState", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.UpstreamClientId) > 0 {
-		i -= len(m.UpstreamClientId)
-		copy(dAtA[i:], m.UpstreamClientId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.UpstreamClientId)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) ProxyRecvPacket(ctx context.Context, in *MsgProxyRecvPacket, opts ...grpc.CallOption) (*MsgProxyRecvPacketResponse, error) {
+	out := new(MsgProxyRecvPacketResponse)
+	err := c.cc.Invoke(ctx, "/ibc.proxy.v1.Msg/ProxyRecvPacket", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgProxyChannelOpenTryResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ProxyAcknowledgePacket(ctx context.Context, in *MsgProxyAcknowledgePacket, opts ...grpc.CallOption) (*MsgProxyAcknowledgePacketResponse, error) {
+	out := new(MsgProxyAcknowledgePacketResponse)
+	err := c.cc.Invoke(ctx, "/ibc.proxy.v1.Msg/ProxyAcknowledgePacket", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgProxyChannelOpenTryResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ProxyPacketBatch(ctx context.Context, in *MsgProxyPacketBatch, opts ...grpc.CallOption) (*MsgProxyPacketBatchResponse, error) {
+	out := new(MsgProxyPacketBatchResponse)
+	err := c.cc.Invoke(ctx, "/ibc.proxy.v1.Msg/ProxyPacketBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgProxyChannelOpenTryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) ProxyTimeoutPacket(ctx context.Context, in *MsgProxyTimeoutPacket, opts ...grpc.CallOption) (*MsgProxyTimeoutPacketResponse, error) {
+	out := new(MsgProxyTimeoutPacketResponse)
+	err := c.cc.Invoke(ctx, "/ibc.proxy.v1.Msg/ProxyTimeoutPacket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgProxyChannelOpenAck) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ProxyTimeoutOnClose(ctx context.Context, in *MsgProxyTimeoutOnClose, opts ...grpc.CallOption) (*MsgProxyTimeoutOnCloseResponse, error) {
+	out := new(MsgProxyTimeoutOnCloseResponse)
+	err := c.cc.Invoke(ctx, "/ibc.proxy.v1.Msg/ProxyTimeoutOnClose", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgProxyChannelOpenAck) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) PruneProxyPacketCommitment(ctx context.Context, in *MsgPruneProxyPacketCommitment, opts ...grpc.CallOption) (*MsgPruneProxyPacketCommitmentResponse, error) {
+	out := new(MsgPruneProxyPacketCommitmentResponse)
+	err := c.cc.Invoke(ctx, "/ibc.proxy.v1.Msg/PruneProxyPacketCommitment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgProxyChannelOpenAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x62
+func (c *msgClient) ProxyWithHeader(ctx context.Context, in *MsgProxyWithHeader, opts ...grpc.CallOption) (*MsgProxyWithHeaderResponse, error) {
+	out := new(MsgProxyWithHeaderResponse)
+	err := c.cc.Invoke(ctx, "/ibc.proxy.v1.Msg/ProxyWithHeader", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	{
-		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	return out, nil
+}
+
+func (c *msgClient) SubmitProxyMisbehaviour(ctx context.Context, in *MsgSubmitProxyMisbehaviour, opts ...grpc.CallOption) (*MsgSubmitProxyMisbehaviourResponse, error) {
+	out := new(MsgSubmitProxyMisbehaviourResponse)
+	err := c.cc.Invoke(ctx, "/ibc.proxy.v1.Msg/SubmitProxyMisbehaviour", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x5a
-	if len(m.ProofTry) > 0 {
-		i -= len(m.ProofTry)
-		copy(dAtA[i:], m.ProofTry)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofTry)))
-		i--
-		dAtA[i] = 0x52
+	return out, nil
+}
+
+func (c *msgClient) PayProxyPacketFee(ctx context.Context, in *MsgPayProxyPacketFee, opts ...grpc.CallOption) (*MsgPayProxyPacketFeeResponse, error) {
+	out := new(MsgPayProxyPacketFeeResponse)
+	err := c.cc.Invoke(ctx, "/ibc.proxy.v1.Msg/PayProxyPacketFee", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0x4a
+	return out, nil
+}
+
+func (c *msgClient) RegisterUpstream(ctx context.Context, in *MsgRegisterUpstream, opts ...grpc.CallOption) (*MsgRegisterUpstreamResponse, error) {
+	out := new(MsgRegisterUpstreamResponse)
+	err := c.cc.Invoke(ctx, "/ibc.proxy.v1.Msg/RegisterUpstream", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.DownstreamChannelId) > 0 {
-		i -= len(m.DownstreamChannelId)
-		copy(dAtA[i:], m.DownstreamChannelId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DownstreamChannelId)))
-		i--
-		dAtA[i] = 0x42
+	return out, nil
+}
+
+func (c *msgClient) DeregisterUpstream(ctx context.Context, in *MsgDeregisterUpstream, opts ...grpc.CallOption) (*MsgDeregisterUpstreamResponse, error) {
+	out := new(MsgDeregisterUpstreamResponse)
+	err := c.cc.Invoke(ctx, "/ibc.proxy.v1.Msg/DeregisterUpstream", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.DownstreamPortId) > 0 {
-		i -= len(m.DownstreamPortId)
-		copy(dAtA[i:], m.DownstreamPortId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DownstreamPortId)))
-		i--
-		dAtA[i] = 0x3a
+	return out, nil
+}
+
+func (c *msgClient) PauseProxy(ctx context.Context, in *MsgPauseProxy, opts ...grpc.CallOption) (*MsgPauseProxyResponse, error) {
+	out := new(MsgPauseProxyResponse)
+	err := c.cc.Invoke(ctx, "/ibc.proxy.v1.Msg/PauseProxy", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
-		i--
-		dAtA[i] = 0x32
+	return out, nil
+}
+
+func (c *msgClient) UnpauseProxy(ctx context.Context, in *MsgUnpauseProxy, opts ...grpc.CallOption) (*MsgUnpauseProxyResponse, error) {
+	out := new(MsgUnpauseProxyResponse)
+	err := c.cc.Invoke(ctx, "/ibc.proxy.v1.Msg/UnpauseProxy", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.PortId) > 0 {
-		i -= len(m.PortId)
-		copy(dAtA[i:], m.PortId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
-		i--
-		dAtA[i] = 0x2a
+	return out, nil
+}
+
+func (c *msgClient) MigrateProxyClient(ctx context.Context, in *MsgMigrateProxyClient, opts ...grpc.CallOption) (*MsgMigrateProxyClientResponse, error) {
+	out := new(MsgMigrateProxyClientResponse)
+	err := c.cc.Invoke(ctx, "/ibc.proxy.v1.Msg/MigrateProxyClient", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.ConnectionHops) > 0 {
-		for iNdEx := len(m.ConnectionHops) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ConnectionHops[iNdEx])
-			copy(dAtA[i:], m.ConnectionHops[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionHops[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if m.Order != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Order))
-		i--
-		dAtA[i] = 0x18
-	}
-	{
-		size, err := m.UpstreamPrefix.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.UpstreamClientId) > 0 {
-		i -= len(m.UpstreamClientId)
-		copy(dAtA[i:], m.UpstreamClientId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.UpstreamClientId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgProxyChannelOpenAckResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	ProxyClientState(context.Context, *MsgProxyClientState) (*MsgProxyClientStateResponse, error)
+	ProxyConnectionOpenTry(context.Context, *MsgProxyConnectionOpenTry) (*MsgProxyConnectionOpenTryResponse, error)
+	ProxyConnectionOpenAck(context.Context, *MsgProxyConnectionOpenAck) (*MsgProxyConnectionOpenAckResponse, error)
+	ProxyConnectionOpenConfirm(context.Context, *MsgProxyConnectionOpenConfirm) (*MsgProxyConnectionOpenConfirmResponse, error)
+	ProxyConnectionOpenFinalize(context.Context, *MsgProxyConnectionOpenFinalize) (*MsgProxyConnectionOpenFinalizeResponse, error)
+	ProxyConnectionState(context.Context, *MsgProxyConnectionState) (*MsgProxyConnectionStateResponse, error)
+	ProxyChannelOpenTry(context.Context, *MsgProxyChannelOpenTry) (*MsgProxyChannelOpenTryResponse, error)
+	ProxyChannelOpenAck(context.Context, *MsgProxyChannelOpenAck) (*MsgProxyChannelOpenAckResponse, error)
+	ProxyChannelOpenConfirm(context.Context, *MsgProxyChannelOpenConfirm) (*MsgProxyChannelOpenConfirmResponse, error)
+	ProxyChannelOpenFinalize(context.Context, *MsgProxyChannelOpenFinalize) (*MsgProxyChannelOpenFinalizeResponse, error)
+	ProxyChannelCloseConfirm(context.Context, *MsgProxyChannelCloseConfirm) (*MsgProxyChannelCloseConfirmResponse, error)
+	ProxyChannelState(context.Context, *MsgProxyChannelState) (*MsgProxyChannelStateResponse, error)
+	ProxyRecvPacket(context.Context, *MsgProxyRecvPacket) (*MsgProxyRecvPacketResponse, error)
+	ProxyAcknowledgePacket(context.Context, *MsgProxyAcknowledgePacket) (*MsgProxyAcknowledgePacketResponse, error)
+	ProxyPacketBatch(context.Context, *MsgProxyPacketBatch) (*MsgProxyPacketBatchResponse, error)
+	ProxyTimeoutPacket(context.Context, *MsgProxyTimeoutPacket) (*MsgProxyTimeoutPacketResponse, error)
+	ProxyTimeoutOnClose(context.Context, *MsgProxyTimeoutOnClose) (*MsgProxyTimeoutOnCloseResponse, error)
+	PruneProxyPacketCommitment(context.Context, *MsgPruneProxyPacketCommitment) (*MsgPruneProxyPacketCommitmentResponse, error)
+	ProxyWithHeader(context.Context, *MsgProxyWithHeader) (*MsgProxyWithHeaderResponse, error)
+	SubmitProxyMisbehaviour(context.Context, *MsgSubmitProxyMisbehaviour) (*MsgSubmitProxyMisbehaviourResponse, error)
+	PayProxyPacketFee(context.Context, *MsgPayProxyPacketFee) (*MsgPayProxyPacketFeeResponse, error)
+	RegisterUpstream(context.Context, *MsgRegisterUpstream) (*MsgRegisterUpstreamResponse, error)
+	DeregisterUpstream(context.Context, *MsgDeregisterUpstream) (*MsgDeregisterUpstreamResponse, error)
+	PauseProxy(context.Context, *MsgPauseProxy) (*MsgPauseProxyResponse, error)
+	UnpauseProxy(context.Context, *MsgUnpauseProxy) (*MsgUnpauseProxyResponse, error)
+	MigrateProxyClient(context.Context, *MsgMigrateProxyClient) (*MsgMigrateProxyClientResponse, error)
 }
 
-func (m *MsgProxyChannelOpenAckResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgProxyChannelOpenAckResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) ProxyClientState(ctx context.Context, req *MsgProxyClientState) (*MsgProxyClientStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProxyClientState not implemented")
+}
+func (*UnimplementedMsgServer) ProxyConnectionOpenTry(ctx context.Context, req *MsgProxyConnectionOpenTry) (*MsgProxyConnectionOpenTryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProxyConnectionOpenTry not implemented")
+}
+func (*UnimplementedMsgServer) ProxyConnectionOpenAck(ctx context.Context, req *MsgProxyConnectionOpenAck) (*MsgProxyConnectionOpenAckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProxyConnectionOpenAck not implemented")
+}
+func (*UnimplementedMsgServer) ProxyConnectionOpenConfirm(ctx context.Context, req *MsgProxyConnectionOpenConfirm) (*MsgProxyConnectionOpenConfirmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProxyConnectionOpenConfirm not implemented")
+}
+func (*UnimplementedMsgServer) ProxyConnectionOpenFinalize(ctx context.Context, req *MsgProxyConnectionOpenFinalize) (*MsgProxyConnectionOpenFinalizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProxyConnectionOpenFinalize not implemented")
+}
+func (*UnimplementedMsgServer) ProxyConnectionState(ctx context.Context, req *MsgProxyConnectionState) (*MsgProxyConnectionStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProxyConnectionState not implemented")
+}
+func (*UnimplementedMsgServer) ProxyChannelOpenTry(ctx context.Context, req *MsgProxyChannelOpenTry) (*MsgProxyChannelOpenTryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProxyChannelOpenTry not implemented")
+}
+func (*UnimplementedMsgServer) ProxyChannelOpenAck(ctx context.Context, req *MsgProxyChannelOpenAck) (*MsgProxyChannelOpenAckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProxyChannelOpenAck not implemented")
+}
+func (*UnimplementedMsgServer) ProxyChannelOpenConfirm(ctx context.Context, req *MsgProxyChannelOpenConfirm) (*MsgProxyChannelOpenConfirmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProxyChannelOpenConfirm not implemented")
+}
+func (*UnimplementedMsgServer) ProxyChannelOpenFinalize(ctx context.Context, req *MsgProxyChannelOpenFinalize) (*MsgProxyChannelOpenFinalizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProxyChannelOpenFinalize not implemented")
+}
+func (*UnimplementedMsgServer) ProxyChannelCloseConfirm(ctx context.Context, req *MsgProxyChannelCloseConfirm) (*MsgProxyChannelCloseConfirmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProxyChannelCloseConfirm not implemented")
+}
+func (*UnimplementedMsgServer) ProxyChannelState(ctx context.Context, req *MsgProxyChannelState) (*MsgProxyChannelStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProxyChannelState not implemented")
+}
+func (*UnimplementedMsgServer) ProxyRecvPacket(ctx context.Context, req *MsgProxyRecvPacket) (*MsgProxyRecvPacketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProxyRecvPacket not implemented")
+}
+func (*UnimplementedMsgServer) ProxyAcknowledgePacket(ctx context.Context, req *MsgProxyAcknowledgePacket) (*MsgProxyAcknowledgePacketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProxyAcknowledgePacket not implemented")
+}
+func (*UnimplementedMsgServer) ProxyPacketBatch(ctx context.Context, req *MsgProxyPacketBatch) (*MsgProxyPacketBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProxyPacketBatch not implemented")
+}
+func (*UnimplementedMsgServer) ProxyTimeoutPacket(ctx context.Context, req *MsgProxyTimeoutPacket) (*MsgProxyTimeoutPacketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProxyTimeoutPacket not implemented")
+}
+func (*UnimplementedMsgServer) ProxyTimeoutOnClose(ctx context.Context, req *MsgProxyTimeoutOnClose) (*MsgProxyTimeoutOnCloseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProxyTimeoutOnClose not implemented")
+}
+func (*UnimplementedMsgServer) PruneProxyPacketCommitment(ctx context.Context, req *MsgPruneProxyPacketCommitment) (*MsgPruneProxyPacketCommitmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneProxyPacketCommitment not implemented")
+}
+func (*UnimplementedMsgServer) ProxyWithHeader(ctx context.Context, req *MsgProxyWithHeader) (*MsgProxyWithHeaderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProxyWithHeader not implemented")
+}
+func (*UnimplementedMsgServer) SubmitProxyMisbehaviour(ctx context.Context, req *MsgSubmitProxyMisbehaviour) (*MsgSubmitProxyMisbehaviourResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitProxyMisbehaviour not implemented")
+}
+func (*UnimplementedMsgServer) PayProxyPacketFee(ctx context.Context, req *MsgPayProxyPacketFee) (*MsgPayProxyPacketFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PayProxyPacketFee not implemented")
+}
+func (*UnimplementedMsgServer) RegisterUpstream(ctx context.Context, req *MsgRegisterUpstream) (*MsgRegisterUpstreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterUpstream not implemented")
+}
+func (*UnimplementedMsgServer) DeregisterUpstream(ctx context.Context, req *MsgDeregisterUpstream) (*MsgDeregisterUpstreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeregisterUpstream not implemented")
+}
+func (*UnimplementedMsgServer) PauseProxy(ctx context.Context, req *MsgPauseProxy) (*MsgPauseProxyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseProxy not implemented")
+}
+func (*UnimplementedMsgServer) UnpauseProxy(ctx context.Context, req *MsgUnpauseProxy) (*MsgUnpauseProxyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpauseProxy not implemented")
+}
+func (*UnimplementedMsgServer) MigrateProxyClient(ctx context.Context, req *MsgMigrateProxyClient) (*MsgMigrateProxyClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateProxyClient not implemented")
 }
 
-func (m *MsgProxyChannelOpenConfirm) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_ProxyClientState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProxyClientState)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).ProxyClientState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.proxy.v1.Msg/ProxyClientState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProxyClientState(ctx, req.(*MsgProxyClientState))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgProxyChannelOpenConfirm) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_ProxyConnectionOpenTry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProxyConnectionOpenTry)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProxyConnectionOpenTry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.proxy.v1.Msg/ProxyConnectionOpenTry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProxyConnectionOpenTry(ctx, req.(*MsgProxyConnectionOpenTry))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgProxyChannelOpenConfirm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x42
+func _Msg_ProxyConnectionOpenAck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProxyConnectionOpenAck)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	{
-		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(MsgServer).ProxyConnectionOpenAck(ctx, in)
 	}
-	i--
-	dAtA[i] = 0x3a
-	if len(m.ProofAck) > 0 {
-		i -= len(m.ProofAck)
-		copy(dAtA[i:], m.ProofAck)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofAck)))
-		i--
-		dAtA[i] = 0x32
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.proxy.v1.Msg/ProxyConnectionOpenAck",
 	}
-	if len(m.DownstreamChannelId) > 0 {
-		i -= len(m.DownstreamChannelId)
-		copy(dAtA[i:], m.DownstreamChannelId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DownstreamChannelId)))
-		i--
-		dAtA[i] = 0x2a
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProxyConnectionOpenAck(ctx, req.(*MsgProxyConnectionOpenAck))
 	}
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
-		i--
-		dAtA[i] = 0x22
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ProxyConnectionOpenConfirm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProxyConnectionOpenConfirm)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.PortId) > 0 {
-		i -= len(m.PortId)
-		copy(dAtA[i:], m.PortId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).ProxyConnectionOpenConfirm(ctx, in)
 	}
-	{
-		size, err := m.UpstreamPrefix.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.proxy.v1.Msg/ProxyConnectionOpenConfirm",
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.UpstreamClientId) > 0 {
-		i -= len(m.UpstreamClientId)
-		copy(dAtA[i:], m.UpstreamClientId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.UpstreamClientId)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProxyConnectionOpenConfirm(ctx, req.(*MsgProxyConnectionOpenConfirm))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgProxyChannelOpenConfirmResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_ProxyConnectionOpenFinalize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProxyConnectionOpenFinalize)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).ProxyConnectionOpenFinalize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.proxy.v1.Msg/ProxyConnectionOpenFinalize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProxyConnectionOpenFinalize(ctx, req.(*MsgProxyConnectionOpenFinalize))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgProxyChannelOpenConfirmResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_ProxyConnectionState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProxyConnectionState)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProxyConnectionState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.proxy.v1.Msg/ProxyConnectionState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProxyConnectionState(ctx, req.(*MsgProxyConnectionState))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgProxyChannelOpenConfirmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_ProxyChannelOpenTry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProxyChannelOpenTry)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProxyChannelOpenTry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.proxy.v1.Msg/ProxyChannelOpenTry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProxyChannelOpenTry(ctx, req.(*MsgProxyChannelOpenTry))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgProxyChannelOpenFinalize) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_ProxyChannelOpenAck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProxyChannelOpenAck)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).ProxyChannelOpenAck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.proxy.v1.Msg/ProxyChannelOpenAck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProxyChannelOpenAck(ctx, req.(*MsgProxyChannelOpenAck))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgProxyChannelOpenFinalize) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_ProxyChannelOpenConfirm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProxyChannelOpenConfirm)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProxyChannelOpenConfirm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.proxy.v1.Msg/ProxyChannelOpenConfirm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProxyChannelOpenConfirm(ctx, req.(*MsgProxyChannelOpenConfirm))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgProxyChannelOpenFinalize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x3a
+func _Msg_ProxyChannelOpenFinalize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProxyChannelOpenFinalize)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	{
-		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(MsgServer).ProxyChannelOpenFinalize(ctx, in)
 	}
-	i--
-	dAtA[i] = 0x32
-	if len(m.ProofConfirm) > 0 {
-		i -= len(m.ProofConfirm)
-		copy(dAtA[i:], m.ProofConfirm)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofConfirm)))
-		i--
-		dAtA[i] = 0x2a
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.proxy.v1.Msg/ProxyChannelOpenFinalize",
 	}
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
-		i--
-		dAtA[i] = 0x22
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProxyChannelOpenFinalize(ctx, req.(*MsgProxyChannelOpenFinalize))
 	}
-	if len(m.PortId) > 0 {
-		i -= len(m.PortId)
-		copy(dAtA[i:], m.PortId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
-		i--
-		dAtA[i] = 0x1a
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ProxyChannelCloseConfirm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProxyChannelCloseConfirm)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	{
-		size, err := m.UpstreamPrefix.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(MsgServer).ProxyChannelCloseConfirm(ctx, in)
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.UpstreamClientId) > 0 {
-		i -= len(m.UpstreamClientId)
-		copy(dAtA[i:], m.UpstreamClientId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.UpstreamClientId)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.proxy.v1.Msg/ProxyChannelCloseConfirm",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProxyChannelCloseConfirm(ctx, req.(*MsgProxyChannelCloseConfirm))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgProxyChannelOpenFinalizeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_ProxyChannelState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProxyChannelState)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).ProxyChannelState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.proxy.v1.Msg/ProxyChannelState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProxyChannelState(ctx, req.(*MsgProxyChannelState))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgProxyChannelOpenFinalizeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_ProxyRecvPacket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProxyRecvPacket)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProxyRecvPacket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.proxy.v1.Msg/ProxyRecvPacket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProxyRecvPacket(ctx, req.(*MsgProxyRecvPacket))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgProxyChannelOpenFinalizeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_ProxyAcknowledgePacket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProxyAcknowledgePacket)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProxyAcknowledgePacket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.proxy.v1.Msg/ProxyAcknowledgePacket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProxyAcknowledgePacket(ctx, req.(*MsgProxyAcknowledgePacket))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgProxyRecvPacket) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_ProxyPacketBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProxyPacketBatch)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).ProxyPacketBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.proxy.v1.Msg/ProxyPacketBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProxyPacketBatch(ctx, req.(*MsgProxyPacketBatch))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgProxyRecvPacket) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_ProxyTimeoutPacket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProxyTimeoutPacket)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProxyTimeoutPacket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.proxy.v1.Msg/ProxyTimeoutPacket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProxyTimeoutPacket(ctx, req.(*MsgProxyTimeoutPacket))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgProxyRecvPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x32
+func _Msg_ProxyTimeoutOnClose_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProxyTimeoutOnClose)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	{
-		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(MsgServer).ProxyTimeoutOnClose(ctx, in)
 	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.Proof) > 0 {
-		i -= len(m.Proof)
-		copy(dAtA[i:], m.Proof)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Proof)))
-		i--
-		dAtA[i] = 0x22
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.proxy.v1.Msg/ProxyTimeoutOnClose",
 	}
-	{
-		size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProxyTimeoutOnClose(ctx, req.(*MsgProxyTimeoutOnClose))
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.UpstreamPrefix.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PruneProxyPacketCommitment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPruneProxyPacketCommitment)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.UpstreamClientId) > 0 {
-		i -= len(m.UpstreamClientId)
-		copy(dAtA[i:], m.UpstreamClientId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.UpstreamClientId)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(MsgServer).PruneProxyPacketCommitment(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.proxy.v1.Msg/PruneProxyPacketCommitment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PruneProxyPacketCommitment(ctx, req.(*MsgPruneProxyPacketCommitment))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgProxyRecvPacketResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_ProxyWithHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProxyWithHeader)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).ProxyWithHeader(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.proxy.v1.Msg/ProxyWithHeader",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProxyWithHeader(ctx, req.(*MsgProxyWithHeader))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgProxyRecvPacketResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_SubmitProxyMisbehaviour_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitProxyMisbehaviour)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitProxyMisbehaviour(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.proxy.v1.Msg/SubmitProxyMisbehaviour",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitProxyMisbehaviour(ctx, req.(*MsgSubmitProxyMisbehaviour))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgProxyRecvPacketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_PayProxyPacketFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPayProxyPacketFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PayProxyPacketFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.proxy.v1.Msg/PayProxyPacketFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PayProxyPacketFee(ctx, req.(*MsgPayProxyPacketFee))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgProxyAcknowledgePacket) Marshal() (dAtA []byte, err error) {
+func _Msg_RegisterUpstream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterUpstream)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterUpstream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.proxy.v1.Msg/RegisterUpstream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterUpstream(ctx, req.(*MsgRegisterUpstream))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeregisterUpstream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeregisterUpstream)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeregisterUpstream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.proxy.v1.Msg/DeregisterUpstream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeregisterUpstream(ctx, req.(*MsgDeregisterUpstream))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PauseProxy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPauseProxy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PauseProxy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.proxy.v1.Msg/PauseProxy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PauseProxy(ctx, req.(*MsgPauseProxy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnpauseProxy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnpauseProxy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnpauseProxy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.proxy.v1.Msg/UnpauseProxy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnpauseProxy(ctx, req.(*MsgUnpauseProxy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MigrateProxyClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateProxyClient)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateProxyClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.proxy.v1.Msg/MigrateProxyClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateProxyClient(ctx, req.(*MsgMigrateProxyClient))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ibc.proxy.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ProxyClientState",
+			Handler:    _Msg_ProxyClientState_Handler,
+		},
+		{
+			MethodName: "ProxyConnectionOpenTry",
+			Handler:    _Msg_ProxyConnectionOpenTry_Handler,
+		},
+		{
+			MethodName: "ProxyConnectionOpenAck",
+			Handler:    _Msg_ProxyConnectionOpenAck_Handler,
+		},
+		{
+			MethodName: "ProxyConnectionOpenConfirm",
+			Handler:    _Msg_ProxyConnectionOpenConfirm_Handler,
+		},
+		{
+			MethodName: "ProxyConnectionOpenFinalize",
+			Handler:    _Msg_ProxyConnectionOpenFinalize_Handler,
+		},
+		{
+			MethodName: "ProxyConnectionState",
+			Handler:    _Msg_ProxyConnectionState_Handler,
+		},
+		{
+			MethodName: "ProxyChannelOpenTry",
+			Handler:    _Msg_ProxyChannelOpenTry_Handler,
+		},
+		{
+			MethodName: "ProxyChannelOpenAck",
+			Handler:    _Msg_ProxyChannelOpenAck_Handler,
+		},
+		{
+			MethodName: "ProxyChannelOpenConfirm",
+			Handler:    _Msg_ProxyChannelOpenConfirm_Handler,
+		},
+		{
+			MethodName: "ProxyChannelOpenFinalize",
+			Handler:    _Msg_ProxyChannelOpenFinalize_Handler,
+		},
+		{
+			MethodName: "ProxyChannelCloseConfirm",
+			Handler:    _Msg_ProxyChannelCloseConfirm_Handler,
+		},
+		{
+			MethodName: "ProxyChannelState",
+			Handler:    _Msg_ProxyChannelState_Handler,
+		},
+		{
+			MethodName: "ProxyRecvPacket",
+			Handler:    _Msg_ProxyRecvPacket_Handler,
+		},
+		{
+			MethodName: "ProxyAcknowledgePacket",
+			Handler:    _Msg_ProxyAcknowledgePacket_Handler,
+		},
+		{
+			MethodName: "ProxyPacketBatch",
+			Handler:    _Msg_ProxyPacketBatch_Handler,
+		},
+		{
+			MethodName: "ProxyTimeoutPacket",
+			Handler:    _Msg_ProxyTimeoutPacket_Handler,
+		},
+		{
+			MethodName: "ProxyTimeoutOnClose",
+			Handler:    _Msg_ProxyTimeoutOnClose_Handler,
+		},
+		{
+			MethodName: "PruneProxyPacketCommitment",
+			Handler:    _Msg_PruneProxyPacketCommitment_Handler,
+		},
+		{
+			MethodName: "ProxyWithHeader",
+			Handler:    _Msg_ProxyWithHeader_Handler,
+		},
+		{
+			MethodName: "SubmitProxyMisbehaviour",
+			Handler:    _Msg_SubmitProxyMisbehaviour_Handler,
+		},
+		{
+			MethodName: "PayProxyPacketFee",
+			Handler:    _Msg_PayProxyPacketFee_Handler,
+		},
+		{
+			MethodName: "RegisterUpstream",
+			Handler:    _Msg_RegisterUpstream_Handler,
+		},
+		{
+			MethodName: "DeregisterUpstream",
+			Handler:    _Msg_DeregisterUpstream_Handler,
+		},
+		{
+			MethodName: "PauseProxy",
+			Handler:    _Msg_PauseProxy_Handler,
+		},
+		{
+			MethodName: "UnpauseProxy",
+			Handler:    _Msg_UnpauseProxy_Handler,
+		},
+		{
+			MethodName: "MigrateProxyClient",
+			Handler:    _Msg_MigrateProxyClient_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ibc/modules/proxy/tx.proto",
+}
+
+func (m *MsgProxyClientState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2897,12 +3717,12 @@ func (m *MsgProxyAcknowledgePacket) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgProxyAcknowledgePacket) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgProxyClientState) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgProxyAcknowledgePacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgProxyClientState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2912,10 +3732,10 @@ func (m *MsgProxyAcknowledgePacket) MarshalToSizedBuffer(dAtA []byte) (int, erro
 		copy(dAtA[i:], m.Signer)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x52
 	}
 	{
-		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.ConsensusHeight.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2923,23 +3743,9 @@ func (m *MsgProxyAcknowledgePacket) MarshalToSizedBuffer(dAtA []byte) (int, erro
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x32
-	if len(m.Proof) > 0 {
-		i -= len(m.Proof)
-		copy(dAtA[i:], m.Proof)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Proof)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Acknowledgement) > 0 {
-		i -= len(m.Acknowledgement)
-		copy(dAtA[i:], m.Acknowledgement)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Acknowledgement)))
-		i--
-		dAtA[i] = 0x22
-	}
+	dAtA[i] = 0x4a
 	{
-		size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2947,7 +3753,52 @@ func (m *MsgProxyAcknowledgePacket) MarshalToSizedBuffer(dAtA []byte) (int, erro
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
+	dAtA[i] = 0x42
+	if len(m.ProofConsensus) > 0 {
+		i -= len(m.ProofConsensus)
+		copy(dAtA[i:], m.ProofConsensus)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofConsensus)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ProofClient) > 0 {
+		i -= len(m.ProofClient)
+		copy(dAtA[i:], m.ProofClient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofClient)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ConsensusState != nil {
+		{
+			size, err := m.ConsensusState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ClientState != nil {
+		{
+			size, err := m.ClientState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CounterpartyClientId) > 0 {
+		i -= len(m.CounterpartyClientId)
+		copy(dAtA[i:], m.CounterpartyClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CounterpartyClientId)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size, err := m.UpstreamPrefix.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -2968,7 +3819,7 @@ func (m *MsgProxyAcknowledgePacket) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgProxyAcknowledgePacketResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgProxyClientStateResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2978,12 +3829,12 @@ func (m *MsgProxyAcknowledgePacketResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgProxyAcknowledgePacketResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgProxyClientStateResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgProxyAcknowledgePacketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgProxyClientStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2991,582 +3842,8418 @@ func (m *MsgProxyAcknowledgePacketResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgProxyConnectionOpenTry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgProxyClientState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgProxyConnectionOpenTry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProxyConnectionOpenTry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.UpstreamClientId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.UpstreamPrefix.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.CounterpartyClientId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ClientState != nil {
-		l = m.ClientState.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
 	}
-	if m.ConsensusState != nil {
-		l = m.ConsensusState.Size()
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.ProxyConsensusHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.ProofClient)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x7a
+	{
+		size, err := m.ProofProxyHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.ProofConsensus)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x72
+	if len(m.ProofProxyConsensus) > 0 {
+		i -= len(m.ProofProxyConsensus)
+		copy(dAtA[i:], m.ProofProxyConsensus)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofProxyConsensus)))
+		i--
+		dAtA[i] = 0x6a
 	}
-	l = m.ProofHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.ConsensusHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ProofProxyClient) > 0 {
+		i -= len(m.ProofProxyClient)
+		copy(dAtA[i:], m.ProofProxyClient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofProxyClient)))
+		i--
+		dAtA[i] = 0x62
 	}
-	return n
-}
-
-func (m *MsgProxyClientStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	{
+		size, err := m.ConsensusHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgProxyConnectionOpenTry) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x5a
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x52
+	if len(m.ProofConsensus) > 0 {
+		i -= len(m.ProofConsensus)
+		copy(dAtA[i:], m.ProofConsensus)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofConsensus)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	l = m.UpstreamPrefix.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Connection.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.DownstreamClientState != nil {
-		l = m.DownstreamClientState.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ProofClient) > 0 {
+		i -= len(m.ProofClient)
+		copy(dAtA[i:], m.ProofClient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofClient)))
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.DownstreamConsensusState != nil {
-		l = m.DownstreamConsensusState.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ProofInit) > 0 {
+		i -= len(m.ProofInit)
+		copy(dAtA[i:], m.ProofInit)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofInit)))
+		i--
+		dAtA[i] = 0x3a
 	}
 	if m.ProxyClientState != nil {
-		l = m.ProxyClientState.Size()
-		n += 1 + l + sovTx(uint64(l))
+		{
+			size, err := m.ProxyClientState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.ProofInit)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.DownstreamConsensusState != nil {
+		{
+			size, err := m.DownstreamConsensusState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.ProofClient)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ProofConsensus)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.DownstreamClientState != nil {
+		{
+			size, err := m.DownstreamClientState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	l = m.ProofHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.ConsensusHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.ProofProxyClient)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Connection.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.ProofProxyConsensus)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.UpstreamPrefix.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.ProofProxyHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.ProxyConsensusHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Signer)
-	if l > 0 {
-		n += 2 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgProxyConnectionOpenTryResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgProxyConnectionOpenTryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgProxyConnectionOpenAck) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.UpstreamPrefix.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Connection.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.DownstreamClientState != nil {
-		l = m.DownstreamClientState.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.DownstreamConsensusState != nil {
-		l = m.DownstreamConsensusState.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ProxyClientState != nil {
-		l = m.ProxyClientState.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ProofTry)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ProofClient)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ProofConsensus)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.ProofHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.ConsensusHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.ProofProxyClient)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ProofProxyConsensus)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.ProofProxyHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.ProxyConsensusHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Signer)
-	if l > 0 {
-		n += 2 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgProxyConnectionOpenTryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgProxyConnectionOpenAckResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgProxyConnectionOpenTryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgProxyConnectionOpenConfirm) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.UpstreamClientId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.UpstreamPrefix.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.CounterpartyConnectionId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ProofAck)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.ProofHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgProxyConnectionOpenAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgProxyConnectionOpenConfirmResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgProxyConnectionOpenAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgProxyConnectionOpenFinalize) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgProxyConnectionOpenAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
 	}
-	l = len(m.UpstreamClientId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.ProxyConsensusHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.UpstreamPrefix.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.ProofConfirm)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x7a
+	{
+		size, err := m.ProofProxyHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.ProofHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x72
+	if len(m.ProofProxyConsensus) > 0 {
+		i -= len(m.ProofProxyConsensus)
+		copy(dAtA[i:], m.ProofProxyConsensus)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofProxyConsensus)))
+		i--
+		dAtA[i] = 0x6a
 	}
-	return n
-}
-
-func (m *MsgProxyConnectionOpenFinalizeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.ProofProxyClient) > 0 {
+		i -= len(m.ProofProxyClient)
+		copy(dAtA[i:], m.ProofProxyClient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofProxyClient)))
+		i--
+		dAtA[i] = 0x62
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgProxyChannelOpenTry) Size() (n int) {
-	if m == nil {
-		return 0
+	{
+		size, err := m.ConsensusHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = len(m.UpstreamClientId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x5a
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.UpstreamPrefix.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.Order != 0 {
-		n += 1 + sovTx(uint64(m.Order))
+	i--
+	dAtA[i] = 0x52
+	if len(m.ProofConsensus) > 0 {
+		i -= len(m.ProofConsensus)
+		copy(dAtA[i:], m.ProofConsensus)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofConsensus)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	if len(m.ConnectionHops) > 0 {
-		for _, s := range m.ConnectionHops {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.ProofClient) > 0 {
+		i -= len(m.ProofClient)
+		copy(dAtA[i:], m.ProofClient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofClient)))
+		i--
+		dAtA[i] = 0x42
 	}
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ProofTry) > 0 {
+		i -= len(m.ProofTry)
+		copy(dAtA[i:], m.ProofTry)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofTry)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.ProxyClientState != nil {
+		{
+			size, err := m.ProxyClientState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.DownstreamPortId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.DownstreamConsensusState != nil {
+		{
+			size, err := m.DownstreamConsensusState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.DownstreamClientState != nil {
+		{
+			size, err := m.DownstreamClientState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.ProofInit)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Connection.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.ProofHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.UpstreamPrefix.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgProxyChannelOpenTryResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgProxyConnectionOpenAckResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProxyConnectionOpenAckResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProxyConnectionOpenAckResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgProxyChannelOpenAck) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgProxyConnectionOpenConfirm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProxyConnectionOpenConfirm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProxyConnectionOpenConfirm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.UpstreamClientId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.UpstreamPrefix.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.Order != 0 {
-		n += 1 + sovTx(uint64(m.Order))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if len(m.ConnectionHops) > 0 {
-		for _, s := range m.ConnectionHops {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x32
+	if len(m.ProofAck) > 0 {
+		i -= len(m.ProofAck)
+		copy(dAtA[i:], m.ProofAck)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofAck)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.CounterpartyConnectionId) > 0 {
+		i -= len(m.CounterpartyConnectionId)
+		copy(dAtA[i:], m.CounterpartyConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CounterpartyConnectionId)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.DownstreamPortId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.UpstreamPrefix.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.DownstreamChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.UpstreamClientId) > 0 {
+		i -= len(m.UpstreamClientId)
+		copy(dAtA[i:], m.UpstreamClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UpstreamClientId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.ProofTry)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.ProofHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgProxyChannelOpenAckResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgProxyConnectionOpenConfirmResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProxyConnectionOpenConfirmResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProxyConnectionOpenConfirmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgProxyChannelOpenConfirm) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgProxyConnectionOpenFinalize) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProxyConnectionOpenFinalize) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProxyConnectionOpenFinalize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.UpstreamClientId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = m.UpstreamPrefix.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.ProofConfirm) > 0 {
+		i -= len(m.ProofConfirm)
+		copy(dAtA[i:], m.ProofConfirm)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofConfirm)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.DownstreamChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.UpstreamPrefix.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.ProofAck)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.UpstreamClientId) > 0 {
+		i -= len(m.UpstreamClientId)
+		copy(dAtA[i:], m.UpstreamClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UpstreamClientId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.ProofHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgProxyChannelOpenConfirmResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgProxyConnectionOpenFinalizeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProxyConnectionOpenFinalizeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProxyConnectionOpenFinalizeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgProxyChannelOpenFinalize) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgProxyConnectionState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProxyConnectionState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProxyConnectionState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.UpstreamClientId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = m.UpstreamPrefix.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.ProofConfirm)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Connection.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.ProofHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.UpstreamPrefix.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x1a
+	if len(m.UpstreamClientId) > 0 {
+		i -= len(m.UpstreamClientId)
+		copy(dAtA[i:], m.UpstreamClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UpstreamClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgProxyChannelOpenFinalizeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgProxyConnectionStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgProxyRecvPacket) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgProxyConnectionStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProxyConnectionStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.UpstreamClientId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.UpstreamPrefix.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Packet.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Proof)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.ProofHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgProxyRecvPacketResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgProxyChannelOpenTry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgProxyAcknowledgePacket) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgProxyChannelOpenTry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProxyChannelOpenTry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.UpstreamClientId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.UpstreamHops) > 0 {
+		for iNdEx := len(m.UpstreamHops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UpstreamHops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
 	}
-	l = m.UpstreamPrefix.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Packet.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Acknowledgement)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x5a
 	}
-	l = len(m.Proof)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.ProofHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x52
+	if len(m.ProofInit) > 0 {
+		i -= len(m.ProofInit)
+		copy(dAtA[i:], m.ProofInit)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofInit)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	return n
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.DownstreamPortId) > 0 {
+		i -= len(m.DownstreamPortId)
+		copy(dAtA[i:], m.DownstreamPortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DownstreamPortId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ConnectionHops) > 0 {
+		for iNdEx := len(m.ConnectionHops) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ConnectionHops[iNdEx])
+			copy(dAtA[i:], m.ConnectionHops[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionHops[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Order != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Order))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.UpstreamPrefix.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.UpstreamClientId) > 0 {
+		i -= len(m.UpstreamClientId)
+		copy(dAtA[i:], m.UpstreamClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UpstreamClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgProxyAcknowledgePacketResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgProxyChannelOpenTryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProxyChannelOpenTryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProxyChannelOpenTryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgProxyChannelOpenAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgProxyChannelOpenAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProxyChannelOpenAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpstreamHops) > 0 {
+		for iNdEx := len(m.UpstreamHops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UpstreamHops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x62
+	}
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.ProofTry) > 0 {
+		i -= len(m.ProofTry)
+		copy(dAtA[i:], m.ProofTry)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofTry)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.DownstreamChannelId) > 0 {
+		i -= len(m.DownstreamChannelId)
+		copy(dAtA[i:], m.DownstreamChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DownstreamChannelId)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.DownstreamPortId) > 0 {
+		i -= len(m.DownstreamPortId)
+		copy(dAtA[i:], m.DownstreamPortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DownstreamPortId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ConnectionHops) > 0 {
+		for iNdEx := len(m.ConnectionHops) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ConnectionHops[iNdEx])
+			copy(dAtA[i:], m.ConnectionHops[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionHops[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Order != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Order))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.UpstreamPrefix.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.UpstreamClientId) > 0 {
+		i -= len(m.UpstreamClientId)
+		copy(dAtA[i:], m.UpstreamClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UpstreamClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProxyChannelOpenAckResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProxyChannelOpenAckResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProxyChannelOpenAckResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProxyChannelOpenConfirm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProxyChannelOpenConfirm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProxyChannelOpenConfirm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x42
+	}
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.ProofAck) > 0 {
+		i -= len(m.ProofAck)
+		copy(dAtA[i:], m.ProofAck)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofAck)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.DownstreamChannelId) > 0 {
+		i -= len(m.DownstreamChannelId)
+		copy(dAtA[i:], m.DownstreamChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DownstreamChannelId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.UpstreamPrefix.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.UpstreamClientId) > 0 {
+		i -= len(m.UpstreamClientId)
+		copy(dAtA[i:], m.UpstreamClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UpstreamClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProxyChannelOpenConfirmResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProxyChannelOpenConfirmResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProxyChannelOpenConfirmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProxyChannelOpenFinalize) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProxyChannelOpenFinalize) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProxyChannelOpenFinalize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.ProofConfirm) > 0 {
+		i -= len(m.ProofConfirm)
+		copy(dAtA[i:], m.ProofConfirm)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofConfirm)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.UpstreamPrefix.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.UpstreamClientId) > 0 {
+		i -= len(m.UpstreamClientId)
+		copy(dAtA[i:], m.UpstreamClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UpstreamClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProxyChannelOpenFinalizeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProxyChannelOpenFinalizeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProxyChannelOpenFinalizeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProxyChannelCloseConfirm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProxyChannelCloseConfirm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProxyChannelCloseConfirm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.ProofInit) > 0 {
+		i -= len(m.ProofInit)
+		copy(dAtA[i:], m.ProofInit)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofInit)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.UpstreamPrefix.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.UpstreamClientId) > 0 {
+		i -= len(m.UpstreamClientId)
+		copy(dAtA[i:], m.UpstreamClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UpstreamClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProxyChannelCloseConfirmResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProxyChannelCloseConfirmResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProxyChannelCloseConfirmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProxyChannelState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProxyChannelState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProxyChannelState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpstreamHops) > 0 {
+		for iNdEx := len(m.UpstreamHops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UpstreamHops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x42
+	}
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.Channel.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.UpstreamPrefix.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.UpstreamClientId) > 0 {
+		i -= len(m.UpstreamClientId)
+		copy(dAtA[i:], m.UpstreamClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UpstreamClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProxyChannelStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProxyChannelStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProxyChannelStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProxyRecvPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProxyRecvPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProxyRecvPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.UpstreamPrefix.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.UpstreamClientId) > 0 {
+		i -= len(m.UpstreamClientId)
+		copy(dAtA[i:], m.UpstreamClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UpstreamClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProxyRecvPacketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProxyRecvPacketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProxyRecvPacketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProxyAcknowledgePacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProxyAcknowledgePacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProxyAcknowledgePacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Acknowledgement) > 0 {
+		i -= len(m.Acknowledgement)
+		copy(dAtA[i:], m.Acknowledgement)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Acknowledgement)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.UpstreamPrefix.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.UpstreamClientId) > 0 {
+		i -= len(m.UpstreamClientId)
+		copy(dAtA[i:], m.UpstreamClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UpstreamClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProxyAcknowledgePacketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProxyAcknowledgePacketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProxyAcknowledgePacketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProxyPacketBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProxyPacketBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProxyPacketBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Atomic {
+		i--
+		if m.Atomic {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.BatchProof) > 0 {
+		i -= len(m.BatchProof)
+		copy(dAtA[i:], m.BatchProof)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BatchProof)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.UpstreamPrefix.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.UpstreamClientId) > 0 {
+		i -= len(m.UpstreamClientId)
+		copy(dAtA[i:], m.UpstreamClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UpstreamClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProxyPacketBatchItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProxyPacketBatchItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProxyPacketBatchItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Acknowledgement) > 0 {
+		i -= len(m.Acknowledgement)
+		copy(dAtA[i:], m.Acknowledgement)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Acknowledgement)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProxyPacketBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProxyPacketBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProxyPacketBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProxyPacketBatchResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProxyPacketBatchResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProxyPacketBatchResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Log) > 0 {
+		i -= len(m.Log)
+		copy(dAtA[i:], m.Log)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Log)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Code != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Codespace) > 0 {
+		i -= len(m.Codespace)
+		copy(dAtA[i:], m.Codespace)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Codespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProxyTimeoutPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProxyTimeoutPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProxyTimeoutPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.NextSequenceRecv != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NextSequenceRecv))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.ProofUnreceived) > 0 {
+		i -= len(m.ProofUnreceived)
+		copy(dAtA[i:], m.ProofUnreceived)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofUnreceived)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.UpstreamPrefix.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.UpstreamClientId) > 0 {
+		i -= len(m.UpstreamClientId)
+		copy(dAtA[i:], m.UpstreamClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UpstreamClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProxyTimeoutPacketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProxyTimeoutPacketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProxyTimeoutPacketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProxyTimeoutOnClose) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProxyTimeoutOnClose) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProxyTimeoutOnClose) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.NextSequenceRecv != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NextSequenceRecv))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.ProofClose) > 0 {
+		i -= len(m.ProofClose)
+		copy(dAtA[i:], m.ProofClose)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofClose)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ProofUnreceived) > 0 {
+		i -= len(m.ProofUnreceived)
+		copy(dAtA[i:], m.ProofUnreceived)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofUnreceived)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.UpstreamPrefix.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.UpstreamClientId) > 0 {
+		i -= len(m.UpstreamClientId)
+		copy(dAtA[i:], m.UpstreamClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UpstreamClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProxyTimeoutOnCloseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProxyTimeoutOnCloseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProxyTimeoutOnCloseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPruneProxyPacketCommitment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPruneProxyPacketCommitment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPruneProxyPacketCommitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x42
+	}
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.ProofAbsence) > 0 {
+		i -= len(m.ProofAbsence)
+		copy(dAtA[i:], m.ProofAbsence)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofAbsence)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.UpstreamPrefix.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.UpstreamClientId) > 0 {
+		i -= len(m.UpstreamClientId)
+		copy(dAtA[i:], m.UpstreamClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UpstreamClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPruneProxyPacketCommitmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPruneProxyPacketCommitmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPruneProxyPacketCommitmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProxyWithHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProxyWithHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProxyWithHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UpstreamClientId) > 0 {
+		i -= len(m.UpstreamClientId)
+		copy(dAtA[i:], m.UpstreamClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UpstreamClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProxyWithHeaderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProxyWithHeaderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProxyWithHeaderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MsgResponse != nil {
+		{
+			size, err := m.MsgResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitProxyMisbehaviour) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitProxyMisbehaviour) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitProxyMisbehaviour) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Misbehaviour.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitProxyMisbehaviourResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitProxyMisbehaviourResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitProxyMisbehaviourResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPayProxyPacketFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPayProxyPacketFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPayProxyPacketFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.UpstreamPrefix.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.Direction != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UpstreamClientId) > 0 {
+		i -= len(m.UpstreamClientId)
+		copy(dAtA[i:], m.UpstreamClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UpstreamClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPayProxyPacketFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPayProxyPacketFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPayProxyPacketFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterUpstream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterUpstream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterUpstream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpstreamClientId) > 0 {
+		i -= len(m.UpstreamClientId)
+		copy(dAtA[i:], m.UpstreamClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UpstreamClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterUpstreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterUpstreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterUpstreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeregisterUpstream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeregisterUpstream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeregisterUpstream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpstreamClientId) > 0 {
+		i -= len(m.UpstreamClientId)
+		copy(dAtA[i:], m.UpstreamClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UpstreamClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeregisterUpstreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeregisterUpstreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeregisterUpstreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseProxy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseProxy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseProxy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.UpstreamClientId) > 0 {
+		i -= len(m.UpstreamClientId)
+		copy(dAtA[i:], m.UpstreamClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UpstreamClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseProxyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseProxyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseProxyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnpauseProxy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnpauseProxy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnpauseProxy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.UpstreamClientId) > 0 {
+		i -= len(m.UpstreamClientId)
+		copy(dAtA[i:], m.UpstreamClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UpstreamClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnpauseProxyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnpauseProxyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnpauseProxyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateProxyClient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateProxyClient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateProxyClient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.NewProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if len(m.ProofNewUpstreamConsensus) > 0 {
+		i -= len(m.ProofNewUpstreamConsensus)
+		copy(dAtA[i:], m.ProofNewUpstreamConsensus)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofNewUpstreamConsensus)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.ProofNewUpstreamClient) > 0 {
+		i -= len(m.ProofNewUpstreamClient)
+		copy(dAtA[i:], m.ProofNewUpstreamClient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofNewUpstreamClient)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.ProofUpstreamConsensus) > 0 {
+		i -= len(m.ProofUpstreamConsensus)
+		copy(dAtA[i:], m.ProofUpstreamConsensus)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofUpstreamConsensus)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ProofUpstreamClient) > 0 {
+		i -= len(m.ProofUpstreamClient)
+		copy(dAtA[i:], m.ProofUpstreamClient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofUpstreamClient)))
+		i--
+		dAtA[i] = 0x42
+	}
+	{
+		size, err := m.UpstreamConsensusHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.UpstreamConsensusState != nil {
+		{
+			size, err := m.UpstreamConsensusState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.NewUpstreamClientState != nil {
+		{
+			size, err := m.NewUpstreamClientState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.UpstreamClientState != nil {
+		{
+			size, err := m.UpstreamClientState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NewClientId) > 0 {
+		i -= len(m.NewClientId)
+		copy(dAtA[i:], m.NewClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewClientId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateProxyClientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateProxyClientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateProxyClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgProxyClientState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UpstreamClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.UpstreamPrefix.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.CounterpartyClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ClientState != nil {
+		l = m.ClientState.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ConsensusState != nil {
+		l = m.ConsensusState.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProofClient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProofConsensus)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ConsensusHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgProxyClientStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgProxyConnectionOpenTry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.UpstreamPrefix.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Connection.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.DownstreamClientState != nil {
+		l = m.DownstreamClientState.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DownstreamConsensusState != nil {
+		l = m.DownstreamConsensusState.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProxyClientState != nil {
+		l = m.ProxyClientState.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProofInit)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProofClient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProofConsensus)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ConsensusHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ProofProxyClient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProofProxyConsensus)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofProxyHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ProxyConsensusHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 2 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgProxyConnectionOpenTryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgProxyConnectionOpenAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.UpstreamPrefix.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Connection.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.DownstreamClientState != nil {
+		l = m.DownstreamClientState.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DownstreamConsensusState != nil {
+		l = m.DownstreamConsensusState.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProxyClientState != nil {
+		l = m.ProxyClientState.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProofTry)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProofClient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProofConsensus)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ConsensusHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ProofProxyClient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProofProxyConsensus)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofProxyHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ProxyConsensusHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 2 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgProxyConnectionOpenAckResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgProxyConnectionOpenConfirm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.UpstreamClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.UpstreamPrefix.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.CounterpartyConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProofAck)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgProxyConnectionOpenConfirmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgProxyConnectionOpenFinalize) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.UpstreamClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.UpstreamPrefix.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ProofConfirm)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgProxyConnectionOpenFinalizeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgProxyConnectionState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.UpstreamClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.UpstreamPrefix.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Connection.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgProxyConnectionStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgProxyChannelOpenTry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UpstreamClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.UpstreamPrefix.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Order != 0 {
+		n += 1 + sovTx(uint64(m.Order))
+	}
+	if len(m.ConnectionHops) > 0 {
+		for _, s := range m.ConnectionHops {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DownstreamPortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProofInit)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.UpstreamHops) > 0 {
+		for _, e := range m.UpstreamHops {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgProxyChannelOpenTryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgProxyChannelOpenAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UpstreamClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.UpstreamPrefix.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Order != 0 {
+		n += 1 + sovTx(uint64(m.Order))
+	}
+	if len(m.ConnectionHops) > 0 {
+		for _, s := range m.ConnectionHops {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DownstreamPortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DownstreamChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProofTry)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.UpstreamHops) > 0 {
+		for _, e := range m.UpstreamHops {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgProxyChannelOpenAckResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgProxyChannelOpenConfirm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UpstreamClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.UpstreamPrefix.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DownstreamChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProofAck)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgProxyChannelOpenConfirmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgProxyChannelOpenFinalize) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UpstreamClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.UpstreamPrefix.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProofConfirm)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgProxyChannelOpenFinalizeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgProxyChannelCloseConfirm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UpstreamClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.UpstreamPrefix.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProofInit)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgProxyChannelCloseConfirmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgProxyChannelState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UpstreamClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.UpstreamPrefix.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Channel.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.UpstreamHops) > 0 {
+		for _, e := range m.UpstreamHops {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgProxyChannelStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgProxyRecvPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UpstreamClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.UpstreamPrefix.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Packet.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgProxyRecvPacketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgProxyAcknowledgePacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UpstreamClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.UpstreamPrefix.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Packet.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Acknowledgement)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgProxyAcknowledgePacketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgProxyPacketBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UpstreamClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.UpstreamPrefix.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.BatchProof)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Atomic {
+		n += 2
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *ProxyPacketBatchItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Packet.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Acknowledgement)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgProxyPacketBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProxyPacketBatchResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Codespace)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Code != 0 {
+		n += 1 + sovTx(uint64(m.Code))
+	}
+	l = len(m.Log)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgProxyTimeoutPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UpstreamClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.UpstreamPrefix.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Packet.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ProofUnreceived)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.NextSequenceRecv != 0 {
+		n += 1 + sovTx(uint64(m.NextSequenceRecv))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgProxyTimeoutPacketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgProxyTimeoutOnClose) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UpstreamClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.UpstreamPrefix.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Packet.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ProofUnreceived)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProofClose)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.NextSequenceRecv != 0 {
+		n += 1 + sovTx(uint64(m.NextSequenceRecv))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgProxyTimeoutOnCloseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPruneProxyPacketCommitment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UpstreamClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.UpstreamPrefix.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	l = len(m.ProofAbsence)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPruneProxyPacketCommitmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgProxyWithHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UpstreamClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgProxyWithHeaderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgResponse != nil {
+		l = m.MsgResponse.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitProxyMisbehaviour) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Misbehaviour.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitProxyMisbehaviourResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPayProxyPacketFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UpstreamClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Direction != 0 {
+		n += 1 + sovTx(uint64(m.Direction))
+	}
+	l = m.UpstreamPrefix.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPayProxyPacketFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterUpstream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.UpstreamClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterUpstreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeregisterUpstream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.UpstreamClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeregisterUpstreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPauseProxy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.UpstreamClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPauseProxyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnpauseProxy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.UpstreamClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnpauseProxyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMigrateProxyClient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UpstreamClientState != nil {
+		l = m.UpstreamClientState.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NewUpstreamClientState != nil {
+		l = m.NewUpstreamClientState.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UpstreamConsensusState != nil {
+		l = m.UpstreamConsensusState.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.UpstreamConsensusHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ProofUpstreamClient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProofUpstreamConsensus)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ProofNewUpstreamClient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProofNewUpstreamConsensus)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.NewProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgMigrateProxyClientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgProxyClientState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProxyClientState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProxyClientState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpstreamClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamPrefix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpstreamPrefix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClientState == nil {
+				m.ClientState = &types1.Any{}
+			}
+			if err := m.ClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsensusState == nil {
+				m.ConsensusState = &types1.Any{}
+			}
+			if err := m.ConsensusState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofClient", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofClient = append(m.ProofClient[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofClient == nil {
+				m.ProofClient = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofConsensus", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofConsensus = append(m.ProofConsensus[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofConsensus == nil {
+				m.ProofConsensus = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConsensusHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProxyClientStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProxyClientStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProxyClientStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProxyConnectionOpenTry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProxyConnectionOpenTry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProxyConnectionOpenTry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamPrefix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpstreamPrefix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Connection", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Connection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownstreamClientState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownstreamClientState == nil {
+				m.DownstreamClientState = &types1.Any{}
+			}
+			if err := m.DownstreamClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownstreamConsensusState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownstreamConsensusState == nil {
+				m.DownstreamConsensusState = &types1.Any{}
+			}
+			if err := m.DownstreamConsensusState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyClientState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProxyClientState == nil {
+				m.ProxyClientState = &types1.Any{}
+			}
+			if err := m.ProxyClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofInit", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofInit = append(m.ProofInit[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofInit == nil {
+				m.ProofInit = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofClient", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofClient = append(m.ProofClient[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofClient == nil {
+				m.ProofClient = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofConsensus", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofConsensus = append(m.ProofConsensus[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofConsensus == nil {
+				m.ProofConsensus = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConsensusHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofProxyClient", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofProxyClient = append(m.ProofProxyClient[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofProxyClient == nil {
+				m.ProofProxyClient = []byte{}
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofProxyConsensus", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofProxyConsensus = append(m.ProofProxyConsensus[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofProxyConsensus == nil {
+				m.ProofProxyConsensus = []byte{}
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofProxyHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofProxyHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyConsensusHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProxyConsensusHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProxyConnectionOpenTryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProxyConnectionOpenTryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProxyConnectionOpenTryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProxyConnectionOpenAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProxyConnectionOpenAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProxyConnectionOpenAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamPrefix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpstreamPrefix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Connection", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Connection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownstreamClientState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownstreamClientState == nil {
+				m.DownstreamClientState = &types1.Any{}
+			}
+			if err := m.DownstreamClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownstreamConsensusState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownstreamConsensusState == nil {
+				m.DownstreamConsensusState = &types1.Any{}
+			}
+			if err := m.DownstreamConsensusState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyClientState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProxyClientState == nil {
+				m.ProxyClientState = &types1.Any{}
+			}
+			if err := m.ProxyClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofTry", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofTry = append(m.ProofTry[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofTry == nil {
+				m.ProofTry = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofClient", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofClient = append(m.ProofClient[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofClient == nil {
+				m.ProofClient = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofConsensus", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofConsensus = append(m.ProofConsensus[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofConsensus == nil {
+				m.ProofConsensus = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConsensusHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofProxyClient", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofProxyClient = append(m.ProofProxyClient[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofProxyClient == nil {
+				m.ProofProxyClient = []byte{}
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofProxyConsensus", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofProxyConsensus = append(m.ProofProxyConsensus[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofProxyConsensus == nil {
+				m.ProofProxyConsensus = []byte{}
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofProxyHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofProxyHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyConsensusHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProxyConsensusHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProxyConnectionOpenAckResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProxyConnectionOpenAckResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProxyConnectionOpenAckResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProxyConnectionOpenConfirm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProxyConnectionOpenConfirm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProxyConnectionOpenConfirm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpstreamClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamPrefix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpstreamPrefix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofAck", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofAck = append(m.ProofAck[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofAck == nil {
+				m.ProofAck = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProxyConnectionOpenConfirmResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProxyConnectionOpenConfirmResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProxyConnectionOpenConfirmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProxyConnectionOpenFinalize) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProxyConnectionOpenFinalize: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProxyConnectionOpenFinalize: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpstreamClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamPrefix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpstreamPrefix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofConfirm", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofConfirm = append(m.ProofConfirm[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofConfirm == nil {
+				m.ProofConfirm = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProxyConnectionOpenFinalizeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProxyConnectionOpenFinalizeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProxyConnectionOpenFinalizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProxyConnectionState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProxyConnectionState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProxyConnectionState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpstreamClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamPrefix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpstreamPrefix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Connection", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Connection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProxyConnectionStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProxyConnectionStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProxyConnectionStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProxyChannelOpenTry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProxyChannelOpenTry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProxyChannelOpenTry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpstreamClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamPrefix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpstreamPrefix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			m.Order = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Order |= types4.Order(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionHops", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionHops = append(m.ConnectionHops, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownstreamPortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DownstreamPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofInit", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofInit = append(m.ProofInit[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofInit == nil {
+				m.ProofInit = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamHops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpstreamHops = append(m.UpstreamHops, ProxyConnectionHop{})
+			if err := m.UpstreamHops[len(m.UpstreamHops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProxyChannelOpenTryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProxyChannelOpenTryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProxyChannelOpenTryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProxyChannelOpenAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProxyChannelOpenAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProxyChannelOpenAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpstreamClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamPrefix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpstreamPrefix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			m.Order = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Order |= types4.Order(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionHops", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionHops = append(m.ConnectionHops, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownstreamPortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DownstreamPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownstreamChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DownstreamChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofTry", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofTry = append(m.ProofTry[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofTry == nil {
+				m.ProofTry = []byte{}
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamHops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpstreamHops = append(m.UpstreamHops, ProxyConnectionHop{})
+			if err := m.UpstreamHops[len(m.UpstreamHops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProxyChannelOpenAckResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProxyChannelOpenAckResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProxyChannelOpenAckResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProxyChannelOpenConfirm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProxyChannelOpenConfirm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProxyChannelOpenConfirm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpstreamClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamPrefix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpstreamPrefix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownstreamChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DownstreamChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofAck", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofAck = append(m.ProofAck[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofAck == nil {
+				m.ProofAck = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProxyChannelOpenConfirmResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProxyChannelOpenConfirmResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProxyChannelOpenConfirmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProxyChannelOpenFinalize) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProxyChannelOpenFinalize: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProxyChannelOpenFinalize: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpstreamClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamPrefix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpstreamPrefix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofConfirm", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofConfirm = append(m.ProofConfirm[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofConfirm == nil {
+				m.ProofConfirm = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProxyChannelOpenFinalizeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProxyChannelOpenFinalizeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProxyChannelOpenFinalizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgProxyClientState) Unmarshal(dAtA []byte) error {
+func (m *MsgProxyChannelCloseConfirm) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3589,10 +12276,10 @@ func (m *MsgProxyClientState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProxyClientState: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProxyChannelCloseConfirm: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProxyClientState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProxyChannelCloseConfirm: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3662,7 +12349,7 @@ func (m *MsgProxyClientState) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3690,13 +12377,13 @@ func (m *MsgProxyClientState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CounterpartyClientId = string(dAtA[iNdEx:postIndex])
+			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3706,101 +12393,27 @@ func (m *MsgProxyClientState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClientState == nil {
-				m.ClientState = &types1.Any{}
-			}
-			if err := m.ClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusState", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ConsensusState == nil {
-				m.ConsensusState = &types1.Any{}
-			}
-			if err := m.ConsensusState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofClient", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProofClient = append(m.ProofClient[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofClient == nil {
-				m.ProofClient = []byte{}
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofConsensus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofInit", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3827,12 +12440,12 @@ func (m *MsgProxyClientState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofConsensus = append(m.ProofConsensus[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofConsensus == nil {
-				m.ProofConsensus = []byte{}
+			m.ProofInit = append(m.ProofInit[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofInit == nil {
+				m.ProofInit = []byte{}
 			}
 			iNdEx = postIndex
-		case 8:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
@@ -3865,40 +12478,7 @@ func (m *MsgProxyClientState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusHeight", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ConsensusHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -3951,7 +12531,7 @@ func (m *MsgProxyClientState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProxyClientStateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgProxyChannelCloseConfirmResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3974,10 +12554,10 @@ func (m *MsgProxyClientStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProxyClientStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProxyChannelCloseConfirmResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProxyClientStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProxyChannelCloseConfirmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4001,7 +12581,7 @@ func (m *MsgProxyClientStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProxyConnectionOpenTry) Unmarshal(dAtA []byte) error {
+func (m *MsgProxyChannelState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4024,15 +12604,15 @@ func (m *MsgProxyConnectionOpenTry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProxyConnectionOpenTry: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProxyChannelState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProxyConnectionOpenTry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProxyChannelState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4060,7 +12640,7 @@ func (m *MsgProxyConnectionOpenTry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			m.UpstreamClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -4097,9 +12677,9 @@ func (m *MsgProxyConnectionOpenTry) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Connection", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4109,30 +12689,29 @@ func (m *MsgProxyConnectionOpenTry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Connection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownstreamClientState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4142,31 +12721,27 @@ func (m *MsgProxyConnectionOpenTry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownstreamClientState == nil {
-				m.DownstreamClientState = &types1.Any{}
-			}
-			if err := m.DownstreamClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownstreamConsensusState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4193,52 +12768,13 @@ func (m *MsgProxyConnectionOpenTry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownstreamConsensusState == nil {
-				m.DownstreamConsensusState = &types1.Any{}
-			}
-			if err := m.DownstreamConsensusState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Channel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProxyClientState", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ProxyClientState == nil {
-				m.ProxyClientState = &types1.Any{}
-			}
-			if err := m.ProxyClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofInit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4265,16 +12801,16 @@ func (m *MsgProxyConnectionOpenTry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofInit = append(m.ProofInit[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofInit == nil {
-				m.ProofInit = []byte{}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
 			}
 			iNdEx = postIndex
-		case 8:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofClient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4284,31 +12820,30 @@ func (m *MsgProxyConnectionOpenTry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofClient = append(m.ProofClient[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofClient == nil {
-				m.ProofClient = []byte{}
-			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofConsensus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4318,29 +12853,27 @@ func (m *MsgProxyConnectionOpenTry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofConsensus = append(m.ProofConsensus[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofConsensus == nil {
-				m.ProofConsensus = []byte{}
-			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamHops", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4367,15 +12900,116 @@ func (m *MsgProxyConnectionOpenTry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.UpstreamHops = append(m.UpstreamHops, ProxyConnectionHop{})
+			if err := m.UpstreamHops[len(m.UpstreamHops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProxyChannelStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProxyChannelStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProxyChannelStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProxyRecvPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProxyRecvPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProxyRecvPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4385,30 +13019,29 @@ func (m *MsgProxyConnectionOpenTry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ConsensusHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.UpstreamClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofProxyClient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamPrefix", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4418,31 +13051,30 @@ func (m *MsgProxyConnectionOpenTry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofProxyClient = append(m.ProofProxyClient[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofProxyClient == nil {
-				m.ProofProxyClient = []byte{}
+			if err := m.UpstreamPrefix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofProxyConsensus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4452,31 +13084,30 @@ func (m *MsgProxyConnectionOpenTry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofProxyConsensus = append(m.ProofProxyConsensus[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofProxyConsensus == nil {
-				m.ProofProxyConsensus = []byte{}
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofProxyHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4486,28 +13117,29 @@ func (m *MsgProxyConnectionOpenTry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProofProxyHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
 			}
 			iNdEx = postIndex
-		case 15:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProxyConsensusHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4534,11 +13166,11 @@ func (m *MsgProxyConnectionOpenTry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProxyConsensusHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -4591,7 +13223,7 @@ func (m *MsgProxyConnectionOpenTry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProxyConnectionOpenTryResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgProxyRecvPacketResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4614,10 +13246,10 @@ func (m *MsgProxyConnectionOpenTryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProxyConnectionOpenTryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProxyRecvPacketResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProxyConnectionOpenTryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProxyRecvPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4641,7 +13273,7 @@ func (m *MsgProxyConnectionOpenTryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProxyConnectionOpenAck) Unmarshal(dAtA []byte) error {
+func (m *MsgProxyAcknowledgePacket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4664,15 +13296,15 @@ func (m *MsgProxyConnectionOpenAck) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProxyConnectionOpenAck: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProxyAcknowledgePacket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProxyConnectionOpenAck: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProxyAcknowledgePacket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4700,7 +13332,7 @@ func (m *MsgProxyConnectionOpenAck) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			m.UpstreamClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -4737,7 +13369,7 @@ func (m *MsgProxyConnectionOpenAck) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Connection", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4764,15 +13396,49 @@ func (m *MsgProxyConnectionOpenAck) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Connection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownstreamClientState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Acknowledgement", wireType)
 			}
-			var msglen int
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Acknowledgement = append(m.Acknowledgement[:0], dAtA[iNdEx:postIndex]...)
+			if m.Acknowledgement == nil {
+				m.Acknowledgement = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4782,31 +13448,29 @@ func (m *MsgProxyConnectionOpenAck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownstreamClientState == nil {
-				m.DownstreamClientState = &types1.Any{}
-			}
-			if err := m.DownstreamClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownstreamConsensusState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4833,18 +13497,15 @@ func (m *MsgProxyConnectionOpenAck) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownstreamConsensusState == nil {
-				m.DownstreamConsensusState = &types1.Any{}
-			}
-			if err := m.DownstreamConsensusState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProxyClientState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4854,33 +13515,129 @@ func (m *MsgProxyConnectionOpenAck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProxyClientState == nil {
-				m.ProxyClientState = &types1.Any{}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if err := m.ProxyClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProxyAcknowledgePacketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProxyAcknowledgePacketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProxyAcknowledgePacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 7:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProxyPacketBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProxyPacketBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProxyPacketBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofTry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4890,31 +13647,29 @@ func (m *MsgProxyConnectionOpenAck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofTry = append(m.ProofTry[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofTry == nil {
-				m.ProofTry = []byte{}
-			}
+			m.UpstreamClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofClient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamPrefix", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4924,31 +13679,30 @@ func (m *MsgProxyConnectionOpenAck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofClient = append(m.ProofClient[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofClient == nil {
-				m.ProofClient = []byte{}
+			if err := m.UpstreamPrefix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofConsensus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4958,31 +13712,31 @@ func (m *MsgProxyConnectionOpenAck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofConsensus = append(m.ProofConsensus[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofConsensus == nil {
-				m.ProofConsensus = []byte{}
+			m.Items = append(m.Items, ProxyPacketBatchItem{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchProof", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4992,28 +13746,29 @@ func (m *MsgProxyConnectionOpenAck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.BatchProof = append(m.BatchProof[:0], dAtA[iNdEx:postIndex]...)
+			if m.BatchProof == nil {
+				m.BatchProof = []byte{}
 			}
 			iNdEx = postIndex
-		case 11:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5040,15 +13795,15 @@ func (m *MsgProxyConnectionOpenAck) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ConsensusHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofProxyClient", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Atomic", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5058,31 +13813,17 @@ func (m *MsgProxyConnectionOpenAck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProofProxyClient = append(m.ProofProxyClient[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofProxyClient == nil {
-				m.ProofProxyClient = []byte{}
-			}
-			iNdEx = postIndex
-		case 13:
+			m.Atomic = bool(v != 0)
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofProxyConsensus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5092,29 +13833,77 @@ func (m *MsgProxyConnectionOpenAck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProxyPacketBatchItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofProxyConsensus = append(m.ProofProxyConsensus[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofProxyConsensus == nil {
-				m.ProofProxyConsensus = []byte{}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 14:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProxyPacketBatchItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProxyPacketBatchItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofProxyHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5141,15 +13930,15 @@ func (m *MsgProxyConnectionOpenAck) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProofProxyHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProxyConsensusHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Acknowledgement", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5159,30 +13948,31 @@ func (m *MsgProxyConnectionOpenAck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProxyConsensusHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Acknowledgement = append(m.Acknowledgement[:0], dAtA[iNdEx:postIndex]...)
+			if m.Acknowledgement == nil {
+				m.Acknowledgement = []byte{}
 			}
 			iNdEx = postIndex
-		case 16:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5192,23 +13982,25 @@ func (m *MsgProxyConnectionOpenAck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5231,7 +14023,7 @@ func (m *MsgProxyConnectionOpenAck) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProxyConnectionOpenAckResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgProxyPacketBatchResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5254,12 +14046,46 @@ func (m *MsgProxyConnectionOpenAckResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProxyConnectionOpenAckResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProxyPacketBatchResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProxyConnectionOpenAckResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProxyPacketBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, ProxyPacketBatchResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5281,7 +14107,7 @@ func (m *MsgProxyConnectionOpenAckResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProxyConnectionOpenConfirm) Unmarshal(dAtA []byte) error {
+func (m *ProxyPacketBatchResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5304,15 +14130,15 @@ func (m *MsgProxyConnectionOpenConfirm) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProxyConnectionOpenConfirm: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProxyPacketBatchResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProxyConnectionOpenConfirm: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProxyPacketBatchResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Codespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5340,9 +14166,110 @@ func (m *MsgProxyConnectionOpenConfirm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			m.Codespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Log = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProxyTimeoutPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProxyTimeoutPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProxyTimeoutPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientId", wireType)
 			}
@@ -5374,7 +14301,7 @@ func (m *MsgProxyConnectionOpenConfirm) Unmarshal(dAtA []byte) error {
 			}
 			m.UpstreamClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamPrefix", wireType)
 			}
@@ -5407,11 +14334,11 @@ func (m *MsgProxyConnectionOpenConfirm) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyConnectionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5421,27 +14348,28 @@ func (m *MsgProxyConnectionOpenConfirm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CounterpartyConnectionId = string(dAtA[iNdEx:postIndex])
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofAck", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofUnreceived", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5468,12 +14396,12 @@ func (m *MsgProxyConnectionOpenConfirm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofAck = append(m.ProofAck[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofAck == nil {
-				m.ProofAck = []byte{}
+			m.ProofUnreceived = append(m.ProofUnreceived[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofUnreceived == nil {
+				m.ProofUnreceived = []byte{}
 			}
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
@@ -5506,6 +14434,25 @@ func (m *MsgProxyConnectionOpenConfirm) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSequenceRecv", wireType)
+			}
+			m.NextSequenceRecv = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSequenceRecv |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
@@ -5559,7 +14506,7 @@ func (m *MsgProxyConnectionOpenConfirm) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProxyConnectionOpenConfirmResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgProxyTimeoutPacketResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5582,10 +14529,10 @@ func (m *MsgProxyConnectionOpenConfirmResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProxyConnectionOpenConfirmResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProxyTimeoutPacketResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProxyConnectionOpenConfirmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProxyTimeoutPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5609,7 +14556,7 @@ func (m *MsgProxyConnectionOpenConfirmResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProxyConnectionOpenFinalize) Unmarshal(dAtA []byte) error {
+func (m *MsgProxyTimeoutOnClose) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5632,15 +14579,15 @@ func (m *MsgProxyConnectionOpenFinalize) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProxyConnectionOpenFinalize: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProxyTimeoutOnClose: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProxyConnectionOpenFinalize: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProxyTimeoutOnClose: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5668,13 +14615,13 @@ func (m *MsgProxyConnectionOpenFinalize) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			m.UpstreamClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamPrefix", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5684,27 +14631,28 @@ func (m *MsgProxyConnectionOpenFinalize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpstreamClientId = string(dAtA[iNdEx:postIndex])
+			if err := m.UpstreamPrefix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5731,13 +14679,13 @@ func (m *MsgProxyConnectionOpenFinalize) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UpstreamPrefix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofConfirm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofUnreceived", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5764,12 +14712,46 @@ func (m *MsgProxyConnectionOpenFinalize) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofConfirm = append(m.ProofConfirm[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofConfirm == nil {
-				m.ProofConfirm = []byte{}
+			m.ProofUnreceived = append(m.ProofUnreceived[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofUnreceived == nil {
+				m.ProofUnreceived = []byte{}
 			}
 			iNdEx = postIndex
 		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofClose", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofClose = append(m.ProofClose[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofClose == nil {
+				m.ProofClose = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
@@ -5802,7 +14784,26 @@ func (m *MsgProxyConnectionOpenFinalize) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSequenceRecv", wireType)
+			}
+			m.NextSequenceRecv = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSequenceRecv |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -5855,7 +14856,7 @@ func (m *MsgProxyConnectionOpenFinalize) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProxyConnectionOpenFinalizeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgProxyTimeoutOnCloseResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5878,10 +14879,10 @@ func (m *MsgProxyConnectionOpenFinalizeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProxyConnectionOpenFinalizeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProxyTimeoutOnCloseResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProxyConnectionOpenFinalizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProxyTimeoutOnCloseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5905,7 +14906,7 @@ func (m *MsgProxyConnectionOpenFinalizeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProxyChannelOpenTry) Unmarshal(dAtA []byte) error {
+func (m *MsgPruneProxyPacketCommitment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5928,10 +14929,10 @@ func (m *MsgProxyChannelOpenTry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProxyChannelOpenTry: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPruneProxyPacketCommitment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProxyChannelOpenTry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPruneProxyPacketCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6000,10 +15001,10 @@ func (m *MsgProxyChannelOpenTry) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
-			m.Order = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6013,14 +15014,27 @@ func (m *MsgProxyChannelOpenTry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Order |= types4.Order(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionHops", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6048,13 +15062,32 @@ func (m *MsgProxyChannelOpenTry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionHops = append(m.ConnectionHops, string(dAtA[iNdEx:postIndex]))
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofAbsence", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6064,29 +15097,31 @@ func (m *MsgProxyChannelOpenTry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
+			m.ProofAbsence = append(m.ProofAbsence[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofAbsence == nil {
+				m.ProofAbsence = []byte{}
+			}
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6096,27 +15131,28 @@ func (m *MsgProxyChannelOpenTry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownstreamPortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6144,11 +15180,111 @@ func (m *MsgProxyChannelOpenTry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DownstreamPortId = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPruneProxyPacketCommitmentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPruneProxyPacketCommitmentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPruneProxyPacketCommitmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProxyWithHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProxyWithHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProxyWithHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6176,13 +15312,13 @@ func (m *MsgProxyChannelOpenTry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.UpstreamClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofInit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6192,29 +15328,31 @@ func (m *MsgProxyChannelOpenTry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofInit = append(m.ProofInit[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofInit == nil {
-				m.ProofInit = []byte{}
+			if m.Header == nil {
+				m.Header = &types1.Any{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6241,11 +15379,14 @@ func (m *MsgProxyChannelOpenTry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Msg == nil {
+				m.Msg = &types1.Any{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -6298,7 +15439,7 @@ func (m *MsgProxyChannelOpenTry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProxyChannelOpenTryResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgProxyWithHeaderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6321,12 +15462,48 @@ func (m *MsgProxyChannelOpenTryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProxyChannelOpenTryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProxyWithHeaderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProxyChannelOpenTryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProxyWithHeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgResponse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MsgResponse == nil {
+				m.MsgResponse = &types1.Any{}
+			}
+			if err := m.MsgResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6348,7 +15525,7 @@ func (m *MsgProxyChannelOpenTryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProxyChannelOpenAck) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitProxyMisbehaviour) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6371,47 +15548,15 @@ func (m *MsgProxyChannelOpenAck) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProxyChannelOpenAck: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitProxyMisbehaviour: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProxyChannelOpenAck: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitProxyMisbehaviour: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.UpstreamClientId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Misbehaviour", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6438,32 +15583,13 @@ func (m *MsgProxyChannelOpenAck) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UpstreamPrefix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Misbehaviour.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
-			}
-			m.Order = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Order |= types4.Order(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionHops", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6491,75 +15617,111 @@ func (m *MsgProxyChannelOpenAck) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionHops = append(m.ConnectionHops, string(dAtA[iNdEx:postIndex]))
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitProxyMisbehaviourResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitProxyMisbehaviourResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitProxyMisbehaviourResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPayProxyPacketFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPayProxyPacketFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPayProxyPacketFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownstreamPortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6587,11 +15749,11 @@ func (m *MsgProxyChannelOpenAck) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DownstreamPortId = string(dAtA[iNdEx:postIndex])
+			m.UpstreamClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownstreamChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6619,11 +15781,11 @@ func (m *MsgProxyChannelOpenAck) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DownstreamChannelId = string(dAtA[iNdEx:postIndex])
+			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6651,13 +15813,13 @@ func (m *MsgProxyChannelOpenAck) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofTry", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			var byteLen int
+			m.Sequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6667,29 +15829,14 @@ func (m *MsgProxyChannelOpenAck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Sequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProofTry = append(m.ProofTry[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofTry == nil {
-				m.ProofTry = []byte{}
-			}
-			iNdEx = postIndex
-		case 11:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6716,11 +15863,12 @@ func (m *MsgProxyChannelOpenAck) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Fee = append(m.Fee, types6.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -6752,6 +15900,58 @@ func (m *MsgProxyChannelOpenAck) Unmarshal(dAtA []byte) error {
 			}
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= PacketDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamPrefix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpstreamPrefix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6773,7 +15973,7 @@ func (m *MsgProxyChannelOpenAck) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProxyChannelOpenAckResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPayProxyPacketFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6796,10 +15996,10 @@ func (m *MsgProxyChannelOpenAckResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProxyChannelOpenAckResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPayProxyPacketFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProxyChannelOpenAckResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPayProxyPacketFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6823,7 +16023,7 @@ func (m *MsgProxyChannelOpenAckResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProxyChannelOpenConfirm) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterUpstream) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6846,15 +16046,15 @@ func (m *MsgProxyChannelOpenConfirm) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProxyChannelOpenConfirm: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterUpstream: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProxyChannelOpenConfirm: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterUpstream: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6882,44 +16082,11 @@ func (m *MsgProxyChannelOpenConfirm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpstreamClientId = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamPrefix", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.UpstreamPrefix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6947,111 +16114,113 @@ func (m *MsgProxyChannelOpenConfirm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
+			m.UpstreamClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownstreamChannelId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterUpstreamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DownstreamChannelId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofAck", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterUpstreamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterUpstreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if byteLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeregisterUpstream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofAck = append(m.ProofAck[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofAck == nil {
-				m.ProofAck = []byte{}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 7:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeregisterUpstream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeregisterUpstream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7061,28 +16230,27 @@ func (m *MsgProxyChannelOpenConfirm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7110,7 +16278,7 @@ func (m *MsgProxyChannelOpenConfirm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.UpstreamClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7133,7 +16301,7 @@ func (m *MsgProxyChannelOpenConfirm) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProxyChannelOpenConfirmResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeregisterUpstreamResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7156,10 +16324,10 @@ func (m *MsgProxyChannelOpenConfirmResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProxyChannelOpenConfirmResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeregisterUpstreamResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProxyChannelOpenConfirmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeregisterUpstreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7183,7 +16351,7 @@ func (m *MsgProxyChannelOpenConfirmResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProxyChannelOpenFinalize) Unmarshal(dAtA []byte) error {
+func (m *MsgPauseProxy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7206,15 +16374,15 @@ func (m *MsgProxyChannelOpenFinalize) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProxyChannelOpenFinalize: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPauseProxy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProxyChannelOpenFinalize: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPauseProxy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7242,13 +16410,13 @@ func (m *MsgProxyChannelOpenFinalize) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpstreamClientId = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7258,24 +16426,23 @@ func (m *MsgProxyChannelOpenFinalize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UpstreamPrefix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.UpstreamClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -7341,11 +16508,111 @@ func (m *MsgProxyChannelOpenFinalize) Unmarshal(dAtA []byte) error {
 			}
 			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPauseProxyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPauseProxyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPauseProxyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnpauseProxy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnpauseProxy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnpauseProxy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofConfirm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7355,31 +16622,29 @@ func (m *MsgProxyChannelOpenFinalize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofConfirm = append(m.ProofConfirm[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofConfirm == nil {
-				m.ProofConfirm = []byte{}
-			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7389,28 +16654,59 @@ func (m *MsgProxyChannelOpenFinalize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.UpstreamClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7438,7 +16734,7 @@ func (m *MsgProxyChannelOpenFinalize) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7461,7 +16757,7 @@ func (m *MsgProxyChannelOpenFinalize) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProxyChannelOpenFinalizeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnpauseProxyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7484,10 +16780,10 @@ func (m *MsgProxyChannelOpenFinalizeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProxyChannelOpenFinalizeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnpauseProxyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProxyChannelOpenFinalizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnpauseProxyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7511,7 +16807,7 @@ func (m *MsgProxyChannelOpenFinalizeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProxyRecvPacket) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateProxyClient) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7534,15 +16830,15 @@ func (m *MsgProxyRecvPacket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProxyRecvPacket: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateProxyClient: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProxyRecvPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateProxyClient: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7570,13 +16866,13 @@ func (m *MsgProxyRecvPacket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpstreamClientId = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7586,30 +16882,29 @@ func (m *MsgProxyRecvPacket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UpstreamPrefix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewClientId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7619,30 +16914,29 @@ func (m *MsgProxyRecvPacket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NewClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientState", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7652,29 +16946,31 @@ func (m *MsgProxyRecvPacket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
-			if m.Proof == nil {
-				m.Proof = []byte{}
+			if m.UpstreamClientState == nil {
+				m.UpstreamClientState = &types1.Any{}
+			}
+			if err := m.UpstreamClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewUpstreamClientState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7701,15 +16997,18 @@ func (m *MsgProxyRecvPacket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.NewUpstreamClientState == nil {
+				m.NewUpstreamClientState = &types1.Any{}
+			}
+			if err := m.NewUpstreamClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamConsensusState", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7719,129 +17018,33 @@ func (m *MsgProxyRecvPacket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgProxyRecvPacketResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.UpstreamConsensusState == nil {
+				m.UpstreamConsensusState = &types1.Any{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProxyRecvPacketResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProxyRecvPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.UpstreamConsensusState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgProxyAcknowledgePacket) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProxyAcknowledgePacket: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProxyAcknowledgePacket: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamConsensusHeight", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7851,29 +17054,30 @@ func (m *MsgProxyAcknowledgePacket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpstreamClientId = string(dAtA[iNdEx:postIndex])
+			if err := m.UpstreamConsensusHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofUpstreamClient", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7883,30 +17087,31 @@ func (m *MsgProxyAcknowledgePacket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UpstreamPrefix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ProofUpstreamClient = append(m.ProofUpstreamClient[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofUpstreamClient == nil {
+				m.ProofUpstreamClient = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofUpstreamConsensus", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7916,30 +17121,31 @@ func (m *MsgProxyAcknowledgePacket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ProofUpstreamConsensus = append(m.ProofUpstreamConsensus[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofUpstreamConsensus == nil {
+				m.ProofUpstreamConsensus = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Acknowledgement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7949,29 +17155,28 @@ func (m *MsgProxyAcknowledgePacket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Acknowledgement = append(m.Acknowledgement[:0], dAtA[iNdEx:postIndex]...)
-			if m.Acknowledgement == nil {
-				m.Acknowledgement = []byte{}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofNewUpstreamClient", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -7998,16 +17203,16 @@ func (m *MsgProxyAcknowledgePacket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
-			if m.Proof == nil {
-				m.Proof = []byte{}
+			m.ProofNewUpstreamClient = append(m.ProofNewUpstreamClient[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofNewUpstreamClient == nil {
+				m.ProofNewUpstreamClient = []byte{}
 			}
 			iNdEx = postIndex
-		case 6:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofNewUpstreamConsensus", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8017,30 +17222,31 @@ func (m *MsgProxyAcknowledgePacket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ProofNewUpstreamConsensus = append(m.ProofNewUpstreamConsensus[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofNewUpstreamConsensus == nil {
+				m.ProofNewUpstreamConsensus = []byte{}
 			}
 			iNdEx = postIndex
-		case 7:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewProofHeight", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8050,23 +17256,24 @@ func (m *MsgProxyAcknowledgePacket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			if err := m.NewProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8089,7 +17296,7 @@ func (m *MsgProxyAcknowledgePacket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProxyAcknowledgePacketResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateProxyClientResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8112,10 +17319,10 @@ func (m *MsgProxyAcknowledgePacketResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProxyAcknowledgePacketResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateProxyClientResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProxyAcknowledgePacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateProxyClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -102,6 +102,7 @@ type TestChain struct {
 	// IBC specific helpers
 	ClientIDs   []string          // ClientID's used on this chain
 	Connections []*TestConnection // track connectionID's created for this chain
+	TxEvents    sdk.Events        // events of the transactions delivered by SendMsgs
 }
 
 // NewTestChain initializes a new TestChain instance with a single validator set using a
@@ -294,6 +295,10 @@ func (chain *TestChain) SendMsgs(msgs ...sdk.Msg) (*sdk.Result, error) {
 		return nil, err
 	}
 
+	for _, ev := range r.Events {
+		chain.TxEvents = append(chain.TxEvents, sdk.Event(ev))
+	}
+
 	// SignCheckDeliver calls app.Commit()
 	chain.NextBlock()
 
@@ -394,6 +399,7 @@ func (chain *TestChain) ConstructNextTestConnection(clientID, counterpartyClient
 		ClientID:             clientID,
 		NextChannelVersion:   nextChannelVersion,
 		CounterpartyClientID: counterpartyClientID,
+		DelayPeriod:          DefaultDelayPeriod,
 	}
 }
 
@@ -643,7 +649,7 @@ func (chain *TestChain) ConnectionOpenInit(
 	msg := connectiontypes.NewMsgConnectionOpenInit(
 		connection.ClientID,
 		connection.CounterpartyClientID,
-		counterparty.GetPrefix(), DefaultOpenInitVersion, DefaultDelayPeriod,
+		counterparty.GetPrefix(), DefaultOpenInitVersion, connection.DelayPeriod,
 		chain.SenderAccount.GetAddress().String(),
 	)
 	return chain.sendMsgs(msg)
@@ -664,7 +670,7 @@ func (chain *TestChain) ConnectionOpenTry(
 	msg := connectiontypes.NewMsgConnectionOpenTry(
 		"", connection.ClientID, // does not support handshake continuation
 		counterpartyConnection.ID, counterpartyConnection.ClientID,
-		counterpartyClient, counterparty.GetPrefix(), []*connectiontypes.Version{ConnectionVersion}, DefaultDelayPeriod,
+		counterpartyClient, counterparty.GetPrefix(), []*connectiontypes.Version{ConnectionVersion}, connection.DelayPeriod,
 		proofInit, proofClient, proofConsensus,
 		proofHeight, consensusHeight,
 		chain.SenderAccount.GetAddress().String(),
@@ -7,6 +7,8 @@ import (
 	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 // ParseClientIDFromEvents parses events emitted from a MsgCreateClient and returns the
@@ -54,3 +56,25 @@ func ParseChannelIDFromEvents(events sdk.Events) (string, error) {
 	}
 	return "", fmt.Errorf("channel identifier event attribute not found")
 }
+
+// ParseProxyEventFromEvents parses a typed event emitted from a proxy message, such as
+// types.EventProxyRecvPacket, and unmarshals the first one of the same type as ev into ev.
+func ParseProxyEventFromEvents(events sdk.Events, ev proto.Message) error {
+	eventType := proto.MessageName(ev)
+	for _, e := range events {
+		if e.Type != eventType {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(abci.Event(e))
+		if err != nil {
+			return err
+		}
+		// copy through the wire format as proto.Merge doesn't support the custom types such as sdk.Int
+		bz, err := proto.Marshal(msg)
+		if err != nil {
+			return err
+		}
+		return proto.Unmarshal(bz, ev)
+	}
+	return fmt.Errorf("%s event not found", eventType)
+}
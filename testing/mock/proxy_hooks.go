@@ -0,0 +1,47 @@
+package mock
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	connectiontypes "github.com/cosmos/ibc-go/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/modules/core/exported"
+
+	proxytypes "github.com/datachainlab/ibc-proxy/modules/proxy/types"
+)
+
+var _ proxytypes.ProxyHooks = (*ProxyHooks)(nil)
+
+// ProxyHooks records the states passed to the proxy hooks.
+// The records are kept in memory, so they aren't reverted with the state of a failed transaction.
+type ProxyHooks struct {
+	ClientStates []string
+	Connections  []connectiontypes.ConnectionEnd
+	Channels     []channeltypes.Channel
+	Commitments  [][]byte
+	Acks         [][]byte
+}
+
+// AfterProxyClientState implements ProxyHooks
+func (h *ProxyHooks) AfterProxyClientState(ctx sdk.Context, upstreamClientID string, upstreamPrefix exported.Prefix, counterpartyClientID string, clientState exported.ClientState) {
+	h.ClientStates = append(h.ClientStates, counterpartyClientID)
+}
+
+// AfterProxyConnection implements ProxyHooks
+func (h *ProxyHooks) AfterProxyConnection(ctx sdk.Context, upstreamClientID string, upstreamPrefix exported.Prefix, connectionID string, connection connectiontypes.ConnectionEnd) {
+	h.Connections = append(h.Connections, connection)
+}
+
+// AfterProxyChannel implements ProxyHooks
+func (h *ProxyHooks) AfterProxyChannel(ctx sdk.Context, upstreamClientID string, upstreamPrefix exported.Prefix, portID, channelID string, channel channeltypes.Channel) {
+	h.Channels = append(h.Channels, channel)
+}
+
+// AfterProxyPacketCommitment implements ProxyHooks
+func (h *ProxyHooks) AfterProxyPacketCommitment(ctx sdk.Context, upstreamClientID string, upstreamPrefix exported.Prefix, portID, channelID string, sequence uint64, commitment []byte) {
+	h.Commitments = append(h.Commitments, commitment)
+}
+
+// AfterProxyAcknowledgement implements ProxyHooks
+func (h *ProxyHooks) AfterProxyAcknowledgement(ctx sdk.Context, upstreamClientID string, upstreamPrefix exported.Prefix, portID, channelID string, sequence uint64, acknowledgement []byte) {
+	h.Acks = append(h.Acks, acknowledgement)
+}
@@ -85,7 +85,7 @@ func (chain *TestChain) ConnectionOpenTryWithProxy(
 	msg := connectiontypes.NewMsgConnectionOpenTry(
 		"", connection.ClientID, // does not support handshake continuation
 		counterpartyConnection.ID, counterpartyConnection.ClientID,
-		upstreamClientState, counterparty.GetPrefix(), []*connectiontypes.Version{ConnectionVersion}, DefaultDelayPeriod,
+		upstreamClientState, counterparty.GetPrefix(), []*connectiontypes.Version{ConnectionVersion}, connection.DelayPeriod,
 		proofInit, proofClient, proofConsensus,
 		proofHeight, upstreamConsensusHeight,
 		chain.SenderAccount.GetAddress().String(),
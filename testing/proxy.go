@@ -10,6 +10,7 @@ import (
 	commitmenttypes "github.com/cosmos/ibc-go/modules/core/23-commitment/types"
 	host "github.com/cosmos/ibc-go/modules/core/24-host"
 	"github.com/cosmos/ibc-go/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/modules/light-clients/07-tendermint/types"
 	proxyclienttypes "github.com/datachainlab/ibc-proxy/modules/light-clients/xx-proxy/types"
 	proxytypes "github.com/datachainlab/ibc-proxy/modules/proxy/types"
 	"github.com/datachainlab/ibc-proxy/testing/simapp"
@@ -33,7 +34,10 @@ func (coord *Coordinator) CreateClient2(
 	return clientID, err
 }
 
+// CreateProxyClient creates a proxy client bound to the upstream chain of the upstream client on the proxy.
+// The proxy commits a block first so that the initial consensus state proves the upstream client.
 func (coord *Coordinator) CreateProxyClient(downstream, proxy *TestChain, clientType string, upstreamClientID string) (string, error) {
+	coord.CommitBlock(proxy)
 	clientID := downstream.NewClientID(proxyclienttypes.ProxyClientType)
 	if err := downstream.CreateProxyClient(proxy, clientType, clientID, upstreamClientID); err != nil {
 		return "", err
@@ -41,16 +45,50 @@ func (coord *Coordinator) CreateProxyClient(downstream, proxy *TestChain, client
 	return clientID, nil
 }
 
+// CreateProxyClientWithConfig creates a proxy client whose underlying tendermint client is configured with the given config
+func (coord *Coordinator) CreateProxyClientWithConfig(downstream, proxy *TestChain, config *TendermintConfig, upstreamClientID string) (string, error) {
+	coord.CommitBlock(proxy)
+	clientID := downstream.NewClientID(proxyclienttypes.ProxyClientType)
+	height := proxy.LastHeader.GetHeight().(clienttypes.Height)
+	clientState := ibctmtypes.NewClientState(
+		proxy.ChainID, config.TrustLevel, config.TrustingPeriod, config.UnbondingPeriod, config.MaxClockDrift,
+		height, commitmenttypes.GetSDKSpecs(), UpgradePath, config.AllowUpdateAfterExpiry, config.AllowUpdateAfterMisbehaviour,
+	)
+	msg, err := clienttypes.NewMsgCreateClient(clientState, proxy.LastHeader.ConsensusState(), downstream.SenderAccount.GetAddress().String())
+	if err != nil {
+		return "", err
+	}
+	if err := downstream.createProxyClient(proxy, msg, upstreamClientID); err != nil {
+		return "", err
+	}
+	return clientID, nil
+}
+
 func (chain *TestChain) CreateProxyClient(proxy *TestChain, clientType string, clientID string, upstreamClientID string) error {
-	msg := chain.ConstructMsgCreateClient(proxy, clientID, clientType)
+	return chain.createProxyClient(proxy, chain.ConstructMsgCreateClient(proxy, clientID, clientType), upstreamClientID)
+}
+
+// createProxyClient creates a proxy client that wraps the client of the given message.
+// The initial consensus state of the message must be the one of the last header of the proxy.
+func (chain *TestChain) createProxyClient(proxy *TestChain, msg *clienttypes.MsgCreateClient, upstreamClientID string) error {
+	upstreamClientProof, err := proxy.QueryUpstreamClientProof(upstreamClientID)
+	if err != nil {
+		return err
+	}
+	upstreamChainID, err := proxyclienttypes.GetChainID(upstreamClientProof.ClientState.GetCachedValue().(exported.ClientState))
+	if err != nil {
+		return err
+	}
 
 	ibcPrefix := commitmenttypes.NewMerklePrefix([]byte(host.StoreKey))
 	proxyPrefix := commitmenttypes.NewMerklePrefix([]byte(proxytypes.StoreKey))
 	clientState := &proxyclienttypes.ClientState{
-		ProxyClientState: msg.ClientState,
-		UpstreamClientId: upstreamClientID,
-		IbcPrefix:        &ibcPrefix,
-		ProxyPrefix:      &proxyPrefix,
+		ProxyClientState:    msg.ClientState,
+		UpstreamClientId:    upstreamClientID,
+		IbcPrefix:           &ibcPrefix,
+		ProxyPrefix:         &proxyPrefix,
+		UpstreamChainId:     upstreamChainID,
+		UpstreamClientProof: upstreamClientProof,
 	}
 	anyClientState, err := clienttypes.PackClientState(clientState)
 	if err != nil {
@@ -86,8 +124,19 @@ func (coord *Coordinator) CreateConnectionWithProxy(
 	nextChannelVersion string,
 	proxies ProxyPair,
 ) (*TestConnection, *TestConnection) {
+	return coord.CreateConnectionWithProxyAndConfig(chainA, chainB, clientA, clientB, nextChannelVersion, NewConnectionConfig(), proxies)
+}
+
+// CreateConnectionWithProxyAndConfig is the same as CreateConnectionWithProxy except that the connection is created with the given config
+func (coord *Coordinator) CreateConnectionWithProxyAndConfig(
+	chainA, chainB *TestChain,
+	clientA, clientB string,
+	nextChannelVersion string,
+	config *ConnectionConfig,
+	proxies ProxyPair,
+) (*TestConnection, *TestConnection) {
 
-	connA, connB, err := coord.ConnOpenInitWithProxy(chainA, chainB, clientA, clientB, nextChannelVersion, proxies)
+	connA, connB, err := coord.ConnOpenInitWithProxy(chainA, chainB, clientA, clientB, nextChannelVersion, config, proxies)
 	require.NoError(coord.t, err)
 
 	err = coord.ConnOpenTryWithProxy(chainB, chainA, connB, connA, proxies.Swap())
@@ -146,14 +195,12 @@ func (pair ProxyPair) Swap() ProxyPair {
 
 func (coord *Coordinator) ConnOpenInitWithProxy(
 	source, counterparty *TestChain,
-	clientID, counterpartyClientID, nextChannelVersion string, proxies ProxyPair,
+	clientID, counterpartyClientID, nextChannelVersion string, config *ConnectionConfig, proxies ProxyPair,
 ) (*TestConnection, *TestConnection, error) {
-	if proxies[1] == nil {
-		return coord.ConnOpenInit(source, counterparty, clientID, counterpartyClientID, nextChannelVersion)
-	}
-
 	sourceConnection := source.AddTestConnection(clientID, counterpartyClientID, nextChannelVersion)
 	counterpartyConnection := counterparty.AddTestConnection(counterpartyClientID, clientID, nextChannelVersion)
+	sourceConnection.DelayPeriod = config.DelayPeriod
+	counterpartyConnection.DelayPeriod = config.DelayPeriod
 
 	// initialize connection on source
 	if err := source.ConnectionOpenInit(counterparty, sourceConnection, counterpartyConnection); err != nil {
@@ -162,14 +209,18 @@ func (coord *Coordinator) ConnOpenInitWithProxy(
 	coord.IncrementTime()
 
 	// update source client on counterparty connection
-	if err := coord.UpdateClient(
-		proxies[1].Chain, source,
-		proxies[1].UpstreamClientID, exported.Tendermint,
-	); err != nil {
+	if proxies[1] == nil {
+		err := coord.UpdateClient(
+			counterparty, source,
+			counterpartyClientID, exported.Tendermint,
+		)
 		return sourceConnection, counterpartyConnection, err
 	}
-
-	return sourceConnection, counterpartyConnection, nil
+	err := coord.UpdateClient(
+		proxies[1].Chain, source,
+		proxies[1].UpstreamClientID, exported.Tendermint,
+	)
+	return sourceConnection, counterpartyConnection, err
 }
 
 func (coord *Coordinator) ConnOpenTryWithProxy(
@@ -261,7 +312,7 @@ func (coord *Coordinator) ConnOpenTryWithProxy(
 
 			msg := connectiontypes.NewMsgConnectionOpenTry(
 				"", sourceConnection.ClientID, counterpartyConnection.ID, counterpartyConnection.ClientID, client, // testing doesn't use flexible selection
-				counterparty.GetPrefix(), []*connectiontypes.Version{ConnectionVersion}, DefaultDelayPeriod,
+				counterparty.GetPrefix(), []*connectiontypes.Version{ConnectionVersion}, sourceConnection.DelayPeriod,
 				proofInit, proofClient, proofConsensus,
 				proofHeight, consensusHeight,
 				source.SenderAccount.GetAddress().String(),
@@ -803,6 +854,386 @@ func (coord *Coordinator) ChanOpenFinalizeWithProxy(
 	return nil
 }
 
+// ProxyOpenConnection proxies the connection end that the upstream has already opened
+// to the proxy under its upstream client, which doesn't have it yet.
+func (coord *Coordinator) ProxyOpenConnection(
+	upstream *TestChain,
+	upstreamConnection *TestConnection,
+	proxy *ProxyInfo,
+) error {
+	if err := coord.UpdateClient(proxy.Chain, upstream, proxy.UpstreamClientID, exported.Tendermint); err != nil {
+		return err
+	}
+
+	proof, proofHeight := upstream.QueryProof(host.ConnectionKey(upstreamConnection.ID))
+	msg := proxytypes.NewMsgProxyConnectionState(
+		upstreamConnection.ID, proxy.UpstreamClientID, proxy.UpstreamPrefix.(commitmenttypes.MerklePrefix),
+		upstream.GetConnection(upstreamConnection), proof, proofHeight, proxy.Chain.SenderAccount.GetAddress().String(),
+	)
+	if _, err := proxy.Chain.SendMsgs(msg); err != nil {
+		return err
+	}
+	coord.CommitBlock(proxy.Chain)
+	return nil
+}
+
+// ProxyOpenChannel proxies the connection end and the channel that the upstream has already opened
+// to the proxy under its upstream client, which doesn't have them yet.
+func (coord *Coordinator) ProxyOpenChannel(
+	upstream *TestChain,
+	upstreamConnection *TestConnection,
+	upstreamChannel TestChannel,
+	proxy *ProxyInfo,
+) error {
+	if err := coord.UpdateClient(proxy.Chain, upstream, proxy.UpstreamClientID, exported.Tendermint); err != nil {
+		return err
+	}
+
+	proofConnection, proofHeight := upstream.QueryProof(host.ConnectionKey(upstreamConnection.ID))
+	proofChannel, _ := upstream.QueryProof(host.ChannelKey(upstreamChannel.PortID, upstreamChannel.ID))
+	signer := proxy.Chain.SenderAccount.GetAddress().String()
+	upstreamPrefix := proxy.UpstreamPrefix.(commitmenttypes.MerklePrefix)
+	connectionMsg := proxytypes.NewMsgProxyConnectionState(
+		upstreamConnection.ID, proxy.UpstreamClientID, upstreamPrefix, upstream.GetConnection(upstreamConnection), proofConnection, proofHeight, signer,
+	)
+	channelMsg := &proxytypes.MsgProxyChannelState{
+		UpstreamClientId: proxy.UpstreamClientID,
+		UpstreamPrefix:   upstreamPrefix,
+		PortId:           upstreamChannel.PortID,
+		ChannelId:        upstreamChannel.ID,
+		Channel:          upstream.GetChannel(upstreamChannel),
+		Proof:            proofChannel,
+		ProofHeight:      proofHeight,
+		Signer:           signer,
+	}
+	if _, err := proxy.Chain.SendMsgs(connectionMsg, channelMsg); err != nil {
+		return err
+	}
+	coord.CommitBlock(proxy.Chain)
+	return nil
+}
+
+// MigrateProxyClientMsg updates the upstream clients of both proxies with the same header of the upstream
+// and the proxy clients of the downstream, and then returns the message that migrates the proxy client
+// of the downstream to the new proxy with the proofs at the latest heights of the proxy clients.
+func (coord *Coordinator) MigrateProxyClientMsg(
+	downstream, upstream *TestChain,
+	proxy, newProxy *ProxyInfo,
+	authority string,
+) (*proxytypes.MsgMigrateProxyClient, error) {
+	coord.CommitBlock(upstream)
+	for _, p := range []*ProxyInfo{proxy, newProxy} {
+		if err := p.Chain.UpdateTMClient(upstream, p.UpstreamClientID); err != nil {
+			return nil, err
+		}
+	}
+	if proxy.Chain == newProxy.Chain {
+		coord.CommitBlock(proxy.Chain)
+	} else {
+		coord.CommitBlock(proxy.Chain, newProxy.Chain)
+	}
+	for _, p := range []*ProxyInfo{proxy, newProxy} {
+		if err := downstream.UpdateProxyClient(p.Chain, p.ClientID); err != nil {
+			return nil, err
+		}
+	}
+
+	upstreamClientState, proofUpstreamClient := proxy.Chain.QueryClientStateProof(proxy.UpstreamClientID)
+	proofUpstreamConsensus, consensusHeight := proxy.Chain.QueryConsensusStateProof(proxy.UpstreamClientID)
+	_, proofHeight := proxy.Chain.QueryProof(host.FullClientStateKey(proxy.UpstreamClientID))
+	upstreamConsensusState, found := proxy.Chain.GetConsensusState(proxy.UpstreamClientID, consensusHeight)
+	if !found {
+		return nil, fmt.Errorf("consensus state of the upstream client %v not found at %v", proxy.UpstreamClientID, consensusHeight)
+	}
+	newUpstreamClientState, proofNewUpstreamClient := newProxy.Chain.QueryClientStateProof(newProxy.UpstreamClientID)
+	proofNewUpstreamConsensus, newConsensusHeight := newProxy.Chain.QueryConsensusStateProof(newProxy.UpstreamClientID)
+	_, newProofHeight := newProxy.Chain.QueryProof(host.FullClientStateKey(newProxy.UpstreamClientID))
+	if !newConsensusHeight.EQ(consensusHeight) {
+		return nil, fmt.Errorf("upstream clients have different latest heights: %v != %v", consensusHeight, newConsensusHeight)
+	}
+
+	return proxytypes.NewMsgMigrateProxyClient(
+		authority, proxy.ClientID, newProxy.ClientID,
+		upstreamClientState, newUpstreamClientState, upstreamConsensusState, consensusHeight,
+		proofUpstreamClient, proofUpstreamConsensus, proofHeight,
+		proofNewUpstreamClient, proofNewUpstreamConsensus, newProofHeight,
+	)
+}
+
+// ProxyHop is a connection hop of a multi-hop channel whose connection end is proxied
+type ProxyHop struct {
+	Chain      *TestChain      // the chain that has the connection
+	Connection *TestConnection // the connection of the hop
+	Proxy      *ProxyInfo      // the proxy that has the connection end, under its client of the chain
+}
+
+func proxyHopsOf(hops []ProxyHop) (connectionHops []string, upstreamHops []proxytypes.ProxyConnectionHop) {
+	for i, hop := range hops {
+		connectionHops = append(connectionHops, hop.Connection.ID)
+		if i > 0 {
+			upstreamHops = append(upstreamHops, proxytypes.ProxyConnectionHop{
+				UpstreamClientId: hop.Proxy.UpstreamClientID,
+				UpstreamPrefix:   hop.Proxy.UpstreamPrefix.(commitmenttypes.MerklePrefix),
+			})
+		}
+	}
+	return connectionHops, upstreamHops
+}
+
+// reverseConnectionHops returns the connection hops from the downstream to the upstream
+func reverseConnectionHops(downstreamConnection *TestConnection, hops []ProxyHop) []string {
+	connectionHops := []string{downstreamConnection.ID}
+	for i := len(hops) - 2; i >= 0; i-- {
+		connectionHops = append(connectionHops, hops[i].Chain.GetConnection(hops[i].Connection).Counterparty.ConnectionId)
+	}
+	return connectionHops
+}
+
+// CreateMultiHopChannelWithProxy creates a channel between the upstream and the downstream over the connection hops.
+// The proxy of the first hop relays the upstream's channel end and must have the connection ends of all hops
+// and the counterparty connection ends of all hops but the last one.
+// As the channel keeper of ibc-go only supports a single connection hop, the channel ends are written into the stores directly,
+// and only the proxy verifies the handshake.
+func (coord *Coordinator) CreateMultiHopChannelWithProxy(
+	upstream, downstream *TestChain,
+	downstreamConnection *TestConnection,
+	upstreamPortID, downstreamPortID string,
+	order channeltypes.Order,
+	hops []ProxyHop,
+) (TestChannel, TestChannel, error) {
+	proxy := hops[0].Proxy
+	upstreamChannel := upstream.AddTestChannel(hops[0].Connection, upstreamPortID)
+	downstreamChannel := downstream.AddTestChannel(downstreamConnection, downstreamPortID)
+	connectionHops, upstreamHops := proxyHopsOf(hops)
+	downstreamHops := reverseConnectionHops(downstreamConnection, hops)
+
+	channel := channeltypes.NewChannel(channeltypes.INIT, order, channeltypes.NewCounterparty(downstreamPortID, ""), connectionHops, upstreamChannel.Version)
+	upstream.setChannel(upstreamChannel, channel)
+	coord.CommitBlock(upstream)
+	if err := coord.UpdateClient(proxy.Chain, upstream, proxy.UpstreamClientID, exported.Tendermint); err != nil {
+		return upstreamChannel, downstreamChannel, err
+	}
+
+	proofInit, proofHeight := upstream.QueryProof(host.ChannelKey(upstreamChannel.PortID, upstreamChannel.ID))
+	tryMsg := &proxytypes.MsgProxyChannelOpenTry{
+		UpstreamClientId: proxy.UpstreamClientID,
+		UpstreamPrefix:   proxy.UpstreamPrefix.(commitmenttypes.MerklePrefix),
+		Order:            order,
+		ConnectionHops:   connectionHops,
+		UpstreamHops:     upstreamHops,
+		PortId:           upstreamChannel.PortID,
+		ChannelId:        upstreamChannel.ID,
+		DownstreamPortId: downstreamPortID,
+		Version:          upstreamChannel.Version,
+		ProofInit:        proofInit,
+		ProofHeight:      proofHeight,
+		Signer:           proxy.Chain.SenderAccount.GetAddress().String(),
+	}
+	if _, err := proxy.Chain.SendMsgs(tryMsg); err != nil {
+		return upstreamChannel, downstreamChannel, err
+	}
+	coord.CommitBlock(proxy.Chain)
+
+	downstream.setChannel(downstreamChannel, channeltypes.NewChannel(
+		channeltypes.TRYOPEN, order, channeltypes.NewCounterparty(upstreamPortID, upstreamChannel.ID), downstreamHops, downstreamChannel.Version,
+	))
+	coord.CommitBlock(downstream)
+
+	channel.State = channeltypes.OPEN
+	channel.Counterparty.ChannelId = downstreamChannel.ID
+	upstream.setChannel(upstreamChannel, channel)
+	coord.CommitBlock(upstream)
+	if err := coord.UpdateClient(proxy.Chain, upstream, proxy.UpstreamClientID, exported.Tendermint); err != nil {
+		return upstreamChannel, downstreamChannel, err
+	}
+
+	proofAck, proofHeight := upstream.QueryProof(host.ChannelKey(upstreamChannel.PortID, upstreamChannel.ID))
+	confirmMsg := &proxytypes.MsgProxyChannelOpenConfirm{
+		UpstreamClientId:    proxy.UpstreamClientID,
+		UpstreamPrefix:      proxy.UpstreamPrefix.(commitmenttypes.MerklePrefix),
+		PortId:              upstreamChannel.PortID,
+		ChannelId:           upstreamChannel.ID,
+		DownstreamChannelId: downstreamChannel.ID,
+		ProofAck:            proofAck,
+		ProofHeight:         proofHeight,
+		Signer:              proxy.Chain.SenderAccount.GetAddress().String(),
+	}
+	if _, err := proxy.Chain.SendMsgs(confirmMsg); err != nil {
+		return upstreamChannel, downstreamChannel, err
+	}
+	coord.CommitBlock(proxy.Chain)
+
+	downstream.setChannel(downstreamChannel, channeltypes.NewChannel(
+		channeltypes.OPEN, order, channeltypes.NewCounterparty(upstreamPortID, upstreamChannel.ID), downstreamHops, downstreamChannel.Version,
+	))
+	coord.CommitBlock(downstream)
+
+	return upstreamChannel, downstreamChannel, nil
+}
+
+// ChanOpenTryWithProxyHops initializes a channel on the downstream over the reversed connection hops,
+// and then relays the upstream's channel end in TRYOPEN to the proxy of the first hop.
+// As with CreateMultiHopChannelWithProxy, the channel ends are written into the stores directly.
+func (coord *Coordinator) ChanOpenTryWithProxyHops(
+	upstream, downstream *TestChain,
+	downstreamConnection *TestConnection,
+	upstreamPortID, downstreamPortID string,
+	order channeltypes.Order,
+	hops []ProxyHop,
+) (TestChannel, TestChannel, error) {
+	proxy := hops[0].Proxy
+	upstreamChannel := upstream.AddTestChannel(hops[0].Connection, upstreamPortID)
+	downstreamChannel := downstream.AddTestChannel(downstreamConnection, downstreamPortID)
+	connectionHops, upstreamHops := proxyHopsOf(hops)
+
+	downstream.setChannel(downstreamChannel, channeltypes.NewChannel(
+		channeltypes.INIT, order, channeltypes.NewCounterparty(upstreamPortID, ""), reverseConnectionHops(downstreamConnection, hops), downstreamChannel.Version,
+	))
+	coord.CommitBlock(downstream)
+
+	upstream.setChannel(upstreamChannel, channeltypes.NewChannel(
+		channeltypes.TRYOPEN, order, channeltypes.NewCounterparty(downstreamPortID, downstreamChannel.ID), connectionHops, upstreamChannel.Version,
+	))
+	coord.CommitBlock(upstream)
+	if err := coord.UpdateClient(proxy.Chain, upstream, proxy.UpstreamClientID, exported.Tendermint); err != nil {
+		return upstreamChannel, downstreamChannel, err
+	}
+
+	proofTry, proofHeight := upstream.QueryProof(host.ChannelKey(upstreamChannel.PortID, upstreamChannel.ID))
+	msg := &proxytypes.MsgProxyChannelOpenAck{
+		UpstreamClientId:    proxy.UpstreamClientID,
+		UpstreamPrefix:      proxy.UpstreamPrefix.(commitmenttypes.MerklePrefix),
+		Order:               order,
+		ConnectionHops:      connectionHops,
+		UpstreamHops:        upstreamHops,
+		PortId:              upstreamChannel.PortID,
+		ChannelId:           upstreamChannel.ID,
+		DownstreamPortId:    downstreamChannel.PortID,
+		DownstreamChannelId: downstreamChannel.ID,
+		Version:             upstreamChannel.Version,
+		ProofTry:            proofTry,
+		ProofHeight:         proofHeight,
+		Signer:              proxy.Chain.SenderAccount.GetAddress().String(),
+	}
+	if _, err := proxy.Chain.SendMsgs(msg); err != nil {
+		return upstreamChannel, downstreamChannel, err
+	}
+	coord.CommitBlock(proxy.Chain)
+
+	return upstreamChannel, downstreamChannel, nil
+}
+
+// setChannel writes the channel end into the store without the channel handshake
+func (chain *TestChain) setChannel(ch TestChannel, channel channeltypes.Channel) {
+	ctx := chain.GetContext()
+	channelKeeper := chain.App.GetIBCKeeper().ChannelKeeper
+	if _, found := channelKeeper.GetChannel(ctx, ch.PortID, ch.ID); !found {
+		channelKeeper.SetNextChannelSequence(ctx, channelKeeper.GetNextChannelSequence(ctx)+1)
+		channelKeeper.SetNextSequenceSend(ctx, ch.PortID, ch.ID, 1)
+		channelKeeper.SetNextSequenceRecv(ctx, ch.PortID, ch.ID, 1)
+		channelKeeper.SetNextSequenceAck(ctx, ch.PortID, ch.ID, 1)
+	}
+	channelKeeper.SetChannel(ctx, ch.PortID, ch.ID, channel)
+}
+
+// ChanCloseInitWithProxy closes a channel on the source chain resulting in the channels state
+// being set to CLOSED.
+//
+// NOTE: does not work with ibc-transfer module
+func (coord *Coordinator) ChanCloseInitWithProxy(
+	source, counterparty *TestChain,
+	sourceChannel TestChannel,
+	counterpartyConnection *TestConnection,
+	proxies ProxyPair,
+) error {
+	if err := source.ChanCloseInit(counterparty, sourceChannel); err != nil {
+		return err
+	}
+	coord.IncrementTime()
+
+	if proxies[1] == nil {
+		return coord.UpdateClient(
+			counterparty, source, counterpartyConnection.ClientID, exported.Tendermint,
+		)
+	} else {
+		return coord.UpdateClient(
+			proxies[1].Chain, source, proxies[1].UpstreamClientID, exported.Tendermint,
+		)
+	}
+}
+
+func (coord *Coordinator) ChanCloseConfirmWithProxy(
+	source, counterparty *TestChain,
+	sourceChannel, counterpartyChannel TestChannel,
+	sourceConnection, counterpartyConnection *TestConnection,
+	proxies ProxyPair,
+) error {
+	if proxies[0] == nil {
+		proofInit, proofHeight := counterparty.QueryProof(host.ChannelKey(counterpartyChannel.PortID, counterpartyChannel.ID))
+		msg := channeltypes.NewMsgChannelCloseConfirm(
+			sourceChannel.PortID, sourceChannel.ID,
+			proofInit, proofHeight,
+			source.SenderAccount.GetAddress().String(),
+		)
+		if _, err := source.SendMsgs(msg); err != nil {
+			return err
+		}
+		coord.IncrementTime()
+	} else {
+		// source: downstream, counterparty: upstream
+		proxy := proxies[0].Chain
+
+		proofInit, proofHeight := counterparty.QueryProof(host.ChannelKey(counterpartyChannel.PortID, counterpartyChannel.ID))
+
+		msg := &proxytypes.MsgProxyChannelCloseConfirm{
+			UpstreamClientId: proxies[0].UpstreamClientID,
+			UpstreamPrefix:   proxies[0].UpstreamPrefix.(commitmenttypes.MerklePrefix),
+			PortId:           counterpartyChannel.PortID,
+			ChannelId:        counterpartyChannel.ID,
+			ProofInit:        proofInit,
+			ProofHeight:      proofHeight,
+			Signer:           proxy.SenderAccount.GetAddress().String(),
+		}
+		if _, err := proxy.SendMsgs(msg); err != nil {
+			return err
+		}
+		coord.CommitBlock(proxy)
+
+		channel := proxy.GetProxyChannel(proxies[0].UpstreamPrefix.(commitmenttypes.MerklePrefix), proxies[0].UpstreamClientID, counterpartyChannel.PortID, counterpartyChannel.ID)
+		if channel.State != channeltypes.CLOSED {
+			return fmt.Errorf("channel state must be CLOSED, but got %v", channel.State)
+		}
+
+		if err := source.UpdateProxyClient(proxy, proxies[0].ClientID); err != nil {
+			return err
+		}
+		coord.CommitBlock(source)
+
+		{
+			proof, proofHeight := proxy.QueryProxyChannelStateProof(counterpartyChannel.PortID, counterpartyChannel.ID, proxies[0].UpstreamPrefix, proxies[0].UpstreamClientID)
+			msg := channeltypes.NewMsgChannelCloseConfirm(
+				sourceChannel.PortID, sourceChannel.ID,
+				proof, proofHeight,
+				source.SenderAccount.GetAddress().String(),
+			)
+			if _, err := source.SendMsgs(msg); err != nil {
+				return err
+			}
+			coord.CommitBlock(source)
+		}
+	}
+
+	if proxies[1] == nil {
+		return coord.UpdateClient(
+			counterparty, source, counterpartyConnection.ClientID, exported.Tendermint,
+		)
+	} else {
+		return coord.UpdateClient(
+			proxies[1].Chain, source, proxies[1].UpstreamClientID, exported.Tendermint,
+		)
+	}
+}
+
 func (coord *Coordinator) SendPacketWithProxy(
 	source, counterparty *TestChain, // source: packet sender, counterparty: packet receiver
 	sourceConnection, counterpartyConnection *TestConnection,
@@ -933,6 +1364,135 @@ func (coord *Coordinator) AcknowledgePacketWithProxy(
 }
 
 // source: packet sender, counterparty: packet receiver
+// TimeoutPacketWithProxy times out the packet on the source chain.
+// If proxies[0] is not nil, the proxy verifies the counterparty hasn't received the packet first.
+func (coord *Coordinator) TimeoutPacketWithProxy(
+	source, counterparty *TestChain, // source: packet sender, counterparty: packet receiver
+	sourceConnection, counterpartyConnection *TestConnection,
+	packet channeltypes.Packet, proxies ProxyPair,
+) error {
+	return coord.timeoutPacketWithProxy(source, counterparty, sourceConnection, counterpartyConnection, packet, proxies, false)
+}
+
+// TimeoutOnCloseWithProxy times out the packet on the source chain after the counterparty channel is closed.
+// If proxies[0] is not nil, the proxy verifies the CLOSED channel and the counterparty hasn't received the packet first.
+func (coord *Coordinator) TimeoutOnCloseWithProxy(
+	source, counterparty *TestChain, // source: packet sender, counterparty: packet receiver
+	sourceConnection, counterpartyConnection *TestConnection,
+	packet channeltypes.Packet, proxies ProxyPair,
+) error {
+	return coord.timeoutPacketWithProxy(source, counterparty, sourceConnection, counterpartyConnection, packet, proxies, true)
+}
+
+func (coord *Coordinator) timeoutPacketWithProxy(
+	source, counterparty *TestChain,
+	sourceConnection, counterpartyConnection *TestConnection,
+	packet channeltypes.Packet, proxies ProxyPair,
+	onClose bool,
+) error {
+	channel, found := counterparty.App.GetIBCKeeper().ChannelKeeper.GetChannel(counterparty.GetContext(), packet.GetDestPort(), packet.GetDestChannel())
+	if !found {
+		return fmt.Errorf("channel '%v:%v' not found", packet.GetDestPort(), packet.GetDestChannel())
+	}
+
+	var (
+		unreceivedKey    []byte
+		nextSequenceRecv uint64
+	)
+	switch channel.Ordering {
+	case channeltypes.ORDERED:
+		unreceivedKey = host.NextSequenceRecvKey(packet.GetDestPort(), packet.GetDestChannel())
+		nextSequenceRecv, _ = counterparty.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(counterparty.GetContext(), packet.GetDestPort(), packet.GetDestChannel())
+	default:
+		unreceivedKey = host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+		nextSequenceRecv = packet.GetSequence()
+	}
+	channelKey := host.ChannelKey(packet.GetDestPort(), packet.GetDestChannel())
+
+	var (
+		proofUnreceived, proofClose []byte
+		proofHeight                 clienttypes.Height
+	)
+	if proxies[0] == nil {
+		if err := coord.UpdateClient(source, counterparty, sourceConnection.ClientID, exported.Tendermint); err != nil {
+			return err
+		}
+		proofUnreceived, proofHeight = counterparty.QueryProof(unreceivedKey)
+		proofClose, _ = counterparty.QueryProof(channelKey)
+	} else {
+		// source: downstream, counterparty: upstream
+		proxy := proxies[0].Chain
+
+		if err := coord.UpdateClient(proxy, counterparty, proxies[0].UpstreamClientID, exported.Tendermint); err != nil {
+			return err
+		}
+
+		upstreamProofUnreceived, upstreamProofHeight := counterparty.QueryProof(unreceivedKey)
+		var msg sdk.Msg
+		if onClose {
+			upstreamProofClose, _ := counterparty.QueryProof(channelKey)
+			msg = &proxytypes.MsgProxyTimeoutOnClose{
+				UpstreamClientId: proxies[0].UpstreamClientID,
+				UpstreamPrefix:   proxies[0].UpstreamPrefix.(commitmenttypes.MerklePrefix),
+				Packet:           packet,
+				ProofUnreceived:  upstreamProofUnreceived,
+				ProofClose:       upstreamProofClose,
+				ProofHeight:      upstreamProofHeight,
+				NextSequenceRecv: nextSequenceRecv,
+				Signer:           proxy.SenderAccount.GetAddress().String(),
+			}
+		} else {
+			msg = &proxytypes.MsgProxyTimeoutPacket{
+				UpstreamClientId: proxies[0].UpstreamClientID,
+				UpstreamPrefix:   proxies[0].UpstreamPrefix.(commitmenttypes.MerklePrefix),
+				Packet:           packet,
+				ProofUnreceived:  upstreamProofUnreceived,
+				ProofHeight:      upstreamProofHeight,
+				NextSequenceRecv: nextSequenceRecv,
+				Signer:           proxy.SenderAccount.GetAddress().String(),
+			}
+		}
+		if _, err := proxy.SendMsgs(msg); err != nil {
+			return err
+		}
+		coord.CommitBlock(proxy)
+
+		if err := source.UpdateProxyClient(proxy, proxies[0].ClientID); err != nil {
+			return err
+		}
+		coord.CommitBlock(source)
+
+		switch channel.Ordering {
+		case channeltypes.ORDERED:
+			proofUnreceived, proofHeight = proxy.QueryProxyNextSequenceRecvProof(packet.GetDestPort(), packet.GetDestChannel(), proxies[0].UpstreamPrefix, proxies[0].UpstreamClientID)
+		default:
+			proofUnreceived, proofHeight = proxy.QueryProxyPacketReceiptAbsenceProof(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(), proxies[0].UpstreamPrefix, proxies[0].UpstreamClientID)
+		}
+		proofClose, _ = proxy.QueryProxyChannelStateProof(packet.GetDestPort(), packet.GetDestChannel(), proxies[0].UpstreamPrefix, proxies[0].UpstreamClientID)
+	}
+
+	var msg sdk.Msg
+	if onClose {
+		msg = channeltypes.NewMsgTimeoutOnClose(packet, nextSequenceRecv, proofUnreceived, proofClose, proofHeight, source.SenderAccount.GetAddress().String())
+	} else {
+		msg = channeltypes.NewMsgTimeout(packet, nextSequenceRecv, proofUnreceived, proofHeight, source.SenderAccount.GetAddress().String())
+	}
+	if _, err := source.SendMsgs(msg); err != nil {
+		return err
+	}
+	coord.CommitBlock(source)
+
+	if proxies[1] == nil {
+		return coord.UpdateClient(
+			counterparty, source, counterpartyConnection.ClientID, exported.Tendermint,
+		)
+	} else {
+		return coord.UpdateClient(
+			proxies[1].Chain, source, proxies[1].UpstreamClientID, exported.Tendermint,
+		)
+	}
+}
+
 func (chain *TestChain) recvPacket(coord *Coordinator, counterparty *TestChain, sourceClient string, packet channeltypes.Packet) error {
 	// get proof of packet commitment on source
 	packetKey := host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
@@ -993,8 +1553,42 @@ func (chain *TestChain) QueryProxyConsensusStateProof(clientID string, upstreamP
 	return proofConsensus, consensusHeight
 }
 
+// QueryProxyConnectionStateProof returns the proof of the proxied connection end with the proof of the upstream client at the same height,
+// which the proxy client bound to the upstream chain verifies
 func (chain *TestChain) QueryProxyConnectionStateProof(connectionID string, upstreamPrefix exported.Prefix, upstreamClientID string) ([]byte, clienttypes.Height) {
-	return chain.QueryProxyProof(proxytypes.ProxyConnectionKey(upstreamPrefix, upstreamClientID, connectionID))
+	proof, proofHeight := chain.QueryProxyProof(proxytypes.ProxyConnectionKey(upstreamPrefix, upstreamClientID, connectionID))
+	upstreamClientProof, err := chain.QueryUpstreamClientProof(upstreamClientID)
+	require.NoError(chain.t, err)
+	bz, err := chain.App.AppCodec().Marshal(proxyclienttypes.NewConnectionProof(proof, *upstreamClientProof))
+	require.NoError(chain.t, err)
+	return bz, proofHeight
+}
+
+// QueryUpstreamClientProof returns the upstream client state that the proxy has committed with the proof of it
+// at the same height as the proofs of QueryProof and QueryProxyProof
+func (chain *TestChain) QueryUpstreamClientProof(upstreamClientID string) (*proxyclienttypes.UpstreamClientProof, error) {
+	res := chain.App.Query(abci.RequestQuery{
+		Path:   fmt.Sprintf("store/%s/key", host.StoreKey),
+		Height: chain.App.LastBlockHeight() - 1,
+		Data:   host.FullClientStateKey(upstreamClientID),
+		Prove:  true,
+	})
+	if len(res.Value) == 0 {
+		return nil, fmt.Errorf("upstream client %v has not been committed on %v", upstreamClientID, chain.ChainID)
+	}
+	upstreamClientState, err := clienttypes.UnmarshalClientState(chain.App.AppCodec(), res.Value)
+	if err != nil {
+		return nil, err
+	}
+	merkleProof, err := commitmenttypes.ConvertProofs(res.ProofOps)
+	if err != nil {
+		return nil, err
+	}
+	proof, err := chain.App.AppCodec().Marshal(&merkleProof)
+	if err != nil {
+		return nil, err
+	}
+	return proxyclienttypes.NewUpstreamClientProof(upstreamClientState, proof)
 }
 
 func (chain *TestChain) QueryProxyChannelStateProof(portID string, channelID string, upstreamPrefix exported.Prefix, upstreamClientID string) ([]byte, clienttypes.Height) {
@@ -1009,6 +1603,14 @@ func (chain *TestChain) QueryProxyAcknowledgementProof(destPort, destChannel str
 	return chain.QueryProxyProof(proxytypes.ProxyAcknowledgementKey(upstreamPrefix, upstreamClientID, destPort, destChannel, packetSequence))
 }
 
+func (chain *TestChain) QueryProxyPacketReceiptAbsenceProof(destPort, destChannel string, packetSequence uint64, upstreamPrefix exported.Prefix, upstreamClientID string) ([]byte, clienttypes.Height) {
+	return chain.QueryProxyProof(proxytypes.ProxyPacketReceiptKey(upstreamPrefix, upstreamClientID, destPort, destChannel, packetSequence))
+}
+
+func (chain *TestChain) QueryProxyNextSequenceRecvProof(destPort, destChannel string, upstreamPrefix exported.Prefix, upstreamClientID string) ([]byte, clienttypes.Height) {
+	return chain.QueryProxyProof(proxytypes.ProxyNextSequenceRecvKey(upstreamPrefix, upstreamClientID, destPort, destChannel))
+}
+
 // QueryProof performs an abci query with the given key and returns the proto encoded merkle proof
 // for the query and the height at which the proof will succeed on a tendermint verifier.
 func (chain *TestChain) QueryProxyProof(key []byte) ([]byte, clienttypes.Height) {
@@ -0,0 +1,593 @@
+package ibctesting_test
+
+import (
+	"fmt"
+	"strings"
+
+	ics23 "github.com/confio/ics23/go"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/modules/core/24-host"
+	"github.com/spf13/cobra"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+
+	proxyclienttypes "github.com/datachainlab/ibc-proxy/modules/light-clients/xx-proxy/types"
+	"github.com/datachainlab/ibc-proxy/modules/proxy/client/cli"
+	proxytypes "github.com/datachainlab/ibc-proxy/modules/proxy/types"
+)
+
+const (
+	testUpstreamClientID = "07-tendermint-0"
+	testConnectionID     = "connection-0"
+	testChannelID        = "channel-0"
+)
+
+var (
+	testConnection = connectiontypes.NewConnectionEnd(
+		connectiontypes.OPEN, "07-tendermint-1",
+		connectiontypes.NewCounterparty("07-tendermint-2", "connection-1", commitmenttypes.NewMerklePrefix([]byte("ibc"))),
+		[]*connectiontypes.Version{connectiontypes.DefaultIBCVersion}, 0,
+	)
+	testChannel = channeltypes.NewChannel(
+		channeltypes.OPEN, channeltypes.UNORDERED, channeltypes.NewCounterparty("transfer", "channel-1"), []string{testConnectionID}, "ics20-1",
+	)
+	testPacketFee = proxytypes.NewPacketFee(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)), sdk.AccAddress("refund_address______").String())
+)
+
+// proxyGenesisState returns a genesis state that has the proxy states of an upstream
+func proxyGenesisState() *proxytypes.GenesisState {
+	upstream := proxytypes.NewUpstreamGenesisState(testUpstreamClientID, commitmenttypes.NewMerklePrefix([]byte("ibc")))
+	upstream.Connections = []connectiontypes.IdentifiedConnection{connectiontypes.NewIdentifiedConnection(testConnectionID, testConnection)}
+	upstream.Channels = []channeltypes.IdentifiedChannel{channeltypes.NewIdentifiedChannel("transfer", testChannelID, testChannel)}
+	upstream.Commitments = []channeltypes.PacketState{channeltypes.NewPacketState("transfer", testChannelID, 1, []byte("commitment"))}
+	upstream.Acknowledgements = []channeltypes.PacketState{channeltypes.NewPacketState("transfer", testChannelID, 1, []byte("ack"))}
+	gs := proxytypes.NewGenesisState([]proxytypes.UpstreamGenesisState{upstream}, proxytypes.DefaultParams())
+	gs.IncentivizedPackets = []proxytypes.IncentivizedPacket{
+		proxytypes.NewIncentivizedPacket(testUpstreamClientID, upstream.UpstreamPrefix, proxytypes.PacketFromUpstream, "transfer", testChannelID, 2, []proxytypes.PacketFee{testPacketFee}),
+		proxytypes.NewIncentivizedPacket(testUpstreamClientID, upstream.UpstreamPrefix, proxytypes.PacketToUpstream, "transfer", testChannelID, 2, []proxytypes.PacketFee{testPacketFee, testPacketFee}),
+	}
+	gs.PausedProxies = []proxytypes.PausedProxy{proxytypes.NewPausedProxy(testUpstreamClientID, "transfer", "channel-1")}
+	return gs
+}
+
+func (s *IntegrationTestSuite) TestProxyQueryCmds() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name   string
+		cmd    *cobra.Command
+		args   []string
+		resp   codec.ProtoMarshaler
+		expErr bool
+	}{
+		{"connection", cli.GetCmdQueryProxyConnection(), []string{testUpstreamClientID, testConnectionID}, &proxytypes.QueryProxyConnectionResponse{}, false},
+		{"channel", cli.GetCmdQueryProxyChannel(), []string{testUpstreamClientID, "transfer", testChannelID}, &proxytypes.QueryProxyChannelResponse{}, false},
+		{"packet-commitment", cli.GetCmdQueryProxyPacketCommitment(), []string{testUpstreamClientID, "transfer", testChannelID, "1"}, &proxytypes.QueryProxyPacketCommitmentResponse{}, false},
+		{"packet-ack", cli.GetCmdQueryProxyPacketAcknowledgement(), []string{testUpstreamClientID, "transfer", testChannelID, "1"}, &proxytypes.QueryProxyPacketAcknowledgementResponse{}, false},
+		{"client-state not found", cli.GetCmdQueryProxyClientState(), []string{testUpstreamClientID, "07-tendermint-1"}, nil, true},
+		{"consensus-state not found", cli.GetCmdQueryProxyConsensusState(), []string{testUpstreamClientID, "07-tendermint-1", "0-1"}, nil, true},
+		{"unknown upstream prefix", cli.GetCmdQueryProxyConnection(), []string{testUpstreamClientID, testConnectionID, fmt.Sprintf("--%s=other", cli.FlagUpstreamPrefix)}, nil, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		for _, prove := range []bool{true, false} {
+			s.Run(fmt.Sprintf("%s prove=%v", tc.name, prove), func() {
+				args := append(tc.args, fmt.Sprintf("--%s=%v", flags.FlagProve, prove), fmt.Sprintf("--%s=json", tmcli.OutputFlag))
+				out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, tc.cmd, args)
+				if tc.expErr {
+					s.Require().Error(err)
+					return
+				}
+				s.Require().NoError(err)
+				s.Require().NoError(val.ClientCtx.JSONCodec.UnmarshalJSON(out.Bytes(), tc.resp))
+
+				switch res := tc.resp.(type) {
+				case *proxytypes.QueryProxyConnectionResponse:
+					s.Require().Equal(testConnection, *res.Connection)
+					s.checkProof(prove, res.Proof)
+				case *proxytypes.QueryProxyChannelResponse:
+					s.Require().Equal(testChannel, *res.Channel)
+					s.checkProof(prove, res.Proof)
+				case *proxytypes.QueryProxyPacketCommitmentResponse:
+					s.Require().Equal([]byte("commitment"), res.Commitment)
+					s.checkProof(prove, res.Proof)
+				case *proxytypes.QueryProxyPacketAcknowledgementResponse:
+					s.Require().Equal([]byte("ack"), res.Acknowledgement)
+					s.checkProof(prove, res.Proof)
+				}
+			})
+		}
+	}
+}
+
+func (s *IntegrationTestSuite) TestIncentivizedPacketQueryCmds() {
+	val := s.network.Validators[0]
+	expected := proxyGenesisState().IncentivizedPackets
+
+	for _, args := range [][]string{nil, {testUpstreamClientID}} {
+		out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdQueryIncentivizedPackets(), append(args, fmt.Sprintf("--%s=json", tmcli.OutputFlag)))
+		s.Require().NoError(err)
+		var res proxytypes.QueryIncentivizedPacketsResponse
+		s.Require().NoError(val.ClientCtx.JSONCodec.UnmarshalJSON(out.Bytes(), &res))
+		s.Require().Equal(expected, res.IncentivizedPackets)
+	}
+
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdQueryIncentivizedPackets(), []string{"07-tendermint-1", fmt.Sprintf("--%s=json", tmcli.OutputFlag)})
+	s.Require().NoError(err)
+	var res proxytypes.QueryIncentivizedPacketsResponse
+	s.Require().NoError(val.ClientCtx.JSONCodec.UnmarshalJSON(out.Bytes(), &res))
+	s.Require().Empty(res.IncentivizedPackets)
+
+	// the packets with the same sequence on the same channel are distinguished by their direction
+	for i, args := range [][]string{nil, {fmt.Sprintf("--%s", cli.FlagToUpstream)}} {
+		out, err = clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdQueryIncentivizedPacket(), append([]string{testUpstreamClientID, "transfer", testChannelID, "2", fmt.Sprintf("--%s=json", tmcli.OutputFlag)}, args...))
+		s.Require().NoError(err)
+		var resPacket proxytypes.QueryIncentivizedPacketResponse
+		s.Require().NoError(val.ClientCtx.JSONCodec.UnmarshalJSON(out.Bytes(), &resPacket))
+		s.Require().Equal(expected[i], resPacket.IncentivizedPacket)
+	}
+
+	_, err = clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdQueryIncentivizedPacket(), []string{testUpstreamClientID, "transfer", testChannelID, "1"})
+	s.Require().Error(err)
+}
+
+func (s *IntegrationTestSuite) TestPausedProxiesQueryCmd() {
+	val := s.network.Validators[0]
+
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdQueryPausedProxies(), []string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)})
+	s.Require().NoError(err)
+	var res proxytypes.QueryPausedProxiesResponse
+	s.Require().NoError(val.ClientCtx.JSONCodec.UnmarshalJSON(out.Bytes(), &res))
+	s.Require().Equal([]proxytypes.PausedProxy{proxytypes.NewPausedProxy(testUpstreamClientID, "transfer", "channel-1")}, res.PausedProxies)
+}
+
+func (s *IntegrationTestSuite) TestUpstreamChainQueryCmd() {
+	val := s.network.Validators[0]
+
+	_, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdQueryUpstreamChain(), []string{"07-tendermint-1", fmt.Sprintf("--%s=json", tmcli.OutputFlag)})
+	s.Require().Error(err)
+	s.Require().Contains(err.Error(), "not found")
+	_, err = clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdQueryUpstreamChain(), []string{"invalid/client", fmt.Sprintf("--%s=json", tmcli.OutputFlag)})
+	s.Require().Error(err)
+}
+
+func (s *IntegrationTestSuite) checkProof(prove bool, proof []byte) {
+	if prove {
+		s.Require().NotEmpty(proof)
+	} else {
+		s.Require().Empty(proof)
+	}
+}
+
+func (s *IntegrationTestSuite) TestCommitmentPathCmd() {
+	val := s.network.Validators[0]
+
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdCommitmentPath(), []string{testUpstreamClientID, "connections/connection-0"})
+	s.Require().NoError(err)
+	s.Require().Equal("/proxy/15:07-tendermint-0/3:ibc/connections/connection-0\n", out.String())
+
+	out, err = clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdCommitmentPath(), []string{
+		testUpstreamClientID, string(host.PacketCommitmentKey("transfer", testChannelID, 1)),
+		fmt.Sprintf("--%s=other", cli.FlagProxyPrefix), fmt.Sprintf("--%s=store/ibc", cli.FlagUpstreamPrefix),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal("/other/15:07-tendermint-0/9:store/ibc/commitments/ports/transfer/channels/channel-0/sequences/1\n", out.String())
+
+	// '%' in the upstream prefix is escaped in the merkle path
+	out, err = clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdCommitmentPath(), []string{
+		testUpstreamClientID, "connections/connection-0", fmt.Sprintf("--%s=a%%2Fb", cli.FlagUpstreamPrefix),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal("/proxy/15:07-tendermint-0/5:a%252Fb/connections/connection-0\n", out.String())
+
+	// the path is the one that the proxy client verifies
+	proxyPrefix, upstreamPrefix := commitmenttypes.NewMerklePrefix([]byte("proxy")), commitmenttypes.NewMerklePrefix([]byte("ibc"))
+	merklePath, err := commitmenttypes.ApplyPrefix(
+		commitmenttypes.MultiPrefix{Prefix: &proxyPrefix, PathPrefix: []byte("15:" + testUpstreamClientID + "/3:" + string(upstreamPrefix.Bytes()))},
+		commitmenttypes.NewMerklePath(host.ConnectionPath(testConnectionID)),
+	)
+	s.Require().NoError(err)
+	path, err := proxytypes.ProxyCommitmentPath(&proxyPrefix, &upstreamPrefix, testUpstreamClientID, host.ConnectionPath(testConnectionID))
+	s.Require().NoError(err)
+	s.Require().Equal("/"+strings.Join(merklePath.KeyPath, "/"), path)
+}
+
+func (s *IntegrationTestSuite) TestProxyTxCmds() {
+	val := s.network.Validators[0]
+	cdc := val.ClientCtx.JSONCodec
+
+	clientStateJSON := testutil.WriteToNewTempFile(
+		s.T(),
+		`{"@type":"/ibc.lightclients.solomachine.v2.ClientState","sequence":"1","is_frozen":false,"consensus_state":{"public_key":{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"AtK50+5pJOoaa04qqAqrnyAqsYrwrR/INnA6UPIaYZlp"},"diversifier":"testing","timestamp":"10"},"allow_update_after_proposal":false}`,
+	).Name()
+	consensusJSON := testutil.WriteToNewTempFile(
+		s.T(),
+		`{"@type":"/ibc.lightclients.solomachine.v2.ConsensusState","public_key":{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"AtK50+5pJOoaa04qqAqrnyAqsYrwrR/INnA6UPIaYZlp"},"diversifier":"testing","timestamp":"10"}`,
+	).Name()
+	proofJSON := testutil.WriteToNewTempFile(s.T(), string(cdc.MustMarshalJSON(&commitmenttypes.MerkleProof{}))).Name()
+	// the messages that are not verified against handshake states reject empty proofs
+	nonEmptyProofJSON := testutil.WriteToNewTempFile(s.T(), string(cdc.MustMarshalJSON(&commitmenttypes.MerkleProof{
+		Proofs: []*ics23.CommitmentProof{{Proof: &ics23.CommitmentProof_Exist{Exist: &ics23.ExistenceProof{Key: []byte("key")}}}},
+	}))).Name()
+
+	connection := connectiontypes.NewConnectionEnd(
+		connectiontypes.INIT, "07-tendermint-0",
+		connectiontypes.NewCounterparty("07-tendermint-1", "", commitmenttypes.NewMerklePrefix([]byte("ibc"))),
+		[]*connectiontypes.Version{connectiontypes.DefaultIBCVersion}, 0,
+	)
+	connectionJSON := testutil.WriteToNewTempFile(s.T(), string(cdc.MustMarshalJSON(&connection))).Name()
+
+	openConnectionJSON := testutil.WriteToNewTempFile(s.T(), string(cdc.MustMarshalJSON(&testConnection))).Name()
+	channelJSON := testutil.WriteToNewTempFile(s.T(), string(cdc.MustMarshalJSON(&testChannel))).Name()
+
+	packet := channeltypes.NewPacket([]byte("data"), 1, "transfer", "channel-0", "transfer", "channel-1", clienttypes.NewHeight(0, 100), 0)
+	packetJSON := testutil.WriteToNewTempFile(s.T(), string(cdc.MustMarshalJSON(&packet))).Name()
+	ackFile := testutil.WriteToNewTempFile(s.T(), `{"result":"AQ=="}`).Name()
+	headerJSON := testutil.WriteToNewTempFile(
+		s.T(),
+		`{"@type":"/ibc.lightclients.solomachine.v2.Header","sequence":"1","timestamp":"10","signature":null,"new_public_key":{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"AtK50+5pJOoaa04qqAqrnyAqsYrwrR/INnA6UPIaYZlp"},"new_diversifier":"testing"}`,
+	).Name()
+	recvPacketMsg := &proxytypes.MsgProxyRecvPacket{UpstreamClientId: "07-tendermint-0", Packet: packet, ProofHeight: clienttypes.NewHeight(0, 10), Signer: val.Address.String()}
+	recvPacketJSON, err := cdc.(codec.Codec).MarshalInterfaceJSON(recvPacketMsg)
+	s.Require().NoError(err)
+	batchItems := []proxytypes.ProxyPacketBatchItem{{Packet: packet}, {Packet: packet, Acknowledgement: []byte(`{"result":"AQ=="}`)}}
+	batchItemsJSON := testutil.WriteToNewTempFile(s.T(), string(cdc.MustMarshalJSON(&proxytypes.MsgProxyPacketBatch{Items: batchItems}))).Name()
+
+	misbehaviour := proxyclienttypes.ProxyMisbehaviour{
+		ClientId:            "proxyclient-0",
+		UpstreamClientId:    "07-tendermint-1",
+		UpstreamPrefix:      commitmenttypes.NewMerklePrefix([]byte("ibc")),
+		Path:                host.PacketCommitmentPath("transfer", "channel-0", 1),
+		ProxyValue:          []byte("fraud"),
+		ProxyProof:          []byte("proof"),
+		ProxyProofHeight:    clienttypes.NewHeight(0, 10),
+		UpstreamValue:       []byte("commitment"),
+		UpstreamProof:       []byte("proof"),
+		UpstreamProofHeight: clienttypes.NewHeight(0, 10),
+	}
+	misbehaviourJSON := testutil.WriteToNewTempFile(s.T(), string(cdc.MustMarshalJSON(&misbehaviour))).Name()
+
+	proofHeight, consensusHeight := "0-10", "0-5"
+	connArgs := []string{
+		"connection-0", connectionJSON, clientStateJSON, consensusJSON, clientStateJSON,
+		proofJSON, proofJSON, proofJSON, proofHeight, consensusHeight, proofJSON, proofJSON, proofHeight, consensusHeight,
+	}
+	expPrefix := commitmenttypes.NewMerklePrefix([]byte("ibc"))
+
+	testCases := []struct {
+		name  string
+		cmd   *cobra.Command
+		args  []string
+		check func(msg sdk.Msg)
+	}{
+		{
+			"client-state",
+			cli.NewProxyClientStateCmd(),
+			[]string{"07-tendermint-0", "07-tendermint-1", clientStateJSON, consensusJSON, proofJSON, proofJSON, proofHeight, consensusHeight},
+			func(msg sdk.Msg) {
+				m := msg.(*proxytypes.MsgProxyClientState)
+				s.Require().Equal("07-tendermint-0", m.UpstreamClientId)
+				s.Require().Equal("07-tendermint-1", m.CounterpartyClientId)
+				s.Require().Equal(expPrefix, m.UpstreamPrefix)
+				s.Require().Equal(clienttypes.NewHeight(0, 5), m.ConsensusHeight)
+				s.Require().NotNil(m.ClientState)
+				s.Require().NotNil(m.ConsensusState)
+			},
+		},
+		{
+			"connection-open-try",
+			cli.NewProxyConnectionOpenTryCmd(),
+			connArgs,
+			func(msg sdk.Msg) {
+				m := msg.(*proxytypes.MsgProxyConnectionOpenTry)
+				s.Require().Equal("connection-0", m.ConnectionId)
+				s.Require().Equal(connection, m.Connection)
+				s.Require().Equal(clienttypes.NewHeight(0, 10), m.ProofProxyHeight)
+			},
+		},
+		{
+			"connection-open-ack",
+			cli.NewProxyConnectionOpenAckCmd(),
+			connArgs,
+			func(msg sdk.Msg) {
+				m := msg.(*proxytypes.MsgProxyConnectionOpenAck)
+				s.Require().Equal("connection-0", m.ConnectionId)
+				s.Require().Equal(clienttypes.NewHeight(0, 5), m.ProxyConsensusHeight)
+			},
+		},
+		{
+			"connection-open-confirm",
+			cli.NewProxyConnectionOpenConfirmCmd(),
+			[]string{"connection-0", "07-tendermint-0", "connection-1", proofJSON, proofHeight},
+			func(msg sdk.Msg) {
+				m := msg.(*proxytypes.MsgProxyConnectionOpenConfirm)
+				s.Require().Equal("connection-1", m.CounterpartyConnectionId)
+			},
+		},
+		{
+			"connection-open-finalize",
+			cli.NewProxyConnectionOpenFinalizeCmd(),
+			[]string{"connection-0", "07-tendermint-0", proofJSON, proofHeight, fmt.Sprintf("--%s=other", cli.FlagUpstreamPrefix)},
+			func(msg sdk.Msg) {
+				m := msg.(*proxytypes.MsgProxyConnectionOpenFinalize)
+				s.Require().Equal(commitmenttypes.NewMerklePrefix([]byte("other")), m.UpstreamPrefix)
+			},
+		},
+		{
+			"connection-state",
+			cli.NewProxyConnectionStateCmd(),
+			[]string{"connection-0", "07-tendermint-0", openConnectionJSON, nonEmptyProofJSON, proofHeight},
+			func(msg sdk.Msg) {
+				m := msg.(*proxytypes.MsgProxyConnectionState)
+				s.Require().Equal(testConnection, m.Connection)
+				s.Require().Equal(expPrefix, m.UpstreamPrefix)
+			},
+		},
+		{
+			"channel-open-try",
+			cli.NewProxyChannelOpenTryCmd(),
+			[]string{
+				"07-tendermint-0", "connection-0,connection-1", "transfer", "channel-0", "transfer", "ics20-1", proofJSON, proofHeight,
+				fmt.Sprintf("--%s=false", cli.FlagOrdered), fmt.Sprintf("--%s=07-tendermint-1:ibc", cli.FlagUpstreamHops),
+			},
+			func(msg sdk.Msg) {
+				m := msg.(*proxytypes.MsgProxyChannelOpenTry)
+				s.Require().Equal(channeltypes.UNORDERED, m.Order)
+				s.Require().Equal([]string{"connection-0", "connection-1"}, m.ConnectionHops)
+				s.Require().Equal([]proxytypes.ProxyConnectionHop{{UpstreamClientId: "07-tendermint-1", UpstreamPrefix: expPrefix}}, m.UpstreamHops)
+			},
+		},
+		{
+			"channel-open-ack",
+			cli.NewProxyChannelOpenAckCmd(),
+			[]string{"07-tendermint-0", "connection-0", "transfer", "channel-0", "transfer", "channel-1", "ics20-1", proofJSON, proofHeight},
+			func(msg sdk.Msg) {
+				m := msg.(*proxytypes.MsgProxyChannelOpenAck)
+				s.Require().Equal(channeltypes.ORDERED, m.Order)
+				s.Require().Equal("channel-1", m.DownstreamChannelId)
+				s.Require().Empty(m.UpstreamHops)
+			},
+		},
+		{
+			"channel-open-confirm",
+			cli.NewProxyChannelOpenConfirmCmd(),
+			[]string{"07-tendermint-0", "transfer", "channel-0", "channel-1", proofJSON, proofHeight},
+			func(msg sdk.Msg) {
+				m := msg.(*proxytypes.MsgProxyChannelOpenConfirm)
+				s.Require().Equal("channel-1", m.DownstreamChannelId)
+			},
+		},
+		{
+			"channel-open-finalize",
+			cli.NewProxyChannelOpenFinalizeCmd(),
+			[]string{"07-tendermint-0", "transfer", "channel-0", proofJSON, proofHeight},
+			func(msg sdk.Msg) {
+				m := msg.(*proxytypes.MsgProxyChannelOpenFinalize)
+				s.Require().Equal("channel-0", m.ChannelId)
+			},
+		},
+		{
+			"channel-close-confirm",
+			cli.NewProxyChannelCloseConfirmCmd(),
+			[]string{"07-tendermint-0", "transfer", "channel-0", proofJSON, proofHeight},
+			func(msg sdk.Msg) {
+				m := msg.(*proxytypes.MsgProxyChannelCloseConfirm)
+				s.Require().Equal("channel-0", m.ChannelId)
+			},
+		},
+		{
+			"channel-state",
+			cli.NewProxyChannelStateCmd(),
+			[]string{"07-tendermint-0", "transfer", "channel-0", channelJSON, nonEmptyProofJSON, proofHeight},
+			func(msg sdk.Msg) {
+				m := msg.(*proxytypes.MsgProxyChannelState)
+				s.Require().Equal("channel-0", m.ChannelId)
+				s.Require().Equal(testChannel, m.Channel)
+			},
+		},
+		{
+			"recv-packet",
+			cli.NewProxyRecvPacketCmd(),
+			[]string{"07-tendermint-0", packetJSON, proofJSON, proofHeight},
+			func(msg sdk.Msg) {
+				m := msg.(*proxytypes.MsgProxyRecvPacket)
+				s.Require().Equal(packet, m.Packet)
+			},
+		},
+		{
+			"acknowledge-packet",
+			cli.NewProxyAcknowledgePacketCmd(),
+			[]string{"07-tendermint-0", packetJSON, ackFile, proofJSON, proofHeight},
+			func(msg sdk.Msg) {
+				m := msg.(*proxytypes.MsgProxyAcknowledgePacket)
+				s.Require().Equal(packet, m.Packet)
+				s.Require().Equal([]byte(`{"result":"AQ=="}`), m.Acknowledgement)
+			},
+		},
+		{
+			"packet-batch",
+			cli.NewProxyPacketBatchCmd(),
+			[]string{"07-tendermint-0", batchItemsJSON, proofHeight, fmt.Sprintf("--%s=%s", cli.FlagBatchProof, proofJSON), fmt.Sprintf("--%s=true", cli.FlagAtomic)},
+			func(msg sdk.Msg) {
+				m := msg.(*proxytypes.MsgProxyPacketBatch)
+				s.Require().Equal(batchItems, m.Items)
+				s.Require().True(m.Atomic)
+			},
+		},
+		{
+			"timeout-packet",
+			cli.NewProxyTimeoutPacketCmd(),
+			[]string{"07-tendermint-0", packetJSON, proofJSON, proofHeight, "2"},
+			func(msg sdk.Msg) {
+				m := msg.(*proxytypes.MsgProxyTimeoutPacket)
+				s.Require().Equal(uint64(2), m.NextSequenceRecv)
+			},
+		},
+		{
+			"timeout-on-close",
+			cli.NewProxyTimeoutOnCloseCmd(),
+			[]string{"07-tendermint-0", packetJSON, proofJSON, proofJSON, proofHeight, "2"},
+			func(msg sdk.Msg) {
+				m := msg.(*proxytypes.MsgProxyTimeoutOnClose)
+				s.Require().Equal(uint64(2), m.NextSequenceRecv)
+			},
+		},
+		{
+			"prune-packet-commitment",
+			cli.NewPruneProxyPacketCommitmentCmd(),
+			[]string{"07-tendermint-0", "transfer", "channel-0", "1", proofJSON, proofHeight},
+			func(msg sdk.Msg) {
+				m := msg.(*proxytypes.MsgPruneProxyPacketCommitment)
+				s.Require().Equal("channel-0", m.ChannelId)
+				s.Require().Equal(uint64(1), m.Sequence)
+				s.Require().Equal(clienttypes.NewHeight(0, 10), m.ProofHeight)
+			},
+		},
+		{
+			"with-header",
+			cli.NewProxyWithHeaderCmd(),
+			[]string{"07-tendermint-0", headerJSON, testutil.WriteToNewTempFile(s.T(), string(recvPacketJSON)).Name()},
+			func(msg sdk.Msg) {
+				m := msg.(*proxytypes.MsgProxyWithHeader)
+				s.Require().Equal("07-tendermint-0", m.UpstreamClientId)
+				s.Require().Equal("/ibc.lightclients.solomachine.v2.Header", m.Header.TypeUrl)
+				s.Require().Equal("/ibc.proxy.v1.MsgProxyRecvPacket", m.Msg.TypeUrl)
+			},
+		},
+		{
+			"misbehaviour",
+			cli.NewSubmitProxyMisbehaviourCmd(),
+			[]string{misbehaviourJSON},
+			func(msg sdk.Msg) {
+				m := msg.(*proxytypes.MsgSubmitProxyMisbehaviour)
+				s.Require().Equal(misbehaviour, m.Misbehaviour)
+			},
+		},
+		{
+			"pay-packet-fee",
+			cli.NewPayProxyPacketFeeCmd(),
+			[]string{"07-tendermint-0", "transfer", "channel-0", "1", "10stake", fmt.Sprintf("--%s", cli.FlagToUpstream)},
+			func(msg sdk.Msg) {
+				m := msg.(*proxytypes.MsgPayProxyPacketFee)
+				s.Require().Equal(proxytypes.PacketToUpstream, m.Direction)
+				s.Require().Equal(commitmenttypes.NewMerklePrefix([]byte("ibc")), m.UpstreamPrefix)
+				s.Require().Equal(uint64(1), m.Sequence)
+				s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), m.Fee)
+				s.Require().Equal(val.Address.String(), m.Signer)
+			},
+		},
+		{
+			"register-upstream",
+			cli.NewRegisterUpstreamCmd(),
+			[]string{"07-tendermint-0"},
+			func(msg sdk.Msg) {
+				m := msg.(*proxytypes.MsgRegisterUpstream)
+				s.Require().Equal(val.Address.String(), m.Authority)
+			},
+		},
+		{
+			"deregister-upstream",
+			cli.NewDeregisterUpstreamCmd(),
+			[]string{"07-tendermint-0"},
+			func(msg sdk.Msg) {
+				m := msg.(*proxytypes.MsgDeregisterUpstream)
+				s.Require().Equal("07-tendermint-0", m.UpstreamClientId)
+			},
+		},
+		{
+			"pause-proxy",
+			cli.NewPauseProxyCmd(),
+			[]string{"07-tendermint-0"},
+			func(msg sdk.Msg) {
+				m := msg.(*proxytypes.MsgPauseProxy)
+				s.Require().Equal(proxytypes.NewMsgPauseProxy(val.Address.String(), "07-tendermint-0", "", ""), m)
+			},
+		},
+		{
+			"unpause-proxy",
+			cli.NewUnpauseProxyCmd(),
+			[]string{"07-tendermint-0", "transfer", "channel-0"},
+			func(msg sdk.Msg) {
+				m := msg.(*proxytypes.MsgUnpauseProxy)
+				s.Require().Equal(proxytypes.NewMsgUnpauseProxy(val.Address.String(), "07-tendermint-0", "transfer", "channel-0"), m)
+			},
+		},
+		{
+			"migrate-client",
+			cli.NewMigrateProxyClientCmd(),
+			[]string{
+				"proxyclient-0", "proxyclient-1", clientStateJSON, clientStateJSON, consensusJSON, consensusHeight,
+				nonEmptyProofJSON, nonEmptyProofJSON, proofHeight, nonEmptyProofJSON, nonEmptyProofJSON, proofHeight,
+			},
+			func(msg sdk.Msg) {
+				m := msg.(*proxytypes.MsgMigrateProxyClient)
+				s.Require().Equal(val.Address.String(), m.Authority)
+				s.Require().Equal("proxyclient-1", m.NewClientId)
+				s.Require().Equal(clienttypes.NewHeight(0, 5), m.UpstreamConsensusHeight)
+				s.Require().Equal(m.UpstreamClientState, m.NewUpstreamClientState)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			args := append(tc.args,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+			)
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, tc.cmd, args)
+			s.Require().NoError(err)
+
+			tx, err := val.ClientCtx.TxConfig.TxJSONDecoder()(out.Bytes())
+			s.Require().NoError(err)
+			s.Require().Len(tx.GetMsgs(), 1)
+			tc.check(tx.GetMsgs()[0])
+		})
+	}
+
+	// invalid arguments
+	_, err = clitestutil.ExecTestCLICmd(val.ClientCtx, cli.NewProxyRecvPacketCmd(), []string{
+		"07-tendermint-0", "invalid.json", proofJSON, proofHeight,
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()), fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+	})
+	s.Require().Error(err)
+	_, err = clitestutil.ExecTestCLICmd(val.ClientCtx, cli.NewProxyChannelOpenTryCmd(), []string{
+		"07-tendermint-0", "connection-0", "transfer", "channel-0", "transfer", "ics20-1", proofJSON, proofHeight,
+		fmt.Sprintf("--%s=07-tendermint-1", cli.FlagUpstreamHops),
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()), fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+	})
+	s.Require().Error(err)
+	_, err = clitestutil.ExecTestCLICmd(val.ClientCtx, cli.NewProxyPacketBatchCmd(), []string{
+		"07-tendermint-0", "{}", proofHeight,
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()), fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+	})
+	s.Require().Error(err)
+	_, err = clitestutil.ExecTestCLICmd(val.ClientCtx, cli.NewPauseProxyCmd(), []string{
+		"07-tendermint-0", "transfer",
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()), fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+	})
+	s.Require().Error(err)
+
+	// the validator isn't the authority of the proxy module
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.NewRegisterUpstreamCmd(), []string{
+		"07-tendermint-0",
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+	})
+	s.Require().NoError(err)
+	var txRes sdk.TxResponse
+	s.Require().NoError(val.ClientCtx.JSONCodec.UnmarshalJSON(out.Bytes(), &txRes))
+	s.Require().Equal(sdkerrors.ErrUnauthorized.ABCICode(), txRes.Code)
+}
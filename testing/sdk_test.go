@@ -29,6 +29,7 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	ibcclientcli "github.com/cosmos/ibc-go/modules/core/02-client/client/cli"
+	proxytypes "github.com/datachainlab/ibc-proxy/modules/proxy/types"
 	"github.com/datachainlab/ibc-proxy/testing/simapp"
 )
 
@@ -52,6 +53,7 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	cfg := DefaultConfig()
 
 	cfg.NumValidators = 2
+	cfg.GenesisState[proxytypes.ModuleName] = cfg.Codec.MustMarshalJSON(proxyGenesisState())
 
 	s.cfg = cfg
 	s.network = network.New(s.T(), cfg)
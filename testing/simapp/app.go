@@ -151,6 +151,7 @@ var (
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 		govtypes.ModuleName:            {authtypes.Burner},
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
+		ibcproxytypes.ModuleName:       nil,
 	}
 )
 
@@ -199,6 +200,9 @@ type SimApp struct {
 	ScopedTransferKeeper capabilitykeeper.ScopedKeeper
 	ScopedIBCMockKeeper  capabilitykeeper.ScopedKeeper
 
+	// make the proxy hooks public for test purposes
+	ProxyHooks *ibcmock.ProxyHooks
+
 	// the module manager
 	mm *module.Manager
 
@@ -309,8 +313,12 @@ func NewSimApp(
 	app.IBCKeeper = applyPatchToIBCKeeper(*ibcKeeper, appCodec, keys[ibchost.StoreKey], app.GetSubspace(ibchost.ModuleName))
 
 	app.IBCProxyKeeper = ibcproxykeeper.NewKeeper(
-		appCodec, keys[ibcproxytypes.StoreKey], keys[ibchost.StoreKey], app.IBCKeeper.ClientKeeper,
+		appCodec, keys[ibcproxytypes.StoreKey], keys[ibchost.StoreKey], app.GetSubspace(ibcproxytypes.ModuleName), app.IBCKeeper.ClientKeeper, app.BankKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
+	// register the proxy hooks before the module copies the keeper
+	app.ProxyHooks = &ibcmock.ProxyHooks{}
+	app.IBCProxyKeeper.SetHooks(ibcproxytypes.NewMultiProxyHooks(app.ProxyHooks))
 	proxyModule := ibcproxy.NewAppModule(app.IBCProxyKeeper)
 
 	app.AuthzKeeper = authzkeeper.NewKeeper(keys[authzkeeper.StoreKey], appCodec, app.BaseApp.MsgServiceRouter())
@@ -321,7 +329,8 @@ func NewSimApp(
 		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
-		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper))
+		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper)).
+		AddRoute(ibcproxytypes.RouterKey, ibcproxy.NewProxyProposalHandler(app.IBCProxyKeeper))
 	app.GovKeeper = govkeeper.NewKeeper(
 		appCodec, keys[govtypes.StoreKey], app.GetSubspace(govtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
 		&stakingKeeper, govRouter,
@@ -682,6 +691,7 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(crisistypes.ModuleName)
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName)
 	paramsKeeper.Subspace(ibchost.ModuleName)
+	paramsKeeper.Subspace(ibcproxytypes.ModuleName)
 
 	return paramsKeeper
 }
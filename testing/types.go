@@ -12,6 +12,7 @@ type TestConnection struct {
 	ClientID             string
 	CounterpartyClientID string
 	NextChannelVersion   string
+	DelayPeriod          uint64
 	Channels             []TestChannel
 }
 